@@ -48,14 +48,37 @@ FROM pg_type t
 WHERE n.nspname = %%schema string%% AND t.typname = %%enum string%%
 ENDSQL
 
+# postgres domain list query
+COMMENT='Domain represents a domain.'
+$XOBIN $PGDB -N -M -B -T Domain -F PgDomains --query-type-comment "$COMMENT" -o $DEST $EXTRA << ENDSQL
+SELECT
+  format_type(t.oid, NULL)::varchar AS domain_name,
+  format_type(t.typbasetype, t.typtypmod)::varchar AS base_type
+FROM pg_type t
+  JOIN ONLY pg_namespace n ON n.oid = t.typnamespace
+WHERE n.nspname = %%schema string%% AND t.typtype = 'd'
+ENDSQL
+
+# postgres composite type list query
+COMMENT='Composite represents a composite type.'
+$XOBIN $PGDB -N -M -B -T Composite -F PgComposites --query-type-comment "$COMMENT" -o $DEST $EXTRA << ENDSQL
+SELECT
+  c.relname::varchar AS composite_name
+FROM pg_class c
+  JOIN ONLY pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = %%schema string%% AND c.relkind = 'c'
+ENDSQL
+
 # postgres sequence list query
-COMMENT='Sequence represents a table that references a sequence.'
+COMMENT='Sequence represents a table column that references a sequence.'
 $XOBIN $PGDB -N -M -B -T Sequence -F PgSequences -o $DEST $EXTRA << ENDSQL
 SELECT
-  t.relname::varchar AS table_name
+  t.relname::varchar AS table_name,
+  a.attname::varchar AS column_name
 FROM pg_class s
   JOIN pg_depend d ON d.objid = s.oid
   JOIN pg_class t ON d.objid = s.oid AND d.refobjid = t.oid
+  JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = d.refobjsubid
   JOIN pg_namespace n ON n.oid = s.relnamespace
 WHERE n.nspname = %%schema string%% AND s.relkind = 'S'
 ENDSQL
@@ -65,7 +88,8 @@ COMMENT='Proc represents a stored procedure.'
 $XOBIN $PGDB -N -M -B -T Proc -F PgProcs --query-type-comment "$COMMENT" -o $DEST $EXTRA << ENDSQL
 SELECT
   p.proname::varchar AS proc_name,
-  pg_get_function_result(p.oid)::varchar AS return_type
+  pg_get_function_result(p.oid)::varchar AS return_type,
+  p.proretset::boolean AS returns_set
 FROM pg_proc p
   JOIN ONLY pg_namespace n ON p.pronamespace = n.oid
 WHERE n.nspname = %%schema string%%
@@ -75,10 +99,14 @@ ENDSQL
 COMMENT='ProcParam represents a stored procedure param.'
 $XOBIN $PGDB -N -M -B -T ProcParam -F PgProcParams --query-type-comment "$COMMENT" -o $DEST $EXTRA << ENDSQL
 SELECT
-  UNNEST(STRING_TO_ARRAY(oidvectortypes(p.proargtypes), ', '))::varchar AS param_type
+  format_type(a.t, NULL)::varchar AS param_type,
+  COALESCE(p.proargnames[a.n], '')::varchar AS param_name,
+  CASE COALESCE(p.proargmodes[a.n], 'i') WHEN 'o' THEN 'OUT' WHEN 't' THEN 'OUT' WHEN 'b' THEN 'INOUT' ELSE 'IN' END::varchar AS param_mode
 FROM pg_proc p
   JOIN ONLY pg_namespace n ON p.pronamespace = n.oid
+  JOIN LATERAL UNNEST(COALESCE(p.proallargtypes, p.proargtypes::oid[])) WITH ORDINALITY AS a(t, n) ON true
 WHERE n.nspname = %%schema string%% AND p.proname = %%proc string%%
+ORDER BY a.n
 ENDSQL
 
 # postgres table list query
@@ -94,7 +122,7 @@ WHERE n.nspname = %%schema string%% AND c.relkind = %%relkind string%%
 ENDSQL
 
 # postgres table column list query
-FIELDS='FieldOrdinal int,ColumnName string,DataType string,NotNull bool,DefaultValue sql.NullString,IsPrimaryKey bool'
+FIELDS='FieldOrdinal int,ColumnName string,DataType string,NotNull bool,DefaultValue sql.NullString,IsPrimaryKey bool,Comment string'
 COMMENT='Column represents column info.'
 $XOBIN $PGDB -N -M -B -T Column -F PgTableColumns -Z "$FIELDS" --query-type-comment "$COMMENT" -o $DEST $EXTRA << ENDSQL
 SELECT
@@ -103,7 +131,8 @@ SELECT
   format_type(a.atttypid, a.atttypmod)::varchar AS data_type,
   a.attnotnull::boolean AS not_null,
   COALESCE(pg_get_expr(ad.adbin, ad.adrelid), '')::varchar AS default_value,
-  COALESCE(ct.contype = 'p', false)::boolean AS is_primary_key
+  COALESCE(ct.contype = 'p', false)::boolean AS is_primary_key,
+  COALESCE(col_description(c.oid, a.attnum), '')::varchar AS comment
 FROM pg_attribute a
   JOIN ONLY pg_class c ON c.oid = a.attrelid
   JOIN ONLY pg_namespace n ON n.oid = c.relnamespace
@@ -210,7 +239,8 @@ ENDSQL
 $XOBIN $MYDB -a -N -M -B -T Proc -F MyProcs -o $DEST $EXTRA << ENDSQL
 SELECT
   r.routine_name AS proc_name,
-  p.dtd_identifier AS return_type
+  p.dtd_identifier AS return_type,
+  false AS returns_set
 FROM information_schema.routines r
 INNER JOIN information_schema.parameters p
   ON p.specific_schema = r.routine_schema AND p.specific_name = r.routine_name AND p.ordinal_position = 0
@@ -220,7 +250,9 @@ ENDSQL
 # mysql proc parameter list query
 $XOBIN $MYDB -a -N -M -B -T ProcParam -F MyProcParams -o $DEST $EXTRA << ENDSQL
 SELECT
-  dtd_identifier AS param_type
+  dtd_identifier AS param_type,
+  parameter_name AS param_name,
+  parameter_mode AS param_mode
 FROM information_schema.parameters
 WHERE ordinal_position > 0 AND specific_schema = %%schema string%% AND specific_name = %%proc string%%
 ORDER BY ordinal_position
@@ -242,7 +274,8 @@ SELECT
   IF(data_type = 'enum', column_name, column_type) AS data_type,
   IF(is_nullable = 'YES', false, true) AS not_null,
   column_default AS default_value,
-  IF(column_key = 'PRI', true, false) AS is_primary_key
+  IF(column_key = 'PRI', true, false) AS is_primary_key,
+  column_comment AS comment
 FROM information_schema.columns
 WHERE table_schema = %%schema string%% AND table_name = %%table string%%
 ORDER BY ordinal_position
@@ -327,6 +360,28 @@ WHERE c.is_identity = 1
 AND schema_name(o.schema_id) = %%schema string%% AND o.type = 'U'
 ENDSQL
 
+# mssql proc list query
+$XOBIN $MSDB -a -N -M -B -T Proc -F MsProcs -o $DEST $EXTRA << ENDSQL
+SELECT
+  p.name AS proc_name,
+  'void' AS return_type
+FROM sys.procedures p
+WHERE SCHEMA_NAME(p.schema_id) = %%schema string%% AND p.is_ms_shipped = 0
+  AND NOT EXISTS (SELECT 1 FROM sys.parameters x INNER JOIN sys.types t ON t.user_type_id = x.user_type_id WHERE x.object_id = p.object_id AND t.is_table_type = 1)
+ENDSQL
+
+# mssql proc parameter list query
+$XOBIN $MSDB -a -N -M -B -T ProcParam -F MsProcParams -o $DEST $EXTRA << ENDSQL
+SELECT
+  TYPE_NAME(p.system_type_id) AS param_type,
+  SUBSTRING(p.name, 2, LEN(p.name)) AS param_name,
+  IIF(p.is_output = 1, 'INOUT', 'IN') AS param_mode
+FROM sys.parameters p
+  INNER JOIN sys.procedures o ON o.object_id = p.object_id
+WHERE p.parameter_id > 0 AND SCHEMA_NAME(o.schema_id) = %%schema string%% AND o.name = %%proc string%%
+ORDER BY p.parameter_id
+ENDSQL
+
 # mssql table list query
 $XOBIN $MSDB -a -N -M -B -T Table -F MsTables -o $DEST $EXTRA << ENDSQL
 SELECT
@@ -400,16 +455,28 @@ ORDER BY k.keyno
 ENDSQL
 
 # oracle proc list query
-#$XOBIN $ORDB -a -N -M -B -T Proc -F OrProcs -o $DEST $EXTRA << ENDSQL
-#SELECT
-#  object_name
-#FROM
-#ENDSQL
+$XOBIN $ORDB -a -N -M -B -T Proc -F OrProcs -o $DEST $EXTRA << ENDSQL
+SELECT
+  LOWER(p.object_name || NVL2(p.procedure_name, '.' || p.procedure_name, '')) AS proc_name,
+  LOWER(NVL(r.data_type, 'void')) AS return_type
+FROM all_procedures p
+  LEFT JOIN all_arguments r ON r.object_id = p.object_id AND r.subprogram_id = p.subprogram_id AND r.position = 0 AND r.data_level = 0
+WHERE p.owner = UPPER(%%schema string%%) AND p.overload IS NULL
+  AND ((p.object_type IN ('FUNCTION', 'PROCEDURE') AND p.procedure_name IS NULL) OR (p.object_type = 'PACKAGE' AND p.procedure_name IS NOT NULL))
+  AND NOT EXISTS (SELECT 1 FROM all_arguments a WHERE a.object_id = p.object_id AND a.subprogram_id = p.subprogram_id AND a.data_level = 0 AND a.data_type NOT IN ('CHAR', 'NCHAR', 'VARCHAR2', 'NVARCHAR2', 'CLOB', 'NCLOB', 'LONG', 'NUMBER', 'FLOAT', 'BINARY_FLOAT', 'BINARY_DOUBLE', 'BINARY_INTEGER', 'PLS_INTEGER', 'DATE', 'TIMESTAMP', 'TIMESTAMP WITH TIME ZONE', 'TIMESTAMP WITH LOCAL TIME ZONE', 'BLOB', 'RAW', 'LONG RAW'))
+ENDSQL
 
 # oracle proc parameter list query
-#$XOBIN $ORDB -a -N -M -B -T ProcParam -F OrProcParams -o $DEST $EXTRA << ENDSQL
-#SELECT
-#ENDSQL
+$XOBIN $ORDB -a -N -M -B -T ProcParam -F OrProcParams -o $DEST $EXTRA << ENDSQL
+SELECT
+  LOWER(data_type) AS param_type,
+  LOWER(argument_name) AS param_name,
+  REPLACE(in_out, '/', '') AS param_mode
+FROM all_arguments
+WHERE owner = UPPER(%%schema string%%) AND LOWER(NVL2(package_name, package_name || '.', '') || object_name) = %%proc string%%
+  AND overload IS NULL AND data_level = 0 AND argument_name IS NOT NULL
+ORDER BY position
+ENDSQL
 
 # oracle table list query
 $XOBIN $ORDB -a -N -M -B -T Table -F OrTables -o $DEST $EXTRA << ENDSQL
@@ -95,6 +95,10 @@ type ArgType struct {
 	// QueryAllowNulls indicates that custom query results can contain null types.
 	QueryAllowNulls bool `arg:"--query-allow-nulls,-U,help:use query column NULL state"`
 
+	// ProcAllowNulls indicates that stored procedure params and return values
+	// can be null.
+	ProcAllowNulls bool `arg:"--proc-allow-nulls,help:use nullable Go types for stored procedure params and return values"`
+
 	// EscapeAll toggles escaping schema, table, and column names in SQL queries.
 	EscapeAll bool `arg:"--escape-all,-X,help:escape all names in SQL queries"`
 
@@ -113,6 +117,28 @@ type ArgType struct {
 	// NameConflictSuffix is the suffix used when a name conflicts with a scoped Go variable.
 	NameConflictSuffix string `arg:"--name-conflict-suffix,-w,help:suffix to append when a name conflicts with a Go variable"`
 
+	// TypeMap are the user supplied Go type overrides for database types or
+	// table columns, in the form of KEY=TYPE[,NULLABLE[,IMPORT]].
+	TypeMap []string `arg:"--type-map,separate,help:Go type overrides for database types or table columns [format: KEY=TYPE optionally followed by a comma separated NULLABLE type and IMPORT path]"`
+
+	// UseContext toggles generating context.Context aware funcs and XODB
+	// interface.
+	UseContext bool `arg:"--context,help:generate context.Context aware funcs and XODB interface in Go code"`
+
+	// BatchSize is the default number of rows inserted per query by the
+	// generated batch insert funcs.
+	BatchSize int `arg:"--batch-size,help:default number of rows inserted per query by the generated batch insert funcs"`
+
+	// DDL is the path to a file of SQL DDL statements to load the schema from
+	// instead of a database. When specified, the DSN is only used to determine
+	// the database type, and for sqlite, the statements are executed against
+	// an in-memory database.
+	DDL string `arg:"--ddl,help:load the schema from the SQL DDL statements in the file instead of the database"`
+
+	// Check toggles comparing the generated code to the existing files
+	// instead of writing them, failing when they differ.
+	Check bool `arg:"--check,help:check that the generated Go code matches the existing files and print a diff and fail when they differ"`
+
 	// TemplatePath is the path to use the user supplied templates instead of
 	// the built in versions.
 	TemplatePath string `arg:"--template-path,help:user supplied template path"`
@@ -144,18 +170,65 @@ type ArgType struct {
 	// KnownTypeMap is the collection of known Go types.
 	KnownTypeMap map[string]bool `arg:"-"`
 
+	// CompositeTypeMap is the collection of the Go types of the loaded
+	// composite types.
+	CompositeTypeMap map[string]bool `arg:"-"`
+
 	// ShortNameTypeMap is the collection of Go style short names for types, mainly
 	// used for use with declaring a func receiver on a type.
 	ShortNameTypeMap map[string]string `arg:"-"`
 
+	// TypeOverrides are the parsed Go type overrides, keyed by the lowercase
+	// database type or table column.
+	TypeOverrides map[string]*TypeOverride `arg:"-"`
+
+	// Imports are the additional imports for the generated code.
+	Imports []string `arg:"-"`
+
+	// SliceTypes are the array types used by the columns, keyed by type
+	// name.
+	SliceTypes map[string]bool `arg:"-"`
+
+	// JSONTypes are the user supplied Go types of JSON columns, and whether
+	// or not their Scan/Value funcs have been generated.
+	JSONTypes map[string]bool `arg:"-"`
+
+	// DomainTypes are the base types of the schema's domains.
+	DomainTypes map[string]string `arg:"-"`
+
 	// IgnoreIndexField is the field to use the user supplied to skip in index function name
 	IgnoreIndexField string `arg:"--ignore-index-field,help:User supplied index field to ignore in index function name"`
 
 	// GraphQL enables the graphql support.
 	GraphQL bool `arg:"-g,--graphql,help:GraphQL enables the graphql support"`
 
-	// GraphQL schema path.
-	GraphQLSchema string `arg:"-S,--gqlschema,help:Path to GraphQL Schema file or directory"`
+	// GraphQL schema path, either a file, a directory or a glob pattern.
+	GraphQLSchema string `arg:"-S,--gqlschema,help:Path to GraphQL Schema file or directory or glob pattern"`
+
+	// GraphQLSDL toggles generating the GraphQL schema (SDL) of the database
+	// schema.
+	GraphQLSDL bool `arg:"--graphql-sdl,help:generate a GraphQL schema (SDL) file named schema.graphql from the database schema"`
+
+	// GraphQLLoaders toggles generating the request scoped GraphQL
+	// dataloaders in a loaders subpackage.
+	GraphQLLoaders bool `arg:"--graphql-loaders,help:generate request scoped GraphQL dataloaders in a loaders subpackage"`
+
+	// ModelsImport is the import path of the generated Go package, used by
+	// the generated subpackages (ie, the GraphQL dataloaders).
+	ModelsImport string `arg:"--models-import,help:import path of the generated Go package used by the generated subpackages (determined from go.mod or GOPATH when not provided)"`
+
+	// GraphQLScalarMap are the user supplied Go types of the GraphQL custom
+	// scalars, in the form of SCALAR=TYPE[,IMPORT].
+	GraphQLScalarMap []string `arg:"--gql-scalar,separate,help:Go types of GraphQL custom scalars [format: SCALAR=TYPE optionally followed by a comma and IMPORT path]"`
+
+	// GraphQLScalars are the parsed Go types of the GraphQL custom scalars,
+	// keyed by scalar name.
+	GraphQLScalars map[string]*TypeOverride `arg:"-"`
+
+	// GraphQLModels are the tables (and their indexes) keyed by type name,
+	// used to generate the resolvers of the GraphQL types of the same name
+	// when generating from a GraphQL schema along with a database.
+	GraphQLModels map[string]*GraphQLModel `arg:"-"`
 
 	// Sub Package is the name used to generate package headers. If not specified,
 	// the name of the directory will be used instead.
@@ -173,49 +246,72 @@ func NewDefaultArgs() *ArgType {
 		ForeignKeyMode:      &fkMode,
 		QueryParamDelimiter: "%%",
 		NameConflictSuffix:  "Val",
-		GraphQL:             true,
+		BatchSize:           1000,
 
 		// KnownTypeMap is the collection of known Go types.
 		KnownTypeMap: map[string]bool{
-			"bool":        true,
-			"string":      true,
-			"byte":        true,
-			"rune":        true,
-			"int":         true,
-			"int16":       true,
-			"int32":       true,
-			"int64":       true,
-			"uint":        true,
-			"uint8":       true,
-			"uint16":      true,
-			"uint32":      true,
-			"uint64":      true,
-			"float32":     true,
-			"float64":     true,
-			"Slice":       true,
-			"StringSlice": true,
+			"bool":             true,
+			"string":           true,
+			"byte":             true,
+			"rune":             true,
+			"int":              true,
+			"int16":            true,
+			"int32":            true,
+			"int64":            true,
+			"uint":             true,
+			"uint8":            true,
+			"uint16":           true,
+			"uint32":           true,
+			"uint64":           true,
+			"float32":          true,
+			"float64":          true,
+			"Slice":            true,
+			"StringSlice":      true,
+			"Int64Slice":       true,
+			"Float64Slice":     true,
+			"BoolSlice":        true,
+			"UUIDSlice":        true,
+			"NullStringSlice":  true,
+			"NullInt64Slice":   true,
+			"NullFloat64Slice": true,
+			"NullBoolSlice":    true,
+			"NullUUIDSlice":    true,
+			"NullJSON":         true,
+			"Int64Range":       true,
+			"Float64Range":     true,
+			"TimeRange":        true,
 		},
 
 		// ShortNameTypeMap is the collection of Go style short names for types, mainly
 		// used for use with declaring a func receiver on a type.
 		ShortNameTypeMap: map[string]string{
-			"bool":        "b",
-			"string":      "s",
-			"byte":        "b",
-			"rune":        "r",
-			"int":         "i",
-			"int16":       "i",
-			"int32":       "i",
-			"int64":       "i",
-			"uint":        "u",
-			"uint8":       "u",
-			"uint16":      "u",
-			"uint32":      "u",
-			"uint64":      "u",
-			"float32":     "f",
-			"float64":     "f",
-			"Slice":       "s",
-			"StringSlice": "ss",
+			"bool":             "b",
+			"string":           "s",
+			"byte":             "b",
+			"rune":             "r",
+			"int":              "i",
+			"int16":            "i",
+			"int32":            "i",
+			"int64":            "i",
+			"uint":             "u",
+			"uint8":            "u",
+			"uint16":           "u",
+			"uint32":           "u",
+			"uint64":           "u",
+			"float32":          "f",
+			"float64":          "f",
+			"Slice":            "s",
+			"StringSlice":      "ss",
+			"Int64Slice":       "is",
+			"Float64Slice":     "fs",
+			"BoolSlice":        "bs",
+			"UUIDSlice":        "us",
+			"NullStringSlice":  "nss",
+			"NullInt64Slice":   "nis",
+			"NullFloat64Slice": "nfs",
+			"NullBoolSlice":    "nbs",
+			"NullUUIDSlice":    "nus",
+			"NullJSON":         "nj",
 		},
 	}
 }
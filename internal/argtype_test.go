@@ -0,0 +1,99 @@
+package internal
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/alexflint/go-arg"
+
+	templates "github.com/sandeepone/xo/tplbin"
+)
+
+func Test_ArgTypeParser(t *testing.T) {
+	if _, err := arg.NewParser(arg.Config{}, NewDefaultArgs()); err != nil {
+		t.Fatalf("expected ArgType's struct tags to be valid, got: %v", err)
+	}
+}
+
+func Test_ArgTypeTypeMap(t *testing.T) {
+	args := NewDefaultArgs()
+	p, err := arg.NewParser(arg.Config{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = p.Parse([]string{
+		"--type-map", "numeric=decimal.Decimal,decimal.NullDecimal,github.com/shopspring/decimal",
+		"--type-map", "books.title=time.Duration,*time.Duration,time",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(args.TypeMap) != 2 {
+		t.Fatalf("expected each --type-map to be kept, got: %v", args.TypeMap)
+	}
+
+	for _, s := range args.TypeMap {
+		key, o, err := ParseTypeOverride(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = args.AddTypeOverride(key, o); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the imports declared by the xo_package template are not repeated
+	if exp := []string{"github.com/shopspring/decimal"}; !reflect.DeepEqual(args.Imports, exp) {
+		t.Errorf("expected imports %v, got: %v", exp, args.Imports)
+	}
+}
+
+func Test_ArgTypeScalarMap(t *testing.T) {
+	args := NewDefaultArgs()
+	p, err := arg.NewParser(arg.Config{}, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = p.Parse([]string{
+		"--gql-scalar", "JSON=json.RawMessage,encoding/json",
+		"--gql-scalar", "Decimal=money.Decimal,github.com/user/app/money",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(args.GraphQLScalarMap) != 2 {
+		t.Fatalf("expected each --gql-scalar to be kept, got: %v", args.GraphQLScalarMap)
+	}
+
+	for _, s := range args.GraphQLScalarMap {
+		name, o, err := ParseScalarOverride(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = args.AddScalarOverride(name, o); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the imports declared by the xo_package template are not repeated
+	if exp := []string{"github.com/user/app/money"}; !reflect.DeepEqual(args.Imports, exp) {
+		t.Errorf("expected imports %v, got: %v", exp, args.Imports)
+	}
+}
+
+func Test_PackageImports(t *testing.T) {
+	// the imports declared by the xo_package template
+	buf := templates.MustAsset("xo_package.go.tpl")
+	imports := regexp.MustCompile(`(?m)^\t"([^"{]+)"$`).FindAllStringSubmatch(string(buf), -1)
+	if len(imports) != len(packageImports) {
+		t.Errorf("expected %d imports in the xo_package template, got: %d", len(packageImports), len(imports))
+	}
+	for _, m := range imports {
+		if !packageImports[m[1]] {
+			t.Errorf("expected %q in packageImports", m[1])
+		}
+	}
+}
@@ -0,0 +1,367 @@
+package internal
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// ConfigFiles are the file names searched for in the working directory when
+// no config file is supplied to the generate command.
+var ConfigFiles = []string{"xo.yaml", "xo.yml", "xo.toml"}
+
+// Config is a xo project configuration, declaring the database, output and
+// naming settings, along with the custom queries to generate in a single
+// run.
+type Config struct {
+	// DSN is the database string.
+	DSN string `yaml:"dsn" toml:"dsn"`
+
+	// DDL is the path to a file of SQL DDL statements to load the schema from
+	// instead of the database, relative to the config file.
+	DDL string `yaml:"ddl" toml:"ddl"`
+
+	// Schema is the name of the schema to query.
+	Schema string `yaml:"schema" toml:"schema"`
+
+	// Out is the output directory.
+	Out string `yaml:"out" toml:"out"`
+
+	// Package is the name used in generated package headers.
+	Package string `yaml:"package" toml:"package"`
+
+	// Suffix is the output suffix for filenames.
+	Suffix string `yaml:"suffix" toml:"suffix"`
+
+	// Tags is the build tags to add to generated Go files.
+	Tags string `yaml:"tags" toml:"tags"`
+
+	// TemplatePath is the path to user supplied templates.
+	TemplatePath string `yaml:"template-path" toml:"template-path"`
+
+	// CustomTypePackage is the Go package name to use for unknown types.
+	CustomTypePackage string `yaml:"custom-type-package" toml:"custom-type-package"`
+
+	// Int32Type is the Go type to assign to integers.
+	Int32Type string `yaml:"int32-type" toml:"int32-type"`
+
+	// Uint32Type is the Go type to assign to unsigned integers.
+	Uint32Type string `yaml:"uint32-type" toml:"uint32-type"`
+
+	// IgnoreFields are the fields to exclude from the generated Go types.
+	IgnoreFields []string `yaml:"ignore-fields" toml:"ignore-fields"`
+
+	// IgnoreIndexField is the field to skip in index func names.
+	IgnoreIndexField string `yaml:"ignore-index-field" toml:"ignore-index-field"`
+
+	// FkMode is the foreign key naming mode (smart, parent, field or key).
+	FkMode string `yaml:"fk-mode" toml:"fk-mode"`
+
+	// UseIndexNames toggles using index names.
+	UseIndexNames bool `yaml:"use-index-names" toml:"use-index-names"`
+
+	// UseReversedEnumConstNames toggles using reversed enum names.
+	UseReversedEnumConstNames bool `yaml:"use-reversed-enum-const-names" toml:"use-reversed-enum-const-names"`
+
+	// EscapeAll toggles escaping schema, table, and column names.
+	EscapeAll bool `yaml:"escape-all" toml:"escape-all"`
+
+	// EscapeSchemaName toggles escaping the schema name.
+	EscapeSchemaName bool `yaml:"escape-schema" toml:"escape-schema"`
+
+	// EscapeTableNames toggles escaping table names.
+	EscapeTableNames bool `yaml:"escape-table" toml:"escape-table"`
+
+	// EscapeColumnNames toggles escaping column names.
+	EscapeColumnNames bool `yaml:"escape-column" toml:"escape-column"`
+
+	// EnablePostgresOIDs toggles postgres oids.
+	EnablePostgresOIDs bool `yaml:"enable-postgres-oids" toml:"enable-postgres-oids"`
+
+	// ProcAllowNulls toggles using nullable Go types for stored procedure
+	// params and return values.
+	ProcAllowNulls bool `yaml:"proc-allow-nulls" toml:"proc-allow-nulls"`
+
+	// NameConflictSuffix is the suffix used when a name conflicts with a
+	// scoped Go variable.
+	NameConflictSuffix string `yaml:"name-conflict-suffix" toml:"name-conflict-suffix"`
+
+	// Types are the Go type overrides, keyed by database type (ie, numeric)
+	// or table column (ie, books.isbn).
+	Types map[string]*TypeOverride `yaml:"types" toml:"types"`
+
+	// Scalars are the Go types of the GraphQL custom scalars, keyed by
+	// scalar name (ie, Decimal).
+	Scalars map[string]*TypeOverride `yaml:"scalars" toml:"scalars"`
+
+	// UseContext toggles generating context.Context aware funcs.
+	UseContext bool `yaml:"context" toml:"context"`
+
+	// BatchSize is the default number of rows inserted per query by the
+	// generated batch insert funcs.
+	BatchSize int `yaml:"batch-size" toml:"batch-size"`
+
+	// GraphQLSDL toggles generating the GraphQL schema (SDL) of the database
+	// schema.
+	GraphQLSDL bool `yaml:"graphql-sdl" toml:"graphql-sdl"`
+
+	// GraphQLLoaders toggles generating the request scoped GraphQL
+	// dataloaders in a loaders subpackage.
+	GraphQLLoaders bool `yaml:"graphql-loaders" toml:"graphql-loaders"`
+
+	// ModelsImport is the import path of the generated Go package, used by
+	// the generated subpackages.
+	ModelsImport string `yaml:"models-import" toml:"models-import"`
+
+	// Verbose enables verbose output.
+	Verbose bool `yaml:"verbose" toml:"verbose"`
+
+	// Queries are the custom queries to generate Go types and funcs for.
+	Queries []*ConfigQuery `yaml:"queries" toml:"queries"`
+
+	// dir is the directory of the loaded config file.
+	dir string
+}
+
+// ConfigQuery is a named custom query in a Config, with the same options as
+// the query mode command line arguments.
+type ConfigQuery struct {
+	// Name is the name of the query, used as the Go type name if Type is not
+	// specified.
+	Name string `yaml:"name" toml:"name"`
+
+	// Query is the query to generate the Go type and func from.
+	Query string `yaml:"query" toml:"query"`
+
+	// File is the path to a file containing the query, relative to the config
+	// file. Used when Query is not specified.
+	File string `yaml:"file" toml:"file"`
+
+	// Type is the query's generated Go type (-T).
+	Type string `yaml:"type" toml:"type"`
+
+	// Func is the query's generated Go func name (-F).
+	Func string `yaml:"func" toml:"func"`
+
+	// OnlyOne toggles the generated func to return only one result (-1).
+	OnlyOne bool `yaml:"only-one" toml:"only-one"`
+
+	// Trim toggles trimming of query whitespace (-M).
+	Trim bool `yaml:"trim" toml:"trim"`
+
+	// Strip toggles stripping type casts from the query (-B).
+	Strip bool `yaml:"strip" toml:"strip"`
+
+	// Interpolate toggles query interpolation (-I).
+	Interpolate bool `yaml:"interpolate" toml:"interpolate"`
+
+	// TypeComment is the comment for the generated Go type.
+	TypeComment string `yaml:"type-comment" toml:"type-comment"`
+
+	// FuncComment is the comment for the generated Go func.
+	FuncComment string `yaml:"func-comment" toml:"func-comment"`
+
+	// Delimiter is the delimiter for the query's embedded Go parameters (-D).
+	Delimiter string `yaml:"delimiter" toml:"delimiter"`
+
+	// Fields is the comma separated list of field names to scan the results
+	// to (-Z).
+	Fields string `yaml:"fields" toml:"fields"`
+
+	// AllowNulls toggles using the query column NULL state (-U).
+	AllowNulls bool `yaml:"allow-nulls" toml:"allow-nulls"`
+}
+
+// LoadConfig loads the config from the file with the supplied name, decoding
+// it as TOML or YAML depending on the file extension. If name is empty, then
+// the first of ConfigFiles found in the working directory is loaded.
+func LoadConfig(name string) (*Config, error) {
+	var err error
+
+	// find default config file
+	if name == "" {
+		for _, n := range ConfigFiles {
+			if _, err = os.Stat(n); err == nil {
+				name = n
+				break
+			}
+		}
+
+		if name == "" {
+			return nil, fmt.Errorf("no config file found (looked for %s)", strings.Join(ConfigFiles, ", "))
+		}
+	}
+
+	buf, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{
+		dir: filepath.Dir(name),
+	}
+
+	// decode
+	switch strings.ToLower(path.Ext(name)) {
+	case ".toml":
+		_, err = toml.Decode(string(buf), cfg)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(buf, cfg)
+	default:
+		return nil, fmt.Errorf("unknown config file type '%s'", path.Ext(name))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+
+	if cfg.DSN == "" {
+		return nil, fmt.Errorf("%s: dsn must be specified", name)
+	}
+
+	// check queries
+	for i, q := range cfg.Queries {
+		if q.Name == "" && q.Type == "" {
+			return nil, fmt.Errorf("%s: query %d must have a name or type", name, i)
+		}
+
+		if q.Query == "" && q.File == "" {
+			return nil, fmt.Errorf("%s: query '%s' must have a query or file", name, q.Name)
+		}
+	}
+
+	return cfg, nil
+}
+
+// Apply applies the config settings to args.
+func (c *Config) Apply(args *ArgType) error {
+	args.DSN = c.DSN
+	args.Schema = c.Schema
+	args.Package = c.Package
+	args.Tags = c.Tags
+	args.CustomTypePackage = c.CustomTypePackage
+	args.IgnoreFields = c.IgnoreFields
+	args.IgnoreIndexField = c.IgnoreIndexField
+	args.UseIndexNames = c.UseIndexNames
+	args.UseReversedEnumConstNames = c.UseReversedEnumConstNames
+	args.EscapeAll = c.EscapeAll
+	args.EscapeSchemaName = c.EscapeSchemaName
+	args.EscapeTableNames = c.EscapeTableNames
+	args.EscapeColumnNames = c.EscapeColumnNames
+	args.EnablePostgresOIDs = c.EnablePostgresOIDs
+	args.ProcAllowNulls = c.ProcAllowNulls
+	args.UseContext = c.UseContext
+	args.GraphQLSDL = c.GraphQLSDL
+	args.GraphQLLoaders = c.GraphQLLoaders
+	args.ModelsImport = c.ModelsImport
+	args.Verbose = c.Verbose
+
+	// paths are relative to the config file
+	if c.Out != "" {
+		args.Out = c.path(c.Out)
+	}
+	if c.DDL != "" {
+		args.DDL = c.path(c.DDL)
+	}
+	if c.TemplatePath != "" {
+		args.TemplatePath = c.path(c.TemplatePath)
+	}
+
+	// settings with defaults
+	if c.Suffix != "" {
+		args.Suffix = c.Suffix
+	}
+	if c.Int32Type != "" {
+		args.Int32Type = c.Int32Type
+	}
+	if c.Uint32Type != "" {
+		args.Uint32Type = c.Uint32Type
+	}
+	if c.NameConflictSuffix != "" {
+		args.NameConflictSuffix = c.NameConflictSuffix
+	}
+	if c.BatchSize != 0 {
+		args.BatchSize = c.BatchSize
+	}
+	if c.FkMode != "" {
+		err := args.ForeignKeyMode.UnmarshalText([]byte(c.FkMode))
+		if err != nil {
+			return err
+		}
+	}
+
+	// type overrides
+	for key, o := range c.Types {
+		err := args.AddTypeOverride(key, o)
+		if err != nil {
+			return err
+		}
+	}
+
+	// graphql scalar types
+	for name, o := range c.Scalars {
+		err := args.AddScalarOverride(name, o)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the query settings to args, replacing the settings of any
+// previously applied query.
+func (q *ConfigQuery) Apply(c *Config, args *ArgType) error {
+	query := q.Query
+	if query == "" {
+		buf, err := ioutil.ReadFile(c.path(q.File))
+		if err != nil {
+			return err
+		}
+		query = string(buf)
+	}
+
+	if q.Trim {
+		query = strings.TrimSpace(query)
+	}
+
+	typ := q.Type
+	if typ == "" {
+		typ = q.Name
+	}
+
+	delimiter := q.Delimiter
+	if delimiter == "" {
+		delimiter = "%%"
+	}
+
+	args.QueryMode = true
+	args.Query = query
+	args.QueryType = typ
+	args.QueryFunc = q.Func
+	args.QueryOnlyOne = q.OnlyOne
+	args.QueryTrim = q.Trim
+	args.QueryStrip = q.Strip
+	args.QueryInterpolate = q.Interpolate
+	args.QueryTypeComment = q.TypeComment
+	args.QueryFuncComment = q.FuncComment
+	args.QueryParamDelimiter = delimiter
+	args.QueryFields = q.Fields
+	args.QueryAllowNulls = q.AllowNulls
+
+	return nil
+}
+
+// path returns name relative to the config file's directory.
+func (c *Config) path(name string) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+
+	return filepath.Join(c.dir, name)
+}
@@ -0,0 +1,1065 @@
+package internal
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sandeepone/xo/models"
+)
+
+// DDL is a schema catalog parsed from SQL DDL statements (CREATE TABLE,
+// CREATE INDEX, CREATE TYPE, ALTER TABLE and COMMENT ON COLUMN), used in place
+// of a database connection when generating code.
+type DDL struct {
+	// Enums are the enum types, in the order they were defined.
+	Enums []*DDLEnum
+
+	// Tables are the tables, in the order they were defined.
+	Tables []*DDLTable
+}
+
+// DDLEnum is an enum type defined in DDL.
+type DDLEnum struct {
+	Schema string
+	Name   string
+	Values []string
+}
+
+// DDLTable is a table defined in DDL.
+type DDLTable struct {
+	Schema      string
+	Name        string
+	Columns     []*DDLColumn
+	ForeignKeys []*models.ForeignKey
+	Indexes     []*DDLIndex
+}
+
+// DDLColumn is a table column defined in DDL.
+type DDLColumn struct {
+	Name          string
+	Type          string
+	NotNull       bool
+	Default       string
+	HasDefault    bool
+	IsPrimaryKey  bool
+	AutoIncrement bool
+	Comment       string
+}
+
+// DDLIndex is a table index defined in DDL.
+type DDLIndex struct {
+	Index   *models.Index
+	Columns []string
+}
+
+// expression determines if the index has an expression element, which is
+// recorded as an empty column name.
+func (ix *DDLIndex) expression() bool {
+	for _, name := range ix.Columns {
+		if name == "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseDDL parses the DDL statements in src into a schema catalog.
+//
+// Only the statements needed for generating code are interpreted, all other
+// statements (DROP, INSERT, CREATE FUNCTION, PRAGMA, etc) are ignored.
+func ParseDDL(src string) (*DDL, error) {
+	toks, err := ddlLex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	ddl := &DDL{}
+	for _, stmt := range ddlSplit(toks) {
+		p := &ddlParser{toks: stmt, ddl: ddl}
+
+		err = p.parse()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", stmt[0].line, err)
+		}
+	}
+
+	return ddl, nil
+}
+
+// table returns the table with name in schema.
+func (d *DDL) table(schema, name string) *DDLTable {
+	for _, t := range d.Tables {
+		if t.Name == name && (schema == "" || t.Schema == "" || t.Schema == schema) {
+			return t
+		}
+	}
+
+	return nil
+}
+
+// column returns the column with name.
+func (t *DDLTable) column(name string) *DDLColumn {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// addIndex adds an index on the columns of t, building the index name from
+// the table and column names and suffix when name is not supplied.
+func (t *DDLTable) addIndex(name, suffix string, cols []string, unique, primary bool) {
+	if name == "" {
+		name = t.Name + "_" + strings.Join(cols, "_") + "_" + suffix
+		if primary {
+			name = t.Name + "_pkey"
+		}
+	}
+
+	origin := "c"
+	switch {
+	case primary:
+		origin = "pk"
+	case suffix == "key":
+		origin = "u"
+	}
+
+	t.Indexes = append(t.Indexes, &DDLIndex{
+		Index: &models.Index{
+			IndexName: name,
+			IsUnique:  unique,
+			IsPrimary: primary,
+			SeqNo:     len(t.Indexes),
+			Origin:    origin,
+		},
+		Columns: cols,
+	})
+}
+
+// ddlTokKind is the kind of a DDL token.
+type ddlTokKind int
+
+const (
+	// ddlWord is a keyword, unquoted identifier or number.
+	ddlWord ddlTokKind = iota
+
+	// ddlIdent is a quoted identifier.
+	ddlIdent
+
+	// ddlString is a string literal, including dollar quoted strings.
+	ddlString
+
+	// ddlPunct is punctuation.
+	ddlPunct
+)
+
+// ddlToken is a lexed DDL token.
+type ddlToken struct {
+	kind ddlTokKind
+	text string
+	line int
+}
+
+// is returns true if the token is the keyword or punctuation s.
+func (t ddlToken) is(s string) bool {
+	return (t.kind == ddlWord || t.kind == ddlPunct) && strings.EqualFold(t.text, s)
+}
+
+// ddlLex splits src into tokens, dropping comments and whitespace.
+func ddlLex(src string) ([]ddlToken, error) {
+	var toks []ddlToken
+
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		start, startLine := i, line
+
+		switch {
+		case c == '\n':
+			line++
+			i++
+
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f':
+			i++
+
+		// line comment
+		case c == '-' && strings.HasPrefix(src[i:], "--"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+
+		// block comment
+		case c == '/' && strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end == -1 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+
+		// string literal or quoted identifier
+		case c == '\'' || c == '"' || c == '`' || (c == '[' && i+1 < len(src) && isDDLIdentStart(src[i+1])):
+			end := c
+			if c == '[' {
+				end = ']'
+			}
+
+			var buf strings.Builder
+			for i++; ; i++ {
+				if i >= len(src) {
+					return nil, fmt.Errorf("line %d: unterminated quote", startLine)
+				}
+
+				if src[i] == end {
+					// doubled quote
+					if c != '[' && i+1 < len(src) && src[i+1] == end {
+						buf.WriteByte(end)
+						i++
+						continue
+					}
+					break
+				}
+
+				if src[i] == '\n' {
+					line++
+				}
+				buf.WriteByte(src[i])
+			}
+			i++
+
+			kind := ddlIdent
+			if c == '\'' {
+				kind = ddlString
+			}
+			toks = append(toks, ddlToken{kind, buf.String(), startLine})
+
+		// dollar quoted string
+		case c == '$' && ddlDollarTag(src[i:]) != "":
+			tag := ddlDollarTag(src[i:])
+			end := strings.Index(src[i+len(tag):], tag)
+			if end == -1 {
+				return nil, fmt.Errorf("line %d: unterminated dollar quoted string", line)
+			}
+
+			body := src[i+len(tag) : i+len(tag)+end]
+			line += strings.Count(body, "\n")
+			i += len(tag) + end + len(tag)
+			toks = append(toks, ddlToken{ddlString, body, startLine})
+
+		// keyword, identifier or number
+		case isDDLIdentStart(c) || (c >= '0' && c <= '9'):
+			for i < len(src) && (isDDLIdentStart(src[i]) || (src[i] >= '0' && src[i] <= '9') || src[i] == '$' || src[i] == '.' && c >= '0' && c <= '9') {
+				i++
+			}
+			toks = append(toks, ddlToken{ddlWord, src[start:i], startLine})
+
+		case c == ':' && strings.HasPrefix(src[i:], "::"):
+			i += 2
+			toks = append(toks, ddlToken{ddlPunct, "::", startLine})
+
+		default:
+			i++
+			toks = append(toks, ddlToken{ddlPunct, string(c), startLine})
+		}
+	}
+
+	return toks, nil
+}
+
+// isDDLIdentStart returns true if c can start an unquoted identifier.
+func isDDLIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+// ddlDollarTag returns the dollar quote tag (ie, $$ or $body$) at the start
+// of s, or an empty string if s does not start with one.
+func ddlDollarTag(s string) string {
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '$':
+			return s[:i+1]
+		case !isDDLIdentStart(c) && !(i > 1 && c >= '0' && c <= '9'):
+			return ""
+		}
+	}
+
+	return ""
+}
+
+// ddlSplit splits toks into statements.
+func ddlSplit(toks []ddlToken) [][]ddlToken {
+	var stmts [][]ddlToken
+
+	start := 0
+	for i, t := range toks {
+		if t.is(";") {
+			if i > start {
+				stmts = append(stmts, toks[start:i])
+			}
+			start = i + 1
+		}
+	}
+	if start < len(toks) {
+		stmts = append(stmts, toks[start:])
+	}
+
+	return stmts
+}
+
+// ddlParser parses a single DDL statement into a DDL catalog.
+type ddlParser struct {
+	toks []ddlToken
+	i    int
+	ddl  *DDL
+}
+
+// peek returns the current token, or an empty token at the end of the
+// statement.
+func (p *ddlParser) peek() ddlToken {
+	if p.i < len(p.toks) {
+		return p.toks[p.i]
+	}
+
+	return ddlToken{kind: ddlPunct}
+}
+
+// next returns the current token and advances.
+func (p *ddlParser) next() ddlToken {
+	t := p.peek()
+	if p.i < len(p.toks) {
+		p.i++
+	}
+
+	return t
+}
+
+// done returns true when at the end of the statement.
+func (p *ddlParser) done() bool {
+	return p.i >= len(p.toks)
+}
+
+// accept advances past the keywords kw if they are next.
+func (p *ddlParser) accept(kw ...string) bool {
+	for n, k := range kw {
+		if p.i+n >= len(p.toks) || !p.toks[p.i+n].is(k) {
+			return false
+		}
+	}
+	p.i += len(kw)
+
+	return true
+}
+
+// expect advances past the keyword or punctuation s, returning an error if
+// it is not next.
+func (p *ddlParser) expect(s string) error {
+	if !p.accept(s) {
+		return fmt.Errorf("expected %s, got %q", s, p.peek().text)
+	}
+
+	return nil
+}
+
+// ident parses an identifier.
+func (p *ddlParser) ident() (string, error) {
+	t := p.next()
+	if t.kind != ddlWord && t.kind != ddlIdent {
+		return "", fmt.Errorf("expected identifier, got %q", t.text)
+	}
+
+	return t.text, nil
+}
+
+// name parses a possibly schema qualified name.
+func (p *ddlParser) name() (string, string, error) {
+	name, err := p.ident()
+	if err != nil {
+		return "", "", err
+	}
+
+	var schema string
+	if p.accept(".") {
+		schema = name
+		name, err = p.ident()
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	return schema, name, nil
+}
+
+// identList parses a parenthesized list of identifiers. Expressions and
+// trailing index options (ie, ASC, DESC, COLLATE, opclass) are skipped, with
+// an empty string returned in place of an expression.
+func (p *ddlParser) identList() ([]string, error) {
+	err := p.expect("(")
+	if err != nil {
+		return nil, err
+	}
+
+	var list []string
+	for {
+		var s string
+		if t := p.peek(); (t.kind == ddlWord || t.kind == ddlIdent) && p.i+1 < len(p.toks) && !p.toks[p.i+1].is("(") {
+			s = p.next().text
+		}
+		list = append(list, s)
+
+		// skip the remainder of the element
+		p.skip(",", ")")
+		if p.done() {
+			return nil, errors.New("unterminated list")
+		}
+		if p.next().is(")") {
+			return list, nil
+		}
+	}
+}
+
+// skip advances to the first of the keywords or punctuation stop outside of
+// parentheses, returning the text of the skipped tokens.
+func (p *ddlParser) skip(stop ...string) string {
+	var buf strings.Builder
+
+	depth := 0
+	for ; !p.done(); p.i++ {
+		t := p.toks[p.i]
+		if depth == 0 {
+			for _, s := range stop {
+				if t.is(s) {
+					return buf.String()
+				}
+			}
+		}
+
+		switch {
+		case t.is("("), t.is("["):
+			depth++
+		case t.is(")"), t.is("]"):
+			if depth == 0 {
+				return buf.String()
+			}
+			depth--
+		}
+
+		ddlWrite(&buf, t)
+	}
+
+	return buf.String()
+}
+
+// ddlWrite writes the token to buf, separating words with a space.
+func ddlWrite(buf *strings.Builder, t ddlToken) {
+	s := t.text
+	switch t.kind {
+	case ddlString:
+		s = "'" + strings.Replace(s, "'", "''", -1) + "'"
+	case ddlIdent:
+		s = `"` + s + `"`
+	}
+
+	if buf.Len() != 0 && t.kind != ddlPunct {
+		switch last := buf.String()[buf.Len()-1]; last {
+		case '(', '[', '.', ',', ':', '-':
+		default:
+			buf.WriteByte(' ')
+		}
+	}
+	buf.WriteString(s)
+}
+
+// parse parses the statement.
+func (p *ddlParser) parse() error {
+	switch {
+	case p.accept("CREATE"):
+		// skip modifiers
+		for p.accept("OR", "REPLACE") || p.accept("TEMP") || p.accept("TEMPORARY") || p.accept("UNLOGGED") {
+		}
+
+		switch {
+		case p.accept("TABLE"):
+			return p.createTable()
+		case p.accept("UNIQUE", "INDEX"):
+			return p.createIndex(true)
+		case p.accept("INDEX"):
+			return p.createIndex(false)
+		case p.accept("TYPE"):
+			return p.createType()
+		}
+
+	case p.accept("ALTER", "TABLE"):
+		return p.alterTable()
+
+	case p.accept("COMMENT", "ON", "COLUMN"):
+		return p.commentOnColumn()
+	}
+
+	return nil
+}
+
+// createTable parses a CREATE TABLE statement.
+func (p *ddlParser) createTable() error {
+	p.accept("IF", "NOT", "EXISTS")
+
+	schema, name, err := p.name()
+	if err != nil {
+		return err
+	}
+
+	// CREATE TABLE ... AS and PARTITION OF are not supported
+	if !p.peek().is("(") {
+		return fmt.Errorf("unsupported CREATE TABLE %s", name)
+	}
+	p.next()
+
+	t := &DDLTable{
+		Schema: schema,
+		Name:   name,
+	}
+	p.ddl.Tables = append(p.ddl.Tables, t)
+
+	for {
+		if !p.tableConstraint(t) {
+			err = p.columnDef(t)
+			if err != nil {
+				return err
+			}
+		}
+
+		switch tok := p.next(); {
+		case tok.is(","):
+		case tok.is(")"):
+			return nil
+		default:
+			return fmt.Errorf("expected , or ) in table %s, got %q", name, tok.text)
+		}
+	}
+}
+
+// columnDef parses a column definition of t.
+func (p *ddlParser) columnDef(t *DDLTable) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+
+	c := &DDLColumn{
+		Name: name,
+		Type: p.skip(ddlColumnConstraints...),
+	}
+	t.Columns = append(t.Columns, c)
+
+	// column constraints
+	for !p.done() && !p.peek().is(",") && !p.peek().is(")") {
+		switch {
+		case p.accept("CONSTRAINT"):
+			p.next()
+
+		case p.accept("NOT", "NULL"):
+			c.NotNull = true
+
+		case p.accept("DEFAULT"):
+			c.Default = p.skip(ddlColumnConstraints...)
+			c.HasDefault = true
+
+		case p.accept("PRIMARY", "KEY"):
+			c.IsPrimaryKey, c.NotNull = true, true
+			t.addIndex("", "pkey", []string{c.Name}, true, true)
+
+		case p.accept("UNIQUE"):
+			t.addIndex("", "key", []string{c.Name}, true, false)
+
+		case p.accept("REFERENCES"):
+			err = p.references(t, []string{c.Name}, "")
+			if err != nil {
+				return err
+			}
+
+		case p.accept("GENERATED"):
+			if strings.Contains(strings.ToUpper(p.skip(ddlColumnConstraints...)), "IDENTITY") {
+				c.AutoIncrement = true
+			}
+
+		case p.accept("AUTOINCREMENT"), p.accept("AUTO_INCREMENT"), p.accept("IDENTITY"):
+			c.AutoIncrement = true
+			p.skip(ddlColumnConstraints...)
+
+		default:
+			// NULL, CHECK, COLLATE, etc
+			p.next()
+			p.skip(ddlColumnConstraints...)
+		}
+	}
+
+	return nil
+}
+
+// ddlColumnConstraints are the keywords starting a column constraint.
+var ddlColumnConstraints = []string{
+	",", "CONSTRAINT", "NOT", "NULL", "DEFAULT", "PRIMARY", "UNIQUE",
+	"REFERENCES", "CHECK", "COLLATE", "GENERATED", "AUTOINCREMENT",
+	"AUTO_INCREMENT", "IDENTITY",
+}
+
+// tableConstraint parses a table constraint of t, returning false if the
+// next element is not a table constraint.
+func (p *ddlParser) tableConstraint(t *DDLTable) bool {
+	start := p.i
+
+	var name string
+	if p.accept("CONSTRAINT") {
+		name = p.next().text
+	}
+
+	var err error
+	switch {
+	case p.accept("PRIMARY", "KEY"):
+		var cols []string
+		cols, err = p.identList()
+		if err == nil {
+			for _, col := range cols {
+				if c := t.column(col); c != nil {
+					c.IsPrimaryKey, c.NotNull = true, true
+				}
+			}
+			t.addIndex(name, "pkey", cols, true, true)
+		}
+
+	case p.accept("UNIQUE"):
+		var cols []string
+		cols, err = p.identList()
+		if err == nil {
+			t.addIndex(name, "key", cols, true, false)
+		}
+
+	case p.accept("FOREIGN", "KEY"):
+		var cols []string
+		cols, err = p.identList()
+		if err == nil {
+			err = p.expect("REFERENCES")
+		}
+		if err == nil {
+			err = p.references(t, cols, name)
+		}
+
+	case p.accept("CHECK"), p.accept("EXCLUDE"):
+
+	default:
+		p.i = start
+		return false
+	}
+
+	if err != nil {
+		// not a table constraint
+		p.i = start
+		return false
+	}
+
+	p.skip(",")
+	return true
+}
+
+// references parses the REFERENCES clause of a foreign key on the columns
+// cols of t.
+func (p *ddlParser) references(t *DDLTable, cols []string, name string) error {
+	_, refTable, err := p.name()
+	if err != nil {
+		return err
+	}
+
+	var refCols []string
+	if p.peek().is("(") {
+		refCols, err = p.identList()
+		if err != nil {
+			return err
+		}
+	}
+
+	// actions
+	var onUpdate, onDelete, match string
+actionLoop:
+	for {
+		switch {
+		case p.accept("ON", "UPDATE"):
+			onUpdate = p.action()
+		case p.accept("ON", "DELETE"):
+			onDelete = p.action()
+		case p.accept("MATCH"):
+			match = strings.ToUpper(p.next().text)
+		default:
+			// DEFERRABLE, etc
+			p.skip(ddlColumnConstraints...)
+			break actionLoop
+		}
+	}
+
+	keyID := len(t.ForeignKeys)
+	for i, col := range cols {
+		var refCol string
+		if i < len(refCols) {
+			refCol = refCols[i]
+		}
+
+		t.ForeignKeys = append(t.ForeignKeys, &models.ForeignKey{
+			ForeignKeyName: name,
+			ColumnName:     col,
+			RefTableName:   refTable,
+			RefColumnName:  refCol,
+			KeyID:          keyID,
+			SeqNo:          i,
+			OnUpdate:       onUpdate,
+			OnDelete:       onDelete,
+			Match:          match,
+		})
+	}
+
+	return nil
+}
+
+// action parses a foreign key referential action.
+func (p *ddlParser) action() string {
+	switch {
+	case p.accept("SET", "NULL"):
+		return "SET NULL"
+	case p.accept("SET", "DEFAULT"):
+		return "SET DEFAULT"
+	case p.accept("NO", "ACTION"):
+		return "NO ACTION"
+	}
+
+	return strings.ToUpper(p.next().text)
+}
+
+// createIndex parses a CREATE INDEX statement.
+func (p *ddlParser) createIndex(unique bool) error {
+	p.accept("CONCURRENTLY")
+	p.accept("IF", "NOT", "EXISTS")
+
+	// index name is optional
+	var name string
+	var err error
+	if !p.peek().is("ON") {
+		name, err = p.ident()
+		if err != nil {
+			return err
+		}
+	}
+
+	err = p.expect("ON")
+	if err != nil {
+		return err
+	}
+	p.accept("ONLY")
+
+	schema, table, err := p.name()
+	if err != nil {
+		return err
+	}
+
+	// index method
+	if p.accept("USING") {
+		p.next()
+	}
+
+	cols, err := p.identList()
+	if err != nil {
+		return err
+	}
+
+	t := p.ddl.table(schema, table)
+	if t == nil {
+		return fmt.Errorf("index %s on undefined table %s", name, table)
+	}
+
+	t.addIndex(name, "idx", cols, unique, false)
+
+	// partial index
+	for !p.done() {
+		if p.next().is("WHERE") {
+			t.Indexes[len(t.Indexes)-1].Index.IsPartial = true
+		}
+	}
+
+	return nil
+}
+
+// createType parses a CREATE TYPE statement. Only enum types are supported,
+// and other types are ignored.
+func (p *ddlParser) createType() error {
+	schema, name, err := p.name()
+	if err != nil {
+		return err
+	}
+
+	if !p.accept("AS", "ENUM") {
+		return nil
+	}
+
+	err = p.expect("(")
+	if err != nil {
+		return err
+	}
+
+	e := &DDLEnum{
+		Schema: schema,
+		Name:   name,
+	}
+	for !p.accept(")") {
+		t := p.next()
+		switch {
+		case t.kind == ddlString:
+			e.Values = append(e.Values, t.text)
+		case t.is(","):
+		default:
+			return fmt.Errorf("expected enum value for type %s, got %q", name, t.text)
+		}
+	}
+	p.ddl.Enums = append(p.ddl.Enums, e)
+
+	return nil
+}
+
+// alterTable parses an ALTER TABLE statement, applying any added columns or
+// constraints.
+func (p *ddlParser) alterTable() error {
+	p.accept("IF", "EXISTS")
+	p.accept("ONLY")
+
+	schema, name, err := p.name()
+	if err != nil {
+		return err
+	}
+
+	t := p.ddl.table(schema, name)
+	if t == nil {
+		return fmt.Errorf("alter of undefined table %s", name)
+	}
+
+	for !p.done() {
+		if !p.accept("ADD") {
+			// other alterations are ignored
+			p.skip(",")
+		} else if !p.tableConstraint(t) {
+			p.accept("COLUMN")
+			p.accept("IF", "NOT", "EXISTS")
+
+			err = p.columnDef(t)
+			if err != nil {
+				return err
+			}
+		}
+
+		p.accept(",")
+	}
+
+	return nil
+}
+
+// commentOnColumn parses a COMMENT ON COLUMN statement.
+func (p *ddlParser) commentOnColumn() error {
+	// [schema.]table.column
+	var names []string
+	for {
+		name, err := p.ident()
+		if err != nil {
+			return err
+		}
+		names = append(names, name)
+
+		if !p.accept(".") {
+			break
+		}
+	}
+	if len(names) < 2 {
+		return errors.New("COMMENT ON COLUMN must specify table.column")
+	}
+
+	var schema string
+	if len(names) > 2 {
+		schema = names[len(names)-3]
+	}
+	table, col := names[len(names)-2], names[len(names)-1]
+
+	t := p.ddl.table(schema, table)
+	if t == nil {
+		return fmt.Errorf("comment on undefined table %s", table)
+	}
+	c := t.column(col)
+	if c == nil {
+		return fmt.Errorf("comment on undefined column %s.%s", table, col)
+	}
+
+	err := p.expect("IS")
+	if err != nil {
+		return err
+	}
+
+	// NULL removes the comment
+	c.Comment = ""
+	if tok := p.next(); tok.kind == ddlString {
+		c.Comment = tok.text
+	}
+
+	return nil
+}
+
+// DDLLoader returns a copy of the type loader that loads the schema from the
+// parsed DDL instead of from a database. The loader's DDLType func is used to
+// convert the DDL column types to the types reported by the database.
+func (tl TypeLoader) DDLLoader(ddl *DDL) (TypeLoader, error) {
+	if tl.DDLType == nil {
+		return tl, errors.New("loading schema from DDL is not supported by the type loader")
+	}
+
+	// enums
+	if tl.EnumList != nil {
+		tl.EnumList = func(db models.XODB, schema string) ([]*models.Enum, error) {
+			var enums []*models.Enum
+			for _, e := range ddl.Enums {
+				if e.Schema == "" || e.Schema == schema {
+					enums = append(enums, &models.Enum{EnumName: e.Name})
+				}
+			}
+			return enums, nil
+		}
+		tl.EnumValueList = func(db models.XODB, schema string, enum string) ([]*models.EnumValue, error) {
+			var values []*models.EnumValue
+			for _, e := range ddl.Enums {
+				if e.Name == enum && (e.Schema == "" || e.Schema == schema) {
+					for i, v := range e.Values {
+						values = append(values, &models.EnumValue{
+							EnumValue:  v,
+							ConstValue: i + 1,
+						})
+					}
+				}
+			}
+			return values, nil
+		}
+	}
+
+	// stored procedures, domains and composite types are not loaded from ddl
+	tl.ProcList, tl.ProcParamList = nil, nil
+	tl.DomainList, tl.CompositeList = nil, nil
+
+	// tables (views are not loaded from ddl)
+	tableKind := tl.Relkind(Table)
+	tl.TableList = func(db models.XODB, schema string, relkind string) ([]*models.Table, error) {
+		var tables []*models.Table
+		if relkind != tableKind {
+			return nil, nil
+		}
+
+		for _, t := range ddl.Tables {
+			if t.Schema != "" && t.Schema != schema {
+				continue
+			}
+
+			// the primary key is manual unless it is a single auto
+			// incrementing column
+			var pks, auto int
+			for _, c := range t.Columns {
+				if c.IsPrimaryKey {
+					pks++
+					if _, inc := tl.DDLType(c.Type, true); inc || c.AutoIncrement {
+						auto++
+					}
+				}
+			}
+
+			tables = append(tables, &models.Table{
+				Type:      relkind,
+				TableName: t.Name,
+				ManualPk:  pks != 1 || auto != 1,
+			})
+		}
+
+		return tables, nil
+	}
+
+	tl.ColumnList = func(db models.XODB, schema string, table string) ([]*models.Column, error) {
+		t := ddl.table(schema, table)
+		if t == nil {
+			return nil, fmt.Errorf("undefined table %s", table)
+		}
+
+		var cols []*models.Column
+		for i, c := range t.Columns {
+			typ, _ := tl.DDLType(c.Type, c.IsPrimaryKey)
+			cols = append(cols, &models.Column{
+				FieldOrdinal: i + 1,
+				ColumnName:   c.Name,
+				DataType:     typ,
+				NotNull:      c.NotNull,
+				DefaultValue: sql.NullString{String: c.Default, Valid: c.HasDefault},
+				IsPrimaryKey: c.IsPrimaryKey,
+				Comment:      c.Comment,
+			})
+		}
+
+		return cols, nil
+	}
+
+	tl.ForeignKeyList = func(db models.XODB, schema string, table string) ([]*models.ForeignKey, error) {
+		t := ddl.table(schema, table)
+		if t == nil {
+			return nil, fmt.Errorf("undefined table %s", table)
+		}
+
+		return t.ForeignKeys, nil
+	}
+
+	tl.IndexList = func(db models.XODB, schema string, table string) ([]*models.Index, error) {
+		t := ddl.table(schema, table)
+		if t == nil {
+			return nil, fmt.Errorf("undefined table %s", table)
+		}
+
+		var indexes []*models.Index
+		for _, ix := range t.Indexes {
+			// skip expression indexes, as the database loaders do
+			if ix.expression() {
+				continue
+			}
+			indexes = append(indexes, ix.Index)
+		}
+
+		return indexes, nil
+	}
+
+	tl.IndexColumnList = func(db models.XODB, schema string, table string, index string) ([]*models.IndexColumn, error) {
+		t := ddl.table(schema, table)
+		if t == nil {
+			return nil, fmt.Errorf("undefined table %s", table)
+		}
+
+		var cols []*models.IndexColumn
+		for _, ix := range t.Indexes {
+			if ix.Index.IndexName != index {
+				continue
+			}
+
+			for i, name := range ix.Columns {
+				cols = append(cols, &models.IndexColumn{
+					SeqNo:      i,
+					ColumnName: name,
+				})
+			}
+		}
+
+		return cols, nil
+	}
+
+	// custom queries need a database to determine the result columns
+	tl.QueryColumnList = func(*ArgType, []string) ([]*models.Column, error) {
+		return nil, errors.New("query columns cannot be determined from DDL, specify the query fields instead")
+	}
+
+	return tl, nil
+}
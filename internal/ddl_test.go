@@ -0,0 +1,121 @@
+package internal
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testDDL = `
+-- booktest schema
+DROP TABLE IF EXISTS books CASCADE;
+
+CREATE TABLE authors (
+  author_id SERIAL PRIMARY KEY,
+  name text NOT NULL DEFAULT ''
+);
+
+CREATE INDEX authors_name_idx ON authors(name);
+
+CREATE TYPE book_type AS ENUM (
+  'FICTION',
+  'NONFICTION'
+);
+
+CREATE TABLE "books" (
+  book_id SERIAL PRIMARY KEY,
+  author_id integer NOT NULL REFERENCES authors(author_id) ON DELETE CASCADE,
+  isbn text NOT NULL DEFAULT '' UNIQUE,
+  price numeric(10, 2),
+  available timestamp with time zone NOT NULL DEFAULT 'NOW()',
+  tags varchar[] NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX books_title_idx ON books USING btree (lower(isbn), price DESC) WHERE price > 0;
+
+CREATE FUNCTION say_hello(text) RETURNS text AS $$
+BEGIN
+  RETURN CONCAT('hello; ', $1);
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TABLE book_authors (
+  book_id integer NOT NULL,
+  author_id integer NOT NULL,
+  CONSTRAINT book_authors_pk PRIMARY KEY (book_id, author_id)
+);
+
+ALTER TABLE ONLY book_authors
+  ADD CONSTRAINT book_authors_book_fk FOREIGN KEY (book_id) REFERENCES books,
+  ADD COLUMN note varchar(255);
+
+COMMENT ON COLUMN public.book_authors.note IS 'xo:json=Note';
+`
+
+func Test_ParseDDL(t *testing.T) {
+	ddl, err := ParseDDL(testDDL)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// enums
+	if len(ddl.Enums) != 1 || ddl.Enums[0].Name != "book_type" || !reflect.DeepEqual(ddl.Enums[0].Values, []string{"FICTION", "NONFICTION"}) {
+		t.Errorf("unexpected enums: %+v", ddl.Enums)
+	}
+
+	// tables
+	var tables []string
+	for _, tbl := range ddl.Tables {
+		tables = append(tables, tbl.Name)
+	}
+	if exp := []string{"authors", "books", "book_authors"}; !reflect.DeepEqual(tables, exp) {
+		t.Fatalf("expected tables %v, got: %v", exp, tables)
+	}
+
+	// columns
+	books := ddl.table("", "books")
+	cols := []DDLColumn{
+		{Name: "book_id", Type: "SERIAL", NotNull: true, IsPrimaryKey: true},
+		{Name: "author_id", Type: "integer", NotNull: true},
+		{Name: "isbn", Type: "text", NotNull: true, Default: "''", HasDefault: true},
+		{Name: "price", Type: "numeric(10,2)"},
+		{Name: "available", Type: "timestamp with time zone", NotNull: true, Default: "'NOW()'", HasDefault: true},
+		{Name: "tags", Type: "varchar[]", NotNull: true, Default: "'{}'", HasDefault: true},
+	}
+	if len(books.Columns) != len(cols) {
+		t.Fatalf("expected %d columns, got: %d", len(cols), len(books.Columns))
+	}
+	for i, c := range books.Columns {
+		if !reflect.DeepEqual(*c, cols[i]) {
+			t.Errorf("column %d expected %+v, got: %+v", i, cols[i], *c)
+		}
+	}
+
+	// foreign keys
+	if fks := books.ForeignKeys; len(fks) != 1 || fks[0].ColumnName != "author_id" || fks[0].RefTableName != "authors" || fks[0].RefColumnName != "author_id" || fks[0].OnDelete != "CASCADE" {
+		t.Errorf("unexpected books foreign keys: %+v", fks)
+	}
+	ba := ddl.table("", "book_authors")
+	if fks := ba.ForeignKeys; len(fks) != 1 || fks[0].ForeignKeyName != "book_authors_book_fk" || fks[0].RefTableName != "books" || fks[0].RefColumnName != "" {
+		t.Errorf("unexpected book_authors foreign keys: %+v", fks)
+	}
+
+	// indexes
+	var indexes []string
+	for _, ix := range books.Indexes {
+		indexes = append(indexes, ix.Index.IndexName)
+	}
+	if exp := []string{"books_pkey", "books_isbn_key", "books_title_idx"}; !reflect.DeepEqual(indexes, exp) {
+		t.Errorf("expected indexes %v, got: %v", exp, indexes)
+	}
+	if ix := books.Indexes[2]; !ix.Index.IsUnique || !ix.Index.IsPartial || !reflect.DeepEqual(ix.Columns, []string{"", "price"}) {
+		t.Errorf("unexpected index: %+v %v", ix.Index, ix.Columns)
+	}
+
+	// composite primary key and added column
+	if len(ba.Columns) != 3 || !ba.Columns[0].IsPrimaryKey || !ba.Columns[1].IsPrimaryKey || ba.Columns[2].Type != "varchar(255)" || ba.Columns[2].Comment != "xo:json=Note" {
+		t.Errorf("unexpected book_authors columns: %+v %+v %+v", ba.Columns[0], ba.Columns[1], ba.Columns[len(ba.Columns)-1])
+	}
+	if ix := ba.Indexes; len(ix) != 1 || ix[0].Index.IndexName != "book_authors_pk" || !ix[0].Index.IsPrimary || !reflect.DeepEqual(ix[0].Columns, []string{"book_id", "author_id"}) {
+		t.Errorf("unexpected book_authors indexes: %+v", ix)
+	}
+}
@@ -0,0 +1,193 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of context lines in a unified diff hunk.
+const diffContext = 3
+
+// diffOp is a line operation in an edit script.
+type diffOp struct {
+	// kind is ' ' for an unchanged line, '-' for a deleted line, and '+' for
+	// an inserted line.
+	kind byte
+
+	// a and b are the 0-based line numbers in a and b.
+	a, b int
+
+	// line is the line text.
+	line string
+}
+
+// Diff returns the unified diff between the texts a and b, named aName and
+// bName in the diff header. An empty string is returned if a and b are equal.
+func Diff(aName, bName, a, b string) string {
+	if a == b {
+		return ""
+	}
+
+	ops := diffOps(splitLines(a), splitLines(b))
+
+	buf := new(strings.Builder)
+	fmt.Fprintf(buf, "--- %s\n+++ %s\n", aName, bName)
+
+	// build hunks
+	for i := 0; i < len(ops); {
+		// skip to next change
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+
+		// hunk start, including leading context
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+
+		// extend hunk while changes are within the context of each other
+		end, unchanged := i, 0
+		for ; end < len(ops); end++ {
+			if ops[end].kind != ' ' {
+				unchanged = 0
+				continue
+			}
+
+			unchanged++
+			if unchanged > 2*diffContext {
+				unchanged--
+				break
+			}
+		}
+
+		// trim trailing context
+		if unchanged > diffContext {
+			end -= unchanged - diffContext
+		}
+		if end > len(ops) {
+			end = len(ops)
+		}
+
+		writeHunk(buf, ops[start:end])
+		i = end
+	}
+
+	return buf.String()
+}
+
+// writeHunk writes the unified diff hunk for ops to buf.
+func writeHunk(buf *strings.Builder, ops []diffOp) {
+	aStart, bStart, aLen, bLen := -1, -1, 0, 0
+	for _, op := range ops {
+		if op.kind != '+' {
+			if aStart == -1 {
+				aStart = op.a
+			}
+			aLen++
+		}
+		if op.kind != '-' {
+			if bStart == -1 {
+				bStart = op.b
+			}
+			bLen++
+		}
+	}
+
+	// line numbers of empty ranges refer to the line before the range
+	if aStart == -1 {
+		aStart = ops[0].a - 1
+	}
+	if bStart == -1 {
+		bStart = ops[0].b - 1
+	}
+
+	fmt.Fprintf(buf, "@@ -%s +%s @@\n", hunkRange(aStart, aLen), hunkRange(bStart, bLen))
+	for _, op := range ops {
+		buf.WriteByte(op.kind)
+		buf.WriteString(op.line)
+		buf.WriteByte('\n')
+	}
+}
+
+// hunkRange formats the 0-based start line and length as a unified diff
+// range.
+func hunkRange(start, n int) string {
+	if n == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+
+	return fmt.Sprintf("%d,%d", start+1, n)
+}
+
+// splitLines splits s into lines, without their line endings.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffOps returns the edit script transforming a into b, using the longest
+// common subsequence of the lines remaining after removing the common prefix
+// and suffix.
+func diffOps(a, b []string) []diffOp {
+	var ops []diffOp
+
+	// common prefix
+	pre := 0
+	for pre < len(a) && pre < len(b) && a[pre] == b[pre] {
+		ops = append(ops, diffOp{' ', pre, pre, a[pre]})
+		pre++
+	}
+
+	// common suffix
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && a[len(a)-1-suf] == b[len(b)-1-suf] {
+		suf++
+	}
+
+	// lcs lengths of the remaining lines
+	am, bm := a[pre:len(a)-suf], b[pre:len(b)-suf]
+	lcs := make([][]int, len(am)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(bm)+1)
+	}
+	for i := len(am) - 1; i >= 0; i-- {
+		for j := len(bm) - 1; j >= 0; j-- {
+			if am[i] == bm[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	// walk lcs, preferring deletes before inserts
+	i, j := 0, 0
+	for i < len(am) || j < len(bm) {
+		switch {
+		case i < len(am) && j < len(bm) && am[i] == bm[j]:
+			ops = append(ops, diffOp{' ', pre + i, pre + j, am[i]})
+			i++
+			j++
+		case i < len(am) && (j == len(bm) || lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, diffOp{'-', pre + i, pre + j, am[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', pre + i, pre + j, bm[j]})
+			j++
+		}
+	}
+
+	// common suffix
+	for k := 0; k < suf; k++ {
+		ops = append(ops, diffOp{' ', len(a) - suf + k, len(b) - suf + k, a[len(a)-suf+k]})
+	}
+
+	return ops
+}
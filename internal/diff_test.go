@@ -0,0 +1,56 @@
+package internal
+
+import "testing"
+
+func Test_Diff(t *testing.T) {
+	tests := []struct {
+		desc string
+		a    string
+		b    string
+		exp  string
+	}{
+		{
+			desc: "equal texts have no diff",
+			a:    "a\nb\n",
+			b:    "a\nb\n",
+			exp:  "",
+		},
+		{
+			desc: "new file",
+			a:    "",
+			b:    "a\nb\n",
+			exp:  "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			desc: "changed line with context",
+			a:    "1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+			b:    "1\n2\n3\n4\nfive\n6\n7\n8\n9\n",
+			exp:  "--- a\n+++ b\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n",
+		},
+		{
+			desc: "separate hunks",
+			a:    "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			b:    "one\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n",
+			exp:  "--- a\n+++ b\n@@ -1,4 +1,4 @@\n-1\n+one\n 2\n 3\n 4\n@@ -10,3 +10,4 @@\n 10\n 11\n 12\n+13\n",
+		},
+		{
+			desc: "nearby changes share a hunk",
+			a:    "1\n2\n3\n4\n5\n6\n7\n8\n",
+			b:    "1\n2\nthree\n4\n5\n6\nseven\n8\n",
+			exp:  "--- a\n+++ b\n@@ -1,8 +1,8 @@\n 1\n 2\n-3\n+three\n 4\n 5\n 6\n-7\n+seven\n 8\n",
+		},
+		{
+			desc: "deleted lines",
+			a:    "1\n2\n3\n",
+			b:    "1\n",
+			exp:  "--- a\n+++ b\n@@ -1,3 +1 @@\n 1\n-2\n-3\n",
+		},
+	}
+
+	for i, tt := range tests {
+		d := Diff("a", "b", tt.a, tt.b)
+		if d != tt.exp {
+			t.Errorf("test #%d: %s\n\texp: %q\n\tgot: %q", i+1, tt.desc, tt.exp, d)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/gedex/inflector"
 	"github.com/knq/snaker"
 )
 
@@ -18,25 +19,29 @@ const (
 	FkModeSmart FkMode = iota
 
 	// FkModeParent causes a foreign key field to be named in the form of
-	// "<type>.<ParentName>".
+	// "<type>.<ParentName>", and the reverse in the form of
+	// "<ParentType>.<Types>".
 	//
 	// For example, if you have an `authors` and `books` tables, then the
-	// foreign key func will be Book.Author.
+	// foreign key funcs will be Book.Author and Author.Books.
 	FkModeParent
 
 	// FkModeField causes a foreign key field to be named in the form of
-	// "<type>.<ParentName>By<Field>".
+	// "<type>.<ParentName>By<Field>", and the reverse in the form of
+	// "<ParentType>.<Types>By<Field>".
 	//
 	// For example, if you have an `authors` and `books` tables, then the
-	// foreign key func will be Book.AuthorByAuthorID.
+	// foreign key funcs will be Book.AuthorByAuthorID and
+	// Author.BooksByAuthorID.
 	FkModeField
 
 	// FkModeKey causes a foreign key field to be named in the form of
-	// "<type>.<ParentName>By<ForeignKeyName>".
+	// "<type>.<ParentName>By<ForeignKeyName>", and the reverse in the form of
+	// "<ParentType>.<Types>By<ForeignKeyName>".
 	//
 	// For example, if you have an `authors` and `books` tables with a foreign
-	// key name of 'fk_123', then the foreign key func will be
-	// Book.AuthorByFk123.
+	// key name of 'fk_123', then the foreign key funcs will be
+	// Book.AuthorByFk123 and Author.BooksByFk123.
 	FkModeKey
 )
 
@@ -98,7 +103,116 @@ func fkName(mode FkMode, fkMap map[string]*ForeignKey, fk *ForeignKey) string {
 	return fkName(FkModeParent, fkMap, fk)
 }
 
+// fkRevName returns the name for the reverse (has-many) of the foreign key.
+func fkRevName(mode FkMode, fkMap map[string]*ForeignKey, fk *ForeignKey) string {
+	switch mode {
+	case FkModeParent:
+		return inflector.Pluralize(fk.Type.Name)
+	case FkModeField:
+		return inflector.Pluralize(fk.Type.Name) + "By" + fk.Field.Name
+	case FkModeKey:
+		return inflector.Pluralize(fk.Type.Name) + "By" + snaker.SnakeToCamelIdentifier(fk.ForeignKey.ForeignKeyName)
+	}
+
+	// mode is FkModeSmart
+	// inspect all foreign keys and use FkModeField if conflict found
+	for _, f := range fkMap {
+		if fk != f && fk.Type.Name == f.Type.Name && fk.RefType.Name == f.RefType.Name {
+			return fkRevName(FkModeField, fkMap, fk)
+		}
+	}
+
+	// no conflict, so use FkModeParent
+	return fkRevName(FkModeParent, fkMap, fk)
+}
+
+// fieldConflict returns name with the name conflict suffix appended when it
+// conflicts with the name of one of the type's fields.
+func fieldConflict(t *Type, name, suffix string) string {
+	for _, f := range t.Fields {
+		if f.Name == name {
+			return name + suffix
+		}
+	}
+
+	return name
+}
+
+// fkLoadNames returns the names for the batch loaders of the foreign key and
+// its reverse, based on the foreign key's names. As the batch loaders are not
+// methods of the types, the reverse name's name conflict suffix is dropped.
+func fkLoadNames(fk *ForeignKey, conflictSuffix string) (string, string) {
+	types, refTypes := inflector.Pluralize(fk.Type.Name), inflector.Pluralize(fk.RefType.Name)
+	suffix := strings.TrimPrefix(fk.Name, fk.RefType.Name)
+	revName := fk.RevName
+	if n := strings.TrimSuffix(revName, conflictSuffix); n != revName && fieldConflict(fk.RefType, n, conflictSuffix) == revName {
+		revName = n
+	}
+	revSuffix := strings.TrimPrefix(revName, types)
+
+	// self referencing, so distinguish by the field
+	if fk.Type.Name == fk.RefType.Name {
+		if suffix == "" {
+			suffix = "By" + fk.Field.Name
+		}
+		return "Load" + refTypes + suffix + "For" + types, "Load" + types + "For" + refTypes + suffix
+	}
+
+	return "Load" + refTypes + suffix + "For" + types, "Load" + types + revSuffix + "For" + refTypes
+}
+
 // ForeignKeyName returns the foreign key name for the passed type.
 func (a *ArgType) ForeignKeyName(fkMap map[string]*ForeignKey, fk *ForeignKey) string {
 	return fkName(*a.ForeignKeyMode, fkMap, fk)
 }
+
+// ForeignKeyRevName returns the reverse (has-many) foreign key name for the
+// passed type, adding the name conflict suffix when it conflicts with the
+// name of a field of the referenced type.
+func (a *ArgType) ForeignKeyRevName(fkMap map[string]*ForeignKey, fk *ForeignKey) string {
+	return fieldConflict(fk.RefType, fkRevName(*a.ForeignKeyMode, fkMap, fk), a.NameConflictSuffix)
+}
+
+// m2mName returns the plural and singular names for the many-to-many
+// relationship.
+func m2mName(mode FkMode, m2ms []*ManyToMany, fkMap map[string]*ForeignKey, m *ManyToMany) (string, string) {
+	var suffix string
+	switch mode {
+	case FkModeField:
+		suffix = "By" + m.ForeignKey.Field.Name
+	case FkModeKey:
+		suffix = "By" + snaker.SnakeToCamelIdentifier(m.ForeignKey.ForeignKey.ForeignKeyName)
+
+	case FkModeSmart:
+		// inspect all many-to-many relationships and has-many foreign keys,
+		// and use FkModeField if conflict found, or FkModeKey when the
+		// conflict is through the same named fields of another join table
+		mode := FkModeSmart
+		for _, o := range m2ms {
+			if m != o && m.Type.Name == o.Type.Name && m.RefType.Name == o.RefType.Name {
+				if m.ForeignKey.Field.Name == o.ForeignKey.Field.Name {
+					return m2mName(FkModeKey, m2ms, fkMap, m)
+				}
+				mode = FkModeField
+			}
+		}
+		if mode == FkModeField {
+			return m2mName(FkModeField, m2ms, fkMap, m)
+		}
+		for _, fk := range fkMap {
+			if fk.RefType.Name == m.Type.Name && fkRevName(mode, fkMap, fk) == inflector.Pluralize(m.RefType.Name) {
+				return m2mName(FkModeField, m2ms, fkMap, m)
+			}
+		}
+	}
+
+	return inflector.Pluralize(m.RefType.Name) + suffix, m.RefType.Name + suffix
+}
+
+// ManyToManyName returns the plural and singular names for the many-to-many
+// relationship, adding the name conflict suffix to the plural name when it
+// conflicts with the name of a field of the type.
+func (a *ArgType) ManyToManyName(m2ms []*ManyToMany, fkMap map[string]*ForeignKey, m *ManyToMany) (string, string) {
+	name, itemName := m2mName(*a.ForeignKeyMode, m2ms, fkMap, m)
+	return fieldConflict(m.Type, name, a.NameConflictSuffix), itemName
+}
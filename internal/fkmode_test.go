@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"testing"
+)
+
+func Test_ForeignKeyRevName(t *testing.T) {
+	author := &Type{Name: "Author", Fields: []*Field{{Name: "AuthorID"}, {Name: "Books"}}}
+	book := &Type{Name: "Book", Fields: []*Field{{Name: "BookID"}, {Name: "AuthorID"}}}
+	fk := &ForeignKey{
+		Name:     "Author",
+		Type:     book,
+		Field:    book.Fields[1],
+		RefType:  author,
+		RefField: author.Fields[0],
+	}
+	fkMap := map[string]*ForeignKey{"book_author_id_fkey": fk}
+
+	args := NewDefaultArgs()
+	fk.RevName = args.ForeignKeyRevName(fkMap, fk)
+	if fk.RevName != "BooksVal" {
+		t.Errorf("expected BooksVal, got: %s", fk.RevName)
+	}
+
+	loadName, revLoadName := fkLoadNames(fk, args.NameConflictSuffix)
+	if loadName != "LoadAuthorsForBooks" || revLoadName != "LoadBooksForAuthors" {
+		t.Errorf("expected LoadAuthorsForBooks and LoadBooksForAuthors, got: %s and %s", loadName, revLoadName)
+	}
+}
+
+func Test_ManyToManyName(t *testing.T) {
+	user := &Type{Name: "User", Fields: []*Field{{Name: "UserID"}, {Name: "Groups"}}}
+	group := &Type{Name: "Group", Fields: []*Field{{Name: "GroupID"}}}
+	userGroup := &Type{Name: "UserGroup", Fields: []*Field{{Name: "UserID"}, {Name: "GroupID"}}}
+	m := &ManyToMany{
+		Type:          user,
+		RefType:       group,
+		JoinType:      userGroup,
+		ForeignKey:    &ForeignKey{Type: userGroup, Field: userGroup.Fields[0], RefType: user},
+		RefForeignKey: &ForeignKey{Type: userGroup, Field: userGroup.Fields[1], RefType: group},
+	}
+
+	args := NewDefaultArgs()
+	name, itemName := args.ManyToManyName([]*ManyToMany{m}, nil, m)
+	if name != "GroupsVal" || itemName != "Group" {
+		t.Errorf("expected GroupsVal and Group, got: %s and %s", name, itemName)
+	}
+}
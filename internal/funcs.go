@@ -2,6 +2,7 @@ package internal
 
 import (
 	"fmt"
+	"path"
 	"strconv"
 	"strings"
 	"text/template"
@@ -30,6 +31,7 @@ func (a *ArgType) NewTemplateFuncs() template.FuncMap {
 		"goparamlist":        a.goparamlist,
 		"reniltype":          a.reniltype,
 		"retype":             a.retype,
+		"qualtype":           a.qualtype,
 		"shortname":          a.shortname,
 		"pluralname":         a.pluralname,
 		"convext":            a.convext,
@@ -39,7 +41,16 @@ func (a *ArgType) NewTemplateFuncs() template.FuncMap {
 		"hasfield":           a.hasfield,
 		"replace":            strings.Replace,
 		"getstartcount":      a.getstartcount,
+		"ctxparam":           a.ctxparam,
+		"ctxarg":             a.ctxarg,
+		"ctxfn":              a.ctxfn,
+		"mask":               a.mask,
+		"nthparam":           a.nthparam,
+		"maxparams":          a.maxparams,
+		"loadertype":         a.loadertype,
 		"gqltype":            a.convertGQLType,
+		"gqlname":            gqlname,
+		"gqlenumvalue":       gqlenumvalue,
 		"pluralize":          a.pluralname,
 		"singularize":        a.singularize,
 		"toTitle":            strings.Title,
@@ -64,6 +75,7 @@ func (a *ArgType) NewTemplateFuncs() template.FuncMap {
 		"trim":               strings.Trim,
 		"trimPrefix":         strings.TrimPrefix,
 		"trimSuffix":         strings.TrimSuffix,
+		"base":               path.Base,
 		"capitalize":         capitalise,
 		"uncapitalize":       unCapitalise,
 		"remove_line_breaks": removeLineBreaks,
@@ -85,9 +97,12 @@ func (a *ArgType) retype(typ string) string {
 	}
 
 	prefix := ""
-	for strings.HasPrefix(typ, "[]") {
-		typ = typ[2:]
-		prefix = prefix + "[]"
+	for strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "*") {
+		n := 1
+		if typ[0] == '[' {
+			n = 2
+		}
+		prefix, typ = prefix+typ[:n], typ[n:]
 	}
 
 	if _, ok := a.KnownTypeMap[typ]; !ok {
@@ -102,6 +117,51 @@ func (a *ArgType) retype(typ string) string {
 	return prefix + typ
 }
 
+// goBuiltinTypes are the predeclared Go types.
+var goBuiltinTypes = map[string]bool{
+	"bool":       true,
+	"byte":       true,
+	"complex64":  true,
+	"complex128": true,
+	"error":      true,
+	"float32":    true,
+	"float64":    true,
+	"int":        true,
+	"int8":       true,
+	"int16":      true,
+	"int32":      true,
+	"int64":      true,
+	"rune":       true,
+	"string":     true,
+	"uint":       true,
+	"uint8":      true,
+	"uint16":     true,
+	"uint32":     true,
+	"uint64":     true,
+	"uintptr":    true,
+}
+
+// qualtype retypes typ (see retype), qualifying it with pkg when it is
+// declared in the generated package, for use outside of the package.
+func (a *ArgType) qualtype(pkg, typ string) string {
+	typ = a.retype(typ)
+
+	prefix := ""
+	for strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "*") {
+		n := 1
+		if typ[0] == '[' {
+			n = 2
+		}
+		prefix, typ = prefix+typ[:n], typ[n:]
+	}
+
+	if strings.Contains(typ, ".") || goBuiltinTypes[typ] || strings.HasPrefix(typ, "interface{") {
+		return prefix + typ
+	}
+
+	return prefix + pkg + "." + typ
+}
+
 // reniltype checks typ against known nil types (similar to retype), prefixing
 // ArgType.CustomTypePackage (if applicable).
 func (a *ArgType) reniltype(typ string) string {
@@ -168,16 +228,24 @@ func (a *ArgType) shortname(typ string, scopeConflicts ...interface{}) string {
 	// initial conflicts are the default imported packages from
 	// xo_package.go.tpl
 	conflicts := map[string]bool{
+		"context": true,
 		"sql":     true,
 		"driver":  true,
 		"csv":     true,
+		"json":    true,
 		"errors":  true,
 		"fmt":     true,
 		"regexp":  true,
+		"strconv": true,
 		"strings": true,
 		"time":    true,
 	}
 
+	// ctx is the first parameter of generated funcs
+	if a.UseContext {
+		conflicts["ctx"] = true
+	}
+
 	// add scopeConflicts to conflicts
 	for _, c := range scopeConflicts {
 		switch k := c.(type) {
@@ -699,6 +767,11 @@ func (a *ArgType) goparamlist(fields []*Field, addPrefix bool, addType bool, ign
 			s = r
 		}
 
+		// avoid shadowing the ctx parameter
+		if a.UseContext && s == "ctx" {
+			s = s + a.NameConflictSuffix
+		}
+
 		// add the go type
 		if addType {
 			s += " " + a.retype(f.Type)
@@ -806,6 +879,70 @@ func (a *ArgType) getstartcount(fields []*Field, pkFields []*Field) int {
 	return len(fields) - len(pkFields)
 }
 
+// ctxparam returns the context.Context parameter declaration to prefix a
+// generated func's parameter list with, when ArgType.UseContext is toggled.
+func (a *ArgType) ctxparam() string {
+	if a.UseContext {
+		return "ctx context.Context, "
+	}
+
+	return ""
+}
+
+// ctxarg returns the ctx argument to prefix a call's argument list with, when
+// ArgType.UseContext is toggled.
+func (a *ArgType) ctxarg() string {
+	if a.UseContext {
+		return "ctx, "
+	}
+
+	return ""
+}
+
+// ctxfn returns the name of the database/sql method to call for name (ie,
+// Exec, Query, QueryRow), using the Context variant when ArgType.UseContext is
+// toggled.
+func (a *ArgType) ctxfn(name string) string {
+	if a.UseContext {
+		return name + "Context"
+	}
+
+	return name
+}
+
+// mask returns the loader's parameter mask (ie, $%d, ?), used by generated
+// code to build place holders at runtime.
+func (a *ArgType) mask() string {
+	return a.Loader.Mask()
+}
+
+// nthparam returns the loader's 0-based Nth parameter place holder (ie, $1,
+// ?).
+func (a *ArgType) nthparam(i int) string {
+	return a.Loader.NthParam(i)
+}
+
+// maxparams returns the maximum number of parameters supported in a query by
+// the loader's database (for Oracle, the maximum number of IN list values).
+func (a *ArgType) maxparams() int {
+	switch a.LoaderType {
+	case "sqlite3":
+		return 999
+	case "mssql":
+		return 2000
+	case "oci8", "ora":
+		return 1000
+	}
+
+	return 65535
+}
+
+// loadertype returns the loader type (ie, postgres, sqlite3), used by
+// templates shared between loaders.
+func (a *ArgType) loadertype() string {
+	return a.LoaderType
+}
+
 func removeLineBreaks(str string) string {
 	return strings.Replace(str, "\n", " ", -1)
 }
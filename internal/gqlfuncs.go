@@ -35,6 +35,12 @@ func pts(s *string) string {
 	return *s
 }
 
+// isGoType returns true when the values of t are Go values (ie, string), or
+// enums and custom scalars, which are not wrapped in a resolver.
+func isGoType(t *Typ) bool {
+	return KnownGoTypes[t.GoType] || t.IsEnum || t.IsScalar
+}
+
 func GenType(t *Typ, mode, fieldName, pkg string) string {
 	var r string
 
@@ -65,10 +71,10 @@ func GenType(t *Typ, mode, fieldName, pkg string) string {
 		// }
 
 		if mode == "interface" || mode == "query" {
-			ok := KnownGoTypes[t.GoType]
+			ok := isGoType(t)
 
 			// Add golang package name if provided
-			if pkg != "package" && pkg != "" && !ok {
+			if pkg != "package" && pkg != "" && !ok && t.Type == nil {
 				r = pkg + "." + r
 			}
 
@@ -88,8 +94,8 @@ func GenType(t *Typ, mode, fieldName, pkg string) string {
 	}
 
 	// Add golang package name if provided
-	ok := KnownGoTypes[t.GoType]
-	if (mode == "struct" || mode == "interface") && pkg != "package" && pkg != "" && !ok {
+	ok := isGoType(t)
+	if (mode == "struct" || mode == "interface") && pkg != "package" && pkg != "" && !ok && t.Type == nil {
 		// Avoid adding pointer multiple times
 		r = strings.Replace(r, "*", "", 1)
 
@@ -108,6 +114,17 @@ func GenType(t *Typ, mode, fieldName, pkg string) string {
 		}
 	}
 
+	// Enums and custom scalars are declared in the objects package, and
+	// argStruct is only used by the queries package
+	if t.IsEnum || t.IsScalar {
+		if mode == "argStruct" {
+			pkg = "objects"
+		}
+		if pkg != "package" && pkg != "" {
+			r = strings.Replace(r, t.GoType, pkg+"."+t.GoType, 1)
+		}
+	}
+
 	if t.Type == nil {
 		return r
 	}
@@ -150,8 +167,8 @@ func GenResolver(f *FieldDef, isModel bool, pkg string) string {
 
 		r += "  return "
 
-		// Add pointer for Pageinfo cursors
-		if f.Parent == "PageInfo" && (f.Name == "endCursor" || f.Name == "startCursor") {
+		// Add pointer for nullable Go values (ie, Pageinfo cursors)
+		if f.Type.IsNullable && f.Type.Type == nil && KnownGoTypes[f.Type.GoType] {
 			r += "&"
 		}
 
@@ -207,26 +224,26 @@ func GenResolver(f *FieldDef, isModel bool, pkg string) string {
 		if f.Type.GQLType != "graphql.ID" {
 			r += "  return "
 
-			if f.Type.IsNullable {
+			if f.Type.IsNullable && !f.Type.IsEnum && !f.Type.IsScalar {
 				r += "&"
 			}
 		}
 
-		if _, ok := KnownGoTypes[f.Type.GQLType]; !ok {
+		if _, ok := KnownGoTypes[f.Type.GQLType]; !ok && !f.Type.IsEnum && !f.Type.IsScalar {
 			//log.Printf("GenResolver code for %s %s", f.Type.GQLType, f.Name)
 
 			if f.Type.GQLType == "graphql.ID" {
-				r += " if r." + f.Parent + "." + f.Name + " == \"\" { "
+				r += " if r." + f.Parent + "." + capitalise(f.Name) + " == \"\" { "
 				r += "  return graphql.ID(\"\")"
 				r += "  }\n\n"
-				r += "//return graphql.ID(r." + f.Parent + "." + f.Name + ")\n"
+				r += "//return graphql.ID(r." + f.Parent + "." + capitalise(f.Name) + ")\n"
 				r += "  return "
 
 				if f.Type.IsNullable {
 					r += "&"
 				}
 
-				r += "relay.ToGlobalID(\"" + f.Parent + "\", r." + f.Parent + "." + f.Name + ")"
+				r += "relay.ToGlobalID(\"" + f.Parent + "\", r." + f.Parent + "." + capitalise(f.Name) + ")"
 			} else if f.Type.GQLType == "graphql.Time" {
 				dref := ""
 				// if f.Type.IsNullable {
@@ -0,0 +1,580 @@
+package internal
+
+import (
+	"log"
+	"sort"
+	"strings"
+)
+
+// modelsPackage is the name of the generated package in the generated
+// subpackages (ie, the GraphQL dataloaders and resolvers), importing it by
+// ArgType.ModelsImport.
+const modelsPackage = "models"
+
+// generateModels maps the models (ie, the types implementing Node or named
+// after a table) to the tables of the same name, and generates the resolvers of the Query and
+// Mutation fields resolved with them: the Query fields having the arguments
+// of an index, and the createX, updateX and deleteX Mutation fields.
+func (g *CodeGen) generateModels(args *ArgType, types []*TypeDef, models, payloads map[string]*TypeDef) error {
+	var err error
+
+	inputs, objects := map[string]*TypeDef{}, map[string]*TypeDef{}
+	for _, t := range types {
+		switch {
+		case t.IsInput:
+			inputs[t.Name] = t
+		case t.IsObject:
+			objects[t.Name] = t
+		}
+	}
+
+	// sort, as the resolvers are generated in order
+	var names []string
+	for n, t := range models {
+		m, ok := args.GraphQLModels[n]
+		if !ok {
+			continue
+		}
+
+		m.Def = t
+		m.Fields = m.fields(t.Fields, "struct")
+		t.Model = m
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	// nothing to resolve
+	if len(names) == 0 {
+		return nil
+	}
+
+	for _, n := range names {
+		m := models[n].Model
+		m.Relations = m.relations(models, args.NameConflictSuffix)
+	}
+
+	for _, t := range types {
+		for _, f := range t.Fields {
+			switch {
+			case t.IsQuery:
+				typ := f.Type
+				if typ.Type != nil {
+					typ = typ.Type
+				}
+				if m := models[typ.GoType]; m != nil && m.Model != nil {
+					m.Model.addResolver(m.Model.queryResolver(f))
+				}
+				if m := models[strings.TrimSuffix(typ.GoType, "Connection")]; m != nil && m.Model != nil && typ == f.Type {
+					m.Model.addResolver(m.Model.pageResolver(f, objects))
+				}
+
+			case t.IsMutation:
+				for _, kind := range []string{"create", "update", "delete"} {
+					if !strings.HasPrefix(f.Name, kind) {
+						continue
+					}
+					if m := models[strings.TrimPrefix(f.Name, kind)]; m != nil && m.Model != nil {
+						m.Model.addResolver(m.Model.mutationResolver(strings.ToUpper(kind), f, inputs, payloads))
+					}
+				}
+			}
+		}
+	}
+
+	log.Printf("Generating Go code for RESOLVER %v", names)
+
+	// generate shared resolver template
+	err = args.ExecuteTemplate(GraphQLModelTemplate, "resolver", "", &GraphQLModel{
+		Template: "RESOLVER",
+		Package:  modelsPackage,
+	})
+	if err != nil {
+		return err
+	}
+
+	// generate model resolver templates
+	for _, n := range names {
+		err = args.ExecuteTemplate(GraphQLModelTemplate, "resolver", n, args.GraphQLModels[n])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addResolver adds the resolver r, if any, to the model.
+func (m *GraphQLModel) addResolver(r *GraphQLResolver) {
+	if r != nil {
+		m.Resolvers = append(m.Resolvers, r)
+	}
+}
+
+// fields maps the scalar fields defs, declared as Go types with mode (see
+// GenType), to the fields of the model's type of the same name, with the id
+// field mapped to the primary key. Fields that cannot be converted are
+// skipped.
+func (m *GraphQLModel) fields(defs []*FieldDef, mode string) []*GraphQLField {
+	var fields []*GraphQLField
+	for _, def := range defs {
+		if def.Type.Type != nil || !KnownGoTypes[def.Type.GoType] {
+			continue
+		}
+
+		// find field
+		var field *Field
+		for _, f := range m.Type.Fields {
+			if strings.EqualFold(f.Name, def.Name) {
+				field = f
+				break
+			}
+		}
+		if field == nil && strings.EqualFold(def.Name, "id") {
+			field = m.Type.PrimaryKey
+		}
+		if field == nil {
+			continue
+		}
+
+		f := &GraphQLField{
+			Name:     capitalise(def.Name),
+			Var:      gqlname(field.Name),
+			Def:      def,
+			Field:    field,
+			IsID:     def.Type.GQLType == "graphql.ID",
+			Nullable: strings.HasPrefix(GenType(def.Type, mode, def.Name, "package"), "*"),
+		}
+
+		// check conversion
+		switch {
+		case f.IsID && field.Type != "string" && !intTypes[field.Type]:
+			continue
+		case mode == "struct" && f.ToGQL("m") == "":
+			continue
+		case mode != "struct" && !f.IsID && f.ToDB("v") == "":
+			continue
+		}
+
+		fields = append(fields, f)
+	}
+
+	return fields
+}
+
+// relations returns the relations of the fields of the model's type returning
+// another model's type, the field being named after the foreign key, has-many
+// or many-to-many func of the model's type (as in the generated GraphQL
+// schema, with suffix appended when conflicting with a column's field).
+// Fields that cannot be resolved are skipped, and left to be implemented.
+func (m *GraphQLModel) relations(models map[string]*TypeDef, suffix string) []*GraphQLRelation {
+	var relations []*GraphQLRelation
+	for _, def := range m.Def.Fields {
+		typ := def.Type
+		if typ.Type != nil {
+			typ = typ.Type
+		}
+		ref := models[typ.GoType]
+		if ref == nil || ref.Model == nil || typ.Type != nil {
+			continue
+		}
+
+		// funcs returning the referenced type
+		var funcs []string
+		if def.Type.Type == nil {
+			for _, fk := range m.ForeignKeys {
+				if fk.RefType == ref.Model.Type {
+					funcs = append(funcs, fk.Name)
+				}
+			}
+		} else {
+			for _, fk := range m.RevForeignKeys {
+				if fk.Type == ref.Model.Type {
+					funcs = append(funcs, fk.RevName)
+				}
+			}
+			for _, mm := range m.ManyToMany {
+				if mm.RefType == ref.Model.Type {
+					funcs = append(funcs, mm.Name)
+				}
+			}
+		}
+
+		for _, fn := range funcs {
+			if name := gqlname(fn); def.Name != name && def.Name != name+suffix {
+				continue
+			}
+
+			// resolvers are returned as pointers, as in the queries
+			// package, in the package of the type
+			ret := GenType(def.Type, "interface", def.Name, "objects")
+
+			relations = append(relations, &GraphQLRelation{
+				Def:     def,
+				Func:    fn,
+				RefType: ref.Name,
+				Ret:     strings.Replace(ret, "objects.", "", 1),
+				List:    def.Type.Type != nil,
+			})
+			break
+		}
+	}
+
+	return relations
+}
+
+// queryResolver returns the resolver of the Query field f when its arguments
+// are the (non-null) fields of one of the model's indexes, unique when f is
+// not a list.
+func (m *GraphQLModel) queryResolver(f *FieldDef) *GraphQLResolver {
+	unique := f.Type.Type == nil
+
+	args := m.fields(f.Args, "argStruct")
+	if len(args) == 0 || len(args) != len(f.Args) {
+		return nil
+	}
+
+	for _, ix := range m.Indexes {
+		if ix.Index.IsUnique != unique || len(ix.Fields) != len(args) {
+			continue
+		}
+
+		// order args as the index fields
+		var ordered []*GraphQLField
+		for _, field := range ix.Fields {
+			for _, a := range args {
+				if a.Field == field && !a.Nullable {
+					ordered = append(ordered, a)
+					break
+				}
+			}
+		}
+		if len(ordered) != len(ix.Fields) {
+			continue
+		}
+
+		return &GraphQLResolver{
+			Kind:  "QUERY",
+			Def:   f,
+			Ret:   GenType(f.Type, "interface", f.Name, "objects"),
+			Index: ix,
+			Args:  ordered,
+		}
+	}
+
+	return nil
+}
+
+// pageResolver returns the resolver of the Query field f returning a Relay
+// connection of the model (ie, BookConnection, having edges of BookEdge and a
+// pageInfo), when its arguments are first, after and the (non-null) fields of
+// one of the model's paginated indexes.
+func (m *GraphQLModel) pageResolver(f *FieldDef, objects map[string]*TypeDef) *GraphQLResolver {
+	conn := objects[f.Type.GoType]
+	if conn == nil {
+		return nil
+	}
+
+	r := &GraphQLResolver{
+		Kind:       "PAGE",
+		Def:        f,
+		Ret:        GenType(f.Type, "interface", f.Name, "objects"),
+		Connection: conn.Name,
+	}
+
+	// check edges and pageInfo
+	for _, cf := range conn.Fields {
+		switch {
+		case cf.Name == "edges" && cf.Type.Type != nil && objects[cf.Type.Type.GoType] != nil:
+			r.Edge = cf.Type.Type.GoType
+			r.EdgesType = GenType(cf.Type, "struct", cf.Name, "objects")
+		case cf.Name == "pageInfo" && cf.Type.Type == nil && objects[cf.Type.GoType] != nil:
+			r.PageInfo = cf.Type.GoType
+			r.PageInfoType = GenType(cf.Type, "struct", cf.Name, "objects")
+			for _, pf := range objects[r.PageInfo].Fields {
+				switch pf.Name {
+				case "hasNextPage", "hasPreviousPage", "startCursor", "endCursor":
+					r.PageFields = append(r.PageFields, capitalise(pf.Name))
+				}
+			}
+		}
+	}
+	if r.Edge == "" || r.PageInfo == "" {
+		return nil
+	}
+
+	var cursor, node bool
+	for _, ef := range objects[r.Edge].Fields {
+		switch {
+		case ef.Name == "cursor" && ef.Type.Type == nil && ef.Type.GoType == "string":
+			cursor = true
+		case ef.Name == "node" && ef.Type.Type == nil && ef.Type.GoType == m.Def.Name:
+			node = true
+			r.NodeValue = !strings.HasPrefix(GenType(ef.Type, "struct", ef.Name, "objects"), "*")
+		}
+	}
+	if !cursor || !node {
+		return nil
+	}
+
+	// the first and after arguments must be nullable
+	var defs []*FieldDef
+	var first, after bool
+	for _, a := range f.Args {
+		switch {
+		case a.Name == "first" && a.Type.Type == nil && a.Type.GoType == "int32":
+			first = a.Type.IsNullable
+		case a.Name == "after" && a.Type.Type == nil && a.Type.GoType == "string":
+			after = a.Type.IsNullable
+		default:
+			defs = append(defs, a)
+		}
+	}
+	if !first || !after {
+		return nil
+	}
+
+	args := m.fields(defs, "argStruct")
+	if len(args) != len(defs) {
+		return nil
+	}
+
+	for _, ix := range m.Indexes {
+		if ix.PageName == "" || len(ix.Fields) != len(args) {
+			continue
+		}
+
+		// order args as the index fields
+		var ordered []*GraphQLField
+		for _, field := range ix.Fields {
+			for _, a := range args {
+				if a.Field == field && !a.Nullable {
+					ordered = append(ordered, a)
+					break
+				}
+			}
+		}
+		if len(ordered) != len(ix.Fields) {
+			continue
+		}
+
+		r.Index, r.Args = ix, ordered
+		return r
+	}
+
+	return nil
+}
+
+// mutationResolver returns the resolver of the create, update or delete
+// Mutation field f, having its values as arguments or as the fields of a
+// single input argument, and returning a payload, the type itself or a
+// Boolean. Update and delete look up the row by the id value.
+func (m *GraphQLModel) mutationResolver(kind string, f *FieldDef, inputs, payloads map[string]*TypeDef) *GraphQLResolver {
+	t := m.Type
+	if t.PrimaryKey == nil || len(t.PrimaryKeyFields) > 1 {
+		return nil
+	}
+
+	// updates are only generated when there are fields other than the
+	// primary key
+	if kind == "UPDATE" && len(t.Fields) == len(t.PrimaryKeyFields) {
+		return nil
+	}
+
+	r := &GraphQLResolver{
+		Kind: kind,
+		Def:  f,
+		Ret:  GenType(f.Type, "interface", f.Name, "package"),
+	}
+
+	// values are the arguments, or the fields of the input argument
+	defs := f.Args
+	if len(f.Args) == 1 {
+		if in, ok := inputs[f.Args[0].Type.GoType]; ok {
+			if f.Args[0].Type.IsNullable {
+				return nil
+			}
+
+			defs = in.Fields
+			r.Input = capitalise(f.Args[0].Name) + "."
+		}
+	}
+	for _, v := range m.fields(defs, "argStruct") {
+		switch {
+		case v.Field == t.PrimaryKey:
+			if !v.Nullable {
+				r.Args = []*GraphQLField{v}
+			}
+		case kind != "DELETE":
+			r.Values = append(r.Values, v)
+		}
+	}
+
+	// look up by the primary key
+	if kind != "CREATE" {
+		for _, ix := range m.Indexes {
+			if ix.Index.IsUnique && len(ix.Fields) == 1 && ix.Fields[0] == t.PrimaryKey {
+				r.Index = ix
+			}
+		}
+		if r.Index == nil || len(r.Args) == 0 {
+			return nil
+		}
+	}
+
+	// return value
+	switch p := payloads[f.Type.GoType]; {
+	case f.Type.GoType == "bool" && f.Type.Type == nil:
+		r.Zero = "false"
+	case p != nil && IsPayloadType(f, "interface", "package"):
+		r.Payload = p
+		r.Zero = "nil"
+		if !strings.HasPrefix(r.Ret, "*") {
+			r.Zero = r.Ret + "{}"
+		}
+		for _, pf := range p.Fields {
+			if pf.Type.Type == nil && pf.Type.GoType == m.Def.Name {
+				r.PayloadField = capitalise(pf.Name)
+				r.PayloadValue = !strings.HasPrefix(GenType(pf.Type, "struct", pf.Name, "objects"), "*")
+			}
+		}
+	case f.Type.Type == nil && f.Type.GoType == m.Def.Name:
+		r.Object = true
+		r.Ret = GenType(f.Type, "interface", f.Name, "objects")
+		r.Zero = "nil"
+		if !strings.HasPrefix(r.Ret, "*") {
+			r.Zero = r.Ret + "{}"
+		}
+	default:
+		log.Printf("Skipping the %s mutation resolver, returning the unsupported type %s", f.Name, f.Type.GoType)
+		return nil
+	}
+
+	return r
+}
+
+// ToGQL returns the expression converting the field of the model expr to the
+// Go type of the GraphQL field.
+func (f *GraphQLField) ToGQL(expr string) string {
+	return toGQL(expr+"."+f.Field.Name, f.Field.Type, f.Def.Type.GoType)
+}
+
+// ToDB returns the expression converting expr, of the Go type of the GraphQL
+// field, to the type of the model's field.
+func (f *GraphQLField) ToDB(expr string) string {
+	return toDB(expr, f.Def.Type.GoType, f.Field.Type)
+}
+
+// intTypes are the Go integer types.
+var intTypes = map[string]bool{
+	"int":    true,
+	"int8":   true,
+	"int16":  true,
+	"int32":  true,
+	"int64":  true,
+	"uint":   true,
+	"uint8":  true,
+	"uint16": true,
+	"uint32": true,
+	"uint64": true,
+}
+
+// toGQL returns the expression converting expr, of the database Go type typ,
+// to the GraphQL Go type gqlType, or "" when not supported.
+func toGQL(expr, typ, gqlType string) string {
+	if typ == gqlType {
+		return expr
+	}
+
+	switch gqlType {
+	case "string":
+		switch {
+		case typ == "sql.NullString" || typ == "dbr.NullString":
+			return expr + ".String"
+		case typ == "[]byte":
+			return "string(" + expr + ")"
+		case !strings.Contains(typ, "Null"):
+			return "fmt.Sprint(" + expr + ")"
+		}
+
+	case "int32":
+		switch {
+		case intTypes[typ]:
+			return "int32(" + expr + ")"
+		case typ == "sql.NullInt64" || typ == "dbr.NullInt64":
+			return "int32(" + expr + ".Int64)"
+		case typ == "sql.NullInt32":
+			return expr + ".Int32"
+		}
+
+	case "float64":
+		switch typ {
+		case "float32":
+			return "float64(" + expr + ")"
+		case "sql.NullFloat64", "dbr.NullFloat64":
+			return expr + ".Float64"
+		}
+
+	case "bool":
+		if typ == "sql.NullBool" || typ == "dbr.NullBool" {
+			return expr + ".Bool"
+		}
+
+	case "time.Time":
+		if strings.HasSuffix(typ, ".NullTime") || typ == "xoutil.SqTime" {
+			return expr + ".Time"
+		}
+	}
+
+	return ""
+}
+
+// toDB returns the expression converting expr, of the GraphQL Go type
+// gqlType, to the database Go type typ, or "" when not supported.
+func toDB(expr, gqlType, typ string) string {
+	if typ == gqlType {
+		return expr
+	}
+
+	switch gqlType {
+	case "string":
+		switch typ {
+		case "sql.NullString":
+			return "sql.NullString{String: " + expr + ", Valid: true}"
+		case "[]byte":
+			return "[]byte(" + expr + ")"
+		}
+
+	case "int32":
+		switch {
+		case intTypes[typ]:
+			return typ + "(" + expr + ")"
+		case typ == "sql.NullInt64":
+			return "sql.NullInt64{Int64: int64(" + expr + "), Valid: true}"
+		case typ == "sql.NullInt32":
+			return "sql.NullInt32{Int32: " + expr + ", Valid: true}"
+		}
+
+	case "float64":
+		switch typ {
+		case "float32":
+			return "float32(" + expr + ")"
+		case "sql.NullFloat64":
+			return "sql.NullFloat64{Float64: " + expr + ", Valid: true}"
+		}
+
+	case "bool":
+		if typ == "sql.NullBool" {
+			return "sql.NullBool{Bool: " + expr + ", Valid: true}"
+		}
+
+	case "time.Time":
+		switch typ {
+		case "sql.NullTime", "pq.NullTime", "mysql.NullTime":
+			return typ + "{Time: " + expr + ", Valid: true}"
+		case "xoutil.SqTime":
+			return typ + "{Time: " + expr + "}"
+		}
+	}
+
+	return ""
+}
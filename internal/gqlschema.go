@@ -0,0 +1,144 @@
+package internal
+
+import (
+	"strings"
+	"unicode"
+)
+
+// FieldType returns the GraphQL type of the field f of the type t, marked
+// non-null when the column is not nullable. The primary key of a Node type
+// (see gqlNode) is typed as ID.
+func (s *GraphQLSchema) FieldType(t *Type, f *Field) string {
+	typ := s.goType(f.Type)
+	switch {
+	case IsJSONType(f.Col.DataType):
+		typ = "JSON"
+	case f.Col.IsPrimaryKey && gqlNode(t) && !strings.HasPrefix(typ, "["):
+		typ = "ID"
+	}
+
+	if f.Col.NotNull || f.Col.IsPrimaryKey {
+		typ += "!"
+	}
+
+	return typ
+}
+
+// ArgType returns the non-null GraphQL type of the field f of the type t, for
+// use as a Query field argument.
+func (s *GraphQLSchema) ArgType(t *Type, f *Field) string {
+	return strings.TrimSuffix(s.FieldType(t, f), "!") + "!"
+}
+
+// gqlNode returns true when the type t implements Node, ie when it is a table
+// with a single column primary key.
+func gqlNode(t *Type) bool {
+	return t.RelType == Table && t.PrimaryKey != nil && len(t.PrimaryKeyFields) == 1
+}
+
+// goType returns the GraphQL type of the Go type typ, defaulting to String.
+func (s *GraphQLSchema) goType(typ string) string {
+	typ = strings.TrimPrefix(typ, "*")
+
+	switch {
+	case s.enums[typ]:
+		return typ
+	case s.jsonTypes[typ]:
+		return "JSON"
+	case typ == "[]byte":
+		return "String"
+	case strings.HasPrefix(typ, "[]"):
+		return "[" + s.goType(typ[2:]) + "!]"
+	case strings.HasSuffix(typ, "Slice") && s.enums[strings.TrimSuffix(typ, "Slice")]:
+		return "[" + strings.TrimSuffix(typ, "Slice") + "!]"
+	}
+
+	switch typ {
+	case "bool", "sql.NullBool", "dbr.NullBool":
+		return "Boolean"
+
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64",
+		"sql.NullInt32", "sql.NullInt64", "dbr.NullInt64":
+		return "Int"
+
+	case "float32", "float64", "sql.NullFloat64", "dbr.NullFloat64":
+		return "Float"
+
+	case "time.Time", "sql.NullTime", "pq.NullTime", "mysql.NullTime", "dbr.NullTime":
+		return "Time"
+
+	case "json.RawMessage", "NullJSON":
+		return "JSON"
+
+	case "Int64Slice":
+		return "[Int!]"
+
+	case "Float64Slice":
+		return "[Float!]"
+
+	case "BoolSlice":
+		return "[Boolean!]"
+
+	case "StringSlice", "UUIDSlice":
+		return "[String!]"
+
+	case "NullInt64Slice":
+		return "[Int]"
+
+	case "NullFloat64Slice":
+		return "[Float]"
+
+	case "NullBoolSlice":
+		return "[Boolean]"
+
+	case "NullStringSlice", "NullUUIDSlice":
+		return "[String]"
+	}
+
+	return "String"
+}
+
+// FieldName returns the GraphQL field name of the relationship name, adding
+// the name conflict suffix when it conflicts with the name of a column field.
+func (st *GraphQLSchemaType) FieldName(name string) string {
+	name = gqlname(name)
+	if st.fields[name] {
+		return name + st.suffix
+	}
+
+	return name
+}
+
+// NodeID returns true when the type implements Node without a field named
+// id, in which case an id field resolved with the primary key is added.
+func (st *GraphQLSchemaType) NodeID() bool {
+	return st.Node && !st.fields["id"]
+}
+
+// gqlname converts the Go identifier s to a GraphQL field name, lower casing
+// the leading upper case letters (ie, ID -> id, AuthorID -> authorID, URLPath
+// -> urlPath).
+func gqlname(s string) string {
+	r := []rune(s)
+	for i := 0; i < len(r) && unicode.IsUpper(r[i]); i++ {
+		if i > 0 && i+1 < len(r) && unicode.IsLower(r[i+1]) {
+			break
+		}
+		r[i] = unicode.ToLower(r[i])
+	}
+
+	return string(r)
+}
+
+// gqlenumvalue converts the enum value s to a GraphQL enum value name, upper
+// casing it and replacing invalid characters with underscores.
+func gqlenumvalue(s string) string {
+	r := []rune(strings.ToUpper(s))
+	for i, c := range r {
+		if c != '_' && !('A' <= c && c <= 'Z') && !(i > 0 && '0' <= c && c <= '9') {
+			r[i] = '_'
+		}
+	}
+
+	return string(r)
+}
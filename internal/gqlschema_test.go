@@ -0,0 +1,37 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/sandeepone/xo/models"
+)
+
+func Test_FieldType(t *testing.T) {
+	pk := func(name string) *Field {
+		return &Field{Name: name, Type: "int", Col: &models.Column{ColumnName: name, DataType: "integer", NotNull: true, IsPrimaryKey: true}}
+	}
+
+	book := &Type{Name: "Book", RelType: Table, Fields: []*Field{pk("BookID")}}
+	book.PrimaryKey, book.PrimaryKeyFields = book.Fields[0], book.Fields
+	bookTag := &Type{Name: "BookTag", RelType: Table, Fields: []*Field{pk("BookID"), pk("TagID")}}
+	bookTag.PrimaryKey, bookTag.PrimaryKeyFields = bookTag.Fields[0], bookTag.Fields
+
+	s := &GraphQLSchema{}
+	tests := []struct {
+		t   *Type
+		exp string
+	}{
+		{book, "ID!"},
+		{bookTag, "Int!"},
+	}
+	for i, tt := range tests {
+		for _, f := range tt.t.PrimaryKeyFields {
+			if typ := s.FieldType(tt.t, f); typ != tt.exp {
+				t.Errorf("test #%d: %s.%s expected %s, got: %s", i+1, tt.t.Name, f.Name, tt.exp, typ)
+			}
+			if typ := s.ArgType(tt.t, f); typ != tt.exp {
+				t.Errorf("test #%d: %s.%s argument expected %s, got: %s", i+1, tt.t.Name, f.Name, tt.exp, typ)
+			}
+		}
+	}
+}
@@ -0,0 +1,411 @@
+package internal
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+// GraphQLSource is a GraphQL schema read from one or more files, with the
+// type extensions (ie, extend type Query) merged into their types, mapping
+// the lines of the schema back to the files.
+type GraphQLSource struct {
+	// Schema is the concatenated schema.
+	Schema string
+
+	// pos are the file positions of the lines of Schema.
+	pos []gqlPos
+}
+
+// gqlPos is the position of a line of a GraphQLSource.
+type gqlPos struct {
+	file string
+	line int
+}
+
+// gqlSchemaExts are the extensions of the GraphQL schema files read from a
+// directory.
+var gqlSchemaExts = map[string]bool{
+	".graphql":  true,
+	".graphqls": true,
+	".gql":      true,
+}
+
+// ReadGraphQLSchema reads the GraphQL schema at path, being either a file, a
+// directory (read recursively, in lexical order, for .graphql, .graphqls and
+// .gql files), or a glob pattern (ie, schema/*.graphql).
+func ReadGraphQLSchema(path string) (*GraphQLSource, error) {
+	var files []string
+
+	fi, err := os.Stat(path)
+	switch {
+	case err == nil && fi.IsDir():
+		err = filepath.Walk(path, func(name string, fi os.FileInfo, err error) error {
+			if err == nil && !fi.IsDir() && gqlSchemaExts[strings.ToLower(filepath.Ext(name))] {
+				files = append(files, name)
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+	case err == nil:
+		files = []string{path}
+
+	case strings.ContainsAny(path, "*?["):
+		files, err = filepath.Glob(path)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no GraphQL schema files found in %s", path)
+	}
+
+	srcs := make([][]byte, len(files))
+	for i, name := range files {
+		srcs[i], err = ioutil.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewGraphQLSource(files, srcs)
+}
+
+// NewGraphQLSource concatenates the GraphQL schema files srcs, named files,
+// merging the type extensions into their types.
+//
+// Extensions of object, input, interface and enum types are merged into the
+// type of the same name, which is then moved to the end of the schema (and
+// declared when only extended), so that the extensions can be declared in
+// any file, with parsers not supporting them.
+func NewGraphQLSource(files []string, srcs [][]byte) (*GraphQLSource, error) {
+	// collect the definitions of the extended types
+	var names []string
+	base, exts := map[string]*gqlDef{}, map[string][]*gqlDef{}
+	for i, src := range srcs {
+		defs, err := gqlDefs(i, string(src))
+		if err != nil {
+			return nil, fmt.Errorf("%s:%v", files[i], err)
+		}
+
+		for _, d := range defs {
+			switch {
+			case d.extend && exts[d.name] == nil:
+				names = append(names, d.name)
+				fallthrough
+			case d.extend:
+				exts[d.name] = append(exts[d.name], d)
+			case base[d.name] == nil:
+				base[d.name] = d
+			}
+		}
+	}
+
+	// blank the extended types, preserving the positions of the remaining
+	// definitions
+	buf := make([][]byte, len(srcs))
+	for i, src := range srcs {
+		buf[i] = append([]byte(nil), src...)
+	}
+	for _, name := range names {
+		for _, d := range append(exts[name], base[name]) {
+			if d == nil {
+				continue
+			}
+			for j := d.start; j < d.end; j++ {
+				if buf[d.file][j] != '\n' {
+					buf[d.file][j] = ' '
+				}
+			}
+		}
+	}
+
+	s := &GraphQLSource{}
+	var schema strings.Builder
+	write := func(text string, file, line int) {
+		if !strings.HasSuffix(text, "\n") {
+			text += "\n"
+		}
+		schema.WriteString(text)
+		for i := strings.Count(text, "\n"); i > 0; i-- {
+			s.pos = append(s.pos, gqlPos{files[file], line})
+			line++
+		}
+	}
+	for i := range buf {
+		write(string(buf[i]), i, 1)
+	}
+
+	// append the merged types
+	for _, name := range names {
+		b, last := base[name], exts[name][len(exts[name])-1]
+
+		// header, adding the interfaces implemented by the extensions
+		var impls []string
+		for _, d := range exts[name] {
+			for _, impl := range d.implements {
+				if b == nil || !includesString(b.implements, impl) && !includesString(impls, impl) {
+					impls = append(impls, impl)
+				}
+			}
+		}
+		header, sep := exts[name][0].kind+" "+name, " implements "
+		file, line := exts[name][0].file, exts[name][0].line
+		if b != nil {
+			header = strings.TrimSpace(string(srcs[b.file][b.start:b.lbrace]))
+			file, line = b.file, b.line
+			if len(b.implements) != 0 {
+				sep = " & "
+			}
+		}
+		if len(impls) != 0 {
+			header += sep + strings.Join(impls, " & ")
+		}
+		write(header+" {", file, line)
+
+		// fields (or values)
+		for _, d := range append([]*gqlDef{b}, exts[name]...) {
+			if d != nil {
+				write(string(srcs[d.file][d.lbrace+1:d.end-1]), d.file, d.lbraceLine)
+			}
+		}
+		write("}", last.file, last.endLine)
+	}
+
+	s.Schema = schema.String()
+	return s, nil
+}
+
+// Error returns err with the positions of the GraphQL query errors (ie,
+// parse errors) mapped to the files of the schema.
+func (s *GraphQLSource) Error(err error) error {
+	qe, ok := err.(*gqlerrors.QueryError)
+	if !ok || len(qe.Locations) == 0 {
+		return err
+	}
+
+	loc := qe.Locations[0]
+	if loc.Line < 1 || loc.Line > len(s.pos) {
+		return err
+	}
+
+	p := s.pos[loc.Line-1]
+	return fmt.Errorf("%s:%d:%d: %s", p.file, p.line, loc.Column, qe.Message)
+}
+
+// gqlDef is an object, input, interface or enum type definition (or
+// extension) in a GraphQL schema file.
+type gqlDef struct {
+	file       int
+	extend     bool
+	kind       string
+	name       string
+	implements []string
+
+	// start, lbrace and end are the offsets (and lines) of the definition
+	// (including its description), of its opening brace, and after its
+	// closing brace.
+	start, lbrace, end        int
+	line, lbraceLine, endLine int
+}
+
+// gqlToken is a lexed GraphQL token.
+type gqlToken struct {
+	text  string
+	start int
+	line  int
+	str   bool
+}
+
+// gqlLex splits the GraphQL schema src into tokens, dropping comments,
+// whitespace and commas.
+func gqlLex(src string) ([]gqlToken, error) {
+	var toks []gqlToken
+
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		start, startLine := i, line
+
+		switch {
+		case c == '\n':
+			line++
+			i++
+
+		case c == ' ' || c == '\t' || c == '\r' || c == ',':
+			i++
+
+		// comment
+		case c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+
+		// block string
+		case strings.HasPrefix(src[i:], `"""`):
+			end := strings.Index(strings.Replace(src[i+3:], `\"""`, `xxxx`, -1), `"""`)
+			if end == -1 {
+				return nil, fmt.Errorf("%d: unterminated block string", line)
+			}
+			line += strings.Count(src[i:i+3+end], "\n")
+			i += end + 6
+			toks = append(toks, gqlToken{src[start:i], start, startLine, true})
+
+		// string
+		case c == '"':
+			for i++; i < len(src) && src[i] != '"'; i++ {
+				if src[i] == '\\' {
+					i++
+				}
+				if i < len(src) && src[i] == '\n' {
+					return nil, fmt.Errorf("%d: unterminated string", startLine)
+				}
+			}
+			if i >= len(src) {
+				return nil, fmt.Errorf("%d: unterminated string", startLine)
+			}
+			i++
+			toks = append(toks, gqlToken{src[start:i], start, startLine, true})
+
+		// name or number
+		case isGQLNameChar(c):
+			for i < len(src) && isGQLNameChar(src[i]) {
+				i++
+			}
+			toks = append(toks, gqlToken{src[start:i], start, startLine, false})
+
+		default:
+			i++
+			toks = append(toks, gqlToken{src[start:i], start, startLine, false})
+		}
+	}
+
+	return toks, nil
+}
+
+// isGQLNameChar returns true if c is a GraphQL name character.
+func isGQLNameChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// gqlDefKinds are the kinds of the GraphQL type definitions that can be
+// extended.
+var gqlDefKinds = map[string]bool{
+	"type":      true,
+	"input":     true,
+	"interface": true,
+	"enum":      true,
+}
+
+// gqlKeywords are the keywords starting a GraphQL definition.
+var gqlKeywords = map[string]bool{
+	"type":      true,
+	"input":     true,
+	"interface": true,
+	"enum":      true,
+	"extend":    true,
+	"scalar":    true,
+	"union":     true,
+	"schema":    true,
+	"directive": true,
+}
+
+// gqlDefs returns the type definitions and extensions of the GraphQL schema
+// file src. Definitions without fields are skipped.
+func gqlDefs(file int, src string) ([]*gqlDef, error) {
+	toks, err := gqlLex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var defs []*gqlDef
+	depth := 0
+	for i := 0; i < len(toks); i++ {
+		t := toks[i]
+		switch t.text {
+		case "{", "(", "[":
+			depth++
+			continue
+		case "}", ")", "]":
+			depth--
+			continue
+		}
+		if depth != 0 || t.str {
+			continue
+		}
+
+		// definition, including its description
+		d := &gqlDef{file: file, start: t.start, line: t.line}
+		if i > 0 && toks[i-1].str {
+			d.start, d.line = toks[i-1].start, toks[i-1].line
+		}
+		j := i
+		if t.text == "extend" {
+			d.extend = true
+			j++
+		}
+		if j+1 >= len(toks) || !gqlDefKinds[toks[j].text] {
+			continue
+		}
+		d.kind, d.name = toks[j].text, toks[j+1].text
+
+		// header, up to the opening brace
+		var n int
+		var impl bool
+	header:
+		for j += 2; j < len(toks); j++ {
+			switch tt := toks[j]; {
+			case n == 0 && (tt.text == "{" || tt.str || gqlKeywords[tt.text]):
+				break header
+			case tt.text == "(" || tt.text == "[":
+				n++
+			case tt.text == ")" || tt.text == "]":
+				n--
+			case n != 0:
+			case tt.text == "implements":
+				impl = true
+			case tt.text == "@":
+				impl = false
+			case impl && isGQLNameChar(tt.text[0]):
+				d.implements = append(d.implements, tt.text)
+			}
+		}
+		if j >= len(toks) || toks[j].text != "{" {
+			i = j - 1
+			continue
+		}
+		d.lbrace, d.lbraceLine = toks[j].start, toks[j].line
+
+		// closing brace
+		for n = 0; j < len(toks); j++ {
+			switch toks[j].text {
+			case "{":
+				n++
+			case "}":
+				n--
+			}
+			if n == 0 {
+				break
+			}
+		}
+		if j >= len(toks) {
+			return nil, fmt.Errorf("%d: unterminated %s %s", d.line, d.kind, d.name)
+		}
+		d.end, d.endLine = toks[j].start+1, toks[j].line
+
+		defs = append(defs, d)
+		i = j
+	}
+
+	return defs, nil
+}
@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+func Test_NewGraphQLSource(t *testing.T) {
+	files := []string{"a.graphql", "b.graphql"}
+	srcs := [][]byte{
+		[]byte("# users\n\"A user\"\ntype User implements Node {\n  id: ID!\n}\nextend type Query {\n  user(id: ID!): User\n}\n"),
+		[]byte("extend type User implements Named { name: String! }\nextend type Query {\n  \"Books\"\n  books: [Book!]!\n}\ntype Book { id: ID! }"),
+	}
+
+	src, err := NewGraphQLSource(files, srcs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// merged types are moved to the end
+	exp := []string{
+		"type Query {\n\n  user(id: ID!): User\n\n  \"Books\"\n  books: [Book!]!\n}\n",
+		"\"A user\"\ntype User implements Node & Named {\n\n  id: ID!\n name: String! \n}\n",
+	}
+	for i, e := range exp {
+		if !strings.Contains(src.Schema, e) {
+			t.Errorf("test #%d: expected schema to contain %q, got:\n%s", i+1, e, src.Schema)
+		}
+	}
+	if !strings.Contains(src.Schema, "\ntype Book { id: ID! }\n") || strings.Contains(src.Schema, "extend") {
+		t.Errorf("expected remaining definitions in place, got:\n%s", src.Schema)
+	}
+
+	// positions
+	tests := []struct {
+		line int
+		exp  string
+	}{
+		{14, "b.graphql:6:3: x"},
+		{17, "a.graphql:7:3: x"},
+		{20, "b.graphql:4:3: x"},
+		{23, "a.graphql:3:3: x"},
+		{26, "b.graphql:1:3: x"},
+	}
+	lines := strings.Split(src.Schema, "\n")
+	for i, tt := range tests {
+		err := src.Error(&gqlerrors.QueryError{Message: "x", Locations: []gqlerrors.Location{{Line: tt.line, Column: 3}}})
+		if err.Error() != tt.exp {
+			t.Errorf("test #%d: line %d (%q), expected %q, got: %q", i+1, tt.line, lines[tt.line-1], tt.exp, err.Error())
+		}
+	}
+}
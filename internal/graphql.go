@@ -4,6 +4,8 @@ import (
 	"log"
 	"strings"
 
+	"github.com/knq/snaker"
+
 	"github.com/graph-gophers/graphql-go"
 	"github.com/graph-gophers/graphql-go/introspection"
 )
@@ -20,6 +22,7 @@ const (
 	gqlID           = "ID"
 	gqlQuery        = "Query"
 	gqlMutation     = "Mutation"
+	gqlSubscription = "Subscription"
 )
 
 var KnownGQLTypes = map[string]bool{
@@ -54,6 +57,9 @@ var KnownGoTypes = map[string]bool{
 type CodeGen struct {
 	rawSchema string
 
+	// source is the schema files, when read from files.
+	source *GraphQLSource
+
 	schema *graphql.Schema
 }
 
@@ -67,13 +73,29 @@ type TypeDef struct {
 	Efields    []*FieldDef // Extra Fields - Model Userdefined Fields ex: Todos, Posts on User type
 	Interfaces map[string]string
 
-	IsQuery     bool
-	IsMutation  bool
-	IsInterface bool
-	IsObject    bool
-	IsInput     bool
-	IsScalar    bool
-	IsModel     bool
+	IsQuery        bool
+	IsMutation     bool
+	IsSubscription bool
+	IsInterface    bool
+	IsObject       bool
+	IsInput        bool
+	IsScalar       bool
+	IsEnum         bool
+	IsUnion        bool
+	IsModel        bool
+
+	// Values are the values of an enum.
+	Values []*EnumValueDef
+
+	// Types are the member types of a union.
+	Types []string
+
+	// GoType is the user supplied Go type of a custom scalar, generated as
+	// a type alias (see ArgType.GraphQLScalars).
+	GoType string
+
+	// Model is the table of the same name, when generating with a database.
+	Model *GraphQLModel
 
 	gqlType *introspection.Type
 }
@@ -89,6 +111,8 @@ type Typ struct {
 	IsNullable  bool
 	IsInterface bool
 	IsInput     bool
+	IsEnum      bool
+	IsScalar    bool
 
 	gqlType *introspection.Type
 }
@@ -111,6 +135,16 @@ type FieldDef struct {
 	gqlField *introspection.Field
 }
 
+// EnumValueDef is a value of a GraphQL enum.
+type EnumValueDef struct {
+	Name        string
+	Value       string
+	Description string
+
+	IsDeprecated      bool
+	DeprecationReason string
+}
+
 func NewType(t *introspection.Type) *TypeDef {
 
 	tp := &TypeDef{
@@ -151,6 +185,10 @@ func NewType(t *introspection.Type) *TypeDef {
 				tp.Interfaces[name] = name
 			}
 		}
+	} else if t.Kind() == gqlUNION {
+		for _, pt := range *t.PossibleTypes() {
+			tp.Types = append(tp.Types, pts(pt.Name()))
+		}
 	} else if t.Kind() == gqlINPUT_OBJECT {
 		for _, input := range *t.InputFields() {
 			f := newField(input.Name(), input.Description(), input.Type())
@@ -160,6 +198,18 @@ func NewType(t *introspection.Type) *TypeDef {
 		}
 	}
 
+	if t.Kind() == gqlENUM {
+		for _, v := range *t.EnumValues(&struct{ IncludeDeprecated bool }{true}) {
+			tp.Values = append(tp.Values, &EnumValueDef{
+				Name:              snaker.SnakeToCamelIdentifier(strings.ToLower(v.Name())),
+				Value:             v.Name(),
+				Description:       pts(v.Description()),
+				IsDeprecated:      v.IsDeprecated(),
+				DeprecationReason: pts(v.DeprecationReason()),
+			})
+		}
+	}
+
 	return tp
 }
 
@@ -236,9 +286,13 @@ FindGoType:
 		td.GoType = "time.Time"
 		td.GQLType = "graphql.Time"
 	default:
-		if tp.Kind() == gqlENUM {
-			td.GoType = "string"
-			td.GQLType = "string"
+		if tp.Kind() == gqlENUM || tp.Kind() == gqlSCALAR {
+			// enums and custom scalars are generated as Go types of the
+			// same name in the objects package
+			td.GoType = pts(tp.Name())
+			td.GQLType = pts(tp.Name())
+			td.IsEnum = tp.Kind() == gqlENUM
+			td.IsScalar = tp.Kind() == gqlSCALAR
 		} else {
 			td.GoType = pts(tp.Name())
 			td.GQLType = pts(tp.Name()) + "Resolver"
@@ -249,18 +303,33 @@ FindGoType:
 }
 
 func NewCodeGen(schema string) *CodeGen {
-	return &CodeGen{schema, nil}
+	return &CodeGen{rawSchema: schema}
+}
+
+// NewSourceCodeGen returns the CodeGen of the schema read from files,
+// reporting the parse errors with their file positions.
+func NewSourceCodeGen(src *GraphQLSource) *CodeGen {
+	return &CodeGen{rawSchema: src.Schema, source: src}
 }
 
 func (g *CodeGen) Parse() error {
 	schema, err := graphql.ParseSchema(g.rawSchema, nil)
+	if err != nil {
+		if g.source != nil {
+			return g.source.Error(err)
+		}
+		return err
+	}
+
 	g.schema = schema
-	return err
+	return nil
 }
 
 func (g *CodeGen) Generate(args *ArgType) error {
 	// Parse the sting schema to schema object
-	g.Parse()
+	if err := g.Parse(); err != nil {
+		return err
+	}
 
 	// Types for Generation
 	types := []*TypeDef{}
@@ -282,10 +351,11 @@ func (g *CodeGen) Generate(args *ArgType) error {
 			gtp := NewType(typ)
 			typName := pts(typ.Name())
 
-			// Identify Query & Mutation definitions
-			if typName == gqlQuery || typName == gqlMutation {
+			// Identify Query, Mutation & Subscription definitions
+			if typName == gqlQuery || typName == gqlMutation || typName == gqlSubscription {
 				gtp.IsQuery = typName == gqlQuery
 				gtp.IsMutation = typName == gqlMutation
+				gtp.IsSubscription = typName == gqlSubscription
 			}
 
 			// Save payloads to string array for better handling
@@ -299,25 +369,34 @@ func (g *CodeGen) Generate(args *ArgType) error {
 		case gqlSCALAR:
 			gtp := NewType(typ)
 			gtp.IsScalar = true
-			//types = append(types, gtp)
+			if o, ok := args.GraphQLScalars[gtp.Name]; ok {
+				gtp.GoType = o.Type
+			}
+			types = append(types, gtp)
 		case gqlINTERFACE:
 			gtp := NewType(typ)
 			gtp.IsInterface = true
 			types = append(types, gtp)
 		case gqlENUM:
+			gtp := NewType(typ)
+			gtp.IsEnum = true
+			types = append(types, gtp)
 		case gqlUNION:
+			gtp := NewType(typ)
+			gtp.IsUnion = true
+			types = append(types, gtp)
 		case gqlINPUT_OBJECT:
 			gtp := NewType(typ)
 			gtp.IsInput = true
 			types = append(types, gtp)
 		default:
-			log.Printf("Unknown graphql type ", *typ.Name(), ":", typ.Kind())
+			log.Printf("Unknown graphql type %s: %s", *typ.Name(), typ.Kind())
 		}
 	}
 
 	for _, t := range types {
 		// Set models
-		if t.IsObject && !t.IsMutation && !t.IsQuery {
+		if t.IsObject && !t.IsMutation && !t.IsQuery && !t.IsSubscription {
 			for _, i := range t.Interfaces {
 				// Get Node implemented types - We can use this info create MODELS etc
 				if i == "Node" {
@@ -328,12 +407,25 @@ func (g *CodeGen) Generate(args *ArgType) error {
 					models[t.Name] = t
 				}
 			}
+
+			// types named after a table are backed by it
+			if _, ok := args.GraphQLModels[t.Name]; ok {
+				t.IsModel = true
+				models[t.Name] = t
+			}
+		}
+	}
+
+	// Map models to the database tables and generate their resolvers
+	if args.GraphQLModels != nil {
+		if err := g.generateModels(args, types, models, payloads); err != nil {
+			return err
 		}
 	}
 
 	for _, t := range types {
 		// For types
-		if t.IsObject && !t.IsMutation && !t.IsQuery {
+		if t.IsObject && !t.IsMutation && !t.IsQuery && !t.IsSubscription {
 
 			// Generate Types
 			if err := g.generateType(args, t, models); err != nil {
@@ -341,15 +433,24 @@ func (g *CodeGen) Generate(args *ArgType) error {
 			}
 		}
 
-		// For Queries & Mutation
-		if t.IsQuery || t.IsMutation {
+		// For Queries, Mutation & Subscription
+		if t.IsQuery || t.IsMutation || t.IsSubscription {
 
-			// Generate Query/Mutation
+			// Generate Query/Mutation/Subscription
 			if err := g.generateQuery(args, t, models, false); err != nil {
 				return err
 			}
 		}
 
+		// For Enums, Unions & Scalars
+		if t.IsEnum || t.IsUnion || t.IsScalar {
+
+			// Generate Enum/Union/Scalar
+			if err := g.generateDecl(args, t); err != nil {
+				return err
+			}
+		}
+
 		// For InputObjects
 		if t.IsInput {
 
@@ -402,6 +503,24 @@ func (g *CodeGen) generateQuery(args *ArgType, tp *TypeDef, models map[string]*T
 		}
 	}
 
+	if tp.IsSubscription {
+		log.Printf("Generating Go code for SUBSCRIPTION [%s]", tp.Name)
+
+		tp.Template = "SUBSCRIPTION"
+		err := args.ExecuteTemplate(GraphQLQueryTemplate, "iSubscription", gqlSubscription, tp)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("Generating Go code for SUBSCRIPTION ARGS [%s]", tp.Name)
+
+		tp.Template = "ARGS"
+		err = args.ExecuteTemplate(GraphQLQueryTemplate, "request", gqlSubscription, tp)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Mutation inputs
 	if tp.IsInput {
 		log.Printf("Generating Go code for INPUT [%s]", tp.Name)
@@ -439,7 +558,7 @@ func (g *CodeGen) generateType(args *ArgType, tp *TypeDef, models map[string]*Ty
 			f := tp.Fields[i]
 
 			// Check if the field is GoType or User defined for Models
-			if _, ok := KnownGoTypes[f.Type.GoType]; !ok {
+			if !isGoType(f.Type) {
 				// Mark this field as userDefined
 				f.IsUserDefined = true
 
@@ -475,3 +594,19 @@ func (g *CodeGen) generateType(args *ArgType, tp *TypeDef, models map[string]*Ty
 
 	return nil
 }
+
+// generateDecl generates the Go type of the enum, union or custom scalar tp.
+func (g *CodeGen) generateDecl(args *ArgType, tp *TypeDef) error {
+	kind := gqlSCALAR
+	switch {
+	case tp.IsEnum:
+		kind = gqlENUM
+	case tp.IsUnion:
+		kind = gqlUNION
+	}
+
+	log.Printf("Generating Go code for %s [%s]", kind, tp.Name)
+
+	tp.Template = kind
+	return args.ExecuteTemplate(GraphQLTypeTemplate, tp.Name, kind, tp)
+}
@@ -3,6 +3,7 @@ package internal
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/gedex/inflector"
@@ -52,6 +53,8 @@ type TypeLoader struct {
 	ParseType       func(*ArgType, string, bool) (int, string, string)
 	EnumList        func(models.XODB, string) ([]*models.Enum, error)
 	EnumValueList   func(models.XODB, string, string) ([]*models.EnumValue, error)
+	DomainList      func(models.XODB, string) ([]*models.Domain, error)
+	CompositeList   func(models.XODB, string) ([]*models.Composite, error)
 	ProcList        func(models.XODB, string) ([]*models.Proc, error)
 	ProcParamList   func(models.XODB, string, string) ([]*models.ProcParam, error)
 	TableList       func(models.XODB, string, string) ([]*models.Table, error)
@@ -61,6 +64,11 @@ type TypeLoader struct {
 	IndexColumnList func(models.XODB, string, string, string) ([]*models.IndexColumn, error)
 	QueryStrip      func([]string, []string)
 	QueryColumnList func(*ArgType, []string) ([]*models.Column, error)
+
+	// DDLType converts a column type declared in DDL to the type reported by
+	// the database, and whether the column auto increments when it is the
+	// primary key. Loading from DDL is only supported when defined.
+	DDLType func(string, bool) (string, bool)
 }
 
 // NthParam satisifies Loader's NthParam.
@@ -108,10 +116,49 @@ func (tl TypeLoader) SchemaName(args *ArgType) (string, error) {
 	return "", nil
 }
 
+// parseType parses the database type dt of the column col in table into a Go
+// type, using the user supplied type override when one is defined for the
+// column or type (or the base type of a domain), or in the column's comment,
+// and otherwise deferring to the loader's ParseType.
+func (tl TypeLoader) parseType(args *ArgType, table, col, dt, comment string, nullable bool) (int, string, string) {
+	o := args.TypeOverride(table, col, dt)
+
+	// resolve domains to their base type
+	for base, ok := args.DomainTypes[dt]; o == nil && ok; base, ok = args.DomainTypes[dt] {
+		dt = base
+		o = args.TypeOverride(table, col, dt)
+	}
+	if o == nil {
+		o = CommentTypeOverride(comment)
+		if o == nil {
+			return tl.ParseType(args, dt, nullable)
+		}
+
+		// comment overrides are always JSON
+		dt = "json"
+	}
+
+	_, precision, _ := args.ParsePrecision(dt)
+	typ, nilVal := o.GoType(nullable)
+
+	// generate Scan/Value for JSON types
+	if IsJSONType(dt) {
+		args.AddJSONType(typ)
+	}
+
+	return precision, nilVal, typ
+}
+
 // ParseQuery satisfies Loader's ParseQuery.
 func (tl TypeLoader) ParseQuery(args *ArgType) error {
 	var err error
 
+	// load domains
+	err = tl.LoadDomains(args)
+	if err != nil {
+		return err
+	}
+
 	// parse supplied query
 	queryStr, params := args.ParseQuery(tl.Mask(), true)
 	inspectStr, _ := args.ParseQuery("NULL", false)
@@ -169,7 +216,7 @@ func (tl TypeLoader) ParseQuery(args *ArgType) error {
 				Name: snaker.SnakeToCamelIdentifier(c.ColumnName),
 				Col:  c,
 			}
-			f.Len, f.NilType, f.Type = tl.ParseType(args, c.DataType, args.QueryAllowNulls && !c.NotNull)
+			f.Len, f.NilType, f.Type = tl.parseType(args, "", "", c.DataType, "", args.QueryAllowNulls && !c.NotNull)
 			typeTpl.Fields = append(typeTpl.Fields, f)
 		}
 	} else {
@@ -201,6 +248,12 @@ func (tl TypeLoader) ParseQuery(args *ArgType) error {
 		return err
 	}
 
+	// generate json types
+	err = tl.LoadJSONTypes(args)
+	if err != nil {
+		return err
+	}
+
 	// build func name
 	funcName := args.QueryFunc
 	if funcName == "" {
@@ -247,14 +300,20 @@ func (tl TypeLoader) ParseQuery(args *ArgType) error {
 func (tl TypeLoader) LoadSchema(args *ArgType) error {
 	var err error
 
+	// load domains
+	err = tl.LoadDomains(args)
+	if err != nil {
+		return err
+	}
+
 	// load enums
-	_, err = tl.LoadEnums(args)
+	enumMap, err := tl.LoadEnums(args)
 	if err != nil {
 		return err
 	}
 
-	// load procs
-	_, err = tl.LoadProcs(args)
+	// load composite types
+	compositeMap, err := tl.LoadComposites(args)
 	if err != nil {
 		return err
 	}
@@ -276,24 +335,61 @@ func (tl TypeLoader) LoadSchema(args *ArgType) error {
 		tableMap[k] = v
 	}
 
+	// load procs
+	_, err = tl.LoadProcs(args, tableMap, compositeMap)
+	if err != nil {
+		return err
+	}
+
+	// load json types
+	err = tl.LoadJSONTypes(args)
+	if err != nil {
+		return err
+	}
+
 	// load foreign keys
-	_, err = tl.LoadForeignKeys(args, tableMap)
+	fkMap, err := tl.LoadForeignKeys(args, tableMap)
+	if err != nil {
+		return err
+	}
+
+	// load many-to-many relationships
+	m2ms, err := tl.LoadManyToMany(args, tableMap, fkMap)
 	if err != nil {
 		return err
 	}
 
 	// load indexes
-	_, err = tl.LoadIndexes(args, tableMap)
+	ixMap, err := tl.LoadIndexes(args, tableMap)
 	if err != nil {
 		return err
 	}
 
 	// GraphQl Support
-	if args.GraphQL {
-		// err := tl.LoadCustomSchema(args, Table, tableMap)
-		// if err != nil {
-		// 	return err
-		// }
+	// err := tl.LoadCustomSchema(args, Table, tableMap)
+	// if err != nil {
+	// 	return err
+	// }
+
+	// generate dataloaders
+	if args.GraphQLLoaders {
+		err = tl.LoadGraphQLLoaders(args, tableMap, fkMap, ixMap)
+		if err != nil {
+			return err
+		}
+	}
+
+	// collect the models of the graphql schema's types
+	if args.GraphQLSchema != "" {
+		tl.LoadGraphQLModels(args, tableMap, fkMap, m2ms, ixMap)
+	}
+
+	// generate graphql schema
+	if args.GraphQLSDL {
+		err = tl.LoadGraphQLSchema(args, enumMap, tableMap, fkMap, m2ms, ixMap)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -323,6 +419,7 @@ func (tl TypeLoader) LoadEnums(args *ArgType) (map[string]*Enum, error) {
 			Values:            []*EnumValue{},
 			Enum:              e,
 			ReverseConstNames: args.UseReversedEnumConstNames,
+			Slice:             args.LoaderType == "postgres",
 		}
 
 		err = tl.LoadEnumValues(args, enumTpl)
@@ -332,6 +429,9 @@ func (tl TypeLoader) LoadEnums(args *ArgType) (map[string]*Enum, error) {
 
 		enumMap[enumTpl.Name] = enumTpl
 		args.KnownTypeMap[enumTpl.Name] = true
+		if enumTpl.Slice {
+			args.KnownTypeMap[enumTpl.Name+"Slice"] = true
+		}
 	}
 
 	// generate enum templates
@@ -345,6 +445,115 @@ func (tl TypeLoader) LoadEnums(args *ArgType) (map[string]*Enum, error) {
 	return enumMap, nil
 }
 
+// LoadDomains loads the base types of schema domains.
+func (tl TypeLoader) LoadDomains(args *ArgType) error {
+	var err error
+
+	// not supplied, so bail
+	if tl.DomainList == nil {
+		return nil
+	}
+
+	// load domains
+	domainList, err := tl.DomainList(args.DB, args.Schema)
+	if err != nil {
+		return err
+	}
+
+	// process domains
+	args.DomainTypes = map[string]string{}
+	for _, d := range domainList {
+		args.DomainTypes[d.DomainName] = d.BaseType
+	}
+
+	return nil
+}
+
+// LoadComposites loads schema composite types.
+func (tl TypeLoader) LoadComposites(args *ArgType) (map[string]*Composite, error) {
+	var err error
+
+	// not supplied, so bail
+	if tl.CompositeList == nil {
+		return nil, nil
+	}
+
+	// load composites
+	compositeList, err := tl.CompositeList(args.DB, args.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	// process composites
+	compositeMap := map[string]*Composite{}
+	for _, c := range compositeList {
+		compositeTpl := &Composite{
+			Name:      snaker.SnakeToCamelIdentifier(c.CompositeName),
+			Schema:    args.Schema,
+			Fields:    []*Field{},
+			Composite: c,
+		}
+
+		// load attributes
+		columnList, err := tl.ColumnList(args.DB, args.Schema, c.CompositeName)
+		if err != nil {
+			return nil, err
+		}
+		for _, col := range columnList {
+			f := &Field{
+				Name: snaker.SnakeToCamelIdentifier(col.ColumnName),
+				Col:  col,
+			}
+			f.Len, f.NilType, f.Type = tl.parseType(args, c.CompositeName, col.ColumnName, col.DataType, col.Comment, !col.NotNull)
+			compositeTpl.Fields = append(compositeTpl.Fields, f)
+		}
+
+		compositeMap[compositeTpl.Name] = compositeTpl
+		args.KnownTypeMap[compositeTpl.Name] = true
+		if args.CompositeTypeMap == nil {
+			args.CompositeTypeMap = map[string]bool{}
+		}
+		args.CompositeTypeMap[compositeTpl.Name] = true
+	}
+
+	// generate composite templates
+	for _, c := range compositeMap {
+		err = args.ExecuteTemplate(CompositeTemplate, c.Name, "", c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return compositeMap, nil
+}
+
+// LoadJSONTypes generates the Scan/Value funcs for the user supplied Go types
+// of JSON columns that have not yet been generated.
+func (tl TypeLoader) LoadJSONTypes(args *ArgType) error {
+	var err error
+
+	// sort, as the order of generated templates determines the output order
+	var names []string
+	for name, generated := range args.JSONTypes {
+		if !generated {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	// generate json templates
+	for _, name := range names {
+		err = args.ExecuteTemplate(JSONTemplate, name, "", &JSONType{Name: name})
+		if err != nil {
+			return err
+		}
+
+		args.JSONTypes[name] = true
+	}
+
+	return nil
+}
+
 // LoadEnumValues loads schema enum values.
 func (tl TypeLoader) LoadEnumValues(args *ArgType, enumTpl *Enum) error {
 	var err error
@@ -375,8 +584,10 @@ func (tl TypeLoader) LoadEnumValues(args *ArgType, enumTpl *Enum) error {
 	return nil
 }
 
-// LoadProcs loads schema stored procedures definitions.
-func (tl TypeLoader) LoadProcs(args *ArgType) (map[string]*Proc, error) {
+// LoadProcs loads schema stored procedures definitions. The rows of the
+// tables (or views) and composite types returned by the procs are scanned
+// from their columns.
+func (tl TypeLoader) LoadProcs(args *ArgType, tableMap map[string]*Type, compositeMap map[string]*Composite) (map[string]*Proc, error) {
 	var err error
 
 	// not supplied, so bail
@@ -399,18 +610,40 @@ func (tl TypeLoader) LoadProcs(args *ArgType) (map[string]*Proc, error) {
 			name = name[1:]
 		}
 
+		// package members (ie, Oracle's pkg.proc) are named Pkg_Proc
+		parts := strings.Split(name, ".")
+		for i, n := range parts {
+			parts[i] = snaker.SnakeToCamelIdentifier(n)
+		}
+
 		// create template
 		procTpl := &Proc{
-			Name:   snaker.SnakeToCamelIdentifier(name),
+			Name:   strings.Join(parts, "_"),
 			Schema: args.Schema,
 			Params: []*Field{},
 			Return: &Field{},
 			Proc:   p,
 		}
 
-		// parse return type into template
-		// TODO: fix this so that nullable types can be returned
-		_, procTpl.Return.NilType, procTpl.Return.Type = tl.ParseType(args, p.ReturnType, false)
+		// parse return type into template (a set returning proc returns rows
+		// of its element type, or of its OUT columns for RETURNS TABLE)
+		returnType := p.ReturnType
+		if p.ReturnsSet {
+			returnType = strings.TrimPrefix(returnType, "SETOF ")
+		}
+		if returnType != "void" && !strings.HasPrefix(returnType, "TABLE(") {
+			_, procTpl.Return.NilType, procTpl.Return.Type = tl.parseType(args, "", "", returnType, "", args.ProcAllowNulls)
+		}
+
+		// rows of a table or composite type are scanned from their columns
+		typ := strings.TrimPrefix(returnType, args.Schema+".")
+		if t, ok := tableMap[typ]; ok {
+			procTpl.Return.Type, procTpl.Return.NilType = t.Name, "nil"
+			procTpl.ReturnFields, procTpl.ReturnTable = t.Fields, t
+		} else if c, ok := compositeMap[snaker.SnakeToCamelIdentifier(typ)]; ok {
+			procTpl.Return.Type, procTpl.Return.NilType = c.Name, "nil"
+			procTpl.ReturnFields = c.Fields
+		}
 
 		// load proc parameters
 		err = tl.LoadProcParams(args, procTpl)
@@ -444,26 +677,86 @@ func (tl TypeLoader) LoadProcParams(args *ArgType, procTpl *Proc) error {
 
 	// process params
 	for i, p := range paramList {
-		// TODO: some databases support named parameters in procs (MySQL)
+		name, fieldName := procParamNames(args, p.ParamName, i)
 		paramTpl := &Field{
-			Name: fmt.Sprintf("v%d", i),
+			Name:  name,
+			Param: p,
 		}
+		_, paramTpl.NilType, paramTpl.Type = tl.parseType(args, "", "", strings.TrimSpace(p.ParamType), "", args.ProcAllowNulls)
 
-		// TODO: fix this so that nullable types can be used as parameters
-		_, _, paramTpl.Type = tl.ParseType(args, strings.TrimSpace(p.ParamType), false)
-
-		// add to proc params
+		// add to proc params (ie, "book_id integer, OUT title text")
+		param := p.ParamType
+		if p.ParamName != "" {
+			param = p.ParamName + " " + param
+		}
+		if p.ParamMode != "" && p.ParamMode != "IN" {
+			param = p.ParamMode + " " + param
+		}
 		if procTpl.ProcParams != "" {
 			procTpl.ProcParams = procTpl.ProcParams + ", "
 		}
-		procTpl.ProcParams = procTpl.ProcParams + p.ParamType
+		procTpl.ProcParams = procTpl.ProcParams + param
 
 		procTpl.Params = append(procTpl.Params, paramTpl)
+
+		// add to inputs and outputs
+		if p.ParamMode != "OUT" {
+			procTpl.Inputs = append(procTpl.Inputs, paramTpl)
+		}
+		if strings.HasSuffix(p.ParamMode, "OUT") {
+			out := *paramTpl
+			out.Name = fieldName
+			procTpl.Outputs = append(procTpl.Outputs, &out)
+		}
 	}
 
 	return nil
 }
 
+// procParamConflicts are the names used by the generated procs, conflicting
+// with their params.
+var procParamConflicts = map[string]bool{
+	"db":      true,
+	"ctx":     true,
+	"err":     true,
+	"ret":     true,
+	"r":       true,
+	"q":       true,
+	"res":     true,
+	"v":       true,
+	"sqlstr":  true,
+	"context": true,
+	"sql":     true,
+	"driver":  true,
+	"json":    true,
+	"time":    true,
+}
+
+// procParamNames returns the Go param and field names of the proc param name,
+// at index i (ie, book_id -> bookID and BookID), or v<i> and V<i> when the
+// param is not named.
+func procParamNames(args *ArgType, name string, i int) (string, string) {
+	name = strings.TrimLeft(name, "_@")
+	if name == "" {
+		return fmt.Sprintf("v%d", i), fmt.Sprintf("V%d", i)
+	}
+
+	// lower case the first word (see goparamlist)
+	fieldName := snaker.SnakeToCamelIdentifier(name)
+	n := strings.Split(snaker.CamelToSnake(fieldName), "_")
+	s := strings.ToLower(n[0]) + fieldName[len(n[0]):]
+
+	// check go reserved names
+	if r, ok := goReservedNames[strings.ToLower(s)]; ok {
+		s = r
+	}
+	if procParamConflicts[s] {
+		s = s + args.NameConflictSuffix
+	}
+
+	return s, fieldName
+}
+
 // LoadRelkind loads a schema table/view definition.
 func (tl TypeLoader) LoadRelkind(args *ArgType, relType RelType) (map[string]*Type, error) {
 	var err error
@@ -542,7 +835,7 @@ func (tl TypeLoader) LoadColumns(args *ArgType, typeTpl *Type) error {
 			Name: snaker.SnakeToCamelIdentifier(c.ColumnName),
 			Col:  c,
 		}
-		f.Len, f.NilType, f.Type = tl.ParseType(args, c.DataType, !c.NotNull)
+		f.Len, f.NilType, f.Type = tl.parseType(args, typeTpl.Table.TableName, c.ColumnName, c.DataType, c.Comment, !c.NotNull)
 
 		// Set primary key fields. Multiple columns may participate in a key.
 		//if c.IsPrimaryKey && len(columnList) > 1 {
@@ -581,15 +874,30 @@ func (tl TypeLoader) LoadForeignKeys(args *ArgType, tableMap map[string]*Type) (
 	// determine foreign key names
 	for _, fk := range fkMap {
 		fk.Name = args.ForeignKeyName(fkMap, fk)
+		fk.RevName = args.ForeignKeyRevName(fkMap, fk)
+		fk.LoadName, fk.RevLoadName = fkLoadNames(fk, args.NameConflictSuffix)
+	}
+
+	// sort, as the order of generated templates determines the output order
+	var names []string
+	for name := range fkMap {
+		names = append(names, name)
 	}
+	sort.Strings(names)
 
 	// generate templates
-	for _, fk := range fkMap {
+	for _, name := range names {
+		fk := fkMap[name]
 		err = args.ExecuteTemplate(ForeignKeyTemplate, fk.Type.Name, fk.ForeignKey.ForeignKeyName, fk)
 		if err != nil {
 			return nil, err
 		}
 
+		// has-many on the referenced type
+		err = args.ExecuteTemplate(HasManyTemplate, fk.RefType.Name, fk.ForeignKey.ForeignKeyName, fk)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return fkMap, nil
@@ -670,6 +978,91 @@ func (tl TypeLoader) LoadTableForeignKeys(args *ArgType, tableMap map[string]*Ty
 	return nil
 }
 
+// LoadManyToMany loads the many-to-many relationships through join tables,
+// ie, tables whose fields are only two foreign keys and an optional surrogate
+// primary key.
+func (tl TypeLoader) LoadManyToMany(args *ArgType, tableMap map[string]*Type, fkMap map[string]*ForeignKey) ([]*ManyToMany, error) {
+	var err error
+
+	// sort, as the order of generated templates determines the output order
+	var names []string
+	for name := range fkMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// collect foreign keys per table
+	joinMap := map[*Type][]*ForeignKey{}
+	for _, name := range names {
+		fk := fkMap[name]
+		joinMap[fk.Type] = append(joinMap[fk.Type], fk)
+	}
+
+	var m2ms []*ManyToMany
+	for _, t := range tableMap {
+		fks := joinMap[t]
+		if !isJoinTable(t, fks) {
+			continue
+		}
+
+		// relationship from each end to the other
+		m2ms = append(m2ms, &ManyToMany{
+			Schema:        args.Schema,
+			Type:          fks[0].RefType,
+			RefType:       fks[1].RefType,
+			JoinType:      t,
+			ForeignKey:    fks[0],
+			RefForeignKey: fks[1],
+		}, &ManyToMany{
+			Schema:        args.Schema,
+			Type:          fks[1].RefType,
+			RefType:       fks[0].RefType,
+			JoinType:      t,
+			ForeignKey:    fks[1],
+			RefForeignKey: fks[0],
+		})
+	}
+
+	// sort by type and join table
+	sort.Slice(m2ms, func(i, j int) bool {
+		if m2ms[i].Type.Name != m2ms[j].Type.Name {
+			return m2ms[i].Type.Name < m2ms[j].Type.Name
+		}
+		return m2ms[i].ForeignKey.ForeignKey.ForeignKeyName < m2ms[j].ForeignKey.ForeignKey.ForeignKeyName
+	})
+
+	// determine names
+	for _, m := range m2ms {
+		m.Name, m.ItemName = args.ManyToManyName(m2ms, fkMap, m)
+	}
+
+	// generate templates
+	for _, m := range m2ms {
+		err = args.ExecuteTemplate(ManyToManyTemplate, m.Type.Name, m.ForeignKey.ForeignKey.ForeignKeyName, m)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m2ms, nil
+}
+
+// isJoinTable determines if the table with the foreign keys is a join table,
+// having only two foreign key fields and an optional surrogate primary key.
+func isJoinTable(t *Type, fks []*ForeignKey) bool {
+	if len(fks) != 2 || fks[0].Field == fks[1].Field {
+		return false
+	}
+
+	for _, f := range t.Fields {
+		if f != fks[0].Field && f != fks[1].Field && (f != t.PrimaryKey || len(t.PrimaryKeyFields) != 1) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // LoadIndexes loads schema index definitions.
 func (tl TypeLoader) LoadIndexes(args *ArgType, tableMap map[string]*Type) (map[string]*Index, error) {
 	var err error
@@ -683,8 +1076,16 @@ func (tl TypeLoader) LoadIndexes(args *ArgType, tableMap map[string]*Type) (map[
 		}
 	}
 
+	// sort, as the order of generated templates determines the output order
+	var ixNames []string
+	for n := range ixMap {
+		ixNames = append(ixNames, n)
+	}
+	sort.Strings(ixNames)
+
 	// generate templates
-	for _, ix := range ixMap {
+	for _, n := range ixNames {
+		ix := ixMap[n]
 		err = args.ExecuteTemplate(IndexTemplate, ix.Type.Name, ix.Index.IndexName, ix)
 		if err != nil {
 			return nil, err
@@ -694,6 +1095,217 @@ func (tl TypeLoader) LoadIndexes(args *ArgType, tableMap map[string]*Type) (map[
 	return ixMap, nil
 }
 
+// LoadGraphQLLoaders generates the request scoped GraphQL dataloaders for the
+// unique indexes and foreign keys of each type.
+func (tl TypeLoader) LoadGraphQLLoaders(args *ArgType, tableMap map[string]*Type, fkMap map[string]*ForeignKey, ixMap map[string]*Index) error {
+	var err error
+
+	// sort, as the order of generated templates determines the output order
+	var tableNames, fkNames, ixNames []string
+	for n := range tableMap {
+		tableNames = append(tableNames, n)
+	}
+	sort.Strings(tableNames)
+	for n := range fkMap {
+		fkNames = append(fkNames, n)
+	}
+	sort.Strings(fkNames)
+	for n, ix := range ixMap {
+		if ix.LoadName != "" {
+			ixNames = append(ixNames, n)
+		}
+	}
+	sort.Strings(ixNames)
+
+	// build loaders
+	var loaders []*GraphQLLoader
+	for _, n := range tableNames {
+		l := &GraphQLLoader{
+			Template: "TYPE",
+			Package:  modelsPackage,
+			Type:     tableMap[n],
+		}
+		for _, ixName := range ixNames {
+			if ix := ixMap[ixName]; ix.Type == l.Type {
+				l.Indexes = append(l.Indexes, ix)
+			}
+		}
+		for _, fkName := range fkNames {
+			fk := fkMap[fkName]
+			if fk.Type == l.Type {
+				l.ForeignKeys = append(l.ForeignKeys, fk)
+			}
+			if fk.RefType == l.Type {
+				l.RevForeignKeys = append(l.RevForeignKeys, fk)
+			}
+		}
+
+		if len(l.Indexes) != 0 || len(l.ForeignKeys) != 0 || len(l.RevForeignKeys) != 0 {
+			loaders = append(loaders, l)
+		}
+	}
+
+	// nothing to load
+	if len(loaders) == 0 {
+		return nil
+	}
+
+	// generate shared loaders template
+	err = args.ExecuteTemplate(GraphQLLoaderTemplate, "loaders", "", &GraphQLLoader{
+		Template: "LOADERS",
+		Package:  modelsPackage,
+	})
+	if err != nil {
+		return err
+	}
+
+	// generate type loader templates
+	for _, l := range loaders {
+		err = args.ExecuteTemplate(GraphQLLoaderTemplate, l.Type.Name, "", l)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadGraphQLSchema generates the GraphQL schema (SDL) of the enums and types,
+// with the types' foreign keys and many-to-many relationships as fields, and
+// their unique indexes as Query fields.
+func (tl TypeLoader) LoadGraphQLSchema(args *ArgType, enumMap map[string]*Enum, tableMap map[string]*Type, fkMap map[string]*ForeignKey, m2ms []*ManyToMany, ixMap map[string]*Index) error {
+	s := &GraphQLSchema{
+		enums:     map[string]bool{},
+		jsonTypes: args.JSONTypes,
+	}
+
+	// sort, as the schema is generated in order
+	var enumNames, tableNames, fkNames, ixNames []string
+	for n := range enumMap {
+		enumNames = append(enumNames, n)
+		s.enums[n] = true
+	}
+	sort.Strings(enumNames)
+	for n := range tableMap {
+		tableNames = append(tableNames, n)
+	}
+	sort.Strings(tableNames)
+	for n := range fkMap {
+		fkNames = append(fkNames, n)
+	}
+	sort.Strings(fkNames)
+	for n, ix := range ixMap {
+		if ix.Index.IsUnique && !ix.Index.IsPartial && len(ix.Fields) != 0 {
+			ixNames = append(ixNames, n)
+		}
+	}
+	sort.Strings(ixNames)
+
+	// enums
+	for _, n := range enumNames {
+		s.Enums = append(s.Enums, enumMap[n])
+	}
+
+	// types
+	scalars := map[string]bool{}
+	for _, n := range tableNames {
+		st := &GraphQLSchemaType{
+			Type:   tableMap[n],
+			fields: map[string]bool{},
+			suffix: args.NameConflictSuffix,
+		}
+		for _, f := range st.Type.Fields {
+			st.fields[gqlname(f.Name)] = true
+			if typ := strings.Trim(s.FieldType(st.Type, f), "[]!"); typ == "Time" || typ == "JSON" {
+				scalars[typ] = true
+			}
+		}
+
+		// the resolvers of the Node types are generated with --gqlschema
+		if gqlNode(st.Type) {
+			st.Node, s.Node = true, true
+		}
+		for _, fkName := range fkNames {
+			fk := fkMap[fkName]
+			if fk.Type == st.Type {
+				st.ForeignKeys = append(st.ForeignKeys, fk)
+			}
+			if fk.RefType == st.Type {
+				st.RevForeignKeys = append(st.RevForeignKeys, fk)
+			}
+		}
+		for _, m := range m2ms {
+			if m.Type == st.Type {
+				st.ManyToMany = append(st.ManyToMany, m)
+			}
+		}
+		s.Types = append(s.Types, st)
+	}
+	for _, typ := range []string{"JSON", "Time"} {
+		if scalars[typ] {
+			s.Scalars = append(s.Scalars, typ)
+		}
+	}
+
+	// query fields
+	for _, n := range ixNames {
+		s.Indexes = append(s.Indexes, ixMap[n])
+	}
+
+	return args.ExecuteTemplate(GraphQLSchemaTemplate, "schema.graphql", "", s)
+}
+
+// LoadGraphQLModels collects the tables, their indexes and relationships as
+// the models of the GraphQL types of the same name, for generating their
+// resolvers when generating from a GraphQL schema.
+func (tl TypeLoader) LoadGraphQLModels(args *ArgType, tableMap map[string]*Type, fkMap map[string]*ForeignKey, m2ms []*ManyToMany, ixMap map[string]*Index) {
+	// sort, as the resolvers are generated in order
+	var ixNames, fkNames []string
+	for n, ix := range ixMap {
+		if !ix.Index.IsPartial && len(ix.Fields) != 0 {
+			ixNames = append(ixNames, n)
+		}
+	}
+	sort.Strings(ixNames)
+	for n := range fkMap {
+		fkNames = append(fkNames, n)
+	}
+	sort.Strings(fkNames)
+
+	args.GraphQLModels = map[string]*GraphQLModel{}
+	for _, t := range tableMap {
+		if t.RelType != Table {
+			continue
+		}
+
+		m := &GraphQLModel{
+			Template: "MODEL",
+			Package:  modelsPackage,
+			Type:     t,
+		}
+		for _, n := range ixNames {
+			if ix := ixMap[n]; ix.Type == t {
+				m.Indexes = append(m.Indexes, ix)
+			}
+		}
+		for _, n := range fkNames {
+			fk := fkMap[n]
+			if fk.Type == t {
+				m.ForeignKeys = append(m.ForeignKeys, fk)
+			}
+			if fk.RefType == t {
+				m.RevForeignKeys = append(m.RevForeignKeys, fk)
+			}
+		}
+		for _, mm := range m2ms {
+			if mm.Type == t {
+				m.ManyToMany = append(m.ManyToMany, mm)
+			}
+		}
+		args.GraphQLModels[t.Name] = m
+	}
+}
+
 // LoadTableIndexes loads schema index definitions per table.
 func (tl TypeLoader) LoadTableIndexes(args *ArgType, typeTpl *Type, ixMap map[string]*Index) error {
 	var err error
@@ -730,27 +1342,37 @@ func (tl TypeLoader) LoadTableIndexes(args *ArgType, typeTpl *Type, ixMap map[st
 		ixMap[typeTpl.Table.TableName+"_"+ix.IndexName] = ixTpl
 	}
 
-	// search for primary key if it was skipped being set in the type
-	pk := typeTpl.PrimaryKey
-	if pk == nil {
+	// search for primary key fields if they were skipped being set in the type
+	pkFields := typeTpl.PrimaryKeyFields
+	if len(pkFields) == 0 {
 		for _, f := range typeTpl.Fields {
 			if f.Col.IsPrimaryKey {
-				pk = f
-				break
+				pkFields = append(pkFields, f)
 			}
 		}
 	}
 
-	// if no primary key index loaded, but a primary key column was defined in
+	// if no primary key index loaded, but primary key columns were defined in
 	// the type, then create the definition here. this is needed for sqlite, as
 	// sqlite doesn't define primary keys in its index list
-	if args.LoaderType != "ora" && !priIxLoaded && pk != nil {
-		ixName := typeTpl.Table.TableName + "_" + pk.Col.ColumnName + "_pkey"
+	if args.LoaderType != "ora" && !priIxLoaded && len(pkFields) != 0 {
+		colNames, fieldNames := []string{}, []string{}
+		for _, f := range pkFields {
+			colNames = append(colNames, f.Col.ColumnName)
+			fieldNames = append(fieldNames, f.Name)
+		}
+
+		ixName := typeTpl.Table.TableName + "_" + strings.Join(colNames, "_") + "_pkey"
+		var loadName string
+		if len(pkFields) == 1 && isComparable(pkFields[0].Type) {
+			loadName = "Load" + inflector.Pluralize(typeTpl.Name) + "By" + fieldNames[0]
+		}
 		ixMap[ixName] = &Index{
-			FuncName: typeTpl.Name + "By" + pk.Name,
+			FuncName: typeTpl.Name + "By" + strings.Join(fieldNames, ""),
+			LoadName: loadName,
 			Schema:   args.Schema,
 			Type:     typeTpl,
-			Fields:   []*Field{pk},
+			Fields:   pkFields,
 			Index: &models.Index{
 				IndexName: ixName,
 				IsUnique:  true,
@@ -54,7 +54,10 @@ func (a *ArgType) ExecuteTemplate(tt TemplateType, name string, sub string, obj
 
 	// build template name
 	loaderType := ""
-	if tt != XOTemplate {
+	switch tt {
+	case XOTemplate, GraphQLSchemaTemplate, GraphQLTypeTemplate, GraphQLModelTemplate, GraphQLQueryTemplate:
+		// shared by all loaders
+	default:
 		if a.LoaderType == "oci8" || a.LoaderType == "ora" {
 			// force oracle for oci8 since the oracle driver doesn't recognize
 			// 'oracle' as valid protocol
@@ -0,0 +1,259 @@
+package internal
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// TypeOverride is a user supplied Go type for a database type or a specific
+// table column, replacing the type determined by the loader's ParseType.
+type TypeOverride struct {
+	// Type is the Go type for non-nullable columns.
+	Type string `yaml:"type" toml:"type"`
+
+	// NullableType is the Go type for nullable columns. If not specified,
+	// then Type is used.
+	NullableType string `yaml:"nullable" toml:"nullable"`
+
+	// Import is the import path of the package containing the types.
+	Import string `yaml:"import" toml:"import"`
+}
+
+// ParseTypeOverride parses a type override in the form of
+// KEY=TYPE[,NULLABLE[,IMPORT]], where KEY is either a database type (ie,
+// numeric, character varying(255)) or a table column (ie, books.isbn).
+func ParseTypeOverride(s string) (string, *TypeOverride, error) {
+	i := strings.Index(s, "=")
+	if i == -1 {
+		return "", nil, errors.New("invalid type map '" + s + "', must be in the form of KEY=TYPE[,NULLABLE[,IMPORT]]")
+	}
+
+	key, v := strings.TrimSpace(s[:i]), strings.Split(s[i+1:], ",")
+	if key == "" || len(v) > 3 {
+		return "", nil, errors.New("invalid type map '" + s + "', must be in the form of KEY=TYPE[,NULLABLE[,IMPORT]]")
+	}
+
+	o := &TypeOverride{
+		Type: strings.TrimSpace(v[0]),
+	}
+	if len(v) > 1 {
+		o.NullableType = strings.TrimSpace(v[1])
+	}
+	if len(v) > 2 {
+		o.Import = strings.TrimSpace(v[2])
+	}
+
+	return key, o, nil
+}
+
+// AddTypeOverride adds the type override o for key, adding the override's
+// import to the imports of the generated code.
+func (a *ArgType) AddTypeOverride(key string, o *TypeOverride) error {
+	if o.Type == "" {
+		return errors.New("type map '" + key + "' must specify a type")
+	}
+
+	if a.TypeOverrides == nil {
+		a.TypeOverrides = map[string]*TypeOverride{}
+	}
+	a.TypeOverrides[strings.ToLower(key)] = o
+	a.addImport(o.Import)
+
+	return nil
+}
+
+// ParseScalarOverride parses the Go type of a GraphQL custom scalar in the
+// form of SCALAR=TYPE[,IMPORT].
+func ParseScalarOverride(s string) (string, *TypeOverride, error) {
+	i := strings.Index(s, "=")
+	if i == -1 {
+		return "", nil, errors.New("invalid scalar map '" + s + "', must be in the form of SCALAR=TYPE[,IMPORT]")
+	}
+
+	key, v := strings.TrimSpace(s[:i]), strings.Split(s[i+1:], ",")
+	if key == "" || len(v) > 2 {
+		return "", nil, errors.New("invalid scalar map '" + s + "', must be in the form of SCALAR=TYPE[,IMPORT]")
+	}
+
+	o := &TypeOverride{
+		Type: strings.TrimSpace(v[0]),
+	}
+	if len(v) > 1 {
+		o.Import = strings.TrimSpace(v[1])
+	}
+
+	return key, o, nil
+}
+
+// AddScalarOverride adds the Go type o of the GraphQL custom scalar name,
+// adding the override's import to the imports of the generated code.
+func (a *ArgType) AddScalarOverride(name string, o *TypeOverride) error {
+	if o.Type == "" {
+		return errors.New("scalar map '" + name + "' must specify a type")
+	}
+
+	if a.GraphQLScalars == nil {
+		a.GraphQLScalars = map[string]*TypeOverride{}
+	}
+	a.GraphQLScalars[name] = o
+	a.addImport(o.Import)
+
+	return nil
+}
+
+// packageImports are the imports always declared by the xo_package template,
+// and must be kept in sync with it.
+var packageImports = map[string]bool{
+	"bytes":                                 true,
+	"context":                               true,
+	"database/sql":                          true,
+	"database/sql/driver":                   true,
+	"encoding/base64":                       true,
+	"encoding/hex":                          true,
+	"encoding/json":                         true,
+	"errors":                                true,
+	"fmt":                                   true,
+	"reflect":                               true,
+	"sort":                                  true,
+	"strconv":                               true,
+	"strings":                               true,
+	"sync":                                  true,
+	"time":                                  true,
+	"github.com/mailru/dbr":                 true,
+	"github.com/labstack/echo":              true,
+	"github.com/graph-gophers/graphql-go":   true,
+	"github.com/graph-gophers/dataloader":   true,
+	"github.com/gleez/demo/app/relay":       true,
+	"github.com/gleez/demo/graphql/objects": true,
+}
+
+// addImport adds the import path imp, if any, to the imports of the
+// generated code, unless already imported by the xo_package template.
+func (a *ArgType) addImport(imp string) {
+	if imp == "" || packageImports[imp] {
+		return
+	}
+
+	for _, i := range a.Imports {
+		if i == imp {
+			return
+		}
+	}
+	a.Imports = append(a.Imports, imp)
+}
+
+// TypeOverride returns the type override for the column col in table with the
+// database type dt, checking in order of precedence, the table column
+// (table.col), the database type (dt), and the database type without
+// precision.
+func (a *ArgType) TypeOverride(table, col, dt string) *TypeOverride {
+	if len(a.TypeOverrides) == 0 {
+		return nil
+	}
+
+	keys := []string{}
+	if table != "" && col != "" {
+		keys = append(keys, table+"."+col)
+	}
+	keys = append(keys, dt)
+	if d, _, _ := a.ParsePrecision(dt); d != dt {
+		keys = append(keys, d)
+	}
+
+	for _, k := range keys {
+		if o, ok := a.TypeOverrides[strings.ToLower(strings.TrimSpace(k))]; ok {
+			return o
+		}
+	}
+
+	return nil
+}
+
+// GoType returns the Go type and nil value for the override.
+func (o *TypeOverride) GoType(nullable bool) (string, string) {
+	typ := o.Type
+	if nullable && o.NullableType != "" {
+		typ = o.NullableType
+	}
+
+	return typ, zeroValue(typ)
+}
+
+// zeroValue returns the zero value expression for the Go type typ.
+func zeroValue(typ string) string {
+	switch {
+	case strings.HasPrefix(typ, "*"), strings.HasPrefix(typ, "[]"),
+		strings.HasPrefix(typ, "map["), strings.HasPrefix(typ, "chan "),
+		strings.HasPrefix(typ, "func("), strings.HasPrefix(typ, "interface{"):
+		return "nil"
+	}
+
+	switch typ {
+	case "bool":
+		return "false"
+	case "string":
+		return `""`
+	case "byte", "rune", "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64",
+		"float32", "float64", "complex64", "complex128":
+		return "0"
+	}
+
+	return typ + "{}"
+}
+
+// jsonCommentRE matches the xo:json=TYPE directive in a column comment.
+var jsonCommentRE = regexp.MustCompile(`\bxo:json=([A-Za-z_][A-Za-z0-9_.]*)`)
+
+// CommentTypeOverride returns the type override for the xo:json=TYPE directive
+// in a column comment, or nil if the comment does not contain the directive.
+// Nullable columns use a pointer to the type.
+func CommentTypeOverride(comment string) *TypeOverride {
+	m := jsonCommentRE.FindStringSubmatch(comment)
+	if m == nil {
+		return nil
+	}
+
+	return &TypeOverride{
+		Type:         m[1],
+		NullableType: "*" + m[1],
+	}
+}
+
+// IsJSONType returns true if the database type dt is a JSON type.
+func IsJSONType(dt string) bool {
+	switch strings.ToLower(strings.TrimSpace(dt)) {
+	case "json", "jsonb":
+		return true
+	}
+
+	return false
+}
+
+// AddJSONType adds the Go type typ of a JSON column, for which Scan/Value
+// funcs encoding the type as JSON are generated. Types declared in other
+// packages (including the custom type package) are skipped, as they must
+// implement sql.Scanner and driver.Valuer themselves.
+func (a *ArgType) AddJSONType(typ string) {
+	typ = strings.TrimPrefix(typ, "*")
+	if typ == "" || strings.ContainsAny(typ, ".[]{}() ") || a.KnownTypeMap[typ] || a.CustomTypePackage != "" {
+		return
+	}
+
+	if a.JSONTypes == nil {
+		a.JSONTypes = map[string]bool{}
+	}
+	if _, ok := a.JSONTypes[typ]; !ok {
+		a.JSONTypes[typ] = false
+	}
+}
+
+// AddSliceType adds the array type typ used by a column, so that only the
+// array types in use and requiring an import (ie, UUIDSlice) are generated.
+func (a *ArgType) AddSliceType(typ string) {
+	if a.SliceTypes == nil {
+		a.SliceTypes = map[string]bool{}
+	}
+	a.SliceTypes[typ] = true
+}
@@ -8,9 +8,13 @@ type TemplateType uint
 // the order here will be the alter the output order per file.
 const (
 	EnumTemplate TemplateType = iota
+	JSONTemplate
+	CompositeTemplate
 	ProcTemplate
 	TypeTemplate
 	ForeignKeyTemplate
+	HasManyTemplate
+	ManyToManyTemplate
 	IndexTemplate
 	QueryTypeTemplate
 	QueryTemplate
@@ -19,6 +23,7 @@ const (
 	GraphQLModelTemplate
 	GraphQLQueryTemplate
 	GraphQLLoaderTemplate
+	GraphQLSchemaTemplate
 
 	// always last
 	XOTemplate
@@ -32,12 +37,20 @@ func (tt TemplateType) String() string {
 		s = "xo_db"
 	case EnumTemplate:
 		s = "enum"
+	case JSONTemplate:
+		s = "json"
+	case CompositeTemplate:
+		s = "composite"
 	case ProcTemplate:
 		s = "proc"
 	case TypeTemplate:
 		s = "type"
 	case ForeignKeyTemplate:
 		s = "foreignkey"
+	case HasManyTemplate:
+		s = "hasmany"
+	case ManyToManyTemplate:
+		s = "manytomany"
 	case IndexTemplate:
 		s = "index"
 	case QueryTypeTemplate:
@@ -52,6 +65,8 @@ func (tt TemplateType) String() string {
 		s = "graphql.query"
 	case GraphQLLoaderTemplate:
 		s = "graphql.loader"
+	case GraphQLSchemaTemplate:
+		s = "graphql.schema"
 	default:
 		panic("unknown TemplateType")
 	}
@@ -107,6 +122,23 @@ type Enum struct {
 	Enum              *models.Enum
 	Comment           string
 	ReverseConstNames bool
+	Slice             bool
+}
+
+// JSONType is a template item for a user supplied Go type stored as JSON in a
+// column.
+type JSONType struct {
+	Name    string
+	Comment string
+}
+
+// Composite is a template item for a composite type.
+type Composite struct {
+	Name      string
+	Schema    string
+	Fields    []*Field
+	Composite *models.Composite
+	Comment   string
 }
 
 // Proc is a template item for a stored procedure.
@@ -118,6 +150,18 @@ type Proc struct {
 	Return     *Field
 	Proc       *models.Proc
 	Comment    string
+
+	// Inputs are the IN and INOUT params, passed to the proc.
+	Inputs []*Field
+
+	// Outputs are the OUT and INOUT params, returned in the proc's result
+	// struct (named with the exported Go field names).
+	Outputs []*Field
+
+	// ReturnFields are the fields of the table (ReturnTable) or composite
+	// type returned by the proc, scanned from the columns of its result.
+	ReturnFields []*Field
+	ReturnTable  *Type
 }
 
 // Field contains field information.
@@ -127,6 +171,7 @@ type Field struct {
 	NilType string
 	Len     int
 	Col     *models.Column
+	Param   *models.ProcParam
 	Comment string
 }
 
@@ -146,19 +191,37 @@ type Type struct {
 
 // ForeignKey is a template item for a foreign relationship on a table.
 type ForeignKey struct {
-	Name       string
-	Schema     string
-	Type       *Type
-	Field      *Field
-	RefType    *Type
-	RefField   *Field
-	ForeignKey *models.ForeignKey
-	Comment    string
+	Name        string
+	RevName     string
+	LoadName    string
+	RevLoadName string
+	Schema      string
+	Type        *Type
+	Field       *Field
+	RefType     *Type
+	RefField    *Field
+	ForeignKey  *models.ForeignKey
+	Comment     string
+}
+
+// ManyToMany is a template item for a many-to-many relationship from a type
+// to another type, through a join table having foreign keys to both.
+type ManyToMany struct {
+	Name          string
+	ItemName      string
+	Schema        string
+	Type          *Type
+	RefType       *Type
+	JoinType      *Type
+	ForeignKey    *ForeignKey
+	RefForeignKey *ForeignKey
 }
 
 // Index is a template item for a index into a table.
 type Index struct {
 	FuncName string
+	LoadName string
+	PageName string
 	Schema   string
 	Type     *Type
 	Fields   []*Field
@@ -166,6 +229,107 @@ type Index struct {
 	Comment  string
 }
 
+// GraphQLLoader is a template item for the request scoped GraphQL dataloaders
+// of a type (Template "TYPE"), or for their shared code (Template "LOADERS").
+type GraphQLLoader struct {
+	Template       string
+	Package        string
+	Type           *Type
+	Indexes        []*Index
+	ForeignKeys    []*ForeignKey
+	RevForeignKeys []*ForeignKey
+}
+
+// GraphQLSchema is a template item for the GraphQL schema (SDL) generated
+// from the database schema.
+type GraphQLSchema struct {
+	Scalars []string
+	Enums   []*Enum
+	Node    bool
+	Types   []*GraphQLSchemaType
+	Indexes []*Index
+
+	enums     map[string]bool
+	jsonTypes map[string]bool
+}
+
+// GraphQLSchemaType is a template item for a type of a GraphQLSchema, along
+// with its relationships.
+type GraphQLSchemaType struct {
+	Type           *Type
+	Node           bool
+	ForeignKeys    []*ForeignKey
+	RevForeignKeys []*ForeignKey
+	ManyToMany     []*ManyToMany
+
+	fields map[string]bool
+	suffix string
+}
+
+// GraphQLModel is a template item for the resolvers of a GraphQL type backed
+// by the table of the same name (Template "MODEL"), or for their shared code
+// (Template "RESOLVER").
+type GraphQLModel struct {
+	Template       string
+	Package        string
+	Def            *TypeDef
+	Type           *Type
+	Indexes        []*Index
+	ForeignKeys    []*ForeignKey
+	RevForeignKeys []*ForeignKey
+	ManyToMany     []*ManyToMany
+	Fields         []*GraphQLField
+	Resolvers      []*GraphQLResolver
+	Relations      []*GraphQLRelation
+}
+
+// GraphQLField maps a field of a GraphQL type, or an argument or input field
+// of a GraphQL field, to a field of a GraphQLModel's type.
+type GraphQLField struct {
+	Name     string
+	Var      string
+	Def      *FieldDef
+	Field    *Field
+	IsID     bool
+	Nullable bool
+}
+
+// GraphQLResolver is a template item for a Query or Mutation field resolved
+// with a GraphQLModel's type (Kind "QUERY", "PAGE", "CREATE", "UPDATE" or
+// "DELETE").
+type GraphQLResolver struct {
+	Kind         string
+	Def          *FieldDef
+	Ret          string
+	Zero         string
+	Index        *Index
+	Args         []*GraphQLField
+	Input        string
+	Values       []*GraphQLField
+	Payload      *TypeDef
+	PayloadField string
+	PayloadValue bool
+	Object       bool
+	Connection   string
+	Edge         string
+	EdgesType    string
+	NodeValue    bool
+	PageInfo     string
+	PageInfoType string
+	PageFields   []string
+}
+
+// GraphQLRelation is a template item for a field of a GraphQLModel's type
+// resolved with the foreign key, has-many or many-to-many func Func of the
+// model's type, returning the rows of the model's type RefType.
+type GraphQLRelation struct {
+	Def     *FieldDef
+	Func    string
+	RefType string
+	Ret     string
+	List    bool
+}
+
 // QueryParam is a query parameter for a custom query.
 type QueryParam struct {
 	Name        string
@@ -189,6 +189,21 @@ func (a *ArgType) BuildIndexFuncName(ixTpl *Index) {
 
 	// store resulting name back
 	ixTpl.FuncName = funcName + strings.Join(paramNames, "")
+
+	// batch load unique single column indexes
+	if ixTpl.Index.IsUnique && !ixTpl.Index.IsPartial && len(ixTpl.Fields) == 1 && isComparable(ixTpl.Fields[0].Type) {
+		ixTpl.LoadName = "Load" + inflector.Pluralize(ixTpl.Type.Name) + "By" + strings.Join(paramNames, "")
+	}
+
+	// paginate non-unique indexes by a single column primary key
+	if pk := ixTpl.Type.PrimaryKey; !ixTpl.Index.IsUnique && pk != nil && len(ixTpl.Type.PrimaryKeyFields) < 2 && isComparable(pk.Type) {
+		ixTpl.PageName = ixTpl.FuncName + "Page"
+	}
+}
+
+// isComparable determines if the Go type typ can be used as a map key.
+func isComparable(typ string) bool {
+	return !strings.HasPrefix(typ, "[]") && !strings.HasSuffix(typ, "Slice")
 }
 
 // letters for GenRandomID
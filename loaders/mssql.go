@@ -18,8 +18,8 @@ func init() {
 		ParseType:      MsParseType,
 		//EnumList:       models.MsEnums,
 		//EnumValueList:  models.MsEnumValues,
-		//ProcList:       models.MsProcs,
-		//ProcParamList:  models.MsProcParams,
+		ProcList:        models.MsProcs,
+		ProcParamList:   models.MsProcParams,
 		TableList:       MsTables,
 		ColumnList:      models.MsTableColumns,
 		ForeignKeyList:  models.MsTableForeignKeys,
@@ -214,7 +214,8 @@ func MsQueryColumns(args *internal.ArgType, inspect []string) ([]*models.Column,
 }
 
 // MsTables returns the MsSQL tables with the manual PK information added.
-// ManualPk is true when the table's primary key is not an identity.
+// ManualPk is true when the table's primary key is not an identity, or is
+// not a single column.
 func MsTables(db models.XODB, schema string, relkind string) ([]*models.Table, error) {
 	var err error
 
@@ -234,6 +235,19 @@ func MsTables(db models.XODB, schema string, relkind string) ([]*models.Table, e
 	// Add information about manual FK.
 	var tables []*models.Table
 	for _, row := range rows {
+		cols, err := models.MsTableColumns(db, schema, row.TableName)
+		if err != nil {
+			return nil, err
+		}
+
+		// count primary key columns
+		var pkCount int
+		for _, c := range cols {
+			if c.IsPrimaryKey {
+				pkCount++
+			}
+		}
+
 		manualPk := true
 		// Look for a match in the table name where it contains the identity
 		for _, identity := range identities {
@@ -244,7 +258,7 @@ func MsTables(db models.XODB, schema string, relkind string) ([]*models.Table, e
 		tables = append(tables, &models.Table{
 			TableName: row.TableName,
 			Type:      row.Type,
-			ManualPk:  manualPk,
+			ManualPk:  manualPk || pkCount != 1,
 		})
 	}
 
@@ -0,0 +1,112 @@
+package loaders_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"go/parser"
+	"go/token"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/sandeepone/xo/internal"
+	_ "github.com/sandeepone/xo/loaders"
+)
+
+// procDriver is a stand-in database/sql driver returning the rows of the
+// first query containing one of its keys.
+type procDriver map[string][][]driver.Value
+
+func (d procDriver) Open(string) (driver.Conn, error) { return procConn{d}, nil }
+
+type procConn struct{ d procDriver }
+
+func (c procConn) Prepare(query string) (driver.Stmt, error) { return procStmt{c.d, query}, nil }
+func (c procConn) Close() error                              { return nil }
+func (c procConn) Begin() (driver.Tx, error)                 { return nil, driver.ErrSkip }
+
+type procStmt struct {
+	d     procDriver
+	query string
+}
+
+func (s procStmt) Close() error                               { return nil }
+func (s procStmt) NumInput() int                              { return -1 }
+func (s procStmt) Exec([]driver.Value) (driver.Result, error) { return driver.RowsAffected(0), nil }
+func (s procStmt) Query(args []driver.Value) (driver.Rows, error) {
+	for k, rows := range s.d {
+		if strings.Contains(s.query, k) && (len(args) < 2 || args[1] == rows[0][0]) {
+			return &procRows{rows: rows[1:]}, nil
+		}
+	}
+	return &procRows{}, nil
+}
+
+type procRows struct {
+	rows [][]driver.Value
+}
+
+func (r *procRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+	return make([]string, len(r.rows[0]))
+}
+func (r *procRows) Close() error { return nil }
+func (r *procRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+func Test_MsLoadProcs(t *testing.T) {
+	sql.Register("xo-mssql-procs", procDriver{
+		// the first row is the proc of the parameters
+		"FROM sys.procedures p WHERE": {{nil}, {"add_book", "void"}, {"ping", "void"}},
+		"FROM sys.parameters p":       {{"add_book"}, {"nvarchar", "title", "IN"}, {"int", "book_id", "INOUT"}},
+	})
+	db, err := sql.Open("xo-mssql-procs", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	args := internal.NewDefaultArgs()
+	args.DB, args.Schema, args.LoaderType = db, "dbo", "mssql"
+	loader := internal.SchemaLoaders["mssql"].(internal.TypeLoader)
+	args.Loader = loader
+
+	procs, err := loader.LoadProcs(args, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(procs) != 2 || len(procs["add_book"].Params) != 2 || len(procs["ping"].Params) != 0 {
+		t.Fatalf("unexpected procs: %+v", procs)
+	}
+
+	var buf strings.Builder
+	for _, g := range args.Generated {
+		buf.WriteString(g.Buf.String())
+	}
+	src := buf.String()
+	if _, err := parser.ParseFile(token.NewFileSet(), "", "package models\n"+src, 0); err != nil {
+		t.Fatalf("expected valid Go code, got: %v\n%s", err, src)
+	}
+
+	tests := []string{
+		"// AddBook calls the stored procedure 'dbo.add_book(@title nvarchar, @book_id int OUTPUT)' on db.",
+		"func AddBook(db XODB, title string, bookID *int) error {",
+		"const sqlstr = `EXEC dbo.add_book @title = @title, @book_id = @book_id OUTPUT`",
+		`_, err = db.Exec(sqlstr, sql.Named("title", title), sql.Named("book_id", sql.Out{Dest: bookID, In: true}))`,
+		"func AddBookRows(db XODB, title string, bookID *int) (*sql.Rows, error) {",
+		"const sqlstr = `EXEC dbo.ping`",
+		"return db.Query(sqlstr)",
+	}
+	for i, s := range tests {
+		if !strings.Contains(src, s) {
+			t.Errorf("test #%d: expected %q in:\n%s", i+1, s, src)
+		}
+	}
+}
@@ -196,6 +196,13 @@ switchDT:
 		// time is not supported by the MySQL driver. Can parse the string to time.Time in the user code.
 		typ = "string"
 
+	case "json":
+		typ = "json.RawMessage"
+		if nullable {
+			nilVal = "NullJSON{}"
+			typ = "NullJSON"
+		}
+
 	default:
 		if strings.HasPrefix(dt, args.Schema+".") {
 			// in the same schema, so chop off
@@ -239,7 +246,8 @@ func MyEnumValues(db models.XODB, schema string, enum string) ([]*models.EnumVal
 }
 
 // MyTables returns the MySql tables with the manual PK information added.
-// ManualPk is true when the table's primary key is not autoincrement.
+// ManualPk is true when the table's primary key is not autoincrement, or is
+// not a single column.
 func MyTables(db models.XODB, schema string, relkind string) ([]*models.Table, error) {
 	var err error
 
@@ -259,6 +267,19 @@ func MyTables(db models.XODB, schema string, relkind string) ([]*models.Table, e
 	// Add information about manual FK.
 	var tables []*models.Table
 	for _, row := range rows {
+		cols, err := models.MyTableColumns(db, schema, row.TableName)
+		if err != nil {
+			return nil, err
+		}
+
+		// count primary key columns
+		var pkCount int
+		for _, c := range cols {
+			if c.IsPrimaryKey {
+				pkCount++
+			}
+		}
+
 		manualPk := true
 		// Look for a match in the table name where it contains the autoincrement
 		for _, autoInc := range autoIncrements {
@@ -269,7 +290,7 @@ func MyTables(db models.XODB, schema string, relkind string) ([]*models.Table, e
 		tables = append(tables, &models.Table{
 			TableName: row.TableName,
 			Type:      row.Type,
-			ManualPk:  manualPk,
+			ManualPk:  manualPk || pkCount != 1,
 		})
 	}
 
@@ -23,9 +23,9 @@ func init() {
 		ParseType:      OrParseType,
 		//EnumList:        models.OrEnums,
 		//EnumValueList:   OrEnumValues,
-		//ProcList:      models.OrProcs,
-		//ProcParamList: models.OrProcParams,
-		TableList:       models.OrTables,
+		ProcList:        models.OrProcs,
+		ProcParamList:   models.OrProcParams,
+		TableList:       OrTables,
 		ColumnList:      models.OrTableColumns,
 		ForeignKeyList:  models.OrTableForeignKeys,
 		IndexList:       models.OrTableIndexes,
@@ -106,7 +106,7 @@ func OrParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 			nilVal = "sql.NullInt64{}"
 			typ = "sql.NullInt64"
 		}
-	case "integer":
+	case "integer", "pls_integer", "binary_integer":
 		nilVal = "0"
 		typ = args.Int32Type
 		if nullable {
@@ -121,14 +121,14 @@ func OrParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 			typ = "sql.NullInt64"
 		}
 
-	case "float", "shortdecimal":
+	case "float", "shortdecimal", "binary_float":
 		nilVal = "0.0"
 		typ = "float32"
 		if nullable {
 			nilVal = "sql.NullFloat64{}"
 			typ = "sql.NullFloat64"
 		}
-	case "number", "decimal":
+	case "number", "decimal", "binary_double":
 		nilVal = "0.0"
 		typ = "float64"
 		if nullable {
@@ -139,7 +139,7 @@ func OrParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 	case "blob", "long raw", "raw":
 		typ = "[]byte"
 
-	case "date", "timestamp", "timestamp with time zone":
+	case "date", "timestamp", "timestamp with time zone", "timestamp with local time zone":
 		typ = "time.Time"
 		nilVal = "time.Time{}"
 
@@ -188,3 +188,40 @@ func OrQueryColumns(args *internal.ArgType, inspect []string) ([]*models.Column,
 	// load column information
 	return cols, err
 }
+
+// OrTables returns the Oracle tables with the manual PK information added.
+// ManualPk is true when the table's primary key is not a single column.
+func OrTables(db models.XODB, schema string, relkind string) ([]*models.Table, error) {
+	var err error
+
+	// get the tables
+	rows, err := models.OrTables(db, schema, relkind)
+	if err != nil {
+		return nil, err
+	}
+
+	// add information about manual pk
+	var tables []*models.Table
+	for _, row := range rows {
+		cols, err := models.OrTableColumns(db, schema, row.TableName)
+		if err != nil {
+			return nil, err
+		}
+
+		// count primary key columns
+		var pkCount int
+		for _, c := range cols {
+			if c.IsPrimaryKey {
+				pkCount++
+			}
+		}
+
+		tables = append(tables, &models.Table{
+			TableName: row.TableName,
+			Type:      row.Type,
+			ManualPk:  pkCount != 1,
+		})
+	}
+
+	return tables, nil
+}
@@ -0,0 +1,64 @@
+// +build oracle
+
+package loaders_test
+
+import (
+	"database/sql"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/sandeepone/xo/internal"
+)
+
+func Test_OrLoadProcs(t *testing.T) {
+	sql.Register("xo-oracle-procs", procDriver{
+		// the first row is the proc of the parameters
+		"FROM all_procedures p": {{nil}, {"books.add_book", "void"}, {"book_count", "number"}},
+		"FROM all_arguments WHERE": {{"books.add_book"},
+			{"varchar2", "title", "IN"}, {"number", "book_id", "OUT"}, {"date", "updated", "INOUT"}},
+	})
+	db, err := sql.Open("xo-oracle-procs", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	args := internal.NewDefaultArgs()
+	args.DB, args.Schema, args.LoaderType = db, "hr", "ora"
+	loader := internal.SchemaLoaders["ora"].(internal.TypeLoader)
+	args.Loader = loader
+
+	procs, err := loader.LoadProcs(args, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(procs) != 2 || procs["books.add_book"].Name != "Books_AddBook" || len(procs["books.add_book"].Params) != 3 {
+		t.Fatalf("unexpected procs: %+v", procs)
+	}
+
+	var buf strings.Builder
+	for _, g := range args.Generated {
+		buf.WriteString(g.Buf.String())
+	}
+	src := buf.String()
+	if _, err := parser.ParseFile(token.NewFileSet(), "", "package models\n"+src, 0); err != nil {
+		t.Fatalf("expected valid Go code, got: %v\n%s", err, src)
+	}
+
+	tests := []string{
+		"// Books_AddBook calls the stored procedure 'hr.books.add_book(title IN varchar2, book_id OUT number, updated IN OUT date)' on db.",
+		"func Books_AddBook(db XODB, title string, bookID *float64, updated *time.Time) error {",
+		"const sqlstr = `BEGIN hr.books.add_book(:title, :bookID, :updated); END;`",
+		"_, err = db.Exec(sqlstr, title, sql.Out{Dest: bookID}, sql.Out{Dest: updated, In: true})",
+		"// BookCount calls the stored function 'hr.book_count() number' on db.",
+		"func BookCount(db XODB) (float64, error) {",
+		"const sqlstr = `BEGIN :ret := hr.book_count(); END;`",
+		"_, err = db.Exec(sqlstr, sql.Out{Dest: &ret})",
+	}
+	for i, s := range tests {
+		if !strings.Contains(src, s) {
+			t.Errorf("test #%d: expected %q in:\n%s", i+1, s, src)
+		}
+	}
+}
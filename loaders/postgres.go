@@ -20,6 +20,8 @@ func init() {
 		ParseType:      PgParseType,
 		EnumList:       models.PgEnums,
 		EnumValueList:  models.PgEnumValues,
+		DomainList:     models.PgDomains,
+		CompositeList:  models.PgComposites,
 		ProcList:       models.PgProcs,
 		ProcParamList:  models.PgProcParams,
 		TableList:      PgTables,
@@ -31,6 +33,7 @@ func init() {
 		IndexColumnList: PgIndexColumns,
 		QueryStrip:      PgQueryStrip,
 		QueryColumnList: PgQueryColumns,
+		DDLType:         PgDDLType,
 	}
 }
 
@@ -53,7 +56,7 @@ func PgRelkind(relType internal.RelType) string {
 func PgParseType(args *internal.ArgType, dt string, nullable bool) (int, string, string) {
 	precision := 0
 	nilVal := "nil"
-	asSlice := false
+	asSlice, nullSlice := false, false
 
 	// handle SETOF
 	if strings.HasPrefix(dt, "SETOF ") {
@@ -64,7 +67,7 @@ func PgParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 	// determine if it's a slice
 	if strings.HasSuffix(dt, "[]") {
 		dt = dt[:len(dt)-2]
-		asSlice = true
+		asSlice, nullSlice = true, nullable
 		nullable = false
 	}
 
@@ -163,6 +166,35 @@ func PgParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 	case "interval":
 		typ = "*time.Duration"
 
+	case "int4range", "int8range":
+		nilVal = "Int64Range{}"
+		typ = "Int64Range"
+		if nullable {
+			nilVal = "nil"
+			typ = "*Int64Range"
+		}
+	case "numrange":
+		nilVal = "Float64Range{}"
+		typ = "Float64Range"
+		if nullable {
+			nilVal = "nil"
+			typ = "*Float64Range"
+		}
+	case "tsrange", "tstzrange", "daterange":
+		nilVal = "TimeRange{}"
+		typ = "TimeRange"
+		if nullable {
+			nilVal = "nil"
+			typ = "*TimeRange"
+		}
+
+	case "json", "jsonb":
+		typ = "json.RawMessage"
+		if nullable {
+			nilVal = "NullJSON{}"
+			typ = "NullJSON"
+		}
+
 	case `"char"`, "bit":
 		// FIXME: this needs to actually be tested ...
 		// i think this should be 'rune' but I don't think database/sql
@@ -194,11 +226,42 @@ func PgParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 			typ = snaker.SnakeToCamelIdentifier(dt)
 			nilVal = typ + "{}"
 		}
+
+		// composite types are structs, and nullable as pointers
+		if args.CompositeTypeMap[typ] {
+			nilVal = typ + "{}"
+			if nullable {
+				nilVal = "nil"
+				typ = "*" + typ
+			}
+		}
 	}
 
 	// special case for []slice
-	if typ == "string" && asSlice {
-		return precision, "StringSlice{}", "StringSlice"
+	if asSlice {
+		switch {
+		case typ == "string":
+			typ = "StringSlice"
+		case typ == "int16", typ == "uint16", typ == args.Int32Type, typ == args.Uint32Type, typ == "int64", typ == "uint64":
+			typ = "Int64Slice"
+		case typ == "float32", typ == "float64":
+			typ = "Float64Slice"
+		case typ == "bool":
+			typ = "BoolSlice"
+		case typ == "uuid.UUID":
+			typ = "UUIDSlice"
+		case args.KnownTypeMap[typ+"Slice"]:
+			// enum slice
+			typ = typ + "Slice"
+		}
+		if strings.HasSuffix(typ, "Slice") {
+			// nullable arrays may have NULL elements
+			if nullSlice && args.KnownTypeMap["Null"+typ] {
+				typ = "Null" + typ
+			}
+			args.AddSliceType(typ)
+			return precision, typ + "{}", typ
+		}
 	}
 
 	// correct type if slice
@@ -210,6 +273,81 @@ func PgParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 	return precision, nilVal, typ
 }
 
+// pgDDLTypes are the postgres type aliases, and the corresponding type names
+// as reported by format_type.
+var pgDDLTypes = map[string]string{
+	"int":         "integer",
+	"int4":        "integer",
+	"int2":        "smallint",
+	"int8":        "bigint",
+	"serial":      "integer",
+	"serial4":     "integer",
+	"smallserial": "smallint",
+	"serial2":     "smallint",
+	"bigserial":   "bigint",
+	"serial8":     "bigint",
+	"bool":        "boolean",
+	"float4":      "real",
+	"float":       "double precision",
+	"float8":      "double precision",
+	"decimal":     "numeric",
+	"varchar":     "character varying",
+	"char":        "character",
+	"bpchar":      "character",
+	"varbit":      "bit varying",
+	"time":        "time without time zone",
+	"timetz":      "time with time zone",
+	"timestamp":   "timestamp without time zone",
+	"timestamptz": "timestamp with time zone",
+}
+
+// pgDDLTypeRE matches a postgres type declared in DDL, capturing the type
+// name, precision, time zone and array suffix.
+var pgDDLTypeRE = regexp.MustCompile(`^([a-z_][a-z0-9_ ."]*?)\s*(\([0-9, ]*\))?\s*(with time zone|without time zone)?\s*((?:\[[0-9]*\])*|array)$`)
+
+// PgDDLType converts a postgres column type declared in DDL to the type
+// as reported by format_type, and whether the type is a serial type.
+func PgDDLType(dt string, pk bool) (string, bool) {
+	dt = strings.Join(strings.Fields(strings.ToLower(dt)), " ")
+
+	m := pgDDLTypeRE.FindStringSubmatch(dt)
+	if m == nil {
+		return dt, false
+	}
+	name, precision, tz, array := m[1], strings.Replace(m[2], " ", "", -1), m[3], m[4]
+
+	serial := strings.Contains(name, "serial")
+	if typ, ok := pgDDLTypes[name]; ok {
+		name = typ
+	}
+
+	// time zone is part of the name
+	if tz != "" {
+		name = strings.TrimSuffix(strings.TrimSuffix(name, " without time zone"), " with time zone")
+		name = name + " " + tz
+	}
+
+	// precision goes before the time zone
+	if precision != "" {
+		if i := strings.Index(name, " with"); i != -1 {
+			name = name[:i] + precision + name[i:]
+		} else {
+			name = name + precision
+		}
+	}
+
+	// bare char is char(1)
+	if name == "character" {
+		name = "character(1)"
+	}
+
+	if array != "" {
+		name = name + "[]"
+	}
+
+	return name, serial && array == ""
+}
+
 // pgQueryStripRE is the regexp to match the '::type AS name' portion in a query,
 // which is a quirk/requirement of generating queries as is done in this
 // package.
@@ -229,7 +367,8 @@ func PgQueryStrip(query []string, queryComments []string) {
 }
 
 // PgTables returns the Postgres tables with the manual PK information added.
-// ManualPk is true when the table does not have a sequence defined.
+// ManualPk is true when the table's primary key is not a single column owning a
+// sequence (ie, a serial or identity column).
 func PgTables(db models.XODB, schema string, relkind string) ([]*models.Table, error) {
 	var err error
 
@@ -239,7 +378,7 @@ func PgTables(db models.XODB, schema string, relkind string) ([]*models.Table, e
 		return nil, err
 	}
 
-	// Get the tables that have a sequence defined.
+	// Get the table columns that have a sequence defined.
 	sequences, err := models.PgSequences(db, schema)
 	if err != nil {
 		// Set it to an empty set on error.
@@ -249,17 +388,33 @@ func PgTables(db models.XODB, schema string, relkind string) ([]*models.Table, e
 	// Add information about manual FK.
 	var tables []*models.Table
 	for _, row := range rows {
+		cols, err := models.PgTableColumns(db, schema, row.TableName, false)
+		if err != nil {
+			return nil, err
+		}
+
+		// count primary key columns
+		var pkCount int
+		var pkCol string
+		for _, c := range cols {
+			if c.IsPrimaryKey {
+				pkCount++
+				pkCol = c.ColumnName
+			}
+		}
+
 		manualPk := true
-		// Look for a match in the table name where it contains the sequence
+		// Look for a match in the table and primary key column names where
+		// they own the sequence
 		for _, sequence := range sequences {
-			if sequence.TableName == row.TableName {
+			if sequence.TableName == row.TableName && sequence.ColumnName == pkCol {
 				manualPk = false
 			}
 		}
 		tables = append(tables, &models.Table{
 			TableName: row.TableName,
 			Type:      row.Type,
-			ManualPk:  manualPk,
+			ManualPk:  manualPk || pkCount != 1,
 		})
 	}
 
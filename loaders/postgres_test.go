@@ -0,0 +1,280 @@
+package loaders_test
+
+import (
+	"database/sql"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/sandeepone/xo/internal"
+	"github.com/sandeepone/xo/loaders"
+)
+
+func Test_PgLoadProcs(t *testing.T) {
+	sql.Register("xo-postgres-procs", procDriver{
+		// the first row is the proc of the parameters
+		"n.oid WHERE": {{nil},
+			{"book_title", "record", false}, {"touch_book", "void", false},
+			{"author_books", "TABLE(book_id integer, title text)", true}, {"book_ids", "SETOF integer", true},
+			{"recent_books", "SETOF books", true}},
+		"WITH ORDINALITY": {{"book_title"},
+			{"integer", "book_id", "IN"}, {"text", "title", "OUT"}, {"integer", "", "OUT"}},
+		"ORDER BY a.n": {{"author_books"},
+			{"integer", "author_id", "IN"}, {"integer", "book_id", "OUT"}, {"text", "title", "OUT"}},
+	})
+	db, err := sql.Open("xo-postgres-procs", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	args := internal.NewDefaultArgs()
+	args.DB, args.Schema, args.LoaderType = db, "public", "postgres"
+	loader := internal.SchemaLoaders["postgres"].(internal.TypeLoader)
+	args.Loader = loader
+
+	book := &internal.Type{Name: "Book", Fields: []*internal.Field{{Name: "BookID", Type: "int"}, {Name: "Title", Type: "string"}}}
+	book.PrimaryKey = book.Fields[0]
+	procs, err := loader.LoadProcs(args, map[string]*internal.Type{"books": book}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := procs["book_title"]
+	if len(procs) != 5 || p == nil || len(p.Inputs) != 1 || len(p.Outputs) != 2 {
+		t.Fatalf("unexpected procs: %+v", procs)
+	}
+
+	var buf strings.Builder
+	for _, g := range args.Generated {
+		buf.WriteString(g.Buf.String())
+	}
+	src := buf.String()
+	if _, err := parser.ParseFile(token.NewFileSet(), "", "package models\n"+src, 0); err != nil {
+		t.Fatalf("expected valid Go code, got: %v\n%s", err, src)
+	}
+
+	tests := []string{
+		"type BookTitleResult struct {",
+		"Title string // title",
+		"V2 int\n",
+		"func BookTitle(db XODB, bookID int) (*BookTitleResult, error) {",
+		"const sqlstr = `SELECT * FROM public.book_title($1)`",
+		"err = db.QueryRow(sqlstr, bookID).Scan(&r.Title, &r.V2)",
+		"func TouchBook(db XODB) error {",
+		"type AuthorBooksRow struct {",
+		"func AuthorBooks(db XODB, authorID int) ([]*AuthorBooksRow, error) {",
+		"const sqlstr = `SELECT * FROM public.author_books($1)`",
+		"err = q.Scan(&r.BookID, &r.Title)",
+		"func BookIds(db XODB) ([]int, error) {",
+		"const sqlstr = `SELECT public.book_ids()`",
+		"func RecentBooks(db XODB) ([]*Book, error) {",
+		"const sqlstr = `SELECT * FROM public.recent_books()`",
+		"_exists: true,",
+		"err = q.Scan(&r.BookID, &r.Title)\n\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n\n\t\tres = append(res, &r)\n\t}\n\n\treturn res, nil\n}\n",
+	}
+	for i, s := range tests {
+		if !strings.Contains(src, s) {
+			t.Errorf("test #%d: expected %q in:\n%s", i+1, s, src)
+		}
+	}
+	// nullable params are opt-in
+	args.Generated, args.ProcAllowNulls = nil, true
+	if _, err = loader.LoadProcs(args, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	for _, g := range args.Generated {
+		buf.WriteString(g.Buf.String())
+	}
+	if exp := "func BookTitle(db XODB, bookID sql.NullInt64) (*BookTitleResult, error) {"; !strings.Contains(buf.String(), exp) {
+		t.Errorf("expected %q in:\n%s", exp, buf.String())
+	}
+}
+
+func Test_PgLoadComposites(t *testing.T) {
+	sql.Register("xo-postgres-composites", procDriver{
+		// the first row is the table of the columns
+		"t.typtype = 'd'": {{nil}, {"isbn", "character varying(13)"}, {"short_isbn", "isbn"}},
+		"c.relkind = 'c'": {{nil}, {"address"}},
+		"ORDER BY a.attnum": {{"address"},
+			{1, "street", "text", false, "", false, ""}, {2, "zip", "short_isbn", false, "", false, ""}, {3, "period", "tstzrange", false, "", false, ""}},
+	})
+	db, err := sql.Open("xo-postgres-composites", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	args := internal.NewDefaultArgs()
+	args.DB, args.Schema, args.LoaderType = db, "public", "postgres"
+	loader := internal.SchemaLoaders["postgres"].(internal.TypeLoader)
+	args.Loader = loader
+	internal.Args = args
+
+	err = loader.LoadDomains(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	composites, err := loader.LoadComposites(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(composites) != 1 || len(composites["Address"].Fields) != 3 || !args.KnownTypeMap["Address"] {
+		t.Fatalf("unexpected composites: %+v", composites)
+	}
+
+	var buf strings.Builder
+	for _, g := range args.Generated {
+		buf.WriteString(g.Buf.String())
+	}
+	src := buf.String()
+	if _, err := parser.ParseFile(token.NewFileSet(), "", "package models\n"+src, 0); err != nil {
+		t.Fatalf("expected valid Go code, got: %v\n%s", err, src)
+	}
+
+	tests := []string{
+		"// Address represents the composite type 'public.address'.",
+		"Zip sql.NullString // zip",
+		"Period *TimeRange // period",
+		"func (a *Address) Scan(src interface{}) error {",
+		"err = scanRecordField(&v.Street, fields[0])",
+		"fields[2], err = recordFieldValue(a.Period)",
+		"return formatRecord(fields), nil",
+	}
+	for i, s := range tests {
+		if !strings.Contains(src, s) {
+			t.Errorf("test #%d: expected %q in:\n%s", i+1, s, src)
+		}
+	}
+}
+
+func Test_PgTables(t *testing.T) {
+	sql.Register("xo-postgres-tables", procDriver{
+		"c.relkind":             {{"r"}, {"r", "authors", false}, {"r", "book_authors", false}, {"r", "tags", false}},
+		"JOIN pg_depend d":      {{nil}, {"authors", "author_id"}, {"book_authors", "seq"}, {"tags", "seq"}},
+		"FROM pg_attribute a":   {{"authors"}, {1, "author_id", "integer", true, "nextval('authors_author_id_seq'::regclass)", true, ""}},
+		"LEFT JOIN pg_attrdef":  {{"tags"}, {1, "tag", "text", true, "", true, ""}, {2, "seq", "integer", true, "nextval('tags_seq_seq'::regclass)", false, ""}},
+		"ON c.oid = a.attrelid": {{"book_authors"}, {1, "book_id", "integer", true, "", true, ""}, {2, "author_id", "integer", true, "", true, ""}, {3, "seq", "integer", true, "nextval('book_authors_seq_seq'::regclass)", false, ""}},
+	})
+	db, err := sql.Open("xo-postgres-tables", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tables, err := loaders.PgTables(db, "public", "r")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 3 {
+		t.Fatalf("expected 3 tables, got: %d", len(tables))
+	}
+
+	// the primary keys are manual, despite the sequences of the other columns
+	tests := []struct {
+		name     string
+		manualPk bool
+	}{
+		{"authors", false},
+		{"book_authors", true},
+		{"tags", true},
+	}
+	for i, test := range tests {
+		if tables[i].TableName != test.name || tables[i].ManualPk != test.manualPk {
+			t.Errorf("test #%d: expected %s with ManualPk %t, got: %+v", i+1, test.name, test.manualPk, tables[i])
+		}
+	}
+}
+
+func Test_PgLoadDDL(t *testing.T) {
+	ddl, err := internal.ParseDDL(`
+CREATE TABLE authors (
+  author_id SERIAL PRIMARY KEY,
+  name text NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX authors_name_idx ON authors (lower(name));`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loader, err := internal.SchemaLoaders["postgres"].(internal.TypeLoader).DDLLoader(ddl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	args := internal.NewDefaultArgs()
+	args.Schema, args.LoaderType = "public", "postgres"
+	args.Loader = loader
+	internal.Args = args
+
+	// the schema is loaded without a database
+	err = loader.LoadSchema(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf strings.Builder
+	for _, g := range args.Generated {
+		buf.WriteString(g.Buf.String())
+	}
+	src := buf.String()
+	if !strings.Contains(src, "type Author struct {") {
+		t.Errorf("expected the Author type in:\n%s", src)
+	}
+
+	// the expression index is skipped
+	if strings.Contains(src, "AuthorsNameIdx") || strings.Contains(src, "func AuthorBy(") {
+		t.Errorf("expected no func for the expression index in:\n%s", src)
+	}
+}
+
+func Test_PgParseType(t *testing.T) {
+	args := internal.NewDefaultArgs()
+	args.LoaderType = "postgres"
+	internal.Args = args
+
+	tests := []struct {
+		dt       string
+		nullable bool
+		typ      string
+	}{
+		{"integer[]", false, "Int64Slice"},
+		{"integer[]", true, "NullInt64Slice"},
+		{"text[]", false, "StringSlice"},
+		{"character varying[]", true, "NullStringSlice"},
+		{"uuid[]", true, "NullUUIDSlice"},
+	}
+	for i, test := range tests {
+		if _, _, typ := loaders.PgParseType(args, test.dt, test.nullable); typ != test.typ {
+			t.Errorf("test #%d: expected %s, got: %s", i+1, test.typ, typ)
+		}
+	}
+
+	// composite types are nullable as pointers
+	args.Schema, args.CompositeTypeMap = "public", map[string]bool{"Address": true}
+	composites := []struct {
+		dt       string
+		nullable bool
+		nilVal   string
+		typ      string
+	}{
+		{"address", false, "Address{}", "Address"},
+		{"address", true, "nil", "*Address"},
+		{"public.address", false, "Address{}", "Address"},
+		{"public.address", true, "nil", "*Address"},
+	}
+	for i, test := range composites {
+		if _, nilVal, typ := loaders.PgParseType(args, test.dt, test.nullable); nilVal != test.nilVal || typ != test.typ {
+			t.Errorf("composite test #%d: expected %s %s, got: %s %s", i+1, test.nilVal, test.typ, nilVal, typ)
+		}
+	}
+
+	// only the used uuid array types are generated
+	err := args.ExecuteTemplate(internal.XOTemplate, "xo_db", "", args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := args.Generated[0].Buf.String()
+	if !strings.Contains(src, "type NullUUIDSlice []uuid.NullUUID") || strings.Contains(src, "type UUIDSlice") {
+		t.Errorf("expected only NullUUIDSlice in:\n%s", src)
+	}
+}
@@ -116,7 +116,8 @@ func SqParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 }
 
 // SqTables returns the sqlite tables with the manual PK information added.
-// ManualPk is true when the table's primary key is not autoincrement.
+// ManualPk is true when the table's primary key is not autoincrement, or is
+// not a single column.
 func SqTables(db models.XODB, schema string, relkind string) ([]*models.Table, error) {
 	var err error
 
@@ -136,6 +137,19 @@ func SqTables(db models.XODB, schema string, relkind string) ([]*models.Table, e
 	// Add information about manual FK.
 	var tables []*models.Table
 	for _, row := range rows {
+		cols, err := SqTableColumns(db, schema, row.TableName)
+		if err != nil {
+			return nil, err
+		}
+
+		// count primary key columns
+		var pkCount int
+		for _, col := range cols {
+			if col.IsPrimaryKey {
+				pkCount++
+			}
+		}
+
 		manualPk := true
 		// Look for a match in the table name where it contains the autoincrement
 		// keyword for the given table in the SQL.
@@ -144,10 +158,6 @@ func SqTables(db models.XODB, schema string, relkind string) ([]*models.Table, e
 			if autoInc.TableName == row.TableName && strings.Contains(lSQL, "autoincrement") {
 				manualPk = false
 			} else {
-				cols, err := SqTableColumns(db, schema, row.TableName)
-				if err != nil {
-					return nil, err
-				}
 				for _, col := range cols {
 					if col.IsPrimaryKey == true {
 						dt := strings.ToUpper(col.DataType)
@@ -162,7 +172,7 @@ func SqTables(db models.XODB, schema string, relkind string) ([]*models.Table, e
 		tables = append(tables, &models.Table{
 			TableName: row.TableName,
 			Type:      row.Type,
-			ManualPk:  manualPk,
+			ManualPk:  manualPk || pkCount != 1,
 		})
 	}
 
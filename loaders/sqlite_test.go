@@ -0,0 +1,42 @@
+package loaders_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/sandeepone/xo/loaders"
+)
+
+func Test_SqTables(t *testing.T) {
+	sql.Register("xo-sqlite-tables", procDriver{
+		"tbl_name AS table_name":          {{nil}, {"authors"}, {"book_authors"}},
+		"name as table_name, sql":         {{nil}, {"authors", "CREATE TABLE authors (author_id INTEGER PRIMARY KEY)"}},
+		"PRAGMA table_info(authors)":      {{nil}, {0, "author_id", "INTEGER", true, nil, 1}},
+		"PRAGMA table_info(book_authors)": {{nil}, {0, "book_id", "INTEGER", true, nil, 1}, {1, "author_id", "INTEGER", true, nil, 2}},
+	})
+	db, err := sql.Open("xo-sqlite-tables", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tables, err := loaders.SqTables(db, "", "table")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 2 {
+		t.Fatalf("expected 2 tables, got: %d", len(tables))
+	}
+
+	tests := []struct {
+		name     string
+		manualPk bool
+	}{
+		{"authors", false},
+		{"book_authors", true},
+	}
+	for i, test := range tests {
+		if tables[i].TableName != test.name || tables[i].ManualPk != test.manualPk {
+			t.Errorf("test #%d: expected %s with ManualPk %t, got: %+v", i+1, test.name, test.manualPk, tables[i])
+		}
+	}
+}
@@ -4,13 +4,17 @@ package main
 //go:generate ./gen.sh models
 
 import (
+	"bytes"
 	"database/sql"
 	"errors"
 	"fmt"
+	"go/build"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path"
+	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -39,6 +43,16 @@ func main() {
 
 	var err error
 
+	// generate from a project config file
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		err = generate(os.Args[2:])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// get defaults
 	internal.Args = internal.NewDefaultArgs()
 	args := internal.Args
@@ -53,14 +67,18 @@ func main() {
 		os.Exit(1)
 	}
 
-	if args.GraphQLSchema == "" {
+	// the database is also loaded when generating from a graphql schema, for
+	// generating the resolvers of the types backed by its tables
+	if args.GraphQLSchema == "" || args.DSN != "" {
 		// open database
 		err = openDB(args)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "error: %v\n", err)
 			os.Exit(1)
 		}
-		defer args.DB.Close()
+		if args.DB != nil {
+			defer args.DB.Close()
+		}
 
 		// load schema name
 		if args.Schema == "" {
@@ -82,26 +100,27 @@ func main() {
 			os.Exit(1)
 		}
 
-		//	// add xo
-		//	err = args.ExecuteTemplate(internal.XOTemplate, "xo_db", "", args)
-		//	if err != nil {
-		//		fmt.Fprintf(os.Stderr, "error: %v\n", err)
-		//		os.Exit(1)
-		//	}
-	} else {
+		// add xo
+		err = args.ExecuteTemplate(internal.XOTemplate, "xo_db", "", args)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	if args.GraphQLSchema != "" {
 		// save driver type
 		//args.LoaderType = "graphql"
-		args.Suffix = ".go"
 		args.GraphQL = true
 
 		// Neelance Graphql Generator
-		schemaBytes, err := ioutil.ReadFile(args.GraphQLSchema)
+		src, err := internal.ReadGraphQLSchema(args.GraphQLSchema)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "error: %v\n", err)
 			os.Exit(1)
 		}
 
-		cg := internal.NewCodeGen(string(schemaBytes))
+		cg := internal.NewSourceCodeGen(src)
 		err = cg.Generate(args)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "error: %v\n", err)
@@ -110,7 +129,14 @@ func main() {
 	}
 
 	// output
-	err = writeTypes(args)
+	if args.Check {
+		// the queries are usually generated in the directory of the schema,
+		// so orphaned files are only reported by xo generate --check, which
+		// generates both
+		err = checkTypes(args, false)
+	} else {
+		err = writeTypes(args)
+	}
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "error: %v\n", err)
 		os.Exit(1)
@@ -181,6 +207,14 @@ func processArgs(args *internal.ArgType) error {
 		args.Package = path.Base(args.Path)
 	}
 
+	// determine import path of the generated package
+	if args.ModelsImport == "" {
+		args.ModelsImport = importPath(args.Path)
+	}
+	if args.GraphQLLoaders && args.ModelsImport == "" {
+		return errors.New("cannot determine the import path of the generated package for the dataloaders, use --models-import")
+	}
+
 	// determine filename if not previously set
 	if args.Filename == "" {
 		args.Filename = args.Package + args.Suffix
@@ -195,6 +229,16 @@ func processArgs(args *internal.ArgType) error {
 		args.Query = string(buf)
 	}
 
+	// check batch size
+	if args.BatchSize < 1 {
+		return errors.New("--batch-size must be greater than 0")
+	}
+
+	// check mode does not write anything
+	if args.Check && args.Append {
+		return errors.New("--check cannot be used with --append")
+	}
+
 	// query mode parsing
 	if args.Query != "" {
 		args.QueryMode = true
@@ -210,6 +254,32 @@ func processArgs(args *internal.ArgType) error {
 		args.Query = strings.TrimSpace(args.Query)
 	}
 
+	// parse type overrides
+	for _, tm := range args.TypeMap {
+		key, o, err := internal.ParseTypeOverride(tm)
+		if err != nil {
+			return err
+		}
+
+		err = args.AddTypeOverride(key, o)
+		if err != nil {
+			return err
+		}
+	}
+
+	// parse graphql scalar types
+	for _, sm := range args.GraphQLScalarMap {
+		name, o, err := internal.ParseScalarOverride(sm)
+		if err != nil {
+			return err
+		}
+
+		err = args.AddScalarOverride(name, o)
+		if err != nil {
+			return err
+		}
+	}
+
 	// escape all
 	if args.EscapeAll {
 		args.EscapeSchemaName = true
@@ -227,6 +297,117 @@ func processArgs(args *internal.ArgType) error {
 	return nil
 }
 
+// generate generates the schema and all the custom queries declared in a
+// project config file, using a single database connection and writing the
+// generated code in one pass.
+//
+// params is the optional path to the config file, and the optional --check
+// flag. If the config file is not supplied, then it is searched for in the
+// working directory.
+func generate(params []string) error {
+	var err error
+
+	// parse params
+	var name string
+	var check bool
+	for _, p := range params {
+		switch {
+		case p == "--check":
+			check = true
+		case name == "" && !strings.HasPrefix(p, "-"):
+			name = p
+		default:
+			return errors.New("usage: xo generate [--check] [CONFIG]")
+		}
+	}
+
+	// load config
+	cfg, err := internal.LoadConfig(name)
+	if err != nil {
+		return err
+	}
+
+	// get defaults
+	internal.Args = internal.NewDefaultArgs()
+	args := internal.Args
+
+	// apply config
+	err = cfg.Apply(args)
+	if err != nil {
+		return err
+	}
+	args.Check = check
+
+	// process args
+	err = processArgs(args)
+	if err != nil {
+		return err
+	}
+
+	// open database
+	err = openDB(args)
+	if err != nil {
+		return err
+	}
+	if args.DB != nil {
+		defer args.DB.Close()
+	}
+
+	// load schema name
+	if args.Schema == "" {
+		args.Schema, err = args.Loader.SchemaName(args)
+		if err != nil {
+			return err
+		}
+	}
+
+	// load defs into type map
+	err = args.Loader.LoadSchema(args)
+	if err != nil {
+		return err
+	}
+
+	// load queries
+	queryTypes := map[string]bool{}
+	for _, q := range cfg.Queries {
+		err = q.Apply(cfg, args)
+		if err != nil {
+			return err
+		}
+
+		n := len(args.Generated)
+		err = args.Loader.ParseQuery(args)
+		if err != nil {
+			return fmt.Errorf("query %s: %v", args.QueryType, err)
+		}
+
+		// only keep the first generated type for queries sharing a type
+		if queryTypes[args.QueryType] {
+			generated := args.Generated[:n]
+			for _, t := range args.Generated[n:] {
+				if t.TemplateType != internal.QueryTypeTemplate {
+					generated = append(generated, t)
+				}
+			}
+			args.Generated = generated
+		}
+		queryTypes[args.QueryType] = true
+	}
+
+	// add xo
+	err = args.ExecuteTemplate(internal.XOTemplate, "xo_db", "", args)
+	if err != nil {
+		return err
+	}
+
+	// output
+	if args.Check {
+		return checkTypes(args, true)
+	}
+
+	return writeTypes(args)
+}
+
 // openDB attempts to open a database connection.
 func openDB(args *internal.ArgType) error {
 	var err error
@@ -247,6 +428,16 @@ func openDB(args *internal.ArgType) error {
 		return errors.New("unsupported database type")
 	}
 
+	// apply ddl to an in-memory sqlite database, introspecting it as usual
+	if args.DDL != "" && u.Driver == "sqlite3" {
+		return openMemoryDB(args)
+	}
+
+	// load schema from ddl instead
+	if args.DDL != "" {
+		return loadDDL(args)
+	}
+
 	// open database connection
 	args.DB, err = sql.Open(u.Driver, u.DSN)
 	if err != nil {
@@ -256,32 +447,124 @@ func openDB(args *internal.ArgType) error {
 	return nil
 }
 
+// openMemoryDB opens an in-memory sqlite database, creating the schema by
+// executing the statements in the DDL file.
+func openMemoryDB(args *internal.ArgType) error {
+	buf, err := ioutil.ReadFile(args.DDL)
+	if err != nil {
+		return err
+	}
+
+	args.DB, err = sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		return err
+	}
+
+	// each connection has its own in-memory database, so only use one
+	args.DB.SetMaxOpenConns(1)
+
+	_, err = args.DB.Exec(string(buf))
+	if err != nil {
+		args.DB.Close()
+		args.DB = nil
+		return fmt.Errorf("%s: %v", args.DDL, err)
+	}
+
+	return nil
+}
+
+// importPath determines the Go import path of the package in dir, using the
+// module path of the nearest go.mod, or the GOPATH. Returns an empty string
+// when it cannot be determined.
+func importPath(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+
+	// search for go.mod
+	for d := dir; ; d = filepath.Dir(d) {
+		buf, err := ioutil.ReadFile(filepath.Join(d, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(buf), "\n") {
+				fields := strings.Fields(line)
+				if len(fields) == 2 && fields[0] == "module" {
+					rel, err := filepath.Rel(d, dir)
+					if err != nil {
+						return ""
+					}
+					return path.Join(strings.Trim(fields[1], `"`), filepath.ToSlash(rel))
+				}
+			}
+			return ""
+		}
+
+		if d == filepath.Dir(d) {
+			break
+		}
+	}
+
+	// check GOPATH
+	for _, p := range filepath.SplitList(build.Default.GOPATH) {
+		rel, err := filepath.Rel(filepath.Join(p, "src"), dir)
+		if err == nil && !strings.HasPrefix(rel, "..") {
+			return filepath.ToSlash(rel)
+		}
+	}
+
+	return ""
+}
+
+// loadDDL parses the DDL file, replacing the loader with one that loads the
+// schema from the parsed DDL instead of from an opened database.
+func loadDDL(args *internal.ArgType) error {
+	buf, err := ioutil.ReadFile(args.DDL)
+	if err != nil {
+		return err
+	}
+
+	ddl, err := internal.ParseDDL(string(buf))
+	if err != nil {
+		return fmt.Errorf("%s: %v", args.DDL, err)
+	}
+
+	tl, ok := args.Loader.(internal.TypeLoader)
+	if !ok || tl.DDLType == nil {
+		return fmt.Errorf("loading schema from DDL is not supported for database type %s", args.LoaderType)
+	}
+
+	args.Loader, err = tl.DDLLoader(ddl)
+	return err
+}
+
 // files is a map of filenames to open file handles.
 var files = map[string]*os.File{}
 
-// getFile builds the filepath from the TBuf information, and retrieves the
-// file from files. If the built filename is not already defined, then it calls
-// the os.OpenFile with the correct parameters depending on the state of args.
-func getFile(args *internal.ArgType, t *internal.TBuf) (*os.File, error) {
-	var f *os.File
-	var err error
+// gqlSuffix is the filename suffix of the code generated from a GraphQL
+// schema, as opposed to args.Suffix used for the code generated from the
+// database schema.
+const gqlSuffix = ".go"
 
-	// determine filename
+// getFilename builds the filepath from the TBuf information, setting the
+// package name used in the file's package header.
+func getFilename(args *internal.ArgType, t *internal.TBuf) string {
 	var filename = strings.ToLower(t.Name)
 	//var bundle = path.Base(filename)
 
 	if t.TemplateType.String() == "graphql.type" {
 		args.Package = "objects"
-		filename = path.Join(args.Path, "objects/"+filename) + args.Suffix
+		filename = path.Join(args.Path, "objects/"+filename) + gqlSuffix
 	} else if t.TemplateType.String() == "graphql.model" {
-		args.Package = "objects"
-		filename = path.Join(args.Path, "objects/"+filename) + args.Suffix
+		args.Package = "queries"
+		filename = path.Join(args.Path, "queries/"+filename) + gqlSuffix
 	} else if t.TemplateType.String() == "graphql.query" {
 		args.Package = "queries"
-		filename = path.Join(args.Path, "queries/"+filename) + args.Suffix
+		filename = path.Join(args.Path, "queries/"+filename) + gqlSuffix
 	} else if t.TemplateType.String() == "graphql.loader" {
 		args.Package = "loaders"
 		filename = path.Join(args.Path, "loaders/"+filename) + args.Suffix
+	} else if t.TemplateType == internal.GraphQLSchemaTemplate {
+		filename = path.Join(args.Path, filename)
 	} else {
 		args.Package = path.Base(args.Path)
 		filename = path.Join(args.Path, filename) + args.Suffix
@@ -292,6 +575,19 @@ func getFile(args *internal.ArgType, t *internal.TBuf) (*os.File, error) {
 		args.SubPackage = path.Base(filename)
 	}
 
+	return filename
+}
+
+// getFile builds the filepath from the TBuf information, and retrieves the
+// file from files. If the built filename is not already defined, then it calls
+// the os.OpenFile with the correct parameters depending on the state of args.
+func getFile(args *internal.ArgType, t *internal.TBuf) (*os.File, error) {
+	var f *os.File
+	var err error
+
+	// determine filename
+	filename := getFilename(args, t)
+
 	dest := path.Dir(filename)
 	if err := prepare(dest); err != nil {
 		return nil, err
@@ -315,11 +611,6 @@ func getFile(args *internal.ArgType, t *internal.TBuf) (*os.File, error) {
 		mode = os.O_APPEND | os.O_WRONLY
 	}
 
-	// skip
-	if t.TemplateType == internal.XOTemplate && fi != nil {
-		return nil, nil
-	}
-
 	// open file
 	f, err = os.OpenFile(filename, mode, 0666)
 	if err != nil {
@@ -327,7 +618,7 @@ func getFile(args *internal.ArgType, t *internal.TBuf) (*os.File, error) {
 	}
 
 	// file didn't originally exist, so add package header
-	if fi == nil || !args.Append {
+	if (fi == nil || !args.Append) && t.TemplateType != internal.GraphQLSchemaTemplate {
 		// add build tags
 		if args.Tags != "" {
 			f.WriteString(`// +build ` + args.Tags + "\n\n")
@@ -378,11 +669,6 @@ func writeTypes(args *internal.ArgType) error {
 			return err
 		}
 
-		// should only be nil when type == xo
-		if f == nil {
-			continue
-		}
-
 		// if verbose
 		if args.Verbose {
 			fmt.Printf("Generated File Name : [%s] \n", f.Name())
@@ -400,7 +686,9 @@ func writeTypes(args *internal.ArgType) error {
 	// build goimports parameters, closing files
 	params := []string{"-w"}
 	for k, f := range files {
-		params = append(params, k)
+		if path.Ext(k) != ".graphql" {
+			params = append(params, k)
+		}
 
 		// close
 		err = f.Close()
@@ -413,6 +701,160 @@ func writeTypes(args *internal.ArgType) error {
 	return exec.Command("goimports", params...).Run()
 }
 
+// checkTypes generates the definitions in memory, formatting them with
+// goimports, and compares them to the files on disk, printing a unified diff
+// for each file that differs. When orphans is true, the files previously
+// generated in the output directories that are no longer generated (ie, of a
+// dropped table) are also reported. Nothing is written to disk.
+func checkTypes(args *internal.ArgType, orphans bool) error {
+	var err error
+
+	out := internal.TBufSlice(args.Generated)
+
+	// loop, building files in order
+	var names []string
+	bufs := map[string]*bytes.Buffer{}
+	for _, t := range out {
+		// check if generated template is only whitespace/empty
+		bufStr := strings.TrimSpace(t.Buf.String())
+		if len(bufStr) == 0 {
+			continue
+		}
+
+		filename := getFilename(args, &t)
+
+		buf, ok := bufs[filename]
+		if !ok {
+			buf = new(bytes.Buffer)
+
+			// add build tags and package header to go files
+			if t.TemplateType != internal.GraphQLSchemaTemplate {
+				if args.Tags != "" {
+					buf.WriteString(`// +build ` + args.Tags + "\n\n")
+				}
+
+				err = args.TemplateSet().Execute(buf, "xo_package.go.tpl", args)
+				if err != nil {
+					return err
+				}
+			}
+
+			bufs[filename] = buf
+			names = append(names, filename)
+		}
+
+		_, err = t.Buf.WriteTo(buf)
+		if err != nil {
+			return err
+		}
+	}
+
+	// compare with files on disk
+	var stale int
+	for _, filename := range names {
+		buf := bufs[filename].Bytes()
+
+		// format go files with goimports
+		if path.Ext(filename) != ".graphql" {
+			cmd := exec.Command("goimports", "-srcdir", path.Dir(filename))
+			cmd.Stdin = bufs[filename]
+			cmd.Stderr = os.Stderr
+			buf, err = cmd.Output()
+			if err != nil {
+				return fmt.Errorf("goimports %s: %v", filename, err)
+			}
+		}
+
+		// read existing file
+		orig, err := ioutil.ReadFile(filename)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		if bytes.Equal(orig, buf) {
+			continue
+		}
+
+		// print diff
+		origName := filename
+		if orig == nil {
+			origName = "/dev/null"
+		}
+		fmt.Fprint(os.Stdout, internal.Diff(origName, filename, string(orig), string(buf)))
+		stale++
+	}
+
+	// report the generated files that are no longer generated
+	if orphans {
+		filenames, err := orphanedFiles(args.Path, names)
+		if err != nil {
+			return err
+		}
+		for _, filename := range filenames {
+			orig, err := ioutil.ReadFile(filename)
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(os.Stdout, internal.Diff(filename, "/dev/null", string(orig), ""))
+			stale++
+		}
+	}
+
+	if stale != 0 {
+		return fmt.Errorf("%d generated file(s) out of date", stale)
+	}
+
+	return nil
+}
+
+// orphanedFiles returns the files generated by xo (ie, having its "Code
+// generated" header) in dir and in the directories of names, that are not
+// in names.
+func orphanedFiles(dir string, names []string) ([]string, error) {
+	dir = path.Clean(dir)
+	dirs, generated := []string{dir}, map[string]bool{}
+	for _, filename := range names {
+		if d := path.Dir(filename); d != dir {
+			dirs = append(dirs, d)
+		}
+		generated[filename] = true
+	}
+	sort.Strings(dirs)
+
+	var orphans []string
+	for i, d := range dirs {
+		if i > 0 && d == dirs[i-1] {
+			continue
+		}
+
+		fis, err := ioutil.ReadDir(d)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		for _, fi := range fis {
+			filename := path.Join(d, fi.Name())
+			if fi.IsDir() || generated[filename] {
+				continue
+			}
+
+			buf, err := ioutil.ReadFile(filename)
+			if err != nil {
+				return nil, err
+			}
+			if generatedHeader.Match(buf) {
+				orphans = append(orphans, filename)
+			}
+		}
+	}
+
+	return orphans, nil
+}
+
+// generatedHeader matches the "Code generated" header of the Go files and
+// the GraphQL schema generated by xo.
+var generatedHeader = regexp.MustCompile(`(?m)^(//|#) Code generated by xo\. DO NOT EDIT\.$`)
+
 func fileExist(path string) bool {
 	_, err := os.Stat(path)
 	return err == nil || os.IsExist(err)
@@ -15,6 +15,7 @@ type Column struct {
 	NotNull      bool           // not_null
 	DefaultValue sql.NullString // default_value
 	IsPrimaryKey bool           // is_primary_key
+	Comment      string         // comment
 }
 
 // PgTableColumns runs a custom query, returning results as Column.
@@ -28,7 +29,8 @@ func PgTableColumns(db XODB, schema string, table string, sys bool) ([]*Column,
 		`format_type(a.atttypid, a.atttypmod), ` + // ::varchar AS data_type
 		`a.attnotnull, ` + // ::boolean AS not_null
 		`COALESCE(pg_get_expr(ad.adbin, ad.adrelid), ''), ` + // ::varchar AS default_value
-		`COALESCE(ct.contype = 'p', false) ` + // ::boolean AS is_primary_key
+		`COALESCE(ct.contype = 'p', false), ` + // ::boolean AS is_primary_key
+		`COALESCE(col_description(c.oid, a.attnum), '') ` + // ::varchar AS comment
 		`FROM pg_attribute a ` +
 		`JOIN ONLY pg_class c ON c.oid = a.attrelid ` +
 		`JOIN ONLY pg_namespace n ON n.oid = c.relnamespace ` +
@@ -51,7 +53,7 @@ func PgTableColumns(db XODB, schema string, table string, sys bool) ([]*Column,
 		c := Column{}
 
 		// scan
-		err = q.Scan(&c.FieldOrdinal, &c.ColumnName, &c.DataType, &c.NotNull, &c.DefaultValue, &c.IsPrimaryKey)
+		err = q.Scan(&c.FieldOrdinal, &c.ColumnName, &c.DataType, &c.NotNull, &c.DefaultValue, &c.IsPrimaryKey, &c.Comment)
 		if err != nil {
 			return nil, err
 		}
@@ -73,7 +75,8 @@ func MyTableColumns(db XODB, schema string, table string) ([]*Column, error) {
 		`IF(data_type = 'enum', column_name, column_type) AS data_type, ` +
 		`IF(is_nullable = 'YES', false, true) AS not_null, ` +
 		`column_default AS default_value, ` +
-		`IF(column_key = 'PRI', true, false) AS is_primary_key ` +
+		`IF(column_key = 'PRI', true, false) AS is_primary_key, ` +
+		`column_comment AS comment ` +
 		`FROM information_schema.columns ` +
 		`WHERE table_schema = ? AND table_name = ? ` +
 		`ORDER BY ordinal_position`
@@ -92,7 +95,7 @@ func MyTableColumns(db XODB, schema string, table string) ([]*Column, error) {
 		c := Column{}
 
 		// scan
-		err = q.Scan(&c.FieldOrdinal, &c.ColumnName, &c.DataType, &c.NotNull, &c.DefaultValue, &c.IsPrimaryKey)
+		err = q.Scan(&c.FieldOrdinal, &c.ColumnName, &c.DataType, &c.NotNull, &c.DefaultValue, &c.IsPrimaryKey, &c.Comment)
 		if err != nil {
 			return nil, err
 		}
@@ -7,6 +7,7 @@ package models
 type Proc struct {
 	ProcName   string // proc_name
 	ReturnType string // return_type
+	ReturnsSet bool   // returns_set
 }
 
 // PgProcs runs a custom query, returning results as Proc.
@@ -16,7 +17,8 @@ func PgProcs(db XODB, schema string) ([]*Proc, error) {
 	// sql query
 	const sqlstr = `SELECT ` +
 		`p.proname, ` + // ::varchar AS proc_name
-		`pg_get_function_result(p.oid) ` + // ::varchar AS return_type
+		`pg_get_function_result(p.oid), ` + // ::varchar AS return_type
+		`p.proretset ` + // ::boolean AS returns_set
 		`FROM pg_proc p ` +
 		`JOIN ONLY pg_namespace n ON p.pronamespace = n.oid ` +
 		`WHERE n.nspname = $1`
@@ -35,7 +37,7 @@ func PgProcs(db XODB, schema string) ([]*Proc, error) {
 		p := Proc{}
 
 		// scan
-		err = q.Scan(&p.ProcName, &p.ReturnType)
+		err = q.Scan(&p.ProcName, &p.ReturnType, &p.ReturnsSet)
 		if err != nil {
 			return nil, err
 		}
@@ -53,7 +55,8 @@ func MyProcs(db XODB, schema string) ([]*Proc, error) {
 	// sql query
 	const sqlstr = `SELECT ` +
 		`r.routine_name AS proc_name, ` +
-		`p.dtd_identifier AS return_type ` +
+		`p.dtd_identifier AS return_type, ` +
+		`false AS returns_set ` +
 		`FROM information_schema.routines r ` +
 		`INNER JOIN information_schema.parameters p ` +
 		`ON p.specific_schema = r.routine_schema AND p.specific_name = r.routine_name AND p.ordinal_position = 0 ` +
@@ -67,6 +70,82 @@ func MyProcs(db XODB, schema string) ([]*Proc, error) {
 	}
 	defer q.Close()
 
+	// load results
+	res := []*Proc{}
+	for q.Next() {
+		p := Proc{}
+
+		// scan
+		err = q.Scan(&p.ProcName, &p.ReturnType, &p.ReturnsSet)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &p)
+	}
+
+	return res, nil
+}
+
+// MsProcs runs a custom query, returning results as Proc.
+func MsProcs(db XODB, schema string) ([]*Proc, error) {
+	var err error
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`p.name AS proc_name, ` +
+		`'void' AS return_type ` +
+		`FROM sys.procedures p ` +
+		`WHERE SCHEMA_NAME(p.schema_id) = $1 AND p.is_ms_shipped = 0 ` +
+		`AND NOT EXISTS (SELECT 1 FROM sys.parameters x INNER JOIN sys.types t ON t.user_type_id = x.user_type_id WHERE x.object_id = p.object_id AND t.is_table_type = 1)`
+
+	// run query
+	XOLog(sqlstr, schema)
+	q, err := db.Query(sqlstr, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	// load results
+	res := []*Proc{}
+	for q.Next() {
+		p := Proc{}
+
+		// scan
+		err = q.Scan(&p.ProcName, &p.ReturnType)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &p)
+	}
+
+	return res, nil
+}
+
+// OrProcs runs a custom query, returning results as Proc.
+func OrProcs(db XODB, schema string) ([]*Proc, error) {
+	var err error
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`LOWER(p.object_name || NVL2(p.procedure_name, '.' || p.procedure_name, '')) AS proc_name, ` +
+		`LOWER(NVL(r.data_type, 'void')) AS return_type ` +
+		`FROM all_procedures p ` +
+		`LEFT JOIN all_arguments r ON r.object_id = p.object_id AND r.subprogram_id = p.subprogram_id AND r.position = 0 AND r.data_level = 0 ` +
+		`WHERE p.owner = UPPER(:1) AND p.overload IS NULL ` +
+		`AND ((p.object_type IN ('FUNCTION', 'PROCEDURE') AND p.procedure_name IS NULL) OR (p.object_type = 'PACKAGE' AND p.procedure_name IS NOT NULL)) ` +
+		`AND NOT EXISTS (SELECT 1 FROM all_arguments a WHERE a.object_id = p.object_id AND a.subprogram_id = p.subprogram_id AND a.data_level = 0 AND a.data_type NOT IN ('CHAR', 'NCHAR', 'VARCHAR2', 'NVARCHAR2', 'CLOB', 'NCLOB', 'LONG', 'NUMBER', 'FLOAT', 'BINARY_FLOAT', 'BINARY_DOUBLE', 'BINARY_INTEGER', 'PLS_INTEGER', 'DATE', 'TIMESTAMP', 'TIMESTAMP WITH TIME ZONE', 'TIMESTAMP WITH LOCAL TIME ZONE', 'BLOB', 'RAW', 'LONG RAW'))`
+
+	// run query
+	XOLog(sqlstr, schema)
+	q, err := db.Query(sqlstr, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
 	// load results
 	res := []*Proc{}
 	for q.Next() {
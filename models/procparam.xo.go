@@ -6,6 +6,8 @@ package models
 // ProcParam represents a stored procedure param.
 type ProcParam struct {
 	ParamType string // param_type
+	ParamName string // param_name
+	ParamMode string // param_mode
 }
 
 // PgProcParams runs a custom query, returning results as ProcParam.
@@ -14,10 +16,14 @@ func PgProcParams(db XODB, schema string, proc string) ([]*ProcParam, error) {
 
 	// sql query
 	const sqlstr = `SELECT ` +
-		`UNNEST(STRING_TO_ARRAY(oidvectortypes(p.proargtypes), ', ')) ` + // ::varchar AS param_type
+		`format_type(a.t, NULL), ` + // ::varchar AS param_type
+		`COALESCE(p.proargnames[a.n], ''), ` + // ::varchar AS param_name
+		`CASE COALESCE(p.proargmodes[a.n], 'i') WHEN 'o' THEN 'OUT' WHEN 't' THEN 'OUT' WHEN 'b' THEN 'INOUT' ELSE 'IN' END ` + // ::varchar AS param_mode
 		`FROM pg_proc p ` +
 		`JOIN ONLY pg_namespace n ON p.pronamespace = n.oid ` +
-		`WHERE n.nspname = $1 AND p.proname = $2`
+		`JOIN LATERAL UNNEST(COALESCE(p.proallargtypes, p.proargtypes::oid[])) WITH ORDINALITY AS a(t, n) ON true ` +
+		`WHERE n.nspname = $1 AND p.proname = $2 ` +
+		`ORDER BY a.n`
 
 	// run query
 	XOLog(sqlstr, schema, proc)
@@ -33,7 +39,7 @@ func PgProcParams(db XODB, schema string, proc string) ([]*ProcParam, error) {
 		pp := ProcParam{}
 
 		// scan
-		err = q.Scan(&pp.ParamType)
+		err = q.Scan(&pp.ParamType, &pp.ParamName, &pp.ParamMode)
 		if err != nil {
 			return nil, err
 		}
@@ -50,7 +56,9 @@ func MyProcParams(db XODB, schema string, proc string) ([]*ProcParam, error) {
 
 	// sql query
 	const sqlstr = `SELECT ` +
-		`dtd_identifier AS param_type ` +
+		`dtd_identifier AS param_type, ` +
+		`parameter_name AS param_name, ` +
+		`parameter_mode AS param_mode ` +
 		`FROM information_schema.parameters ` +
 		`WHERE ordinal_position > 0 AND specific_schema = ? AND specific_name = ? ` +
 		`ORDER BY ordinal_position`
@@ -69,7 +77,85 @@ func MyProcParams(db XODB, schema string, proc string) ([]*ProcParam, error) {
 		pp := ProcParam{}
 
 		// scan
-		err = q.Scan(&pp.ParamType)
+		err = q.Scan(&pp.ParamType, &pp.ParamName, &pp.ParamMode)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &pp)
+	}
+
+	return res, nil
+}
+
+// MsProcParams runs a custom query, returning results as ProcParam.
+func MsProcParams(db XODB, schema string, proc string) ([]*ProcParam, error) {
+	var err error
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`TYPE_NAME(p.system_type_id) AS param_type, ` +
+		`SUBSTRING(p.name, 2, LEN(p.name)) AS param_name, ` +
+		`IIF(p.is_output = 1, 'INOUT', 'IN') AS param_mode ` +
+		`FROM sys.parameters p ` +
+		`INNER JOIN sys.procedures o ON o.object_id = p.object_id ` +
+		`WHERE p.parameter_id > 0 AND SCHEMA_NAME(o.schema_id) = $1 AND o.name = $2 ` +
+		`ORDER BY p.parameter_id`
+
+	// run query
+	XOLog(sqlstr, schema, proc)
+	q, err := db.Query(sqlstr, schema, proc)
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	// load results
+	res := []*ProcParam{}
+	for q.Next() {
+		pp := ProcParam{}
+
+		// scan
+		err = q.Scan(&pp.ParamType, &pp.ParamName, &pp.ParamMode)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &pp)
+	}
+
+	return res, nil
+}
+
+// OrProcParams runs a custom query, returning results as ProcParam.
+func OrProcParams(db XODB, schema string, proc string) ([]*ProcParam, error) {
+	var err error
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`LOWER(data_type) AS param_type, ` +
+		`LOWER(argument_name) AS param_name, ` +
+		`REPLACE(in_out, '/', '') AS param_mode ` +
+		`FROM all_arguments ` +
+		`WHERE owner = UPPER(:1) AND LOWER(NVL2(package_name, package_name || '.', '') || object_name) = :2 ` +
+		`AND overload IS NULL AND data_level = 0 AND argument_name IS NOT NULL ` +
+		`ORDER BY position`
+
+	// run query
+	XOLog(sqlstr, schema, proc)
+	q, err := db.Query(sqlstr, schema, proc)
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	// load results
+	res := []*ProcParam{}
+	for q.Next() {
+		pp := ProcParam{}
+
+		// scan
+		err = q.Scan(&pp.ParamType, &pp.ParamName, &pp.ParamMode)
 		if err != nil {
 			return nil, err
 		}
@@ -5,7 +5,8 @@ package models
 
 // Sequence represents a row from '[custom sequence]'.
 type Sequence struct {
-	TableName string // table_name
+	TableName  string // table_name
+	ColumnName string // column_name
 }
 
 // PgSequences runs a custom query, returning results as Sequence.
@@ -14,10 +15,12 @@ func PgSequences(db XODB, schema string) ([]*Sequence, error) {
 
 	// sql query
 	const sqlstr = `SELECT ` +
-		`t.relname ` + // ::varchar AS table_name
+		`t.relname, ` + // ::varchar AS table_name
+		`a.attname ` + // ::varchar AS column_name
 		`FROM pg_class s ` +
 		`JOIN pg_depend d ON d.objid = s.oid ` +
 		`JOIN pg_class t ON d.objid = s.oid AND d.refobjid = t.oid ` +
+		`JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = d.refobjsubid ` +
 		`JOIN pg_namespace n ON n.oid = s.relnamespace ` +
 		`WHERE n.nspname = $1 AND s.relkind = 'S'`
 
@@ -35,7 +38,7 @@ func PgSequences(db XODB, schema string) ([]*Sequence, error) {
 		s := Sequence{}
 
 		// scan
-		err = q.Scan(&s.TableName)
+		err = q.Scan(&s.TableName, &s.ColumnName)
 		if err != nil {
 			return nil, err
 		}
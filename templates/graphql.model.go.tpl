@@ -0,0 +1,162 @@
+{{- $pkg := .Package -}}
+{{- if eq .Template "RESOLVER" }}
+// Resolver resolves the Query and Mutation fields of the types backed by the
+// database tables of the same name, and is meant to be embedded in the root
+// resolver.
+type Resolver struct {
+	DB {{ $pkg }}.XODB
+}
+
+// DefaultFirst is the number of edges of the connections returned when the
+// first argument is not set.
+var DefaultFirst = 20
+
+// FromGlobalID returns the database key of the global id of a node of type
+// typ. It is used to decode the ID arguments, and returns id unchanged by
+// default (ie, set it to decode the relay global IDs).
+var FromGlobalID = func(typ, id string) string {
+	return id
+}
+
+// scanID decodes the ID id of a node of type typ to dest.
+func scanID(typ, id string, dest interface{}) error {
+	if s, ok := dest.(*string); ok {
+		*s = FromGlobalID(typ, id)
+		return nil
+	}
+
+	_, err := fmt.Sscan(FromGlobalID(typ, id), dest)
+	return err
+}
+{{- else }}
+{{- $type := .Def.Name -}}
+{{- $model := .Type.Name -}}
+{{- range .Resolvers }}
+{{- $name := (capitalize .Def.Name) }}
+{{- $input := .Input }}
+{{- $zero := .Zero }}
+{{- if eq .Kind "QUERY" }}
+// {{ $name }} resolves the {{ .Def.Name }} query with {{ $pkg }}.{{ .Index.FuncName }}.
+func (r *Resolver) {{ $name }}(ctx context.Context, args {{ $name }}Args) ({{ .Ret }}, error) {
+{{- else if eq .Kind "PAGE" }}
+// {{ $name }} resolves the {{ .Def.Name }} query with {{ $pkg }}.{{ .Index.PageName }}.
+func (r *Resolver) {{ $name }}(ctx context.Context, args {{ $name }}Args) ({{ .Ret }}, error) {
+{{- else if eq .Kind "CREATE" }}
+// {{ $name }} resolves the {{ .Def.Name }} mutation, inserting the {{ $model }}.
+func (r *Resolver) {{ $name }}(ctx context.Context, args *{{ $name }}Args) ({{ .Ret }}, error) {
+{{- else }}
+// {{ $name }} resolves the {{ .Def.Name }} mutation, {{ if eq .Kind "UPDATE" }}updating{{ else }}deleting{{ end }} the {{ $model }}.
+func (r *Resolver) {{ $name }}(ctx context.Context, args *{{ $name }}Args) ({{ .Ret }}, error) {
+{{- end }}
+{{- range .Args }}
+{{- if .IsID }}
+	var {{ .Var }} {{ .Field.Type }}
+	if err := scanID("{{ $type }}", args.{{ $input }}{{ .Name }}, &{{ .Var }}); err != nil {
+		return {{ or $zero "nil" }}, err
+	}
+{{ end }}
+{{- end }}
+{{- if eq .Kind "CREATE" }}
+	m := &{{ $pkg }}.{{ $model }}{}
+{{- else if eq .Kind "PAGE" }}
+	first := DefaultFirst
+	if args.First != nil {
+		first = int(*args.First)
+	}
+	var after {{ $pkg }}.Cursor
+	if args.After != nil {
+		after = {{ $pkg }}.Cursor(*args.After)
+	}
+
+	ms, page, err := {{ $pkg }}.{{ .Index.PageName }}({{ ctxarg }}r.DB
+	{{- range .Args }}, {{ if .IsID }}{{ .Var }}{{ else }}{{ .ToDB (print "args." .Name) }}{{ end }}{{ end }}, after, first)
+	if err != nil {
+		return nil, err
+	}
+{{- else }}
+	{{ if and (eq .Kind "QUERY") (not .Index.Index.IsUnique) }}ms{{ else }}m{{ end }}, err := {{ $pkg }}.{{ .Index.FuncName }}({{ ctxarg }}r.DB
+	{{- range .Args }}, {{ if .IsID }}{{ .Var }}{{ else }}{{ .ToDB (print "args." .Name) }}{{ end }}{{ end }})
+{{- if and (eq .Kind "QUERY") .Index.Index.IsUnique .Def.Type.IsNullable }}
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+{{- end }}
+	if err != nil {
+		return {{ or $zero "nil" }}, err
+	}
+{{- end }}
+{{- if eq .Kind "QUERY" }}
+{{- if .Index.Index.IsUnique }}
+
+	return objects.New{{ $type }}Resolver(r.DB, m), nil
+{{- else }}
+
+	res := make({{ trimPrefix .Ret "*" }}, len(ms))
+	for i, m := range ms {
+		res[i] = objects.New{{ $type }}Resolver(r.DB, m)
+	}
+
+	return {{ if hasPrefix .Ret "*" }}&{{ end }}res, nil
+{{- end }}
+{{- else if eq .Kind "PAGE" }}
+{{- $edges := (trimPrefix .EdgesType "*") }}
+
+	edges := make({{ $edges }}, len(ms))
+	for i, m := range ms {
+		edges[i] = {{ if hasPrefix $edges "[]*" }}&{{ end }}objects.{{ .Edge }}Resolver{ {{- .Edge }}: objects.{{ .Edge }}{
+			Cursor: string(page.Cursors[i]),
+			Node:   {{ if .NodeValue }}*{{ end }}objects.New{{ $type }}Resolver(r.DB, m),
+		}}
+	}
+
+	return {{ if hasPrefix .Ret "*" }}&{{ end }}objects.{{ .Connection }}Resolver{ {{- .Connection }}: objects.{{ .Connection }}{
+		Edges: {{ if hasPrefix .EdgesType "*" }}&{{ end }}edges,
+		PageInfo: {{ if hasPrefix .PageInfoType "*" }}&{{ end }}objects.{{ .PageInfo }}Resolver{ {{- .PageInfo }}: objects.{{ .PageInfo }}{
+		{{- range .PageFields }}
+			{{ . }}: {{ if hasSuffix . "Cursor" }}string(page.{{ . }}()){{ else }}page.{{ . }}{{ end }},
+		{{- end }}
+		}},
+	}}, nil
+{{- else }}
+{{- if eq .Kind "UPDATE" }}
+{{ end }}
+{{- range .Values }}
+{{- if .Nullable }}
+	if args.{{ $input }}{{ .Name }} != nil {
+		m.{{ .Field.Name }} = {{ .ToDB (print "*args." $input .Name) }}
+	}
+{{- else }}
+	m.{{ .Field.Name }} = {{ .ToDB (print "args." $input .Name) }}
+{{- end }}
+{{- end }}
+{{- if eq .Kind "CREATE" }}
+
+	if err := m.Insert({{ ctxarg }}r.DB); err != nil {
+{{- else if eq .Kind "UPDATE" }}
+
+	if err := m.Update({{ ctxarg }}r.DB); err != nil {
+{{- else }}
+
+	if err := m.Delete({{ ctxarg }}r.DB); err != nil {
+{{- end }}
+		return {{ $zero }}, err
+	}
+
+{{- if .Payload }}
+
+	return {{ if not (hasPrefix .Ret "*") }}*{{ end }}New{{ .Payload.Name }}({{ .Payload.Name }}{
+	{{- if .PayloadField }}
+		{{ .PayloadField }}: {{ if .PayloadValue }}*{{ end }}objects.New{{ $type }}Resolver(r.DB, m),
+	{{- end }}
+	}), nil
+{{- else if .Object }}
+
+	return {{ if not (hasPrefix .Ret "*") }}*{{ end }}objects.New{{ $type }}Resolver(r.DB, m), nil
+{{- else }}
+
+	return true, nil
+{{- end }}
+{{- end }}
+}
+{{ end }}
+{{- end }}
@@ -33,7 +33,26 @@
       {{- $hasArguments := gt (.Args | len) 0}}
       
       // {{capitalize .Name}} {{.Description}}
-      {{capitalize .Name}}({{if $hasArguments}}ctx context.Context, args *{{capitalize .Name}}Args{{end}}) ({{genType .Type "interface" .Name "package"}}, error)
+      {{capitalize .Name}}({{if $hasArguments}}ctx context.Context, args *{{capitalize .Name}}Args{{end}}) ({{genType .Type "interface" .Name (or (and (hasSuffix .Type.GoType "Payload") "package") "objects")}}, error)
+    {{- end}}
+  }
+
+{{- end}}
+
+
+
+{{- if eq .Template "SUBSCRIPTION" }}
+
+  // {{capitalize .Name}} - {{.Description}}
+  type {{capitalize .Name}}Resolver interface {
+    {{- range .Fields}}
+      {{- $hasArguments := gt (.Args | len) 0}}
+
+      // {{capitalize .Name}} {{.Description}}
+      //
+      // The events are sent on the returned channel, which is closed when
+      // the subscription ends (ie, when ctx is done).
+      {{capitalize .Name}}(ctx context.Context{{if $hasArguments}}, args *{{capitalize .Name}}Args{{end}}) (<-chan {{genType .Type "interface" .Name "objects"}}, error)
     {{- end}}
   }
 
@@ -44,7 +63,7 @@
 {{- if eq .Template "ARGS" }}
 
   {{- range .Fields}}
-    // {{capitalize .Name}}Args are the arguments for the "{{capitalize .Name}}" query.
+    // {{capitalize .Name}}Args are the arguments for the "{{capitalize .Name}}" {{ lcfirst .Parent }}.
     type {{capitalize .Name}}Args struct{
       {{- range .Args}}
         {{capitalize .Name}} {{genType .Type "argStruct" .Name "package"}}
@@ -16,6 +16,11 @@
   // {{.Name}}Resolver resolver for {{.Name}}
   type {{.Name}}Resolver struct {
     {{.Name}}
+    {{- if .Model }}
+
+    db {{.Model.Package}}.XODB
+    m  *{{.Model.Package}}.{{.Model.Type.Name}}
+    {{- end }}
   }
   
   {{ range .Fields}}
@@ -30,6 +35,54 @@
   func (r *{{.Name}}Resolver) UnmarshalJSON(data []byte) error {
     return json.Unmarshal(data, &r.{{.Name}})
   }
+  {{- if .Model }}
+
+  // New{{.Name}}Resolver returns the resolver of the {{.Model.Package}}.{{.Model.Type.Name}} m, resolving
+  // its relations with db.
+  func New{{.Name}}Resolver(db {{.Model.Package}}.XODB, m *{{.Model.Package}}.{{.Model.Type.Name}}) *{{.Name}}Resolver {
+    return &{{.Name}}Resolver{
+      {{.Name}}: {{.Name}}{
+      {{- range .Model.Fields }}
+        {{ .Name }}: {{ .ToGQL "m" }},
+      {{- end }}
+      },
+      db: db,
+      m:  m,
+    }
+  }
+  {{- $pkg := .Model.Package }}
+  {{- range .Model.Relations }}
+
+  // {{ capitalize .Def.Name }} resolves the {{ .Def.Name }} field with {{ $pkg }}.{{ $.Model.Type.Name }}.{{ .Func }}.
+  func (r *{{ $TypeName }}Resolver) {{ capitalize .Def.Name }}(ctx context.Context) ({{ .Ret }}, error) {
+  {{- if .List }}
+    ms, err := r.m.{{ .Func }}({{ ctxarg }}r.db)
+    if err != nil {
+      return nil, err
+    }
+
+    res := make({{ trimPrefix .Ret "*" }}, len(ms))
+    for i, m := range ms {
+      res[i] = New{{ .RefType }}Resolver(r.db, m)
+    }
+
+    return {{ if hasPrefix .Ret "*" }}&{{ end }}res, nil
+  {{- else }}
+    m, err := r.m.{{ .Func }}({{ ctxarg }}r.db)
+    {{- if .Def.Type.IsNullable }}
+    if err == sql.ErrNoRows {
+      return nil, nil
+    }
+    {{- end }}
+    if err != nil {
+      return nil, err
+    }
+
+    return New{{ .RefType }}Resolver(r.db, m), nil
+  {{- end }}
+  }
+  {{- end }}
+  {{- end }}
 {{- end}}
 
 
@@ -44,3 +97,156 @@
   
 {{- end}}
 
+
+
+
+
+{{- if eq .Template "ENUM" }}
+{{- $type := .Name }}
+{{- $short := (shortname .Name "text" "v") }}
+// {{ .Name }} {{ .Description }}
+type {{ .Name }} string
+
+const (
+{{- range .Values }}
+	// {{ $type }}{{ .Name }} is the '{{ .Value }}' {{ $type }}. {{ .Description }}
+	{{- if .IsDeprecated }}
+	//
+	// Deprecated: {{ .DeprecationReason }}
+	{{- end }}
+	{{ $type }}{{ .Name }} {{ $type }} = "{{ .Value }}"
+{{- end }}
+)
+
+// IsValid returns true when {{ $short }} is a value of {{ $type }}.
+func ({{ $short }} {{ $type }}) IsValid() bool {
+	switch {{ $short }} {
+	case {{ range $i, $v := .Values }}{{ if $i }}, {{ end }}{{ $type }}{{ $v.Name }}{{ end }}:
+		return true
+	}
+
+	return false
+}
+
+// String returns the string value of the {{ $type }}.
+func ({{ $short }} {{ $type }}) String() string {
+	return string({{ $short }})
+}
+
+// UnmarshalText unmarshals {{ $type }} from text.
+func ({{ $short }} *{{ $type }}) UnmarshalText(text []byte) error {
+	v := {{ $type }}(text)
+	if !v.IsValid() {
+		return errors.New("invalid {{ $type }}")
+	}
+	*{{ $short }} = v
+
+	return nil
+}
+{{- end }}
+
+
+
+
+{{- if eq .Template "UNION" }}
+{{- $type := .Name }}
+// {{ .Name }} {{ .Description }}
+//
+// {{ .Name }} is implemented by the resolvers of the union's types.
+type {{ .Name }} interface {
+	is{{ .Name }}()
+}
+
+// {{ .Name }}Resolver resolver for {{ .Name }}
+type {{ .Name }}Resolver struct {
+	{{ .Name }}
+}
+{{ range .Types }}
+// To{{ . }} returns the {{ . }} resolver of the {{ $type }}, if it is a {{ . }}.
+func (r *{{ $type }}Resolver) To{{ . }}() (*{{ . }}Resolver, bool) {
+	res, ok := r.{{ $type }}.(*{{ . }}Resolver)
+	return res, ok
+}
+{{ end }}
+func (r *{{ .Name }}Resolver) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.{{ .Name }})
+}
+{{ range .Types }}
+// is{{ $type }} satisfies the {{ $type }} interface.
+func (*{{ . }}Resolver) is{{ $type }}() {}
+{{ end }}
+{{- end }}
+
+
+
+
+{{- if eq .Template "SCALAR" }}
+{{- $short := (shortname .Name "name" "input" "buf" "err" "v") }}
+// {{ .Name }} {{ .Description }}
+{{- if .GoType }}
+//
+// {{ .Name }} is the user supplied Go type of the scalar, which must implement
+// the graphql.Unmarshaler interface.
+type {{ .Name }} = {{ .GoType }}
+{{- else }}
+{{- if eq .Name "JSON" }}
+//
+// {{ .Name }} holds the JSON encoding of the scalar's values.
+type {{ .Name }} json.RawMessage
+{{- else }}
+//
+// {{ .Name }} holds the string value of the scalar's values.
+type {{ .Name }} string
+{{- end }}
+
+// ImplementsGraphQLType satisfies the graphql.Unmarshaler interface.
+func ({{ .Name }}) ImplementsGraphQLType(name string) bool {
+	return name == "{{ .Name }}"
+}
+{{- if eq .Name "JSON" }}
+
+// UnmarshalGraphQL satisfies the graphql.Unmarshaler interface, encoding the
+// input value as JSON.
+func ({{ $short }} *{{ .Name }}) UnmarshalGraphQL(input interface{}) error {
+	buf, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+	*{{ $short }} = buf
+
+	return nil
+}
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func ({{ $short }} {{ .Name }}) MarshalJSON() ([]byte, error) {
+	if {{ $short }} == nil {
+		return []byte("null"), nil
+	}
+
+	return {{ $short }}, nil
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func ({{ $short }} *{{ .Name }}) UnmarshalJSON(buf []byte) error {
+	*{{ $short }} = append((*{{ $short }})[:0], buf...)
+	return nil
+}
+{{- else }}
+
+// UnmarshalGraphQL satisfies the graphql.Unmarshaler interface, accepting
+// string and number values.
+func ({{ $short }} *{{ .Name }}) UnmarshalGraphQL(input interface{}) error {
+	switch v := input.(type) {
+	case string:
+		*{{ $short }} = {{ .Name }}(v)
+	case int32, int64, float64:
+		*{{ $short }} = {{ .Name }}(fmt.Sprint(v))
+	default:
+		return fmt.Errorf("invalid {{ .Name }} %v", input)
+	}
+
+	return nil
+}
+{{- end }}
+{{- end }}
+{{- end }}
@@ -0,0 +1,45 @@
+{{- $proc := (schema .Schema .Proc.ProcName) -}}
+{{- $sig := "" }}{{ $exec := "" }}{{ $args := "" }}{{ $out := false -}}
+{{- range $i, $p := .Params }}
+	{{- $sig = print $sig (or (and $i ", ") "") "@" .Param.ParamName " " .Param.ParamType }}
+	{{- $exec = print $exec (or (and $i ",") "") " @" .Param.ParamName " = @" .Param.ParamName }}
+	{{- if hasSuffix .Param.ParamMode "OUT" }}
+		{{- $sig = print $sig " OUTPUT" }}{{ $exec = print $exec " OUTPUT" }}{{ $out = true }}
+		{{- $args = print $args ", sql.Named(\"" .Param.ParamName "\", sql.Out{Dest: " .Name ", In: true})" }}
+	{{- else }}
+		{{- $args = print $args ", sql.Named(\"" .Param.ParamName "\", " .Name ")" }}
+	{{- end }}
+{{- end -}}
+// {{ .Name }} calls the stored procedure '{{ $proc }}({{ $sig }})' on db.
+{{- if $out }}
+//
+// The OUTPUT parameters are passed as pointers, and set to the values of the
+// parameters when the procedure returns.
+{{- end }}
+func {{ .Name }}({{ ctxparam }}db XODB{{ range .Params }}, {{ .Name }} {{ if hasSuffix .Param.ParamMode "OUT" }}*{{ end }}{{ retype .Type }}{{ end }}) error {
+	var err error
+
+	// sql query
+	const sqlstr = `EXEC {{ $proc }}{{ $exec }}`
+
+	// run query
+	XOLog(sqlstr{{ goparamlist .Params true false }})
+	_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr{{ $args }})
+	return err
+}
+
+// {{ .Name }}Rows calls the stored procedure '{{ $proc }}({{ $sig }})' on db,
+// returning the rows of its result sets (use rows.NextResultSet to advance to
+// the next result set).
+{{- if $out }}
+//
+// The OUTPUT parameters are set once the rows are closed.
+{{- end }}
+func {{ .Name }}Rows({{ ctxparam }}db XODB{{ range .Params }}, {{ .Name }} {{ if hasSuffix .Param.ParamMode "OUT" }}*{{ end }}{{ retype .Type }}{{ end }}) (*sql.Rows, error) {
+	// sql query
+	const sqlstr = `EXEC {{ $proc }}{{ $exec }}`
+
+	// run query
+	XOLog(sqlstr{{ goparamlist .Params true false }})
+	return db.{{ ctxfn "Query" }}({{ ctxarg }}sqlstr{{ $args }})
+}
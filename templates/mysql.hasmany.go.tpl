@@ -0,0 +1 @@
+postgres.hasmany.go.tpl
\ No newline at end of file
@@ -0,0 +1 @@
+postgres.json.go.tpl
\ No newline at end of file
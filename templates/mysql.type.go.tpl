@@ -1,5 +1,8 @@
 {{- $short := (shortname .Name "err" "res" "sqlstr" "db" "XOLog") -}}
 {{- $table := (schema .Schema .Table.TableName) -}}
+{{- $plural := (pluralname .Name) -}}
+{{- $bshort := (shortname .Name "err" "res" "sqlstr" "query" "db" "XOLog" "batch" "vals" "lim" "n" "i" "id" "ids" "q") -}}
+{{- $rows := (print $bshort "s") -}}
 {{- if .Comment -}}
 // {{ .Comment }}
 {{- else -}}
@@ -28,7 +31,7 @@ func ({{ $short }} *{{ .Name }}) Deleted() bool {
 }
 
 // Insert inserts the {{ .Name }} to the database.
-func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
+func ({{ $short }} *{{ .Name }}) Insert({{ ctxparam }}db XODB) error {
 	var err error
 
 	// if already exist, bail
@@ -47,7 +50,7 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 
 	// run query
 	XOLog(sqlstr, {{ fieldnames .Fields $short }})
-	_, err = db.Exec(sqlstr, {{ fieldnames .Fields $short }})
+	_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames .Fields $short }})
 	if err != nil {
 		return err
 	}
@@ -64,7 +67,7 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 
 	// run query
 	XOLog(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }})
-	res, err := db.Exec(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }})
+	res, err := db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }})
 	if err != nil {
 		return err
 	}
@@ -83,9 +86,94 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 	return nil
 }
 
-{{ if ne (fieldnames .Fields $short .PrimaryKey.Name) "" }}
+// Insert{{ $plural }} inserts the {{ .Name }}s to the database using multi-row
+// inserts, in batches of XOBatchSize rows.
+func Insert{{ $plural }}({{ ctxparam }}db XODB, {{ $rows }} []*{{ .Name }}) error {
+	// if already exist, bail
+	for _, {{ $bshort }} := range {{ $rows }} {
+		if {{ $bshort }}._exists {
+			return errors.New("insert failed: already exists")
+		}
+	}
+{{ $ncols := (len .Fields) }}
+{{- if .Table.ManualPk }}
+	// sql insert query, primary key must be provided
+	const sqlstr = `INSERT INTO {{ $table }} (` +
+		`{{ colnames .Fields }}` +
+		`) VALUES `
+{{- else }}
+{{- $ncols = (getstartcount .Fields .PrimaryKeyFields) }}
+	// sql insert query, primary key provided by autoincrement
+	const sqlstr = `INSERT INTO {{ $table }} (` +
+		`{{ colnames .Fields .PrimaryKey.Name }}` +
+		`) VALUES `
+{{- end }}
+
+	// limit rows per query to the max number of params
+	lim := XOBatchSize
+{{- if $ncols }}
+	if n := {{ maxparams }} / {{ $ncols }}; lim > n {
+		lim = n
+	}
+{{- end }}
+
+	for len({{ $rows }}) > 0 {
+		batch := {{ $rows }}
+		if len(batch) > lim {
+			batch = batch[:lim]
+		}
+
+		// load values
+		var vals []interface{}
+		for _, {{ $bshort }} := range batch {
+{{- if .Table.ManualPk }}
+			vals = append(vals, {{ fieldnames .Fields $bshort }})
+{{- else }}
+			vals = append(vals, {{ fieldnames .Fields $bshort .PrimaryKey.Name }})
+{{- end }}
+		}
+
+		// run query
+		query := sqlstr + xoValues("{{ mask }}", len(batch), {{ $ncols }})
+		XOLog(query, vals...)
+{{- if .Table.ManualPk }}
+		_, err := db.{{ ctxfn "Exec" }}({{ ctxarg }}query, vals...)
+		if err != nil {
+			return err
+		}
+{{- else }}
+		res, err := db.{{ ctxfn "Exec" }}({{ ctxarg }}query, vals...)
+		if err != nil {
+			return err
+		}
+
+		// retrieve id of the first row
+		id, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		// set primary keys, assuming the auto increment values of the rows
+		// are consecutive (MySQL has no RETURNING clause)
+		for i, {{ $bshort }} := range batch {
+			{{ $bshort }}.{{ .PrimaryKey.Name }} = {{ .PrimaryKey.Type }}(id + int64(i))
+		}
+{{- end }}
+
+		// set existence
+		for _, {{ $bshort }} := range batch {
+			{{ $bshort }}._exists = true
+		}
+
+		{{ $rows }} = {{ $rows }}[len(batch):]
+	}
+
+	return nil
+}
+
+{{ if ne (fieldnamesmulti .Fields $short .PrimaryKeyFields) "" }}
 	// Update updates the {{ .Name }} in the database.
-	func ({{ $short }} *{{ .Name }}) Update(db XODB) error {
+	func ({{ $short }} *{{ .Name }}) Update({{ ctxparam }}db XODB) error {
 		var err error
 
 		// if doesn't exist, bail
@@ -100,29 +188,29 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 
 		// sql query
 		const sqlstr = `UPDATE {{ $table }} SET ` +
-			`{{ colnamesquery .Fields ", " .PrimaryKey.Name }}` +
-			` WHERE {{ colname .PrimaryKey.Col }} = ?`
+			`{{ colnamesquerymulti .Fields ", " 0 .PrimaryKeyFields }}` +
+			` WHERE {{ colnamesquerymulti .PrimaryKeyFields " AND " (getstartcount .Fields .PrimaryKeyFields) nil }}`
 
 		// run query
-		XOLog(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, {{ $short }}.{{ .PrimaryKey.Name }})
-		_, err = db.Exec(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, {{ $short }}.{{ .PrimaryKey.Name }})
+		XOLog(sqlstr, {{ fieldnamesmulti .Fields $short .PrimaryKeyFields }}, {{ fieldnames .PrimaryKeyFields $short }})
+		_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnamesmulti .Fields $short .PrimaryKeyFields }}, {{ fieldnames .PrimaryKeyFields $short }})
 		return err
 	}
 
 	// Save saves the {{ .Name }} to the database.
-	func ({{ $short }} *{{ .Name }}) Save(db XODB) error {
+	func ({{ $short }} *{{ .Name }}) Save({{ ctxparam }}db XODB) error {
 		if {{ $short }}.Exists() {
-			return {{ $short }}.Update(db)
+			return {{ $short }}.Update({{ ctxarg }}db)
 		}
 
-		return {{ $short }}.Insert(db)
+		return {{ $short }}.Insert({{ ctxarg }}db)
 	}
 {{ else }}
 	// Update statements omitted due to lack of fields other than primary key
 {{ end }}
 
 // Delete deletes the {{ .Name }} from the database.
-func ({{ $short }} *{{ .Name }}) Delete(db XODB) error {
+func ({{ $short }} *{{ .Name }}) Delete({{ ctxparam }}db XODB) error {
 	var err error
 
 	// if doesn't exist, bail
@@ -136,11 +224,11 @@ func ({{ $short }} *{{ .Name }}) Delete(db XODB) error {
 	}
 
 	// sql query
-	const sqlstr = `DELETE FROM {{ $table }} WHERE {{ colname .PrimaryKey.Col }} = ?`
+	const sqlstr = `DELETE FROM {{ $table }} WHERE {{ colnamesquery .PrimaryKeyFields " AND " }}`
 
 	// run query
-	XOLog(sqlstr, {{ $short }}.{{ .PrimaryKey.Name }})
-	_, err = db.Exec(sqlstr, {{ $short }}.{{ .PrimaryKey.Name }})
+	XOLog(sqlstr, {{ fieldnames .PrimaryKeyFields $short }})
+	_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames .PrimaryKeyFields $short }})
 	if err != nil {
 		return err
 	}
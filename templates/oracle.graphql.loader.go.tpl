@@ -0,0 +1 @@
+postgres.graphql.loader.go.tpl
\ No newline at end of file
@@ -0,0 +1,42 @@
+{{- $notVoid := (ne .Proc.ReturnType "void") -}}
+{{- $proc := (schema .Schema .Proc.ProcName) -}}
+{{- $sig := "" }}{{ $call := "" }}{{ $args := "" }}{{ $out := false -}}
+{{- range $i, $p := .Params }}
+	{{- $sig = print $sig (or (and $i ", ") "") .Param.ParamName " " (replace .Param.ParamMode "INOUT" "IN OUT" 1) " " .Param.ParamType }}
+	{{- $call = print $call (or (and $i ", ") "") ":" .Name }}
+	{{- if eq .Param.ParamMode "OUT" }}
+		{{- $args = print $args ", sql.Out{Dest: " .Name "}" }}{{ $out = true }}
+	{{- else if eq .Param.ParamMode "INOUT" }}
+		{{- $args = print $args ", sql.Out{Dest: " .Name ", In: true}" }}{{ $out = true }}
+	{{- else }}
+		{{- $args = print $args ", " .Name }}
+	{{- end }}
+{{- end -}}
+// {{ .Name }} calls the stored {{ if $notVoid }}function '{{ $proc }}({{ $sig }}) {{ .Proc.ReturnType }}'{{ else }}procedure '{{ $proc }}({{ $sig }})'{{ end }} on db.
+{{- if $out }}
+//
+// The OUT and IN OUT parameters are passed as pointers, and set to the values
+// of the parameters when the {{ if $notVoid }}function{{ else }}procedure{{ end }} returns.
+{{- end }}
+func {{ .Name }}({{ ctxparam }}db XODB{{ range .Params }}, {{ .Name }} {{ if hasSuffix .Param.ParamMode "OUT" }}*{{ end }}{{ retype .Type }}{{ end }}) {{ if $notVoid }}({{ retype .Return.Type }}, error){{ else }}error{{ end }} {
+	var err error
+
+	// sql query
+	const sqlstr = `BEGIN {{ if $notVoid }}:ret := {{ end }}{{ $proc }}({{ $call }}); END;`
+
+	// run query
+{{- if $notVoid }}
+	var ret {{ retype .Return.Type }}
+	XOLog(sqlstr{{ goparamlist .Params true false }})
+	_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr, sql.Out{Dest: &ret}{{ $args }})
+	if err != nil {
+		return {{ reniltype .Return.NilType }}, err
+	}
+
+	return ret, nil
+{{- else }}
+	XOLog(sqlstr{{ goparamlist .Params true false }})
+	_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr{{ $args }})
+	return err
+{{- end }}
+}
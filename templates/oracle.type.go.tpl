@@ -28,7 +28,7 @@ func ({{ $short }} *{{ .Name }}) Deleted() bool {
 }
 
 // Insert inserts the {{ .Name }} to the database.
-func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
+func ({{ $short }} *{{ .Name }}) Insert({{ ctxparam }}db XODB) error {
 	var err error
 
 	// if already exist, bail
@@ -36,7 +36,25 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 		return errors.New("insert failed: already exists")
 	}
 
-	// sql query
+{{ if .Table.ManualPk }}
+	// sql insert query, primary key must be provided
+	const sqlstr = `INSERT INTO {{ $table }} (` +
+		`{{ colnames .Fields }}` +
+		`) VALUES (` +
+		`{{ colvals .Fields }}` +
+		`)`
+
+	// run query
+	XOLog(sqlstr, {{ fieldnames .Fields $short }})
+	_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames .Fields $short }})
+	if err != nil {
+		return err
+	}
+
+	// set existence
+	{{ $short }}._exists = true
+{{ else }}
+	// sql insert query, primary key provided by identity
 	const sqlstr = `INSERT INTO {{ $table }} (` +
 		`{{ colnames .Fields .PrimaryKey.Name }}` +
 		`) VALUES (` +
@@ -45,7 +63,7 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 
 	// run query
 	XOLog(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, nil)
-	res, err := db.Exec(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, nil)
+	res, err := db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, nil)
 	if err != nil {
 		return err
 	}
@@ -59,13 +77,14 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 	// set primary key and existence
 	{{ $short }}.{{ .PrimaryKey.Name }} = {{ .PrimaryKey.Type }}(id)
 	{{ $short }}._exists = true
+{{ end }}
 
 	return nil
 }
 
-{{ if ne (fieldnames .Fields $short .PrimaryKey.Name) "" }}
+{{ if ne (fieldnamesmulti .Fields $short .PrimaryKeyFields) "" }}
 	// Update updates the {{ .Name }} in the database.
-	func ({{ $short }} *{{ .Name }}) Update(db XODB) error {
+	func ({{ $short }} *{{ .Name }}) Update({{ ctxparam }}db XODB) error {
 		var err error
 
 		// if doesn't exist, bail
@@ -80,29 +99,29 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 
 		// sql query
 		const sqlstr = `UPDATE {{ $table }} SET ` +
-			`{{ colnamesquery .Fields ", " .PrimaryKey.Name }}` +
-			` WHERE {{ colname .PrimaryKey.Col }} = :{{ colcount .Fields .PrimaryKey.Name }}`
+			`{{ colnamesquerymulti .Fields ", " 0 .PrimaryKeyFields }}` +
+			` WHERE {{ colnamesquerymulti .PrimaryKeyFields " AND " (getstartcount .Fields .PrimaryKeyFields) nil }}`
 
 		// run query
-		XOLog(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, {{ $short }}.{{ .PrimaryKey.Name }})
-		_, err = db.Exec(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, {{ $short }}.{{ .PrimaryKey.Name }})
+		XOLog(sqlstr, {{ fieldnamesmulti .Fields $short .PrimaryKeyFields }}, {{ fieldnames .PrimaryKeyFields $short }})
+		_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnamesmulti .Fields $short .PrimaryKeyFields }}, {{ fieldnames .PrimaryKeyFields $short }})
 		return err
 	}
 
 	// Save saves the {{ .Name }} to the database.
-	func ({{ $short }} *{{ .Name }}) Save(db XODB) error {
+	func ({{ $short }} *{{ .Name }}) Save({{ ctxparam }}db XODB) error {
 		if {{ $short }}.Exists() {
-			return {{ $short }}.Update(db)
+			return {{ $short }}.Update({{ ctxarg }}db)
 		}
 
-		return {{ $short }}.Insert(db)
+		return {{ $short }}.Insert({{ ctxarg }}db)
 	}
 {{ else }}
 	// Update statements omitted due to lack of fields other than primary key
 {{ end }}
 
 // Delete deletes the {{ .Name }} from the database.
-func ({{ $short }} *{{ .Name }}) Delete(db XODB) error {
+func ({{ $short }} *{{ .Name }}) Delete({{ ctxparam }}db XODB) error {
 	var err error
 
 	// if doesn't exist, bail
@@ -116,11 +135,11 @@ func ({{ $short }} *{{ .Name }}) Delete(db XODB) error {
 	}
 
 	// sql query
-	const sqlstr = `DELETE FROM {{ $table }} WHERE {{ colname .PrimaryKey.Col }} = :1`
+	const sqlstr = `DELETE FROM {{ $table }} WHERE {{ colnamesquery .PrimaryKeyFields " AND " }}`
 
 	// run query
-	XOLog(sqlstr, {{ $short }}.{{ .PrimaryKey.Name }})
-	_, err = db.Exec(sqlstr, {{ $short }}.{{ .PrimaryKey.Name }})
+	XOLog(sqlstr, {{ fieldnames .PrimaryKeyFields $short }})
+	_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames .PrimaryKeyFields $short }})
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,51 @@
+{{- $type := .Name -}}
+{{- $short := (shortname $type "src" "fields" "err" "v") -}}
+// {{ $type }} represents the composite type '{{ schema .Schema .Composite.CompositeName }}'.
+type {{ $type }} struct {
+{{- range .Fields }}
+	{{ .Name }} {{ retype .Type }} // {{ .Col.ColumnName }}
+{{- end }}
+}
+
+// Scan satisfies the sql.Scanner interface for {{ $type }}, parsing the record
+// literal.
+func ({{ $short }} *{{ $type }}) Scan(src interface{}) error {
+	if src == nil {
+		*{{ $short }} = {{ $type }}{}
+		return nil
+	}
+
+	fields, err := parseRecord(src, "{{ $type }}")
+	if err != nil {
+		return err
+	}
+	if len(fields) != {{ len .Fields }} {
+		return errors.New("invalid {{ $type }}")
+	}
+
+	v := {{ $type }}{}
+{{- range $i, $f := .Fields }}
+	err = scanRecordField(&v.{{ .Name }}, fields[{{ $i }}])
+	if err != nil {
+		return err
+	}
+{{- end }}
+	*{{ $short }} = v
+
+	return nil
+}
+
+// Value satisfies the driver.Valuer interface for {{ $type }}, encoding the
+// value as a record literal.
+func ({{ $short }} {{ $type }}) Value() (driver.Value, error) {
+	var err error
+	fields := make([]*string, {{ len .Fields }})
+{{- range $i, $f := .Fields }}
+	fields[{{ $i }}], err = recordFieldValue({{ $short }}.{{ .Name }})
+	if err != nil {
+		return nil, err
+	}
+{{- end }}
+
+	return formatRecord(fields), nil
+}
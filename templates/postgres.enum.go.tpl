@@ -1,5 +1,5 @@
 {{- $type := .Name -}}
-{{- $short := (shortname $type "enumVal" "text" "buf" "ok" "src") -}}
+{{- $short := (shortname $type "enumVal" "text" "buf" "ok" "src" "err" "i" "str" "strs" "val" "vals") -}}
 {{- $reverseNames := .ReverseConstNames -}}
 // {{ $type }} is the '{{ .Enum.EnumName }}' enum type from schema '{{ .Schema  }}'.
 type {{ $type }} uint16
@@ -59,4 +59,43 @@ func ({{ $short }} *{{ $type }}) Scan(src interface{}) error {
 
 	return {{ $short }}.UnmarshalText(buf)
 }
+{{- if .Slice }}
 
+// {{ $type }}Slice is a slice of {{ $type }}.
+type {{ $type }}Slice []{{ $type }}
+
+// Scan satisfies the database/sql.Scanner interface for {{ $type }}Slice.
+func ({{ $short }}s *{{ $type }}Slice) Scan(src interface{}) error {
+	var strs StringSlice
+	if err := strs.Scan(src); err != nil {
+		return err
+	}
+	if strs == nil {
+		*{{ $short }}s = nil
+		return nil
+	}
+
+	vals := make({{ $type }}Slice, len(strs))
+	for i, str := range strs {
+		if err := vals[i].UnmarshalText([]byte(str)); err != nil {
+			return err
+		}
+	}
+	*{{ $short }}s = vals
+
+	return nil
+}
+
+// Value satisfies the sql/driver.Valuer interface for {{ $type }}Slice.
+func ({{ $short }}s {{ $type }}Slice) Value() (driver.Value, error) {
+	if {{ $short }}s == nil {
+		return nil, nil
+	}
+
+	strs := make(StringSlice, len({{ $short }}s))
+	for i, {{ $short }} := range {{ $short }}s {
+		strs[i] = {{ $short }}.String()
+	}
+	return strs.Value()
+}
+{{- end }}
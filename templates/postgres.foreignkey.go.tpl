@@ -1,8 +1,82 @@
 {{- $short := (shortname .Type.Name) -}}
+{{- $lshort := (shortname .Type.Name "err" "sqlstr" "query" "db" "q" "res" "keys" "key" "seen" "batch" "XOLog") -}}
+{{- $rshort := (shortname .RefType.Name "err" "sqlstr" "query" "db" "q" "res" "keys" "key" "seen" "batch" "XOLog" $lshort) -}}
+{{- $rows := (print $lshort "s") -}}
+{{- $keytype := (retype .RefField.Type) -}}
 // {{ .Name }} returns the {{ .RefType.Name }} associated with the {{ .Type.Name }}'s {{ .Field.Name }} ({{ .Field.Col.ColumnName }}).
 //
 // Generated from foreign key '{{ .ForeignKey.ForeignKeyName }}'.
-func ({{ $short }} *{{ .Type.Name }}) {{ .Name }}(db XODB) (*{{ .RefType.Name }}, error) {
-	return {{ .RefType.Name }}By{{ .RefField.Name }}(db, {{ convext $short .Field .RefField }})
+func ({{ $short }} *{{ .Type.Name }}) {{ .Name }}({{ ctxparam }}db XODB) (*{{ .RefType.Name }}, error) {
+	return {{ .RefType.Name }}By{{ .RefField.Name }}({{ ctxarg }}db, {{ convext $short .Field .RefField }})
+}
+
+// {{ .LoadName }} returns the {{ .RefType.Name }}s associated with the {{ .Type.Name }}s' {{ .Field.Name }} ({{ .Field.Col.ColumnName }}),
+// keyed by their {{ .RefField.Name }}, in a single query per batch of keys.
+//
+// Generated from foreign key '{{ .ForeignKey.ForeignKeyName }}'.
+func {{ .LoadName }}({{ ctxparam }}db XODB, {{ $rows }} []*{{ .Type.Name }}) (map[{{ $keytype }}]*{{ .RefType.Name }}, error) {
+	// collect keys
+	var keys []interface{}
+	seen := map[{{ $keytype }}]bool{}
+	for _, {{ $lshort }} := range {{ $rows }} {
+{{- if hasPrefix .Field.Type "sql.Null" }}
+		if !{{ $lshort }}.{{ .Field.Name }}.Valid {
+			continue
+		}
+{{- end }}
+		if key := {{ convext $lshort .Field .RefField }}; !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`{{ colnames .RefType.Fields }} ` +
+		`FROM {{ schema .Schema .RefType.Table.TableName }} ` +
+		`WHERE {{ colname .RefField.Col }} IN `
+
+	res := map[{{ $keytype }}]*{{ .RefType.Name }}{}
+	for len(keys) > 0 {
+		batch := keys
+		if len(batch) > {{ maxparams }} {
+			batch = batch[:{{ maxparams }}]
+		}
+
+		// run query
+		query := sqlstr + xoValues("{{ mask }}", 1, len(batch))
+		XOLog(query, batch...)
+		q, err := db.{{ ctxfn "Query" }}({{ ctxarg }}query, batch...)
+		if err != nil {
+			return nil, err
+		}
+
+		// load results
+		for q.Next() {
+			{{ $rshort }} := {{ .RefType.Name }}{
+			{{- if .RefType.PrimaryKey }}
+				_exists: true,
+			{{ end -}}
+			}
+
+			// scan
+			err = q.Scan({{ fieldnames .RefType.Fields (print "&" $rshort) }})
+			if err != nil {
+				q.Close()
+				return nil, err
+			}
+
+			res[{{ $rshort }}.{{ .RefField.Name }}] = &{{ $rshort }}
+		}
+		err = q.Err()
+		q.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = keys[len(batch):]
+	}
+
+	return res, nil
 }
 
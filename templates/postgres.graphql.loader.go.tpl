@@ -1 +1,205 @@
+{{- $pkg := .Package -}}
+{{- if eq .Template "LOADERS" }}
+// Loaders are the request scoped dataloaders, batching and caching the
+// lookups made with the same context.
+type Loaders struct {
+	db      {{ $pkg }}.XODB
+	mu      sync.Mutex
+	loaders map[string]*dataloader.Loader
+}
 
+// contextKey is the type of the context keys of this package.
+type contextKey int
+
+// loadersKey is the context key of the Loaders.
+const loadersKey contextKey = 0
+
+// ErrNoLoaders is the error returned when loading with a context that has no
+// Loaders.
+var ErrNoLoaders = errors.New("loaders: context has no loaders")
+
+// NewContext returns a copy of ctx with new Loaders using db. It should be
+// called once per request (ie, in a HTTP middleware), so that rows are not
+// cached across requests.
+func NewContext(ctx context.Context, db {{ $pkg }}.XODB) context.Context {
+	return context.WithValue(ctx, loadersKey, &Loaders{
+		db:      db,
+		loaders: map[string]*dataloader.Loader{},
+	})
+}
+
+// FromContext returns the Loaders of ctx.
+func FromContext(ctx context.Context) (*Loaders, bool) {
+	l, ok := ctx.Value(loadersKey).(*Loaders)
+	return l, ok
+}
+
+// load loads k with the named loader of the Loaders of ctx, creating the
+// loader with the batch func returned by fn when first used.
+func load(ctx context.Context, name string, fn func({{ $pkg }}.XODB) dataloader.BatchFunc, k key) (interface{}, error) {
+	l, ok := FromContext(ctx)
+	if !ok {
+		return nil, ErrNoLoaders
+	}
+
+	l.mu.Lock()
+	ld, ok := l.loaders[name]
+	if !ok {
+		ld = dataloader.NewBatchedLoader(fn(l.db))
+		l.loaders[name] = ld
+	}
+	l.mu.Unlock()
+
+	return ld.Load(ctx, k)()
+}
+
+// key is a dataloader key for raw, cached by the string representation of
+// id.
+type key struct {
+	id  interface{}
+	raw interface{}
+}
+
+// String satisfies the dataloader.Key interface.
+func (k key) String() string {
+	return fmt.Sprint(k.id)
+}
+
+// Raw satisfies the dataloader.Key interface.
+func (k key) Raw() interface{} {
+	return k.raw
+}
+{{- else }}
+{{- $type := .Type.Name -}}
+{{- $short := (shortname .Type.Name "ctx" "db" "err" "res" "rows" "keys" "k" "i" "m" "v" "ok" "id" "ids" $pkg) -}}
+{{- range .Indexes }}
+{{- $field := (index .Fields 0) }}
+{{- $keytype := (qualtype $pkg $field.Type) }}
+// {{ .FuncName }} loads the {{ $type }} by its {{ $field.Name }}, batching and caching the
+// lookups made with the same context.
+//
+// Generated from index '{{ .Index.IndexName }}'.
+func {{ .FuncName }}(ctx context.Context, id {{ $keytype }}) (*{{ $pkg }}.{{ $type }}, error) {
+	v, err := load(ctx, "{{ .FuncName }}", {{ lcfirst .FuncName }}Batch, key{id, id})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*{{ $pkg }}.{{ $type }}), nil
+}
+
+// {{ lcfirst .FuncName }}Batch returns the batch func of {{ .FuncName }}.
+func {{ lcfirst .FuncName }}Batch(db {{ $pkg }}.XODB) dataloader.BatchFunc {
+	return func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		ids := make([]{{ $keytype }}, len(keys))
+		for i, k := range keys {
+			ids[i] = k.Raw().({{ $keytype }})
+		}
+
+		// load rows
+		m, err := {{ $pkg }}.{{ .LoadName }}({{ ctxarg }}db, ids)
+
+		res := make([]*dataloader.Result, len(keys))
+		for i, id := range ids {
+			switch v, ok := m[id]; {
+			case err != nil:
+				res[i] = &dataloader.Result{Error: err}
+			case !ok:
+				res[i] = &dataloader.Result{Error: sql.ErrNoRows}
+			default:
+				res[i] = &dataloader.Result{Data: v}
+			}
+		}
+
+		return res
+	}
+}
+{{ end }}
+{{- range .ForeignKeys }}
+{{- $name := (print $type .Name) }}
+// {{ $name }} loads the {{ .RefType.Name }} associated with the {{ $type }}'s {{ .Field.Name }} ({{ .Field.Col.ColumnName }}),
+// batching and caching the lookups made with the same context.
+//
+// Generated from foreign key '{{ .ForeignKey.ForeignKeyName }}'.
+func {{ $name }}(ctx context.Context, {{ $short }} *{{ $pkg }}.{{ $type }}) (*{{ $pkg }}.{{ .RefType.Name }}, error) {
+{{- if hasPrefix .Field.Type "sql.Null" }}
+	if !{{ $short }}.{{ .Field.Name }}.Valid {
+		return nil, nil
+	}
+
+{{- end }}
+	v, err := load(ctx, "{{ $name }}", {{ lcfirst $name }}Batch, key{ {{- convext $short .Field .RefField }}, {{ $short -}} })
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*{{ $pkg }}.{{ .RefType.Name }}), nil
+}
+
+// {{ lcfirst $name }}Batch returns the batch func of {{ $name }}.
+func {{ lcfirst $name }}Batch(db {{ $pkg }}.XODB) dataloader.BatchFunc {
+	return func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		rows := make([]*{{ $pkg }}.{{ $type }}, len(keys))
+		for i, k := range keys {
+			rows[i] = k.Raw().(*{{ $pkg }}.{{ $type }})
+		}
+
+		// load rows
+		m, err := {{ $pkg }}.{{ .LoadName }}({{ ctxarg }}db, rows)
+
+		res := make([]*dataloader.Result, len(keys))
+		for i, {{ $short }} := range rows {
+			switch v, ok := m[{{ convext $short .Field .RefField }}]; {
+			case err != nil:
+				res[i] = &dataloader.Result{Error: err}
+			case !ok:
+				res[i] = &dataloader.Result{Error: sql.ErrNoRows}
+			default:
+				res[i] = &dataloader.Result{Data: v}
+			}
+		}
+
+		return res
+	}
+}
+{{ end }}
+{{- range .RevForeignKeys }}
+{{- $name := (print $type .RevName) }}
+// {{ $name }} loads the {{ .Type.Name }}s associated with the {{ $type }}'s {{ .RefField.Name }} ({{ .RefField.Col.ColumnName }}),
+// batching and caching the lookups made with the same context.
+//
+// Generated from foreign key '{{ .ForeignKey.ForeignKeyName }}'.
+func {{ $name }}(ctx context.Context, {{ $short }} *{{ $pkg }}.{{ $type }}) ([]*{{ $pkg }}.{{ .Type.Name }}, error) {
+	v, err := load(ctx, "{{ $name }}", {{ lcfirst $name }}Batch, key{ {{- $short }}.{{ .RefField.Name }}, {{ $short -}} })
+	if err != nil {
+		return nil, err
+	}
+
+	return v.([]*{{ $pkg }}.{{ .Type.Name }}), nil
+}
+
+// {{ lcfirst $name }}Batch returns the batch func of {{ $name }}.
+func {{ lcfirst $name }}Batch(db {{ $pkg }}.XODB) dataloader.BatchFunc {
+	return func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		rows := make([]*{{ $pkg }}.{{ $type }}, len(keys))
+		for i, k := range keys {
+			rows[i] = k.Raw().(*{{ $pkg }}.{{ $type }})
+		}
+
+		// load rows
+		m, err := {{ $pkg }}.{{ .RevLoadName }}({{ ctxarg }}db, rows)
+
+		res := make([]*dataloader.Result, len(keys))
+		for i, {{ $short }} := range rows {
+			if err != nil {
+				res[i] = &dataloader.Result{Error: err}
+				continue
+			}
+			res[i] = &dataloader.Result{Data: m[{{ $short }}.{{ .RefField.Name }}]}
+		}
+
+		return res
+	}
+}
+{{ end }}
+{{- end }}
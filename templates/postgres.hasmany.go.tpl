@@ -0,0 +1,114 @@
+{{- $short := (shortname .RefType.Name "err" "sqlstr" "db" "q" "res" "XOLog") -}}
+{{- $rshort := (shortname .Type.Name "err" "sqlstr" "db" "q" "res" "XOLog" $short) -}}
+{{- $table := (schema .Schema .Type.Table.TableName) -}}
+{{- $lshort := (shortname .RefType.Name "err" "sqlstr" "query" "db" "q" "res" "keys" "key" "seen" "batch" "XOLog") -}}
+{{- $cshort := (shortname .Type.Name "err" "sqlstr" "query" "db" "q" "res" "keys" "key" "seen" "batch" "XOLog" $lshort) -}}
+{{- $rows := (print $lshort "s") -}}
+{{- $keytype := (retype .RefField.Type) -}}
+// {{ .RevName }} returns the {{ .Type.Name }}s associated with the {{ .RefType.Name }}'s {{ .RefField.Name }} ({{ .RefField.Col.ColumnName }}).
+//
+// Generated from foreign key '{{ .ForeignKey.ForeignKeyName }}'.
+func ({{ $short }} *{{ .RefType.Name }}) {{ .RevName }}({{ ctxparam }}db XODB) ([]*{{ .Type.Name }}, error) {
+	var err error
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`{{ colnames .Type.Fields }} ` +
+		`FROM {{ $table }} ` +
+		`WHERE {{ colname .Field.Col }} = {{ nthparam 0 }}`
+
+	// run query
+	XOLog(sqlstr, {{ $short }}.{{ .RefField.Name }})
+	q, err := db.{{ ctxfn "Query" }}({{ ctxarg }}sqlstr, {{ $short }}.{{ .RefField.Name }})
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	// load results
+	res := []*{{ .Type.Name }}{}
+	for q.Next() {
+		{{ $rshort }} := {{ .Type.Name }}{
+		{{- if .Type.PrimaryKey }}
+			_exists: true,
+		{{ end -}}
+		}
+
+		// scan
+		err = q.Scan({{ fieldnames .Type.Fields (print "&" $rshort) }})
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &{{ $rshort }})
+	}
+
+	return res, nil
+}
+
+// {{ .RevLoadName }} returns the {{ .Type.Name }}s associated with the {{ .RefType.Name }}s' {{ .RefField.Name }} ({{ .RefField.Col.ColumnName }}),
+// keyed by {{ .RefField.Name }}, in a single query per batch of keys.
+//
+// Generated from foreign key '{{ .ForeignKey.ForeignKeyName }}'.
+func {{ .RevLoadName }}({{ ctxparam }}db XODB, {{ $rows }} []*{{ .RefType.Name }}) (map[{{ $keytype }}][]*{{ .Type.Name }}, error) {
+	// collect keys
+	var keys []interface{}
+	seen := map[{{ $keytype }}]bool{}
+	for _, {{ $lshort }} := range {{ $rows }} {
+		if key := {{ $lshort }}.{{ .RefField.Name }}; !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`{{ colnames .Type.Fields }} ` +
+		`FROM {{ $table }} ` +
+		`WHERE {{ colname .Field.Col }} IN `
+
+	res := map[{{ $keytype }}][]*{{ .Type.Name }}{}
+	for len(keys) > 0 {
+		batch := keys
+		if len(batch) > {{ maxparams }} {
+			batch = batch[:{{ maxparams }}]
+		}
+
+		// run query
+		query := sqlstr + xoValues("{{ mask }}", 1, len(batch))
+		XOLog(query, batch...)
+		q, err := db.{{ ctxfn "Query" }}({{ ctxarg }}query, batch...)
+		if err != nil {
+			return nil, err
+		}
+
+		// load results
+		for q.Next() {
+			{{ $cshort }} := {{ .Type.Name }}{
+			{{- if .Type.PrimaryKey }}
+				_exists: true,
+			{{ end -}}
+			}
+
+			// scan
+			err = q.Scan({{ fieldnames .Type.Fields (print "&" $cshort) }})
+			if err != nil {
+				q.Close()
+				return nil, err
+			}
+
+			key := {{ convext $cshort .Field .RefField }}
+			res[key] = append(res[key], &{{ $cshort }})
+		}
+		err = q.Err()
+		q.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = keys[len(batch):]
+	}
+
+	return res, nil
+}
+
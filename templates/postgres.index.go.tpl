@@ -1,9 +1,9 @@
-{{- $short := (shortname .Type.Name "err" "sqlstr" "db" "q" "res" "XOLog" .Fields) -}}
+{{- $short := (shortname .Type.Name "err" "sqlstr" "db" "q" "res" "XOLog" "query" "keys" "key" "batch" "vals" "params" "page" "after" "first" .Fields) -}}
 {{- $table := (schema .Schema .Type.Table.TableName) -}}
 // {{ .FuncName }} retrieves a row from '{{ $table }}' as a {{ .Type.Name }}.
 //
 // Generated from index '{{ .Index.IndexName }}'.
-func {{ .FuncName }}(db XODB{{ goparamlist .Fields true true }}) ({{ if not .Index.IsUnique }}[]{{ end }}*{{ .Type.Name }}, error) {
+func {{ .FuncName }}({{ ctxparam }}db XODB{{ goparamlist .Fields true true }}) ({{ if not .Index.IsUnique }}[]{{ end }}*{{ .Type.Name }}, error) {
 	var err error
 
 	// sql query
@@ -21,14 +21,14 @@ func {{ .FuncName }}(db XODB{{ goparamlist .Fields true true }}) ({{ if not .Ind
 	{{ end -}}
 	}
 
-	err = db.QueryRow(sqlstr{{ goparamlist .Fields true false }}).Scan({{ fieldnames .Type.Fields (print "&" $short) }})
+	err = db.{{ ctxfn "QueryRow" }}({{ ctxarg }}sqlstr{{ goparamlist .Fields true false }}).Scan({{ fieldnames .Type.Fields (print "&" $short) }})
 	if err != nil {
 		return nil, err
 	}
 
 	return &{{ $short }}, nil
 {{- else }}
-	q, err := db.Query(sqlstr{{ goparamlist .Fields true false }})
+	q, err := db.{{ ctxfn "Query" }}({{ ctxarg }}sqlstr{{ goparamlist .Fields true false }})
 	if err != nil {
 		return nil, err
 	}
@@ -55,4 +55,167 @@ func {{ .FuncName }}(db XODB{{ goparamlist .Fields true true }}) ({{ if not .Ind
 	return res, nil
 {{- end }}
 }
+{{- if .LoadName }}
+{{- $field := (index .Fields 0) }}
+{{- $keytype := (retype $field.Type) }}
 
+// {{ .LoadName }} retrieves the rows from '{{ $table }}' as {{ .Type.Name }}s,
+// keyed by {{ $field.Name }}, in a single query per batch of keys.
+//
+// Generated from index '{{ .Index.IndexName }}'.
+func {{ .LoadName }}({{ ctxparam }}db XODB, keys []{{ $keytype }}) (map[{{ $keytype }}]*{{ .Type.Name }}, error) {
+	// sql query
+	const sqlstr = `SELECT ` +
+		`{{ colnames .Type.Fields }} ` +
+		`FROM {{ $table }} ` +
+		`WHERE {{ colname $field.Col }} IN `
+
+	res := map[{{ $keytype }}]*{{ .Type.Name }}{}
+	for len(keys) > 0 {
+		batch := keys
+		if len(batch) > {{ maxparams }} {
+			batch = batch[:{{ maxparams }}]
+		}
+
+		// load values
+		vals := make([]interface{}, len(batch))
+		for i, key := range batch {
+			vals[i] = key
+		}
+
+		// run query
+		query := sqlstr + xoValues("{{ mask }}", 1, len(batch))
+		XOLog(query, vals...)
+		q, err := db.{{ ctxfn "Query" }}({{ ctxarg }}query, vals...)
+		if err != nil {
+			return nil, err
+		}
+
+		// load results
+		for q.Next() {
+			{{ $short }} := {{ .Type.Name }}{
+			{{- if .Type.PrimaryKey }}
+				_exists: true,
+			{{ end -}}
+			}
+
+			// scan
+			err = q.Scan({{ fieldnames .Type.Fields (print "&" $short) }})
+			if err != nil {
+				q.Close()
+				return nil, err
+			}
+
+			res[{{ $short }}.{{ $field.Name }}] = &{{ $short }}
+		}
+		err = q.Err()
+		q.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = keys[len(batch):]
+	}
+
+	return res, nil
+}
+{{- end }}
+{{- if .PageName }}
+{{- $pk := .Type.PrimaryKey }}
+{{- $limit := "LIMIT " }}
+{{- $rows := "" }}
+{{- if or (eq loadertype "mssql") (eq loadertype "oci8") (eq loadertype "ora") }}
+{{- $limit = "OFFSET 0 ROWS FETCH NEXT " }}
+{{- $rows = " ROWS ONLY" }}
+{{- end }}
+
+// {{ .PageName }} retrieves a page of at most first rows from '{{ $table }}' as
+// {{ .Type.Name }}s, ordered by {{ $pk.Name }}, following the row of the cursor after (or
+// from the first row when empty), along with the page's pagination
+// information.
+//
+// Generated from index '{{ .Index.IndexName }}'.
+func {{ .PageName }}({{ ctxparam }}db XODB{{ goparamlist .Fields true true }}, after Cursor, first int) ([]*{{ .Type.Name }}, *PageInfo, error) {
+	var err error
+
+	if first < 0 {
+		return nil, nil, errors.New("first cannot be negative")
+	}
+
+	// sql query
+	sqlstr := `SELECT ` +
+		`{{ colnames .Type.Fields }} ` +
+		`FROM {{ $table }} ` +
+		`WHERE {{ colnamesquery .Fields " AND " }}`
+	params := []interface{}{ {{- goparamlist .Fields false false -}} }
+
+	page := &PageInfo{}
+	if after != "" {
+		var key {{ $pk.Type }}
+		err = after.decode([]interface{}{ {{- goparamlist .Fields false false -}} }, &key)
+		if err != nil {
+			return nil, nil, err
+		}
+		sqlstr += ` AND {{ colname $pk.Col }} > {{ nthparam (len .Fields) }}`
+		params = append(params, key)
+
+		// sql query of the previous rows
+		const prevstr = `SELECT {{ colname $pk.Col }} ` +
+			`FROM {{ $table }} ` +
+			`WHERE {{ colnamesquery .Fields " AND " }} AND {{ colname $pk.Col }} <= {{ nthparam (len .Fields) }} ` +
+			`ORDER BY {{ colname $pk.Col }} {{ $limit }}1{{ $rows }}`
+
+		// check for previous rows
+		XOLog(prevstr, params...)
+		err = db.{{ ctxfn "QueryRow" }}({{ ctxarg }}prevstr, params...).Scan(&key)
+		switch {
+		case err == sql.ErrNoRows:
+		case err != nil:
+			return nil, nil, err
+		default:
+			page.HasPreviousPage = true
+		}
+	}
+
+	// retrieve an extra row, determining if there is a next page
+	sqlstr += ` ORDER BY {{ colname $pk.Col }} {{ $limit }}` + strconv.Itoa(first+1)
+	{{- if $rows }} + `{{ $rows }}`{{ end }}
+
+	// run query
+	XOLog(sqlstr, params...)
+	q, err := db.{{ ctxfn "Query" }}({{ ctxarg }}sqlstr, params...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer q.Close()
+
+	// load results
+	res := []*{{ .Type.Name }}{}
+	for q.Next() {
+		{{ $short }} := {{ .Type.Name }}{
+			_exists: true,
+		}
+
+		// scan
+		err = q.Scan({{ fieldnames .Type.Fields (print "&" $short) }})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		res = append(res, &{{ $short }})
+	}
+	if err = q.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if len(res) > first {
+		res = res[:first]
+		page.HasNextPage = true
+	}
+	for _, {{ $short }} := range res {
+		page.Cursors = append(page.Cursors, xoCursor({{ goparamlist .Fields false false }}, {{ $short }}.{{ $pk.Name }}))
+	}
+
+	return res, page, nil
+}
+{{- end }}
@@ -0,0 +1,26 @@
+{{- $type := .Name -}}
+{{- $short := (shortname $type "src" "buf") -}}
+// Scan satisfies the sql.Scanner interface for {{ $type }}, decoding the JSON
+// value.
+func ({{ $short }} *{{ $type }}) Scan(src interface{}) error {
+	var buf []byte
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		buf = v
+	case string:
+		buf = []byte(v)
+	default:
+		return errors.New("invalid {{ $type }}")
+	}
+
+	return json.Unmarshal(buf, {{ $short }})
+}
+
+// Value satisfies the driver.Valuer interface for {{ $type }}, encoding the
+// value as JSON.
+func ({{ $short }} {{ $type }}) Value() (driver.Value, error) {
+	return json.Marshal({{ $short }})
+}
+
@@ -0,0 +1,81 @@
+{{- $short := (shortname .Type.Name "err" "sqlstr" "db" "q" "res" "XOLog") -}}
+{{- $rshort := (shortname .RefType.Name "err" "sqlstr" "db" "q" "res" "XOLog" $short) -}}
+{{- $field := .ForeignKey.RefField -}}
+{{- $joinField := .ForeignKey.Field -}}
+{{- $refField := .RefForeignKey.RefField -}}
+{{- $refJoinField := .RefForeignKey.Field -}}
+{{- $table := (schema .Schema .RefType.Table.TableName) -}}
+{{- $joinTable := (schema .Schema .JoinType.Table.TableName) -}}
+// {{ .Name }} returns the {{ .RefType.Name }}s associated with the {{ .Type.Name }} through '{{ $joinTable }}'.
+//
+// Generated from foreign keys '{{ .ForeignKey.ForeignKey.ForeignKeyName }}' and '{{ .RefForeignKey.ForeignKey.ForeignKeyName }}'.
+func ({{ $short }} *{{ .Type.Name }}) {{ .Name }}({{ ctxparam }}db XODB) ([]*{{ .RefType.Name }}, error) {
+	var err error
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`{{ colprefixnames .RefType.Fields "r" }} ` +
+		`FROM {{ $table }} r ` +
+		`JOIN {{ $joinTable }} j ON j.{{ colname $refJoinField.Col }} = r.{{ colname $refField.Col }} ` +
+		`WHERE j.{{ colname $joinField.Col }} = {{ nthparam 0 }}`
+
+	// run query
+	XOLog(sqlstr, {{ $short }}.{{ $field.Name }})
+	q, err := db.{{ ctxfn "Query" }}({{ ctxarg }}sqlstr, {{ $short }}.{{ $field.Name }})
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	// load results
+	res := []*{{ .RefType.Name }}{}
+	for q.Next() {
+		{{ $rshort }} := {{ .RefType.Name }}{
+		{{- if .RefType.PrimaryKey }}
+			_exists: true,
+		{{ end -}}
+		}
+
+		// scan
+		err = q.Scan({{ fieldnames .RefType.Fields (print "&" $rshort) }})
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &{{ $rshort }})
+	}
+
+	return res, nil
+}
+
+// Add{{ .ItemName }} associates the {{ .RefType.Name }} with the {{ .Type.Name }} through '{{ $joinTable }}'.
+func ({{ $short }} *{{ .Type.Name }}) Add{{ .ItemName }}({{ ctxparam }}db XODB, {{ $rshort }} *{{ .RefType.Name }}) error {
+	var err error
+
+	// sql query
+	const sqlstr = `INSERT INTO {{ $joinTable }} (` +
+		`{{ colname $joinField.Col }}, {{ colname $refJoinField.Col }}` +
+		`) VALUES (` +
+		`{{ nthparam 0 }}, {{ nthparam 1 }}` +
+		`)`
+
+	// run query
+	XOLog(sqlstr, {{ $short }}.{{ $field.Name }}, {{ $rshort }}.{{ $refField.Name }})
+	_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr, {{ $short }}.{{ $field.Name }}, {{ $rshort }}.{{ $refField.Name }})
+	return err
+}
+
+// Remove{{ .ItemName }} removes the association of the {{ .RefType.Name }} with the {{ .Type.Name }} through '{{ $joinTable }}'.
+func ({{ $short }} *{{ .Type.Name }}) Remove{{ .ItemName }}({{ ctxparam }}db XODB, {{ $rshort }} *{{ .RefType.Name }}) error {
+	var err error
+
+	// sql query
+	const sqlstr = `DELETE FROM {{ $joinTable }} ` +
+		`WHERE {{ colname $joinField.Col }} = {{ nthparam 0 }} AND {{ colname $refJoinField.Col }} = {{ nthparam 1 }}`
+
+	// run query
+	XOLog(sqlstr, {{ $short }}.{{ $field.Name }}, {{ $rshort }}.{{ $refField.Name }})
+	_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr, {{ $short }}.{{ $field.Name }}, {{ $rshort }}.{{ $refField.Name }})
+	return err
+}
+
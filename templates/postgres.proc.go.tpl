@@ -1,28 +1,121 @@
 {{- $notVoid := (ne .Proc.ReturnType "void") -}}
 {{- $proc := (schema .Schema .Proc.ProcName) -}}
+{{- $set := .Proc.ReturnsSet -}}
+{{- $res := (print .Name (or (and $set "Row") "Result")) -}}
 {{- if ne .Proc.ReturnType "trigger" -}}
+{{- if .Outputs -}}
+{{- if $set -}}
+// {{ $res }} is a row returned by the stored procedure '{{ $proc }}', holding
+// its OUT columns.
+{{- else -}}
+// {{ $res }} is the result of the stored procedure '{{ $proc }}', holding
+// its OUT params.
+{{- end }}
+type {{ $res }} struct {
+{{- range .Outputs }}
+	{{ .Name }} {{ retype .Type }}{{ if .Param.ParamName }} // {{ .Param.ParamName }}{{ end }}
+{{- end }}
+}
+
+{{ end -}}
 // {{ .Name }} calls the stored procedure '{{ $proc }}({{ .ProcParams }}) {{ .Proc.ReturnType }}' on db.
-func {{ .Name }}(db XODB{{ goparamlist .Params true true }}) ({{ if $notVoid }}{{ retype .Return.Type }}, {{ end }}error) {
+{{- if $set }}
+//
+// The rows returned by the set returning function are returned as a slice.
+{{- end }}
+func {{ .Name }}({{ ctxparam }}db XODB{{ goparamlist .Inputs true true }}) {{ if and $set .Outputs }}([]*{{ $res }}, error){{ else if and $set .ReturnFields }}([]*{{ .Return.Type }}, error){{ else if $set }}([]{{ retype .Return.Type }}, error){{ else if .Outputs }}(*{{ $res }}, error){{ else if .ReturnFields }}(*{{ .Return.Type }}, error){{ else if $notVoid }}({{ retype .Return.Type }}, error){{ else }}error{{ end }} {
 	var err error
 
 	// sql query
-	const sqlstr = `SELECT {{ $proc }}({{ colvals .Params }})`
+	const sqlstr = `SELECT {{ if or .Outputs .ReturnFields }}* FROM {{ end }}{{ $proc }}({{ colvals .Inputs }})`
 
 	// run query
-{{- if $notVoid }}
+	XOLog(sqlstr{{ goparamlist .Inputs true false }})
+{{- if $set }}
+	q, err := db.{{ ctxfn "Query" }}({{ ctxarg }}sqlstr{{ goparamlist .Inputs true false }})
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	// load results
+{{- if .Outputs }}
+	res := []*{{ $res }}{}
+	for q.Next() {
+		r := {{ $res }}{}
+
+		// scan
+		err = q.Scan({{ fieldnames .Outputs "&r" }})
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &r)
+	}
+{{- else if .ReturnFields }}
+	res := []*{{ .Return.Type }}{}
+	for q.Next() {
+		r := {{ .Return.Type }}{
+		{{- if .ReturnTable }}{{ if .ReturnTable.PrimaryKey }}
+			_exists: true,
+		{{ end }}{{ end -}}
+		}
+
+		// scan
+		err = q.Scan({{ fieldnames .ReturnFields "&r" }})
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &r)
+	}
+{{- else }}
+	res := []{{ retype .Return.Type }}{}
+	for q.Next() {
+		var v {{ retype .Return.Type }}
+
+		// scan
+		err = q.Scan(&v)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, v)
+	}
+{{- end }}
+
+	return res, nil
+{{- else if .Outputs }}
+	var r {{ $res }}
+	err = db.{{ ctxfn "QueryRow" }}({{ ctxarg }}sqlstr{{ goparamlist .Inputs true false }}).Scan({{ fieldnames .Outputs "&r" }})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+{{- else if .ReturnFields }}
+	r := {{ .Return.Type }}{
+	{{- if .ReturnTable }}{{ if .ReturnTable.PrimaryKey }}
+		_exists: true,
+	{{ end }}{{ end -}}
+	}
+	err = db.{{ ctxfn "QueryRow" }}({{ ctxarg }}sqlstr{{ goparamlist .Inputs true false }}).Scan({{ fieldnames .ReturnFields "&r" }})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+{{- else if $notVoid }}
 	var ret {{ retype .Return.Type }}
-	XOLog(sqlstr{{ goparamlist .Params true false }})
-	err = db.QueryRow(sqlstr{{ goparamlist .Params true false }}).Scan(&ret)
+	err = db.{{ ctxfn "QueryRow" }}({{ ctxarg }}sqlstr{{ goparamlist .Inputs true false }}).Scan(&ret)
 	if err != nil {
 		return {{ reniltype .Return.NilType }}, err
 	}
 
 	return ret, nil
 {{- else }}
-	XOLog(sqlstr)
-	_, err = db.Exec(sqlstr)
+	_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr{{ goparamlist .Inputs true false }})
 	return err
 {{- end }}
 }
 {{- end }}
-
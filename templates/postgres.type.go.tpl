@@ -1,5 +1,8 @@
 {{- $short := (shortname .Name "err" "res" "sqlstr" "db" "XOLog") -}}
 {{- $table := (schema .Schema .Table.TableName) -}}
+{{- $plural := (pluralname .Name) -}}
+{{- $bshort := (shortname .Name "err" "sqlstr" "sqlconflict" "query" "db" "tx" "XOLog" "batch" "vals" "lim" "n" "i" "id" "ids" "q" "stmt") -}}
+{{- $rows := (print $bshort "s") -}}
 {{- if .Comment -}}
 // {{ .Comment }}
 {{- else -}}
@@ -28,7 +31,7 @@ func ({{ $short }} *{{ .Name }}) Deleted() bool {
 }
 
 // Insert inserts the {{ .Name }} to the database.
-func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
+func ({{ $short }} *{{ .Name }}) Insert({{ ctxparam }}db XODB) error {
 	var err error
 
 	// if already exist, bail
@@ -46,7 +49,7 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 
 	// run query
 	XOLog(sqlstr, {{ fieldnames .Fields $short }})
-	err = db.QueryRow(sqlstr, {{ fieldnames .Fields $short }}).Scan(&{{ $short }}.{{ .PrimaryKey.Name }})
+	_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames .Fields $short }})
 	if err != nil {
 		return err
 	}
@@ -60,7 +63,7 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 
 	// run query
 	XOLog(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }})
-	err = db.QueryRow(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}).Scan(&{{ $short }}.{{ .PrimaryKey.Name }})
+	err = db.{{ ctxfn "QueryRow" }}({{ ctxarg }}sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}).Scan(&{{ $short }}.{{ .PrimaryKey.Name }})
 	if err != nil {
 		return err
 	}
@@ -72,9 +75,215 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 	return nil
 }
 
-{{ if ne (fieldnames .Fields $short .PrimaryKey.Name) "" }}
+{{ if eq loadertype "postgres" }}
+// Insert{{ $plural }} inserts the {{ .Name }}s to the database using COPY, in
+// batches of XOBatchSize rows. When db is a *sql.DB, the rows are inserted in
+// a transaction.
+func Insert{{ $plural }}({{ ctxparam }}db XODB, {{ $rows }} []*{{ .Name }}) (err error) {
+	// if already exist, bail
+	for _, {{ $bshort }} := range {{ $rows }} {
+		if {{ $bshort }}._exists {
+			return errors.New("insert failed: already exists")
+		}
+	}
+
+	// COPY must be run in a transaction
+	tx, end, err := xoCopyTx({{ ctxarg }}db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = end(err)
+	}()
+{{ if not .Table.ManualPk }}
+	// sql query, primary keys provided by sequence
+	const seqstr = `SELECT nextval(pg_get_serial_sequence('{{ $table }}', '{{ .PrimaryKey.Col.ColumnName }}')) ` +
+		`FROM generate_series(1, $1)`
+
+	// run query
+	XOLog(seqstr, len({{ $rows }}))
+	q, err := tx.{{ ctxfn "Query" }}({{ ctxarg }}seqstr, len({{ $rows }}))
+	if err != nil {
+		return err
+	}
+	defer q.Close()
+
+	// load primary keys
+	var ids []{{ .PrimaryKey.Type }}
+	for q.Next() {
+		var id {{ .PrimaryKey.Type }}
+		err = q.Scan(&id)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+	err = q.Err()
+	if err != nil {
+		return err
+	}
+	if len(ids) != len({{ $rows }}) {
+		return errors.New("insert failed: could not retrieve primary keys")
+	}
+
+	// set primary keys
+	for i, {{ $bshort }} := range {{ $rows }} {
+		{{ $bshort }}.{{ .PrimaryKey.Name }} = ids[i]
+	}
+{{ end }}
+	// sql query
+	sqlstr := pq.CopyInSchema(`{{ .Schema }}`, `{{ .Table.TableName }}`,
+		{{- range $i, $f := .Fields }}{{ if $i }}, {{ end }}`{{ $f.Col.ColumnName }}`{{ end }})
+
+	for len({{ $rows }}) > 0 {
+		batch := {{ $rows }}
+		if len(batch) > XOBatchSize {
+			batch = batch[:XOBatchSize]
+		}
+
+		// prepare copy
+		stmt, err := tx.{{ ctxfn "Prepare" }}({{ ctxarg }}sqlstr)
+		if err != nil {
+			return err
+		}
+
+		// copy rows
+		for _, {{ $bshort }} := range batch {
+			XOLog(sqlstr, {{ fieldnames .Fields $bshort }})
+			_, err = stmt.{{ ctxfn "Exec" }}({{ ctxarg }}
+				{{- range $i, $f := .Fields }}{{ if $i }}, {{ end }}
+				{{- if eq $f.Col.DataType "json" "jsonb" }}xoCopyText({{ $bshort }}.{{ $f.Name }}){{ else }}{{ $bshort }}.{{ $f.Name }}{{ end }}
+				{{- end }})
+			if err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+
+		// flush rows
+		_, err = stmt.{{ ctxfn "Exec" }}({{ if ctxarg }}ctx{{ end }})
+		if err != nil {
+			stmt.Close()
+			return err
+		}
+		err = stmt.Close()
+		if err != nil {
+			return err
+		}
+
+		// set existence
+		for _, {{ $bshort }} := range batch {
+			{{ $bshort }}._exists = true
+		}
+
+		{{ $rows }} = {{ $rows }}[len(batch):]
+	}
+
+	return nil
+}
+{{ else }}
+// Insert{{ $plural }} inserts the {{ .Name }}s to the database using multi-row
+// inserts, in batches of XOBatchSize rows.
+func Insert{{ $plural }}({{ ctxparam }}db XODB, {{ $rows }} []*{{ .Name }}) error {
+	// if already exist, bail
+	for _, {{ $bshort }} := range {{ $rows }} {
+		if {{ $bshort }}._exists {
+			return errors.New("insert failed: already exists")
+		}
+	}
+{{ $ncols := (len .Fields) }}
+{{- if .Table.ManualPk }}
+	// sql insert query, primary key must be provided
+	const sqlstr = `INSERT INTO {{ $table }} (` +
+		`{{ colnames .Fields }}` +
+		`) VALUES `
+{{- else }}
+{{- $ncols = (getstartcount .Fields .PrimaryKeyFields) }}
+	// sql insert query, primary key provided by autoincrement
+	const sqlstr = `INSERT INTO {{ $table }} (` +
+		`{{ colnames .Fields .PrimaryKey.Name }}` +
+		`) VALUES `
+{{- end }}
+
+	// limit rows per query to the max number of params
+	lim := XOBatchSize
+{{- if $ncols }}
+	if n := {{ maxparams }} / {{ $ncols }}; lim > n {
+		lim = n
+	}
+{{- end }}
+
+	for len({{ $rows }}) > 0 {
+		batch := {{ $rows }}
+		if len(batch) > lim {
+			batch = batch[:lim]
+		}
+
+		// load values
+		var vals []interface{}
+		for _, {{ $bshort }} := range batch {
+{{- if .Table.ManualPk }}
+			vals = append(vals, {{ fieldnames .Fields $bshort }})
+{{- else }}
+			vals = append(vals, {{ fieldnames .Fields $bshort .PrimaryKey.Name }})
+{{- end }}
+		}
+
+		// run query
+		query := sqlstr + xoValues("{{ mask }}", len(batch), {{ $ncols }})
+		XOLog(query, vals...)
+{{- if .Table.ManualPk }}
+		_, err := db.{{ ctxfn "Exec" }}({{ ctxarg }}query, vals...)
+		if err != nil {
+			return err
+		}
+{{- else }}
+		q, err := db.{{ ctxfn "Query" }}({{ ctxarg }}query+` RETURNING {{ colname .PrimaryKey.Col }}`, vals...)
+		if err != nil {
+			return err
+		}
+
+		// load primary keys
+		var ids []int64
+		for q.Next() {
+			var id int64
+			err = q.Scan(&id)
+			if err != nil {
+				q.Close()
+				return err
+			}
+			ids = append(ids, id)
+		}
+		err = q.Err()
+		if err != nil {
+			return err
+		}
+		if len(ids) != len(batch) {
+			return errors.New("insert failed: could not retrieve primary keys")
+		}
+
+		// set primary keys, the order of the returned rows being undefined,
+		// but the keys of the rows being assigned in increasing order
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		for i, {{ $bshort }} := range batch {
+			{{ $bshort }}.{{ .PrimaryKey.Name }} = {{ .PrimaryKey.Type }}(ids[i])
+		}
+{{- end }}
+
+		// set existence
+		for _, {{ $bshort }} := range batch {
+			{{ $bshort }}._exists = true
+		}
+
+		{{ $rows }} = {{ $rows }}[len(batch):]
+	}
+
+	return nil
+}
+{{ end }}
+{{ if ne (fieldnamesmulti .Fields $short .PrimaryKeyFields) "" }}
 	// Update updates the {{ .Name }} in the database.
-	func ({{ $short }} *{{ .Name }}) Update(db XODB) error {
+	func ({{ $short }} *{{ .Name }}) Update({{ ctxparam }}db XODB) error {
 		var err error
 
 		// if doesn't exist, bail
@@ -89,30 +298,30 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 
 		// sql query
 		const sqlstr = `UPDATE {{ $table }} SET (` +
-			`{{ colnames .Fields .PrimaryKey.Name }}` +
+			`{{ colnamesmulti .Fields .PrimaryKeyFields }}` +
 			`) = ( ` +
-			`{{ colvals .Fields .PrimaryKey.Name }}` +
-			`) WHERE {{ colname .PrimaryKey.Col }} = ${{ colcount .Fields .PrimaryKey.Name }}`
+			`{{ colvalsmulti .Fields .PrimaryKeyFields }}` +
+			`) WHERE {{ colnamesquerymulti .PrimaryKeyFields " AND " (getstartcount .Fields .PrimaryKeyFields) nil }}`
 
 		// run query
-		XOLog(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, {{ $short }}.{{ .PrimaryKey.Name }})
-		_, err = db.Exec(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, {{ $short }}.{{ .PrimaryKey.Name }})
+		XOLog(sqlstr, {{ fieldnamesmulti .Fields $short .PrimaryKeyFields }}, {{ fieldnames .PrimaryKeyFields $short }})
+		_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnamesmulti .Fields $short .PrimaryKeyFields }}, {{ fieldnames .PrimaryKeyFields $short }})
 		return err
 	}
 
 	// Save saves the {{ .Name }} to the database.
-	func ({{ $short }} *{{ .Name }}) Save(db XODB) error {
+	func ({{ $short }} *{{ .Name }}) Save({{ ctxparam }}db XODB) error {
 		if {{ $short }}.Exists() {
-			return {{ $short }}.Update(db)
+			return {{ $short }}.Update({{ ctxarg }}db)
 		}
 
-		return {{ $short }}.Insert(db)
+		return {{ $short }}.Insert({{ ctxarg }}db)
 	}
 
 	// Upsert performs an upsert for {{ .Name }}.
 	//
 	// NOTE: PostgreSQL 9.5+ only
-	func ({{ $short }} *{{ .Name }}) Upsert(db XODB) error {
+	func ({{ $short }} *{{ .Name }}) Upsert({{ ctxparam }}db XODB) error {
 		var err error
 
 		// if already exist, bail
@@ -125,7 +334,7 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 			`{{ colnames .Fields }}` +
 			`) VALUES (` +
 			`{{ colvals .Fields }}` +
-			`) ON CONFLICT ({{ colname .PrimaryKey.Col }}) DO UPDATE SET (` +
+			`) ON CONFLICT ({{ colnames .PrimaryKeyFields }}) DO UPDATE SET (` +
 			`{{ colnames .Fields }}` +
 			`) = (` +
 			`{{ colprefixnames .Fields "EXCLUDED" }}` +
@@ -133,7 +342,7 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 
 		// run query
 		XOLog(sqlstr, {{ fieldnames .Fields $short }})
-		_, err = db.Exec(sqlstr, {{ fieldnames .Fields $short }})
+		_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames .Fields $short }})
 		if err != nil {
 			return err
 		}
@@ -143,12 +352,73 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 
 		return nil
 }
+
+	// Upsert{{ $plural }} performs an upsert for the {{ .Name }}s using multi-row
+	// inserts, in batches of XOBatchSize rows.
+	//
+	// NOTE: PostgreSQL 9.5+ only
+	func Upsert{{ $plural }}({{ ctxparam }}db XODB, {{ $rows }} []*{{ .Name }}) error {
+		var err error
+
+		// if already exist, bail
+		for _, {{ $bshort }} := range {{ $rows }} {
+			if {{ $bshort }}._exists {
+				return errors.New("insert failed: already exists")
+			}
+		}
+
+		// sql query
+		const sqlstr = `INSERT INTO {{ $table }} (` +
+			`{{ colnames .Fields }}` +
+			`) VALUES `
+		const sqlconflict = ` ON CONFLICT ({{ colnames .PrimaryKeyFields }}) DO UPDATE SET (` +
+			`{{ colnames .Fields }}` +
+			`) = (` +
+			`{{ colprefixnames .Fields "EXCLUDED" }}` +
+			`)`
+
+		// limit rows per query to the max number of params
+		lim := XOBatchSize
+		if n := {{ maxparams }} / {{ len .Fields }}; lim > n {
+			lim = n
+		}
+
+		for len({{ $rows }}) > 0 {
+			batch := {{ $rows }}
+			if len(batch) > lim {
+				batch = batch[:lim]
+			}
+
+			// load values
+			var vals []interface{}
+			for _, {{ $bshort }} := range batch {
+				vals = append(vals, {{ fieldnames .Fields $bshort }})
+			}
+
+			// run query
+			query := sqlstr + xoValues("{{ mask }}", len(batch), {{ len .Fields }}) + sqlconflict
+			XOLog(query, vals...)
+			_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}query, vals...)
+			if err != nil {
+				return err
+			}
+
+			// set existence
+			for _, {{ $bshort }} := range batch {
+				{{ $bshort }}._exists = true
+			}
+
+			{{ $rows }} = {{ $rows }}[len(batch):]
+		}
+
+		return nil
+	}
 {{ else }}
 	// Update statements omitted due to lack of fields other than primary key
 {{ end }}
 
 // Delete deletes the {{ .Name }} from the database.
-func ({{ $short }} *{{ .Name }}) Delete(db XODB) error {
+func ({{ $short }} *{{ .Name }}) Delete({{ ctxparam }}db XODB) error {
 	var err error
 
 	// if doesn't exist, bail
@@ -162,11 +432,11 @@ func ({{ $short }} *{{ .Name }}) Delete(db XODB) error {
 	}
 
 	// sql query
-	const sqlstr = `DELETE FROM {{ $table }} WHERE {{ colname .PrimaryKey.Col }} = $1`
+	const sqlstr = `DELETE FROM {{ $table }} WHERE {{ colnamesquery .PrimaryKeyFields " AND " }}`
 
 	// run query
-	XOLog(sqlstr, {{ $short }}.{{ .PrimaryKey.Name }})
-	_, err = db.Exec(sqlstr, {{ $short }}.{{ .PrimaryKey.Name }})
+	XOLog(sqlstr, {{ fieldnames .PrimaryKeyFields $short }})
+	_, err = db.{{ ctxfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames .PrimaryKeyFields $short }})
 	if err != nil {
 		return err
 	}
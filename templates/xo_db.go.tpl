@@ -3,14 +3,167 @@
 //
 // This should work with database/sql.DB and database/sql.Tx.
 type XODB interface {
+{{- if .UseContext }}
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+{{- else }}
 	Exec(string, ...interface{}) (sql.Result, error)
 	Query(string, ...interface{}) (*sql.Rows, error)
 	QueryRow(string, ...interface{}) *sql.Row
+{{- end }}
 }
 
 // XOLog provides the log func used by generated queries.
 var XOLog = func(string, ...interface{}) { }
 
+// XOBatchSize is the maximum number of rows inserted per query by the
+// generated batch insert funcs.
+var XOBatchSize = {{ .BatchSize }}
+{{- if eq .LoaderType "postgres" }}
+
+// xoCopyTx returns the transaction used by the generated batch insert funcs to
+// COPY rows, as COPY must be run in a transaction, beginning one when db is a
+// *sql.DB. The returned func ends a begun transaction, committing it, or
+// rolling it back when err is not nil.
+func xoCopyTx({{ if .UseContext }}ctx context.Context, {{ end }}db XODB) (*sql.Tx, func(error) error, error) {
+	switch v := db.(type) {
+	case *sql.Tx:
+		return v, func(err error) error { return err }, nil
+
+	case *sql.DB:
+{{- if .UseContext }}
+		tx, err := v.BeginTx(ctx, nil)
+{{- else }}
+		tx, err := v.Begin()
+{{- end }}
+		if err != nil {
+			return nil, nil, err
+		}
+		return tx, func(err error) error {
+			if err != nil {
+				tx.Rollback()
+				return err
+			}
+			return tx.Commit()
+		}, nil
+	}
+
+	return nil, nil, errors.New("insert failed: COPY requires a *sql.DB or *sql.Tx")
+}
+
+// xoCopyText returns the value v of a json column COPY'd by the generated
+// batch insert funcs, converting its []byte value (ie, a json.RawMessage or
+// the value of a driver.Valuer) to a string, as COPY encodes []byte values as
+// bytea. Values failing to convert are returned unchanged.
+func xoCopyText(v interface{}) interface{} {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return v
+	}
+
+	val := v
+	if vr, ok := v.(driver.Valuer); ok {
+		var err error
+		val, err = vr.Value()
+		if err != nil {
+			return v
+		}
+	}
+	if rv := reflect.ValueOf(val); rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+		return string(rv.Bytes())
+	}
+
+	return val
+}
+{{- end }}
+
+// xoValues returns the VALUES place holders for n rows of cols values, using
+// mask for each place holder (ie, "($1, $2), ($3, $4)" or "(?, ?), (?, ?)").
+func xoValues(mask string, n, cols int) string {
+	rows := make([]string, n)
+	vals := make([]string, cols)
+	for i := range rows {
+		for j := range vals {
+			vals[j] = mask
+			if strings.Contains(mask, "%") {
+				vals[j] = fmt.Sprintf(mask, i*cols+j+1)
+			}
+		}
+		rows[i] = "(" + strings.Join(vals, ", ") + ")"
+	}
+	return strings.Join(rows, ", ")
+}
+
+// Cursor is an opaque cursor of a row in the pages of rows retrieved by the
+// generated <Index>Page funcs, encoding the row's index and primary key
+// values.
+type Cursor string
+
+// ErrInvalidCursor is the error returned when paginating after an invalid
+// Cursor, or after the Cursor of another index or index values.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// xoCursor returns the Cursor of a row, encoding its key values vals.
+func xoCursor(vals ...interface{}) Cursor {
+	buf, _ := json.Marshal(vals)
+	return Cursor(base64.RawURLEncoding.EncodeToString(buf))
+}
+
+// decode decodes the last key value of the Cursor to dest, checking that the
+// preceding key values are vals.
+func (c Cursor) decode(vals []interface{}, dest interface{}) error {
+	buf, err := base64.RawURLEncoding.DecodeString(string(c))
+	if err != nil {
+		return ErrInvalidCursor
+	}
+
+	var keys []json.RawMessage
+	if err := json.Unmarshal(buf, &keys); err != nil || len(keys) != len(vals)+1 {
+		return ErrInvalidCursor
+	}
+	for i, v := range vals {
+		if buf, err := json.Marshal(v); err != nil || !bytes.Equal(buf, keys[i]) {
+			return ErrInvalidCursor
+		}
+	}
+	if err := json.Unmarshal(keys[len(vals)], dest); err != nil {
+		return ErrInvalidCursor
+	}
+
+	return nil
+}
+
+// PageInfo is the pagination information of a page of rows retrieved by a
+// generated <Index>Page func.
+type PageInfo struct {
+	// Cursors are the cursors of the page's rows.
+	Cursors []Cursor
+
+	// HasNextPage is true when rows follow the page.
+	HasNextPage bool
+
+	// HasPreviousPage is true when rows precede the page.
+	HasPreviousPage bool
+}
+
+// StartCursor returns the cursor of the first row of the page, if any.
+func (p *PageInfo) StartCursor() Cursor {
+	if len(p.Cursors) == 0 {
+		return ""
+	}
+
+	return p.Cursors[0]
+}
+
+// EndCursor returns the cursor of the last row of the page, if any.
+func (p *PageInfo) EndCursor() Cursor {
+	if len(p.Cursors) == 0 {
+		return ""
+	}
+
+	return p.Cursors[len(p.Cursors)-1]
+}
+
 // ScannerValuer is the common interface for types that implement both the
 // database/sql.Scanner and sql/driver.Valuer interfaces.
 type ScannerValuer interface {
@@ -18,41 +171,91 @@ type ScannerValuer interface {
 	driver.Valuer
 }
 
-// StringSlice is a slice of strings.
-type StringSlice []string
+// parseArray splits the text representation of a one-dimensional array into
+// its elements, returning nil for NULL elements.
+func parseArray(src interface{}, name string) ([]*string, error) {
+	var buf []byte
+	switch v := src.(type) {
+	case []byte:
+		buf = v
+	case string:
+		buf = []byte(v)
+	default:
+		return nil, errors.New("invalid " + name)
+	}
 
-// quoteEscapeRegex is the regex to match escaped characters in a string.
-var quoteEscapeRegex = regexp.MustCompile(`([^\\]([\\]{2})*)\\"`)
+	// remove braces
+	if len(buf) < 2 || buf[0] != '{' || buf[len(buf)-1] != '}' {
+		return nil, errors.New("invalid " + name)
+	}
+	buf = buf[1 : len(buf)-1]
 
-// Scan satisfies the sql.Scanner interface for StringSlice.
-func (ss *StringSlice) Scan(src interface{}) error {
-	buf, ok := src.([]byte)
-	if !ok {
-		return errors.New("invalid StringSlice")
+	elems := []*string{}
+	for i := 0; i < len(buf); i++ {
+		var elem []byte
+		quoted := buf[i] == '"'
+		if quoted {
+			// quoted element, with backslash escapes
+			for i++; i < len(buf) && buf[i] != '"'; i++ {
+				if buf[i] == '\\' && i+1 < len(buf) {
+					i++
+				}
+				elem = append(elem, buf[i])
+			}
+			if i == len(buf) {
+				return nil, errors.New("invalid " + name)
+			}
+			i++
+		} else {
+			for ; i < len(buf) && buf[i] != ','; i++ {
+				if buf[i] == '{' {
+					return nil, errors.New("invalid " + name + ": multidimensional arrays are not supported")
+				}
+				elem = append(elem, buf[i])
+			}
+		}
+
+		if i < len(buf) && buf[i] != ',' {
+			return nil, errors.New("invalid " + name)
+		}
+
+		// unquoted NULL is a NULL element
+		if !quoted && string(elem) == "NULL" {
+			elems = append(elems, nil)
+			continue
+		}
+
+		str := string(elem)
+		elems = append(elems, &str)
 	}
 
-	// change quote escapes for csv parser
-	str := quoteEscapeRegex.ReplaceAllString(string(buf), `$1""`)
-	str = strings.Replace(str, `\\`, `\`, -1)
+	return elems, nil
+}
 
-	// remove braces
-	str = str[1:len(str)-1]
+// StringSlice is a slice of strings, for arrays without NULL elements. A nil
+// StringSlice is stored as an empty array.
+type StringSlice []string
 
-	// bail if only one
-	if len(str) == 0 {
-		*ss = StringSlice([]string{})
+// Scan satisfies the sql.Scanner interface for StringSlice.
+func (ss *StringSlice) Scan(src interface{}) error {
+	if src == nil {
+		*ss = nil
 		return nil
 	}
 
-	// parse with csv reader
-	cr := csv.NewReader(strings.NewReader(str))
-	slice, err := cr.Read()
+	elems, err := parseArray(src, "StringSlice")
 	if err != nil {
-		fmt.Printf("exiting!: %v\n", err)
 		return err
 	}
 
-	*ss = StringSlice(slice)
+	v := make(StringSlice, len(elems))
+	for i, e := range elems {
+		if e == nil {
+			return errors.New("invalid StringSlice: NULL element")
+		}
+		v[i] = *e
+	}
+	*ss = v
 
 	return nil
 }
@@ -61,11 +264,917 @@ func (ss *StringSlice) Scan(src interface{}) error {
 func (ss StringSlice) Value() (driver.Value, error) {
 	v := make([]string, len(ss))
 	for i, s := range ss {
-		v[i] = `"` + strings.Replace(strings.Replace(s, `\`, `\\\`, -1), `"`, `\"`, -1) + `"`
+		v[i] = `"` + strings.Replace(strings.Replace(s, `\`, `\\`, -1), `"`, `\"`, -1) + `"`
+	}
+	return "{" + strings.Join(v, ",") + "}", nil
+}
+
+// Int64Slice is a slice of int64s, for arrays without NULL elements. A nil
+// Int64Slice is stored as an empty array.
+type Int64Slice []int64
+
+// Scan satisfies the sql.Scanner interface for Int64Slice.
+func (is *Int64Slice) Scan(src interface{}) error {
+	if src == nil {
+		*is = nil
+		return nil
+	}
+
+	elems, err := parseArray(src, "Int64Slice")
+	if err != nil {
+		return err
+	}
+
+	v := make(Int64Slice, len(elems))
+	for i, e := range elems {
+		if e == nil {
+			return errors.New("invalid Int64Slice: NULL element")
+		}
+		v[i], err = strconv.ParseInt(*e, 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+	*is = v
+
+	return nil
+}
+
+// Value satisfies the driver.Valuer interface for Int64Slice.
+func (is Int64Slice) Value() (driver.Value, error) {
+	v := make([]string, len(is))
+	for i, n := range is {
+		v[i] = strconv.FormatInt(n, 10)
+	}
+	return "{" + strings.Join(v, ",") + "}", nil
+}
+
+// Float64Slice is a slice of float64s, for arrays without NULL elements. A
+// nil Float64Slice is stored as an empty array.
+type Float64Slice []float64
+
+// Scan satisfies the sql.Scanner interface for Float64Slice.
+func (fs *Float64Slice) Scan(src interface{}) error {
+	if src == nil {
+		*fs = nil
+		return nil
+	}
+
+	elems, err := parseArray(src, "Float64Slice")
+	if err != nil {
+		return err
+	}
+
+	v := make(Float64Slice, len(elems))
+	for i, e := range elems {
+		if e == nil {
+			return errors.New("invalid Float64Slice: NULL element")
+		}
+		v[i], err = strconv.ParseFloat(*e, 64)
+		if err != nil {
+			return err
+		}
+	}
+	*fs = v
+
+	return nil
+}
+
+// Value satisfies the driver.Valuer interface for Float64Slice.
+func (fs Float64Slice) Value() (driver.Value, error) {
+	v := make([]string, len(fs))
+	for i, f := range fs {
+		v[i] = strconv.FormatFloat(f, 'g', -1, 64)
 	}
 	return "{" + strings.Join(v, ",") + "}", nil
 }
 
+// BoolSlice is a slice of bools, for arrays without NULL elements. A nil
+// BoolSlice is stored as an empty array.
+type BoolSlice []bool
+
+// Scan satisfies the sql.Scanner interface for BoolSlice.
+func (bs *BoolSlice) Scan(src interface{}) error {
+	if src == nil {
+		*bs = nil
+		return nil
+	}
+
+	elems, err := parseArray(src, "BoolSlice")
+	if err != nil {
+		return err
+	}
+
+	v := make(BoolSlice, len(elems))
+	for i, e := range elems {
+		if e == nil {
+			return errors.New("invalid BoolSlice: NULL element")
+		}
+		v[i], err = strconv.ParseBool(*e)
+		if err != nil {
+			return err
+		}
+	}
+	*bs = v
+
+	return nil
+}
+
+// Value satisfies the driver.Valuer interface for BoolSlice.
+func (bs BoolSlice) Value() (driver.Value, error) {
+	v := make([]string, len(bs))
+	for i, b := range bs {
+		v[i] = "f"
+		if b {
+			v[i] = "t"
+		}
+	}
+	return "{" + strings.Join(v, ",") + "}", nil
+}
+
+// NullStringSlice is a slice of sql.NullStrings, for nullable arrays that may
+// have NULL elements. A nil NullStringSlice is stored as NULL.
+type NullStringSlice []sql.NullString
+
+// Scan satisfies the sql.Scanner interface for NullStringSlice.
+func (nss *NullStringSlice) Scan(src interface{}) error {
+	if src == nil {
+		*nss = nil
+		return nil
+	}
+
+	elems, err := parseArray(src, "NullStringSlice")
+	if err != nil {
+		return err
+	}
+
+	v := make(NullStringSlice, len(elems))
+	for i, e := range elems {
+		if e == nil {
+			continue
+		}
+		v[i] = sql.NullString{String: *e, Valid: true}
+	}
+	*nss = v
+
+	return nil
+}
+
+// Value satisfies the driver.Valuer interface for NullStringSlice.
+func (nss NullStringSlice) Value() (driver.Value, error) {
+	if nss == nil {
+		return nil, nil
+	}
+
+	v := make([]string, len(nss))
+	for i, e := range nss {
+		v[i] = "NULL"
+		if e.Valid {
+			v[i] = `"` + strings.Replace(strings.Replace(e.String, `\`, `\\`, -1), `"`, `\"`, -1) + `"`
+		}
+	}
+	return "{" + strings.Join(v, ",") + "}", nil
+}
+
+// NullInt64Slice is a slice of sql.NullInt64s, for nullable arrays that may
+// have NULL elements. A nil NullInt64Slice is stored as NULL.
+type NullInt64Slice []sql.NullInt64
+
+// Scan satisfies the sql.Scanner interface for NullInt64Slice.
+func (nis *NullInt64Slice) Scan(src interface{}) error {
+	if src == nil {
+		*nis = nil
+		return nil
+	}
+
+	elems, err := parseArray(src, "NullInt64Slice")
+	if err != nil {
+		return err
+	}
+
+	v := make(NullInt64Slice, len(elems))
+	for i, e := range elems {
+		if e == nil {
+			continue
+		}
+		n, err := strconv.ParseInt(*e, 10, 64)
+		if err != nil {
+			return err
+		}
+		v[i] = sql.NullInt64{Int64: n, Valid: true}
+	}
+	*nis = v
+
+	return nil
+}
+
+// Value satisfies the driver.Valuer interface for NullInt64Slice.
+func (nis NullInt64Slice) Value() (driver.Value, error) {
+	if nis == nil {
+		return nil, nil
+	}
+
+	v := make([]string, len(nis))
+	for i, e := range nis {
+		v[i] = "NULL"
+		if e.Valid {
+			v[i] = strconv.FormatInt(e.Int64, 10)
+		}
+	}
+	return "{" + strings.Join(v, ",") + "}", nil
+}
+
+// NullFloat64Slice is a slice of sql.NullFloat64s, for nullable arrays that may
+// have NULL elements. A nil NullFloat64Slice is stored as NULL.
+type NullFloat64Slice []sql.NullFloat64
+
+// Scan satisfies the sql.Scanner interface for NullFloat64Slice.
+func (nfs *NullFloat64Slice) Scan(src interface{}) error {
+	if src == nil {
+		*nfs = nil
+		return nil
+	}
+
+	elems, err := parseArray(src, "NullFloat64Slice")
+	if err != nil {
+		return err
+	}
+
+	v := make(NullFloat64Slice, len(elems))
+	for i, e := range elems {
+		if e == nil {
+			continue
+		}
+		f, err := strconv.ParseFloat(*e, 64)
+		if err != nil {
+			return err
+		}
+		v[i] = sql.NullFloat64{Float64: f, Valid: true}
+	}
+	*nfs = v
+
+	return nil
+}
+
+// Value satisfies the driver.Valuer interface for NullFloat64Slice.
+func (nfs NullFloat64Slice) Value() (driver.Value, error) {
+	if nfs == nil {
+		return nil, nil
+	}
+
+	v := make([]string, len(nfs))
+	for i, e := range nfs {
+		v[i] = "NULL"
+		if e.Valid {
+			v[i] = strconv.FormatFloat(e.Float64, 'g', -1, 64)
+		}
+	}
+	return "{" + strings.Join(v, ",") + "}", nil
+}
+
+// NullBoolSlice is a slice of sql.NullBools, for nullable arrays that may have
+// NULL elements. A nil NullBoolSlice is stored as NULL.
+type NullBoolSlice []sql.NullBool
+
+// Scan satisfies the sql.Scanner interface for NullBoolSlice.
+func (nbs *NullBoolSlice) Scan(src interface{}) error {
+	if src == nil {
+		*nbs = nil
+		return nil
+	}
+
+	elems, err := parseArray(src, "NullBoolSlice")
+	if err != nil {
+		return err
+	}
+
+	v := make(NullBoolSlice, len(elems))
+	for i, e := range elems {
+		if e == nil {
+			continue
+		}
+		b, err := strconv.ParseBool(*e)
+		if err != nil {
+			return err
+		}
+		v[i] = sql.NullBool{Bool: b, Valid: true}
+	}
+	*nbs = v
+
+	return nil
+}
+
+// Value satisfies the driver.Valuer interface for NullBoolSlice.
+func (nbs NullBoolSlice) Value() (driver.Value, error) {
+	if nbs == nil {
+		return nil, nil
+	}
+
+	v := make([]string, len(nbs))
+	for i, e := range nbs {
+		v[i] = "NULL"
+		if e.Valid {
+			v[i] = "f"
+			if e.Bool {
+				v[i] = "t"
+			}
+		}
+	}
+	return "{" + strings.Join(v, ",") + "}", nil
+}
+{{- if eq .LoaderType "postgres" }}
+{{- if index .SliceTypes "UUIDSlice" }}
+
+// UUIDSlice is a slice of UUIDs, for arrays without NULL elements. A nil
+// UUIDSlice is stored as an empty array.
+type UUIDSlice []uuid.UUID
+
+// Scan satisfies the sql.Scanner interface for UUIDSlice.
+func (us *UUIDSlice) Scan(src interface{}) error {
+	if src == nil {
+		*us = nil
+		return nil
+	}
+
+	elems, err := parseArray(src, "UUIDSlice")
+	if err != nil {
+		return err
+	}
+
+	v := make(UUIDSlice, len(elems))
+	for i, e := range elems {
+		if e == nil {
+			return errors.New("invalid UUIDSlice: NULL element")
+		}
+		v[i], err = uuid.Parse(*e)
+		if err != nil {
+			return err
+		}
+	}
+	*us = v
+
+	return nil
+}
+
+// Value satisfies the driver.Valuer interface for UUIDSlice.
+func (us UUIDSlice) Value() (driver.Value, error) {
+	v := make([]string, len(us))
+	for i, u := range us {
+		v[i] = u.String()
+	}
+	return "{" + strings.Join(v, ",") + "}", nil
+}
+{{- end }}
+{{- if index .SliceTypes "NullUUIDSlice" }}
+
+// NullUUIDSlice is a slice of uuid.NullUUIDs, for nullable arrays that may have
+// NULL elements. A nil NullUUIDSlice is stored as NULL.
+type NullUUIDSlice []uuid.NullUUID
+
+// Scan satisfies the sql.Scanner interface for NullUUIDSlice.
+func (nus *NullUUIDSlice) Scan(src interface{}) error {
+	if src == nil {
+		*nus = nil
+		return nil
+	}
+
+	elems, err := parseArray(src, "NullUUIDSlice")
+	if err != nil {
+		return err
+	}
+
+	v := make(NullUUIDSlice, len(elems))
+	for i, e := range elems {
+		if e == nil {
+			continue
+		}
+		u, err := uuid.Parse(*e)
+		if err != nil {
+			return err
+		}
+		v[i] = uuid.NullUUID{UUID: u, Valid: true}
+	}
+	*nus = v
+
+	return nil
+}
+
+// Value satisfies the driver.Valuer interface for NullUUIDSlice.
+func (nus NullUUIDSlice) Value() (driver.Value, error) {
+	if nus == nil {
+		return nil, nil
+	}
+
+	v := make([]string, len(nus))
+	for i, e := range nus {
+		v[i] = "NULL"
+		if e.Valid {
+			v[i] = e.UUID.String()
+		}
+	}
+	return "{" + strings.Join(v, ",") + "}", nil
+}
+{{- end }}
+
+// splitRecord splits the fields of a record or range, returning nil for NULL
+// (empty and unquoted) fields.
+func splitRecord(buf []byte, name string) ([]*string, error) {
+	fields := []*string{}
+	for i := 0; i <= len(buf); i++ {
+		var field []byte
+		quoted, inQuotes := false, false
+		for ; i < len(buf) && (inQuotes || buf[i] != ','); i++ {
+			switch c := buf[i]; {
+			case c == '"' && inQuotes && i+1 < len(buf) && buf[i+1] == '"':
+				// doubled quote
+				i++
+				field = append(field, '"')
+			case c == '"':
+				quoted, inQuotes = true, !inQuotes
+			case c == '\\' && i+1 < len(buf):
+				i++
+				field = append(field, buf[i])
+			default:
+				field = append(field, c)
+			}
+		}
+		if inQuotes {
+			return nil, errors.New("invalid " + name)
+		}
+
+		if !quoted && len(field) == 0 {
+			fields = append(fields, nil)
+			continue
+		}
+
+		str := string(field)
+		fields = append(fields, &str)
+	}
+
+	return fields, nil
+}
+
+// quoteRecordField quotes a record or range field, returning the empty string
+// for NULL.
+func quoteRecordField(field *string) string {
+	if field == nil {
+		return ""
+	}
+
+	return `"` + strings.Replace(strings.Replace(*field, `\`, `\\`, -1), `"`, `\"`, -1) + `"`
+}
+
+// parseRecord splits the text representation of a composite type's record
+// literal into its fields, returning nil for NULL fields.
+func parseRecord(src interface{}, name string) ([]*string, error) {
+	var buf []byte
+	switch v := src.(type) {
+	case []byte:
+		buf = v
+	case string:
+		buf = []byte(v)
+	default:
+		return nil, errors.New("invalid " + name)
+	}
+
+	// remove parentheses
+	if len(buf) < 2 || buf[0] != '(' || buf[len(buf)-1] != ')' {
+		return nil, errors.New("invalid " + name)
+	}
+
+	return splitRecord(buf[1:len(buf)-1], name)
+}
+
+// formatRecord formats fields as a record literal.
+func formatRecord(fields []*string) string {
+	v := make([]string, len(fields))
+	for i, f := range fields {
+		v[i] = quoteRecordField(f)
+	}
+	return "(" + strings.Join(v, ",") + ")"
+}
+
+// timestampLayouts are the layouts of the text representations of dates and
+// timestamps.
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00:00",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// parseTimestamp parses the text representation of a date or timestamp.
+func parseTimestamp(s string) (time.Time, error) {
+	for _, layout := range timestampLayouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, errors.New("invalid timestamp " + strconv.Quote(s))
+}
+
+// scanRecordField scans the text representation of a record field into dest,
+// leaving dest unchanged (ie, the zero value) for NULL.
+func scanRecordField(dest interface{}, field *string) error {
+	if field == nil {
+		return nil
+	}
+
+	// allocate pointers (ie, nullable ranges)
+	if rv := reflect.ValueOf(dest); rv.Kind() == reflect.Ptr && rv.Elem().Kind() == reflect.Ptr {
+		rv.Elem().Set(reflect.New(rv.Elem().Type().Elem()))
+		return scanRecordField(rv.Elem().Interface(), field)
+	}
+
+	var err error
+	s := *field
+	switch d := dest.(type) {
+	case *time.Time:
+		*d, err = parseTimestamp(s)
+	case *pq.NullTime:
+		d.Time, err = parseTimestamp(s)
+		d.Valid = err == nil
+	case sql.Scanner:
+		err = d.Scan([]byte(s))
+	case *string:
+		*d = s
+	case *bool:
+		*d = s == "t"
+	case *int:
+		var n int64
+		n, err = strconv.ParseInt(s, 10, 0)
+		*d = int(n)
+	case *int16:
+		var n int64
+		n, err = strconv.ParseInt(s, 10, 16)
+		*d = int16(n)
+	case *int32:
+		var n int64
+		n, err = strconv.ParseInt(s, 10, 32)
+		*d = int32(n)
+	case *int64:
+		*d, err = strconv.ParseInt(s, 10, 64)
+	case *uint:
+		var n uint64
+		n, err = strconv.ParseUint(s, 10, 0)
+		*d = uint(n)
+	case *uint16:
+		var n uint64
+		n, err = strconv.ParseUint(s, 10, 16)
+		*d = uint16(n)
+	case *uint32:
+		var n uint64
+		n, err = strconv.ParseUint(s, 10, 32)
+		*d = uint32(n)
+	case *uint64:
+		*d, err = strconv.ParseUint(s, 10, 64)
+	case *float32:
+		var f float64
+		f, err = strconv.ParseFloat(s, 32)
+		*d = float32(f)
+	case *float64:
+		*d, err = strconv.ParseFloat(s, 64)
+	case *json.RawMessage:
+		*d = json.RawMessage(s)
+	case *[]byte:
+		// bytea, in hex format
+		if !strings.HasPrefix(s, `\x`) {
+			return errors.New("invalid bytea " + strconv.Quote(s))
+		}
+		*d, err = hex.DecodeString(s[2:])
+	default:
+		return fmt.Errorf("unsupported record field type %T", dest)
+	}
+
+	return err
+}
+
+// recordFieldValue returns the text representation of a record field, or nil
+// for NULL.
+func recordFieldValue(v interface{}) (*string, error) {
+	// bytea, in hex format
+	if b, ok := v.([]byte); ok {
+		if b == nil {
+			return nil, nil
+		}
+		s := `\x` + hex.EncodeToString(b)
+		return &s, nil
+	}
+
+	dv, err := driver.DefaultParameterConverter.ConvertValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var s string
+	switch x := dv.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		s = string(x)
+	case string:
+		s = x
+	case int64:
+		s = strconv.FormatInt(x, 10)
+	case float64:
+		s = strconv.FormatFloat(x, 'g', -1, 64)
+	case bool:
+		s = "f"
+		if x {
+			s = "t"
+		}
+	case time.Time:
+		s = x.Format(timestampLayouts[1])
+	default:
+		return nil, fmt.Errorf("unsupported record field type %T", v)
+	}
+
+	return &s, nil
+}
+
+// rangeBounds is the text representation of the bounds of a range.
+type rangeBounds struct {
+	lower, upper       *string
+	lowerInc, upperInc bool
+	empty              bool
+}
+
+// parseRange parses the text representation of a range into its bounds,
+// returning nil for an unbounded bound.
+func parseRange(src interface{}, name string) (*rangeBounds, error) {
+	var buf []byte
+	switch v := src.(type) {
+	case []byte:
+		buf = v
+	case string:
+		buf = []byte(v)
+	default:
+		return nil, errors.New("invalid " + name)
+	}
+
+	if string(buf) == "empty" {
+		return &rangeBounds{empty: true}, nil
+	}
+
+	// remove brackets
+	if len(buf) < 3 || (buf[0] != '[' && buf[0] != '(') || (buf[len(buf)-1] != ']' && buf[len(buf)-1] != ')') {
+		return nil, errors.New("invalid " + name)
+	}
+	bounds, err := splitRecord(buf[1:len(buf)-1], name)
+	if err != nil {
+		return nil, err
+	}
+	if len(bounds) != 2 {
+		return nil, errors.New("invalid " + name)
+	}
+
+	return &rangeBounds{
+		lower:    bounds[0],
+		upper:    bounds[1],
+		lowerInc: buf[0] == '[',
+		upperInc: buf[len(buf)-1] == ']',
+	}, nil
+}
+
+// String returns the text representation of the range.
+func (rb rangeBounds) String() string {
+	if rb.empty {
+		return "empty"
+	}
+
+	lb, ub := "(", ")"
+	if rb.lowerInc {
+		lb = "["
+	}
+	if rb.upperInc {
+		ub = "]"
+	}
+	return lb + quoteRecordField(rb.lower) + "," + quoteRecordField(rb.upper) + ub
+}
+
+// Int64Range is a int4range or int8range.
+type Int64Range struct {
+	Lower    *int64 // Lower is the lower bound, or nil if unbounded
+	Upper    *int64 // Upper is the upper bound, or nil if unbounded
+	LowerInc bool   // LowerInc is true if the lower bound is inclusive
+	UpperInc bool   // UpperInc is true if the upper bound is inclusive
+	Empty    bool   // Empty is true for the empty range
+}
+
+// Scan satisfies the sql.Scanner interface for Int64Range.
+func (r *Int64Range) Scan(src interface{}) error {
+	if src == nil {
+		*r = Int64Range{}
+		return nil
+	}
+
+	rb, err := parseRange(src, "Int64Range")
+	if err != nil {
+		return err
+	}
+
+	v := Int64Range{LowerInc: rb.lowerInc, UpperInc: rb.upperInc, Empty: rb.empty}
+	if rb.lower != nil {
+		n, err := strconv.ParseInt(*rb.lower, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.Lower = &n
+	}
+	if rb.upper != nil {
+		n, err := strconv.ParseInt(*rb.upper, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.Upper = &n
+	}
+	*r = v
+
+	return nil
+}
+
+// Value satisfies the driver.Valuer interface for Int64Range.
+func (r Int64Range) Value() (driver.Value, error) {
+	rb := rangeBounds{lowerInc: r.LowerInc, upperInc: r.UpperInc, empty: r.Empty}
+	if r.Lower != nil {
+		s := strconv.FormatInt(*r.Lower, 10)
+		rb.lower = &s
+	}
+	if r.Upper != nil {
+		s := strconv.FormatInt(*r.Upper, 10)
+		rb.upper = &s
+	}
+	return rb.String(), nil
+}
+
+// Float64Range is a numrange.
+type Float64Range struct {
+	Lower    *float64 // Lower is the lower bound, or nil if unbounded
+	Upper    *float64 // Upper is the upper bound, or nil if unbounded
+	LowerInc bool     // LowerInc is true if the lower bound is inclusive
+	UpperInc bool     // UpperInc is true if the upper bound is inclusive
+	Empty    bool     // Empty is true for the empty range
+}
+
+// Scan satisfies the sql.Scanner interface for Float64Range.
+func (r *Float64Range) Scan(src interface{}) error {
+	if src == nil {
+		*r = Float64Range{}
+		return nil
+	}
+
+	rb, err := parseRange(src, "Float64Range")
+	if err != nil {
+		return err
+	}
+
+	v := Float64Range{LowerInc: rb.lowerInc, UpperInc: rb.upperInc, Empty: rb.empty}
+	if rb.lower != nil {
+		f, err := strconv.ParseFloat(*rb.lower, 64)
+		if err != nil {
+			return err
+		}
+		v.Lower = &f
+	}
+	if rb.upper != nil {
+		f, err := strconv.ParseFloat(*rb.upper, 64)
+		if err != nil {
+			return err
+		}
+		v.Upper = &f
+	}
+	*r = v
+
+	return nil
+}
+
+// Value satisfies the driver.Valuer interface for Float64Range.
+func (r Float64Range) Value() (driver.Value, error) {
+	rb := rangeBounds{lowerInc: r.LowerInc, upperInc: r.UpperInc, empty: r.Empty}
+	if r.Lower != nil {
+		s := strconv.FormatFloat(*r.Lower, 'g', -1, 64)
+		rb.lower = &s
+	}
+	if r.Upper != nil {
+		s := strconv.FormatFloat(*r.Upper, 'g', -1, 64)
+		rb.upper = &s
+	}
+	return rb.String(), nil
+}
+
+// TimeRange is a tsrange, tstzrange or daterange.
+type TimeRange struct {
+	Lower    *time.Time // Lower is the lower bound, or nil if unbounded
+	Upper    *time.Time // Upper is the upper bound, or nil if unbounded
+	LowerInc bool       // LowerInc is true if the lower bound is inclusive
+	UpperInc bool       // UpperInc is true if the upper bound is inclusive
+	Empty    bool       // Empty is true for the empty range
+}
+
+// Scan satisfies the sql.Scanner interface for TimeRange.
+func (r *TimeRange) Scan(src interface{}) error {
+	if src == nil {
+		*r = TimeRange{}
+		return nil
+	}
+
+	rb, err := parseRange(src, "TimeRange")
+	if err != nil {
+		return err
+	}
+
+	v := TimeRange{LowerInc: rb.lowerInc, UpperInc: rb.upperInc, Empty: rb.empty}
+	if rb.lower != nil {
+		t, err := parseTimestamp(*rb.lower)
+		if err != nil {
+			return err
+		}
+		v.Lower = &t
+	}
+	if rb.upper != nil {
+		t, err := parseTimestamp(*rb.upper)
+		if err != nil {
+			return err
+		}
+		v.Upper = &t
+	}
+	*r = v
+
+	return nil
+}
+
+// Value satisfies the driver.Valuer interface for TimeRange.
+func (r TimeRange) Value() (driver.Value, error) {
+	rb := rangeBounds{lowerInc: r.LowerInc, upperInc: r.UpperInc, empty: r.Empty}
+	if r.Lower != nil {
+		s := r.Lower.Format(timestampLayouts[1])
+		rb.lower = &s
+	}
+	if r.Upper != nil {
+		s := r.Upper.Format(timestampLayouts[1])
+		rb.upper = &s
+	}
+	return rb.String(), nil
+}
+{{- end }}
+
 // Slice is a slice of ScannerValuers.
 type Slice []ScannerValuer
 
+// NullJSON is a nullable JSON value.
+type NullJSON struct {
+	JSON  json.RawMessage
+	Valid bool // Valid is true if JSON is not NULL
+}
+
+// Scan satisfies the sql.Scanner interface for NullJSON.
+func (nj *NullJSON) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		nj.JSON, nj.Valid = nil, false
+	case []byte:
+		nj.JSON, nj.Valid = append(json.RawMessage{}, v...), true
+	case string:
+		nj.JSON, nj.Valid = json.RawMessage(v), true
+	default:
+		return errors.New("invalid NullJSON")
+	}
+
+	return nil
+}
+
+// Value satisfies the driver.Valuer interface for NullJSON.
+func (nj NullJSON) Value() (driver.Value, error) {
+	if !nj.Valid {
+		return nil, nil
+	}
+
+	return []byte(nj.JSON), nil
+}
+
+// MarshalJSON satisfies the json.Marshaler interface for NullJSON, encoding
+// NULL as null.
+func (nj NullJSON) MarshalJSON() ([]byte, error) {
+	if !nj.Valid {
+		return []byte("null"), nil
+	}
+
+	return nj.JSON, nil
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface for NullJSON,
+// decoding null as NULL.
+func (nj *NullJSON) UnmarshalJSON(buf []byte) error {
+	if string(buf) == "null" {
+		nj.JSON, nj.Valid = nil, false
+		return nil
+	}
+
+	nj.JSON, nj.Valid = append(json.RawMessage{}, buf...), true
+
+	return nil
+}
+
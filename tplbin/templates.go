@@ -1,35 +1,56 @@
 // Code generated by go-bindata.
 // sources:
+// templates/graphql.model.go.tpl
 // templates/graphql.query.go.tpl
+// templates/graphql.schema.go.tpl
 // templates/graphql.type.go.tpl
 // templates/mssql.foreignkey.go.tpl
+// templates/mssql.graphql.loader.go.tpl
+// templates/mssql.hasmany.go.tpl
 // templates/mssql.index.go.tpl
+// templates/mssql.manytomany.go.tpl
+// templates/mssql.proc.go.tpl
 // templates/mssql.query.go.tpl
 // templates/mssql.querytype.go.tpl
 // templates/mssql.type.go.tpl
 // templates/mysql.enum.go.tpl
 // templates/mysql.foreignkey.go.tpl
+// templates/mysql.graphql.loader.go.tpl
+// templates/mysql.hasmany.go.tpl
 // templates/mysql.index.go.tpl
+// templates/mysql.json.go.tpl
+// templates/mysql.manytomany.go.tpl
 // templates/mysql.proc.go.tpl
 // templates/mysql.query.go.tpl
 // templates/mysql.querytype.go.tpl
 // templates/mysql.type.go.tpl
 // templates/oracle.foreignkey.go.tpl
+// templates/oracle.graphql.loader.go.tpl
+// templates/oracle.hasmany.go.tpl
 // templates/oracle.index.go.tpl
+// templates/oracle.manytomany.go.tpl
+// templates/oracle.proc.go.tpl
 // templates/oracle.query.go.tpl
 // templates/oracle.querytype.go.tpl
 // templates/oracle.type.go.tpl
+// templates/postgres.composite.go.tpl
 // templates/postgres.enum.go.tpl
 // templates/postgres.foreignkey.go.tpl
 // templates/postgres.graphql.bundle.go.tpl
 // templates/postgres.graphql.loader.go.tpl
+// templates/postgres.hasmany.go.tpl
 // templates/postgres.index.go.tpl
+// templates/postgres.json.go.tpl
+// templates/postgres.manytomany.go.tpl
 // templates/postgres.proc.go.tpl
 // templates/postgres.query.go.tpl
 // templates/postgres.querytype.go.tpl
 // templates/postgres.type.go.tpl
 // templates/sqlite3.foreignkey.go.tpl
+// templates/sqlite3.graphql.loader.go.tpl
+// templates/sqlite3.hasmany.go.tpl
 // templates/sqlite3.index.go.tpl
+// templates/sqlite3.manytomany.go.tpl
 // templates/sqlite3.query.go.tpl
 // templates/sqlite3.querytype.go.tpl
 // templates/sqlite3.type.go.tpl
@@ -102,7 +123,27 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _graphqlQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x96\xcb\x6e\xdb\x3a\x10\x86\xf7\x7a\x8a\x01\x71\x10\x48\x07\x09\xd3\x75\x80\x2e\x84\xa6\x0d\x0c\xa4\xce\xcd\x59\x64\x17\x46\x19\x2b\x4a\x19\x4a\x21\xa9\x36\x2e\xcb\x77\x2f\x28\x59\x94\x65\xd1\x6e\x8c\x16\x6d\x17\x5d\xd8\x96\xc5\xe1\x70\xfe\x6f\x2e\x52\x64\xcc\x01\x14\x73\xc0\x67\xa0\x33\x7c\xaa\x38\xd3\x08\xe4\xe2\xfa\xfd\xe5\x0d\x01\x6b\xa3\x08\xe0\xf0\x10\x8c\xc9\x58\x55\x68\xc6\x8b\xaf\x08\x74\xca\x9e\xd0\x5a\x38\x00\x63\xe8\x31\xaa\x4c\x16\x95\x2e\x4a\x61\x6d\x04\xa0\x17\x15\x06\xcd\x2f\x51\x95\xfc\x33\x4a\x28\x84\x46\x39\x67\x19\x82\x89\x00\x00\x5c\x00\x92\x89\x1c\x81\x7e\x28\x90\xdf\xab\xc6\x4f\xbb\x02\xff\x3d\x30\x95\xca\xbc\x7e\x42\xa1\x15\x1c\xbd\x85\x5c\x43\x4c\x53\x99\x2b\xf8\x06\x1c\x45\x02\x6f\xbc\xf9\xa6\x38\x03\x51\xb6\xde\xc7\xb6\xb1\x31\xc5\x7c\x78\xa8\xb5\x99\x7e\x81\xac\x14\x1a\x5f\x34\x7d\xd7\xfe\xee\x03\x73\x21\x84\x5c\xb8\xd8\x8c\x41\x71\x6f\x6d\x02\xb1\x31\x39\x8a\x99\x63\x42\x9b\x6f\xe2\xd5\x93\x76\x03\x90\xf2\xee\x11\x33\xad\x88\xb5\xfb\x80\x52\x96\x32\xf1\x58\x1a\x2f\x11\x80\xfb\x44\x9b\x49\x6d\x10\xde\x50\x62\x12\x41\x3f\xa0\x8b\x77\x09\x71\x5e\xca\xe6\x0e\x09\xed\x21\xf0\x5c\xa3\x5c\xd0\xc6\xef\xc6\x5c\x36\x9e\x95\x96\x75\xa6\x8d\xa7\xe9\x63\x73\xab\x9e\x72\x98\x33\x8c\xb8\x30\x99\x5f\x35\x0e\x3d\x97\x8a\x65\x9f\x58\x8e\x64\x25\x61\x3d\x91\x96\x89\xa7\xd2\xde\x8e\x56\x2e\xa3\x28\x5c\xd9\x1f\xaf\x67\xe9\x6c\x72\x36\xfd\x0b\x8a\xfb\x60\xa7\xea\xfe\x63\x45\xfe\xff\x2f\xa9\xf2\x3e\x9b\x5b\xaa\x7c\x98\xc0\x60\xfe\xd2\xcb\x93\xab\x2e\x77\xff\xfa\x61\x7b\x3f\x00\x0c\x80\x2e\xff\x8e\x90\x4e\xa6\xe7\xd7\xb3\x41\x3f\xbc\xa6\x09\x3a\x9b\x56\xf6\x2b\xca\xfd\x67\x55\x03\xdc\x3e\xaa\x52\x1c\x11\x7f\x36\xb9\x85\xa5\x20\x81\xb0\x1a\x27\x10\x27\x67\xa9\x65\x10\xff\x1a\xb4\x9d\xcb\xef\x3c\xbd\x39\x3d\x4b\x8f\x83\xb4\x7e\x33\x2b\x35\x04\xd5\x3f\x46\x82\xa0\x76\x9a\x1b\x81\x67\xcf\xaa\x52\x3f\xed\x64\x77\xe1\x7a\xc8\x2f\x8f\x74\x7b\xfb\x35\xfd\xbd\x7d\x07\x73\x8a\x5f\x7a\x5a\xeb\x4e\xe7\xb5\xc8\x06\x16\x71\xc5\x7b\x83\xc4\x0d\xaa\xf5\x03\xdb\x93\x24\xea\x5a\x0a\xd8\x1b\xad\x9b\x8a\x0f\x1e\xaf\xbb\x4c\x93\x30\xb9\x26\xc6\x58\xae\x0f\xcd\x73\x26\x51\xe8\xfe\xe0\x24\x3c\x98\x93\x71\x96\xb7\xbd\x2f\xc0\xea\xb0\xe9\x4a\x75\x51\x21\x3d\xb9\x38\x6d\x77\xe7\x92\x55\x0f\xcf\x9c\x4e\xda\x8a\xed\x46\xd0\x12\x88\x31\x6e\x57\xbb\x65\xa2\xa6\x35\xe7\xec\x8e\xa3\xeb\x9c\xbd\xbe\x04\x7a\x17\xb1\xa4\x61\x55\x34\x24\x26\xe9\x5f\xe2\x90\x2b\x0c\x1c\xbf\x93\xbb\x1f\xcd\xbc\xe0\x2b\xc0\xf7\x00\x00\x00\xff\xff\xf4\x5d\xd2\xcc\xdd\x0a\x00\x00"
+var _graphqlModelGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x58\xdb\x6e\xdb\x46\x10\x7d\x96\xbe\x62\x2b\x18\x06\x29\x28\x4c\xd1\x47\x17\x7e\x70\x2c\x39\x10\xda\xda\x8a\x7c\x41\xdb\x20\x28\x56\xe2\x52\x66\xcd\x8b\xbc\xbb\x8c\xad\x08\xfc\xf7\xce\xec\x85\x5c\x92\x52\x6c\xc7\x05\xfc\x62\x51\xdc\xd9\x99\x39\x73\x39\x33\xf2\x76\xfb\x8e\x1c\xac\xef\x56\xe4\xe8\x98\x04\x33\xba\xbc\xa3\x2b\x46\xde\x95\x65\x7f\x0b\x07\x71\x44\xd8\x3d\x09\xae\x58\xba\x4e\xa8\x64\x64\x30\x9f\x5c\x5e\xfc\x7e\x33\x99\x0f\x08\x48\xbc\x7f\x4f\xe6\x4c\xe4\xc9\x57\xc6\x09\xd7\x0f\x82\xc8\x5b\x46\x3e\x15\x8c\x6f\x08\xcd\x42\xf2\x47\x21\xa9\x8c\xf3\x8c\x44\x31\x4b\x42\x41\xf2\x48\x09\xc8\xcd\x1a\x44\x17\x60\x8d\x85\x64\xb1\xc1\x77\xa8\x2d\xa4\x92\x2e\xa8\x80\x73\xba\x48\x58\x25\x2d\x68\xca\x48\x06\x7f\x46\x4a\x67\x2c\x48\xca\x68\x26\x89\xcc\xc9\x82\x11\x96\x2e\x58\x18\x82\x9e\x38\x53\xd2\x3c\xcf\x25\x2a\x33\x1e\xf1\xa0\x8f\xd6\x6a\x4f\x85\xe4\xc5\x52\x92\x6d\xbf\x37\xfe\x40\xb6\x5b\x0d\xbe\x2c\x83\x3f\x2f\xc6\x1f\xfa\x65\x1f\xaf\x8e\x59\x44\x8b\x44\x9e\xc5\x5c\x48\x34\x87\x6a\xb3\x02\xec\x70\x74\x89\x85\xab\xda\xb7\x65\x9e\x65\x6c\x89\x10\x05\x58\x94\x05\xcf\xc0\x93\x87\x5b\x96\x59\x4c\x91\x52\x42\xf9\xaa\x48\x59\xa6\xb4\x65\xb9\x24\x82\xc9\xa0\xff\x95\xf2\xa6\xa9\x63\xf2\xcb\xcf\xca\x81\x33\x9e\xa7\x1f\x93\x7c\x41\x93\xe9\xd8\xa8\xd5\x5e\x54\x11\xba\x63\x1b\xeb\xc2\x4a\x09\x92\x38\xc4\x17\x14\xd4\x87\x4c\x1d\x01\x6a\xd4\x05\x9f\x01\x99\x2a\xcb\x85\x00\xe7\x20\x6a\x21\x5b\xa2\x10\x5e\x06\xfd\xd6\x37\xa1\xc3\x6b\xcd\x81\xbe\x22\x5b\xde\xd2\x6c\xa5\x72\xa4\xf2\xa3\x9d\x25\x5e\x0c\xa9\x00\x08\x24\x96\x2d\x75\x9c\x25\x74\x63\x3d\x9a\x8e\x85\xaf\x51\x36\xf0\x1c\x93\x08\x14\x7b\xe0\xd7\x08\x8d\x40\x3e\xe2\x6c\xe5\x9b\x4f\xcc\x8b\xf6\x00\xce\x4c\x3a\xc4\x92\x66\x70\x4f\x9b\x11\xd6\xed\x5d\x78\xf1\x8f\xf6\x48\x40\x7c\xd1\x8c\xb9\xdc\xb2\x36\x52\x12\x50\x31\x92\xf1\x88\x2e\xd9\xb6\xf4\x09\xe3\x3c\xe7\x68\x1e\x8a\x1e\x42\x91\xdf\x61\x47\x28\x45\xde\xd0\xf8\xf8\x2b\xbe\x05\x89\xde\x50\x00\x0a\x17\x94\xd5\xef\xc3\xa1\x71\x3f\x8b\x93\x7e\x0f\x00\xf4\xfe\x19\xa1\x6e\xd4\x16\xa5\x32\xb8\x44\x87\xbc\x9d\x77\xb5\x57\x7e\x15\x00\xb8\xd5\xd7\x7d\xc8\x12\x48\xb9\xe9\xc9\x03\x05\x14\xbb\x15\x6a\x27\x38\xc7\xde\xb0\xed\x7a\x90\x42\x2c\x12\x75\x76\x05\x42\xcd\x43\x8e\x99\x24\x81\xed\x03\x51\xe9\xc3\xce\xc2\x3b\xde\x92\xae\x63\x49\x93\xf8\x1b\xab\x75\xfb\x95\x58\x9c\xad\x0b\xa9\x74\x4f\xd5\x93\x7d\xff\x8d\xf1\x5c\xbd\xfe\x1b\x1f\x9a\xc4\xf1\x5b\x0c\x05\x35\xf8\x74\x3d\x99\xff\x65\x19\x03\x3b\x4e\x59\x2c\xcb\x26\x69\xc0\x41\x8d\x08\x0e\xef\x15\x89\x3c\xc4\xf2\xd6\xed\x52\x94\x9a\x66\x21\x7b\x0c\xce\x20\xbb\x46\xd6\xa4\xda\xe3\x64\x68\xe1\xf9\xae\x21\x6f\x29\x1f\xb1\x53\x25\x7b\x94\xc1\xa9\xfe\x1c\x61\xdd\x0b\x57\xea\x04\xbe\xfb\xc4\x43\x0b\x73\x86\xf8\x46\xba\x26\x40\x55\x9d\x84\x06\xb0\xd9\xc9\xc7\xc9\xff\x8e\x6b\x06\x04\xfc\xc6\xb8\x4e\xe7\x93\x93\xab\x97\x23\x4b\x0d\xd9\x43\x31\x67\x82\x71\x89\xed\x6c\xe4\x4c\x65\xbe\x0a\xd2\xf0\xa5\x98\x7e\xd8\x7d\x38\x69\xc4\xe3\x7a\x36\x36\xf1\x28\xd6\xc0\xc0\x80\x0b\x24\x8c\x09\x80\xc5\xec\x1b\x90\x05\x3d\x6f\x05\x59\x59\x77\x5b\x1d\x6f\x38\x0d\x19\x4c\x05\xd0\x26\x7c\xef\x21\x25\xa3\x9e\x1b\xf8\x04\x87\xf1\xf1\x0c\xe7\xb3\x62\x0d\x25\x81\xf0\x35\x67\x19\xfe\x1c\xa0\x27\x52\x1f\x0f\xb4\x77\x58\xb3\x86\x15\xca\x12\x75\x98\x40\x8e\xc8\x61\xad\x1c\x38\x13\x15\xfd\x74\x8c\x7c\xa8\xb8\xd3\x90\x1b\x88\x00\xdd\x6a\xf6\x18\xc0\xd9\xc0\x22\x42\xd2\xac\x82\xd9\x46\xb6\xaf\x4c\x7b\x29\xfa\x7a\xd8\x6c\xa8\x2a\x03\xdb\xf2\xa9\x06\xee\xe9\x31\x0d\x3a\xdc\x81\xac\xe2\xa0\xa0\xea\xf9\xec\xa0\x88\xcc\xc0\x86\x11\xe2\x0d\x6b\x11\x1f\xbd\x57\xf1\xa5\x11\xcc\x16\xb7\xc3\x4f\x0b\x2e\x72\x5e\xab\x3c\x51\x02\x8e\x4a\x7d\xe3\xb8\x7b\xc7\x18\x50\x17\x7c\x3d\x53\x52\x98\x50\x6b\x20\x8a\x6a\xb4\x3c\x45\x25\x58\x37\x50\x67\xa0\x08\xbe\xf0\x00\x56\x9d\x5e\xb7\x52\x6c\xe5\xdb\x4a\xa9\xd3\x58\xd7\x3b\xbe\xbb\xca\x61\x75\xf2\xd6\x30\x13\x25\x19\x28\xdf\x06\xa4\x9a\x16\x55\xee\xaa\x87\x91\x0e\xc6\x48\xef\x42\x7e\x55\x5d\xdd\xa2\x80\xaf\x4e\x0d\xd4\x6d\xdc\xd3\x7e\xe1\x7a\xe2\xb5\xe7\x0a\xf4\x04\x6e\x54\x06\xb2\xf9\x2b\xae\xb3\x18\x88\x16\xfd\x49\x45\xed\x7c\xea\xf8\xf4\xbd\xc0\x39\xb3\xe5\x2d\x03\xe7\xdb\x9a\xdf\x03\x7c\x27\x66\x4d\x6a\x6a\xfe\x4f\xc5\x79\x91\x24\xb8\x4b\xbb\x4d\x7d\x0c\x4d\x7d\x9f\x04\x13\xce\xcf\xf3\x79\xfe\x20\x3a\x09\x30\x9b\x8b\xd3\x7a\xfb\x33\xf6\x44\x1b\xef\x6f\xde\x7a\x29\xa8\xe8\x69\x17\x98\x12\xab\xdd\xd8\xca\x17\xff\xc2\xaa\x2d\x82\x73\xf6\xe0\xb0\x91\xe5\x54\x0f\x93\x33\x22\xa9\xaf\x01\xb8\xe5\x83\x1a\x04\x26\x3b\xa5\x77\x0c\x13\x0a\xeb\x5c\x3a\xe3\x2c\x8a\x1f\x35\x99\x0e\x86\xda\xef\x84\x65\x5e\x2a\x7c\x28\xd1\x08\x50\xc5\xa0\x0d\x6f\xe9\x5c\xa7\x36\x50\xe2\x73\xfc\x05\xda\xf4\x99\xde\xe8\x7e\xad\xa3\x05\x48\x6f\xa9\xe8\x1a\x3f\xac\xb2\x0e\x16\x1c\x08\x0e\x0d\xee\xa7\x2f\xb5\x88\xe9\xdf\x25\xb8\xc8\xb9\xf0\x26\xf8\x56\xf1\x3a\xd8\xf1\x75\x34\x2a\x49\x1b\x0f\x73\xf7\x99\x21\x50\xc2\x3a\x08\x6d\x40\x46\xd1\xe0\xf3\x97\x16\x28\x1b\x2d\xec\x02\x74\xc9\x09\xd5\x96\xa0\xfb\xf6\xed\x11\xd9\x21\x8a\x56\x7b\x9a\x0c\x8f\xcc\x0a\xef\x21\xfd\x19\x82\x44\x67\xfc\x11\xca\x9c\x03\xe3\x1f\x11\x62\xdb\x11\xbf\xde\xd0\x44\xd5\xd1\xb0\xe3\xcb\x53\x75\x04\x0a\xb1\xf6\x5f\x9a\x3f\xd7\xff\xd3\xea\xe7\x61\x17\x70\xe3\xac\x09\xbb\x71\x84\xe0\x55\x16\x8f\xba\xf6\x1b\xd9\x6d\x78\xa1\x12\x81\x10\x70\x08\x4c\xb3\x28\xdf\x71\xdb\x1e\xed\x54\xe0\xfa\x63\x05\xbb\x20\x9c\x93\x26\x04\xe7\x00\x01\x38\x9c\x89\x27\x67\xfa\xdf\x01\x18\x5e\x3c\x23\x81\xba\x5f\x39\x78\x59\x44\xca\x41\x98\xf1\x2a\xc1\xe8\x99\x9b\x76\x73\xc3\xf3\xfd\x9a\x5f\xdd\x83\x9a\xe6\x8d\x69\xcb\x63\x3d\xf5\x0a\xeb\xbc\xcd\x11\x1d\x82\xaa\x97\xbe\xd6\x3a\x62\x60\xa8\xba\x6a\xec\x57\x6d\xb2\xfd\xde\x92\xe4\x52\x69\x1a\xd4\x1b\x98\x3d\x56\xad\xd5\x9c\x17\x43\x33\x30\x8c\xba\xfa\xe7\x59\x7b\x58\x3e\x53\xdf\x3e\x75\x6d\xda\x79\x7a\xfb\x72\xf7\xc5\x14\x18\x1c\x97\xff\xce\xdc\x6c\x6f\x82\xbb\x29\xcd\x89\x7a\x53\xeb\x35\x6e\xde\xec\xf9\x5a\x3b\x0a\xc6\xb8\xa8\x3f\x53\x81\xad\x96\xba\xed\xf5\x84\x73\x66\x5b\x95\xf5\x19\xdd\x24\x39\x0d\x1b\x93\x4a\x17\x32\xae\x25\x5e\x97\x2c\xfc\x06\x19\x69\x12\xb2\x5a\x02\x67\xeb\x68\xbf\xdb\xea\xcd\xc3\xb1\xa9\x52\xac\x3d\x75\xe4\xed\x5b\xdb\x4f\xf6\xfd\x6b\x88\xd0\x8d\x4a\xd9\x9e\xb0\x68\xe3\x42\xa9\xfa\xd1\x20\xbc\x6a\xb2\x2b\x63\x92\x17\x6c\xf7\xd4\xd4\x8f\x7b\x7e\x53\xfc\x07\xb6\xd4\x97\xc5\xfe\x14\x00\x00"
+
+func graphqlModelGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_graphqlModelGoTpl,
+		"graphql.model.go.tpl",
+	)
+}
+
+func graphqlModelGoTpl() (*asset, error) {
+	bytes, err := graphqlModelGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "graphql.model.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _graphqlQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x57\xcb\x72\xda\x30\x14\xdd\xf3\x15\x77\x3c\x9d\x8c\xdd\x01\xd3\x75\xa6\x5d\xd0\xa4\xcd\x30\x93\x12\xc2\x63\x91\x5d\x84\xb9\xc6\x4e\x15\xd9\x91\xe4\x3c\xea\xfa\xdf\x2b\x59\x60\x03\x16\x29\xa4\x9d\xb4\x59\x00\x96\x75\x9f\x47\xe7\x1e\x9b\x56\x9e\x77\x20\x0e\x01\xef\xc0\x9f\xe0\x6d\x4a\x89\x44\x70\x2e\xa7\x5f\x46\x57\x0e\x14\x45\xab\x05\xd0\xed\x42\x9e\x07\x24\x8d\x25\xa1\xf1\x0f\x04\x7f\x40\x6e\xb1\x28\xa0\xa3\x6e\xfb\xa7\x28\x02\x1e\xa7\x32\x4e\x98\xb2\x06\x90\x4f\x29\x5a\xcd\x47\x28\x12\x7a\x8f\x1c\x62\x26\x91\x87\x24\x50\x66\xca\x1e\x40\x17\xc0\x09\x5b\x28\xcb\xaf\x31\xd2\xb9\x28\xe3\x98\x1d\x78\x17\x11\xd1\xe3\x8b\xec\x16\x99\x14\x70\xfc\x09\x16\x12\x5c\x5f\xdd\x11\xf0\x13\x28\x32\x0f\x3e\x54\xe6\xbb\xea\xb4\x54\x69\xa2\x37\x6d\xdd\x3c\x57\x58\x6c\x24\x2d\x8a\x40\x3e\x42\x90\xa8\xaa\x1f\xa5\x7f\x62\x7e\xdb\x40\x74\x09\xb6\x10\xba\xb6\x3c\x47\x36\x2f\x0a\x0f\x54\xbc\x05\xb2\x89\xc6\xc4\x2f\xbf\x9d\xaa\x7b\xc7\x38\x80\x93\xcc\x6e\x30\x90\xc2\x29\x8a\x36\x20\xe7\x09\xf7\x2a\x58\xca\x28\x6a\xa5\x3f\xad\xdd\x48\xed\x68\xbc\x44\x89\x70\x04\x19\xa1\xae\x77\x09\x62\x98\xf0\xf2\x8e\x63\xf3\x71\xe0\x2e\x43\xfe\xe4\x97\x71\x77\x9e\x65\x19\x59\x48\x9e\x05\x32\xaf\xd0\xac\x6a\xd3\xbb\x15\xca\x76\x9c\xa1\x81\x8b\xaa\x6f\x5c\x06\xac\x70\x49\x49\xf0\x9d\x2c\xd0\x59\x3b\xb0\x1a\x11\x83\x49\x85\x8a\xb9\xdd\x5a\xbb\x6c\x99\x55\x83\xd9\xdf\xa6\x93\xde\xa4\x7f\x31\xf8\x0f\xc8\xdd\x39\x88\xdd\xff\x8c\xe4\xef\xff\x06\xcb\x5d\x45\x3a\x97\xb0\x39\xb8\x2a\xed\x38\x0b\xc3\xf8\xd1\xd8\xfa\x67\x89\x71\x19\x92\x27\x9a\x90\xb9\xe3\xd5\x27\xef\xd5\xc3\xe1\x3d\x3b\x1d\x9b\x07\x6f\x3d\xf7\xf1\xf4\xf3\xf8\x64\xd4\x1f\xbe\xb1\xb3\x7f\xd9\xa9\x77\xbb\xb5\xdf\x44\x8d\x3a\xde\x97\x49\xb4\x16\x08\x75\x05\x09\x2b\x15\x80\xa3\xcc\x38\xc3\x39\x04\x11\x61\x0c\x69\x1b\x1e\xa2\x38\x88\x20\x16\x10\xd0\x44\xa8\x8d\x87\x08\x59\x1d\x4a\xfb\x88\x6c\x56\xe5\xd3\x90\x0b\x70\x63\x6c\x97\x86\xa0\x39\xa4\x7c\xe7\x09\x43\xcf\x7f\x8e\x80\x16\xb2\xd9\x38\xb9\x3f\x01\x3f\x76\x74\x0b\x4d\x55\x79\xa1\xda\xee\xc1\xa7\xde\xe8\x6c\xbc\xe2\xd1\xab\xe8\xb2\x7a\x18\xd2\x20\x8c\xb9\x90\xe0\x0f\x95\xb7\x3a\xc6\xa2\x78\x9b\x3a\xad\x96\xeb\x00\x2f\x97\x0d\x88\xfb\x83\xe1\x74\xb2\x31\xab\xfb\x0c\xe8\xca\xc6\xb4\xbd\xc7\x28\xfe\x69\xd7\x00\xd7\x37\x22\x61\xc7\x4e\x95\xdb\xb9\x86\x65\x43\x4c\x45\x58\xab\x13\x1c\xdd\xce\xb2\x97\x8d\xfa\xb7\x40\x3b\x98\x8e\xc3\xde\xd5\xf9\x45\xef\xd4\x8a\xd6\x2b\x63\x25\x36\x81\xaa\x07\xce\x0a\xd4\x41\xca\x66\x79\x27\x5a\xef\xb4\x52\x62\xbe\xba\xd0\x33\x55\x6d\x37\xfa\xae\xec\xb7\xfa\xaf\xed\x57\x60\x0e\xf0\xa1\x46\x6b\x3b\x68\x98\xb1\x60\xc3\xc2\x4d\x69\x6d\xe0\x69\xfd\xda\x4e\x68\x32\x19\xfd\x85\xa3\xc6\x7e\x9e\xd2\x8d\xd7\xbe\x43\xd4\xc5\x8e\x5c\x59\xa3\xcb\xb7\xb5\xd4\x08\x49\x9d\xd8\xb3\xeb\xb5\x77\x98\xb2\xc2\xba\xd8\xac\xa8\x5a\x3e\xeb\x2f\xcf\x8d\xf7\x82\x93\x34\xba\xa3\x7e\xdf\x30\x76\x25\x41\x4b\x40\x54\xc3\xca\xcb\xb8\xf4\xc5\x20\xa3\x94\xcc\x28\xea\xc9\x39\xaa\x29\x50\x87\x70\xb9\x6f\xef\xca\xb7\x35\xe3\xd5\x7f\x2e\x90\x0a\xb4\xa4\x3f\x28\xdc\xef\x34\xcf\xfa\x6a\xfa\x0b\x0f\xa1\x9e\xfb\x75\x0d\x00\x00"
 
 func graphqlQueryGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -122,7 +163,27 @@ func graphqlQueryGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _graphqlTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x6f\x6b\xdb\x30\x10\xc6\xdf\xfb\x53\x3c\x88\x31\xec\x91\x38\xef\x03\x7b\x31\x58\x06\x1b\x24\x1b\x99\x07\x2b\xa5\x10\xd5\x3e\xbb\x6a\x1d\xd9\x95\x95\x42\x2a\xf4\xdd\x8b\xe5\xbf\x8d\xd3\xd2\x42\x5f\x24\x48\xdc\x73\xf7\xbb\xe7\x4e\x36\x66\x8e\x4f\xd1\xb1\xa4\x0d\xdf\x13\x96\x5f\xe1\x87\xf5\x29\xc0\xdc\x5a\xcf\x05\x7f\x56\xeb\x22\xa1\xbc\x89\xb5\x97\x26\xec\x79\x4e\x21\x52\xd0\x3d\xc2\x88\xf6\x65\xce\x35\x81\x45\x17\x7f\x56\x0c\xd6\x7a\xc0\x62\x01\x63\x5c\x45\x6b\xeb\xd3\x77\xaa\x62\x25\x4a\x2d\x0a\xe9\xe2\xfa\x58\xd2\x48\x51\x69\x75\x88\x35\x8c\x07\x00\x75\x6d\xc5\x65\x46\x08\x7f\x08\xca\x93\xca\x65\x74\x11\x91\x42\x12\x5c\x22\x98\x14\x49\x0b\xec\x04\x31\x2f\x85\xe6\xb9\x78\x6c\x35\x0e\x9f\x91\xac\xad\x22\x74\xff\xac\xa1\xb1\xae\x48\xc9\xe3\x3b\x9e\x11\xb3\x16\xd8\xdd\x56\x85\x5c\xb2\xbe\x33\xb6\x6b\xac\x9c\x2d\x3b\x71\xd5\xf5\x48\x32\x69\xef\xe3\x5b\xfd\x3b\x19\xcd\x96\xaa\x22\x7f\x20\x05\xd5\x1d\xd2\x42\x0d\xe1\xc9\xa0\x7a\xfd\xc9\xc0\x06\x7d\x0b\x31\xe6\xec\x08\xdf\xe5\xc5\x18\x64\x24\x7b\x64\x38\x3c\x89\xd1\xc8\x1a\x56\x67\xd1\x03\xd2\x83\x8c\xe1\x2b\x7c\x99\x34\x1d\x60\xcd\x55\x75\xc3\xf3\x5f\x7f\x7f\x6f\xfc\x00\xfe\xe5\xd5\xf5\x51\xd3\x0c\xa4\x54\xa1\x82\xd6\x8c\x22\x7d\x50\x12\xf5\x1e\xc2\x56\xef\x7f\x56\x61\x5f\x2d\x18\x4c\xbe\x86\xfa\x27\xf7\x23\x58\xc2\x35\x47\x83\x0b\x1a\xdc\x19\x5a\x9f\xe2\xe4\x33\x4c\xa9\xc3\x36\x5f\xfc\x06\x56\xff\xa3\xed\x37\xf7\x26\x9f\x6d\x61\x95\xbe\x65\x0d\xf3\x8f\x5b\xc4\xa8\xd5\xa7\x00\x00\x00\xff\xff\xd4\x20\x16\x16\xeb\x03\x00\x00"
+var _graphqlSchemaGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\xc1\x6e\xdb\x30\x0c\xbd\xfb\x2b\x58\x24\x40\x57\x20\xf3\x07\x04\xd8\x61\x68\x5a\x20\x1b\x96\x62\x6d\xb0\x4b\xb1\x83\x12\xd3\x8e\x32\x5b\x4a\x65\x3b\x4d\x10\xf4\xdf\x27\x92\xb2\xe3\x38\x4d\x77\xda\xc1\x86\x24\x3e\xf1\x91\x8f\x22\x07\x70\x6b\x13\x84\x0c\x0d\x3a\x55\x61\x02\x8b\x3d\xec\x6c\x0c\x93\x07\x98\x3d\xcc\xe1\x6e\x32\x9d\xc7\xd1\xe1\xf0\x19\x9c\x32\x19\x42\xfc\xb4\x54\xb9\x72\x25\xbc\xbd\x45\x51\xc9\x6b\x38\x1c\x20\xa6\x3d\xa1\xd0\x24\xcd\x32\x5c\xb8\x33\x75\x21\xf0\x01\x23\x67\xaa\x40\xbf\x05\x5d\x42\xb5\x42\xb8\xa6\x33\xc2\xf0\x2f\x18\xaf\xbd\x9f\xba\x80\x6a\xbf\x41\x48\x9d\x2d\xa0\x5c\xae\xb0\x50\x02\x7e\x92\xb5\x47\xc5\x11\xc3\xba\x5e\x0f\x5d\xea\x5f\x2a\xaf\x91\xb9\x81\x40\xd9\x4b\x4e\xf8\x2d\x9d\xb2\x91\x29\x19\xd4\x0b\xff\x2c\x17\x9d\x7a\x0a\xd2\x49\xf2\xe0\xa5\x4f\x40\x19\xb0\x8b\x35\x2e\x2b\x78\xd5\xd5\x8a\xb6\x3a\x19\x71\x5a\x1b\xa7\x0b\xe5\xf6\xf0\x07\xf7\x60\x53\xd0\x55\x09\xce\xbe\xc6\x91\x36\x15\xba\x54\x2d\x51\x7c\x1c\x7c\x64\x3a\x19\xc3\x74\x72\x75\x4e\x1a\xb2\x98\x7b\x15\xca\xe6\x6c\x58\x56\x30\xfe\x22\x7a\x07\x41\xc9\xde\xe6\xef\x70\xe3\xb0\x44\xe3\xf9\x14\x31\x8a\x7c\xa4\x5b\x90\x50\xe0\x4f\xdd\xcd\x5c\x2d\xf2\xf0\x6f\xf4\x8f\x23\xd6\xbe\xef\xde\xef\x3b\x4a\x80\x2e\x36\x39\x16\x4c\x46\x47\xde\x2a\xd1\x87\x2a\x34\xd0\xe9\x44\x4a\xd0\x24\x7a\x39\xcd\xf8\x5e\x63\x9e\x9c\x54\xcc\x10\x73\xc3\x3f\xa6\xc3\xa1\xa0\x08\x4f\x72\xf0\xc5\x8f\x1e\xe0\xbd\x75\xa8\x33\xf3\x1d\xf7\xf2\x0c\x01\x06\x30\x5f\x49\x72\x8f\x98\x9e\xc8\xe7\x4b\x55\x05\x13\x93\xb4\x86\x4f\xc7\xa3\x5b\x9b\xd3\x57\x17\x26\x18\x6f\x46\xa2\x72\x2a\x44\x5c\x73\x7e\xa9\x47\xe6\xce\xf2\x28\x31\xa7\x48\x19\xb0\xdf\xd9\x59\xa2\xfd\xe8\x82\xfa\xc7\x28\x66\xb6\x9a\xd5\x79\xee\x4d\x57\xad\xf6\x17\x44\x78\xc4\xed\x47\x3a\x74\x69\xca\xae\x0a\x3e\x84\x77\x84\x68\x4f\xff\xab\x16\x3e\xe6\x56\x8e\xe7\x7e\x94\x57\xbf\x2f\x3d\xa4\x1f\xca\xec\xe7\x96\xfe\xff\x2a\x77\x09\x6b\xab\x8d\x9f\x79\xd5\xca\xd9\x3a\x5b\x9d\xf4\x49\xd3\x22\xdf\x3c\xe4\x72\x9b\x7c\x58\xc3\xe7\x77\x38\x7b\x71\xbf\x3b\x69\xa6\x26\xc1\x1d\x36\x43\xf3\x67\x8d\x7e\x90\x84\x71\xe9\xac\xad\xe0\x85\x4f\xa8\x43\x47\xbe\xe1\x2b\xa7\x71\xab\x4d\x46\xed\x5e\xd2\xf8\xf6\x38\xed\xa0\x36\xda\xe3\x40\x8b\xaf\xd0\xd0\xe2\xab\x3b\x24\x87\x7a\xe4\xbf\x1d\x0f\x95\x0e\x6f\x08\x65\xa8\x65\xd3\x44\x48\x62\x3e\x0a\x23\x96\xed\x23\x39\x69\x21\x2e\x3f\xb3\x4a\xe1\xd9\xa9\xfc\x7b\xb2\xb5\xdd\x7d\x5f\x9b\x65\xb0\xd1\xf3\x0a\x81\xad\x7d\x60\x29\xc7\xd5\x4e\x05\xe9\x80\xe1\xda\x2f\x47\xd0\x46\xd5\x71\x35\x4c\x7b\xa3\xe2\xab\xcb\x64\x50\xe8\x9d\x0c\x0a\xef\x92\x6f\xc8\xd5\x9b\xf1\x59\x02\xa7\xd5\x89\xc2\x73\xa0\x41\xcd\xb2\x8f\x45\xc3\xd3\xc2\xfd\x05\x00\x44\xf4\xf8\x45\x07\x00\x00"
+
+func graphqlSchemaGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_graphqlSchemaGoTpl,
+		"graphql.schema.go.tpl",
+	)
+}
+
+func graphqlSchemaGoTpl() (*asset, error) {
+	bytes, err := graphqlSchemaGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "graphql.schema.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _graphqlTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x58\x59\x6f\xdb\x46\x10\x7e\x96\x7e\xc5\x86\x50\x13\xd2\x50\x98\xa2\x2d\xfa\x20\x40\x0f\x69\xec\x06\x2e\x6c\x25\x91\x9d\x22\x45\x10\x20\x2b\x6a\x69\x6d\xcd\x2b\x5c\x4a\x4e\x2a\xe8\xbf\x77\x66\x2f\xee\x52\x94\x65\xb7\x09\x62\x87\xdc\x99\x9d\xe3\x9b\x93\xd9\x6e\x9f\x93\xd1\xf5\xb7\x8a\xcd\x68\xce\xc8\x64\x4a\xc2\x18\x9f\x22\xf2\x7c\xb7\x1b\x6e\x91\x78\x2e\x2e\xcb\x25\xcb\x14\x4d\xbf\x28\x32\xfc\x41\x0e\x9e\x12\xf6\x85\xc4\xd7\x2c\xaf\x32\xda\x30\x12\x5c\xff\xf5\xf6\x2c\x20\xc0\x40\xc8\x8b\x17\x64\xbb\x95\x12\x77\x3b\x7c\x3a\x65\x22\xa9\x79\xd5\xf0\xb2\x90\xf4\x06\x34\x3b\x1c\xa2\xa9\xd7\x49\x43\xb6\x40\x21\x04\x65\xd7\xb4\xb8\x61\x24\xfe\x9d\xb3\x6c\x29\xe4\x0d\x43\x01\xad\x05\x50\xa4\xd9\x41\xc1\x97\x5a\xa1\x61\x48\x68\xc5\x1b\x9a\xf1\x7f\x34\x8f\x54\x7f\xc3\x0a\x74\x15\x6c\xc5\xdf\x81\xd2\x16\x18\x21\x15\x4d\x6e\xe9\x0d\x0b\x80\x95\x7c\xfe\x5b\x94\xc5\x24\xb0\x96\x05\x9f\x95\x2b\xbd\x62\xf7\xbc\x32\x36\xb2\x62\xa9\xdf\xdd\x37\xfc\xe9\x40\x33\x67\xa2\xcc\x36\xac\x26\xb5\x79\x48\xcb\xba\x25\xef\x01\x65\xf9\x3b\x80\xb5\xfc\x16\xa4\x58\x45\x0f\xc3\x85\xa7\xcb\x05\xb2\xc9\xb3\xf8\xad\x72\x78\xb7\x8b\x3f\xbc\x39\xfd\x4d\x92\x73\x42\x4e\x7a\xe8\xf6\x08\x81\xeb\x2a\x01\xbf\x88\xeb\xd8\x76\xdb\x1b\xb6\x47\xe1\x07\x32\x20\x58\xd6\xcd\xb8\x4d\x43\x27\x4c\x4a\x97\x81\x15\xfe\xa6\xeb\x22\x21\x61\x2d\x5d\xf0\x81\x8a\xc8\x25\xad\xc5\x8a\x66\x7f\x5c\xbd\x99\x85\x11\x09\x3f\x7e\x5a\x7c\x6b\xd8\x98\xb0\xba\x2e\x81\xaa\x00\xac\x59\xb3\xae\x0b\x82\xb1\x8f\x35\x7f\xf8\xb4\x8e\xad\xb4\xa8\x75\xf2\x3e\x55\xef\x8b\xdc\x51\xb6\xa4\x0d\x25\x4a\x5d\xa4\xd4\xf5\x68\xb3\x57\x24\xfb\x98\xf4\x69\xed\x0b\x28\x80\x3a\x63\x77\x7d\x79\x84\xb2\x05\x69\x56\xac\xcd\xa9\x32\x95\xef\x0f\x8c\x2f\xc9\xc7\xfa\x2a\x2f\x6e\x94\x2a\xde\x08\x38\x82\x22\x87\x40\x09\x72\xc7\x9b\x15\xe4\x53\x6c\xd0\xe8\x33\x24\x3c\x9c\x6f\x63\x48\xb6\x87\xe6\x5a\xd4\x83\xb3\x8f\xe2\xd3\x3d\xfa\xd6\x16\xa2\x26\x4c\xda\xc7\xad\x53\xa4\x3a\x57\x95\x4e\x95\xb1\x7e\x2b\xd1\xfd\x41\x09\x80\xde\x51\xbe\x7e\x77\x41\x82\x1c\x1b\xce\xd8\x2f\xf6\xf6\x9e\xa5\x2c\x17\x13\xf8\x31\x6f\xf9\x04\x7e\xd4\xcb\xce\x89\xea\xa8\xba\xbd\xc1\xfe\xea\x63\x41\x76\x86\xee\x99\x38\xb7\x01\xb0\x29\x00\x66\xb9\x85\x75\xca\x52\x63\xb2\x09\xbe\xd0\x91\xf7\x89\x29\x7a\xab\xe2\x08\x24\x69\x85\x0c\x00\x19\x75\x43\xa0\xcf\xe3\xdf\x31\xd0\xf0\xec\x57\x80\x33\x41\xdc\x3a\x38\x6c\x56\x98\x34\x5f\x49\x52\x16\x0d\xfb\xda\xc4\xaf\xd4\xbf\x50\x94\xa8\x61\xce\x1a\x04\xd6\x2d\x4c\x93\xf8\x17\x5c\x34\x06\xe2\x5c\x48\x16\x44\xad\x8e\x73\xd7\x36\x94\x02\xe2\x69\x8d\xce\xd4\xf1\x72\x11\xc9\x0b\x38\xa5\x80\xff\xc9\x94\x14\x3c\x23\x26\x01\x74\xf6\xc0\x91\x14\xa7\x23\xa3\x13\x4b\xa0\xf4\x9c\xde\x32\x14\xd9\xd4\x3c\x7f\x5b\xb3\x94\x7f\x55\x36\x06\x27\x32\x01\x48\xc6\x8a\x30\x17\x91\x52\x82\x5d\x9b\x63\x62\xa3\x59\x32\x68\xb9\x70\x74\x89\x8f\xfc\x13\x99\xaa\x42\x41\x29\xa9\x9c\x43\x4e\xb5\xa0\xb9\x70\x3d\xf2\xed\x90\x26\xc2\x0d\x70\x61\x45\xc5\xbe\x11\x4f\x55\x17\x44\x77\x19\xc0\x02\xce\x68\xd0\x58\x26\x98\x05\xec\x91\x78\x19\xd0\x31\x6a\x32\x0d\xce\xc5\x6c\x9d\x65\x74\x91\x59\x91\x1a\xd2\xe9\x94\x88\x2f\x59\x7c\x56\xd7\xb3\x72\x5e\xde\x89\x5e\x70\x95\x51\x2a\xe5\xf7\xea\xe5\x3f\xc4\x46\x92\x8e\x03\xe9\xa1\xe1\xce\x29\xef\xc0\x79\x69\x07\xf5\xc1\xf5\xe6\xec\xc3\xf5\xfc\x65\xa0\xab\xaf\x1d\x76\x67\xe9\x43\xa6\xdd\xf3\xef\x37\xef\x7c\x53\x0f\xd8\x3a\x7b\x7f\x19\x18\xbf\x46\x72\x89\xc0\x3e\xa3\xcb\x50\x9d\x8a\x55\x59\x37\x72\xbd\x93\x4f\x05\xd2\xf4\x4a\x84\x75\x19\x90\x60\x13\x44\xc8\xad\x3a\x8d\xed\x1e\xaa\x9b\x58\x4f\x90\x43\x6f\x29\x2d\x0f\xec\x27\x38\x3d\x86\x50\xeb\x50\xbb\xe1\xd0\xe9\x66\x7f\xd2\x6c\xcd\x64\x13\x1b\x28\xc1\xca\x3c\x68\xce\xce\x7d\xae\x1a\xd7\x33\x3c\x93\x17\xe0\xf0\x99\xcb\x1c\xf7\x99\x31\x30\xc9\x7b\x2e\x4e\x59\x55\xb3\x04\xb0\x58\x6a\x4d\x52\x5b\x7b\x3a\xd1\xf7\xd5\x3b\xdc\x9f\x33\x2a\x1c\x29\x3a\x2d\x06\x07\xec\x73\x8e\xa1\xb0\x03\xd7\xcc\x60\xe8\xdc\x8f\x86\x88\xde\xb9\x00\x22\x5f\xb6\xd3\xb9\x06\xce\xbb\x15\x93\xd5\xad\xe3\xa0\x9c\xa6\x64\x23\xc5\xc0\xc8\x76\x9d\x1d\xaa\xa6\xeb\x71\x3b\xf4\xc8\x68\x80\x0d\x67\x51\x96\x58\x4a\x03\x01\xed\x3d\x59\xf9\x0a\xe0\x38\xa1\x82\xb5\xc9\x3b\x82\xa6\x35\xda\xc8\xd4\xb0\x61\x51\x0d\x67\xc4\x65\x97\xb3\x1d\xc6\xc7\x61\xb4\x31\x48\x58\x86\xc9\x70\x30\xd0\xe5\x89\xee\x0d\x07\x90\x9e\xe6\x20\xa5\xd0\x90\x86\x3b\x89\xc5\x95\xcc\x0c\x6f\x51\x51\xc9\xd2\x7a\xae\x47\xd6\xc3\xbd\x57\x32\xc1\x79\x2d\x69\x6b\x35\xab\x03\xef\x6a\xa4\x0d\xb1\xab\xd7\x35\x24\x3b\x59\x9b\x37\xe1\xc5\x36\xad\xcb\x9c\xc8\x69\xd5\x67\xc4\x89\x67\x85\x27\x30\xc4\x4b\x7b\xeb\xdf\x40\x62\xed\xdc\x92\x6c\xd1\x70\x00\x88\x3f\xd9\xc4\x6d\x14\xb7\x2d\x9a\xf2\xae\x88\xa1\xe7\x85\x01\x2f\x36\x32\x91\x1c\x09\x41\x84\x50\x0f\x4e\x3c\xc3\xa6\x64\xd3\xa2\x8f\x9d\x70\xe7\x26\xe5\xc1\xae\xf1\x7e\x76\xfe\x66\x76\x4f\xdb\x38\xde\x08\xa0\xce\x3a\x4c\x90\xd4\x1c\xe4\xb3\x9c\x15\x58\x8c\x8b\x6f\xde\x6e\x2a\x4c\xbc\xd7\x05\x48\x78\x26\xe4\xf7\x8e\x88\xf7\x1b\x0a\x87\xdb\x75\x4a\x13\x86\xd0\x70\xe1\x90\x42\x13\x50\xe7\xec\xd0\x77\x55\xeb\x4a\x57\xc1\xfe\x97\xd5\xc0\x65\x47\x44\x4c\x03\xc3\x99\x23\x34\x1c\xd7\x25\x72\xa9\x85\xab\x4d\xe8\xf6\xac\xbb\x81\xdb\xb0\x8d\x11\x7d\xde\xa8\x9a\xd7\xfc\x26\xc7\x6a\x2f\xb1\xda\xbd\xca\x2a\xc3\x0f\x99\x13\xfd\x6c\xc8\x63\x59\xf9\x91\xca\x7d\xd8\x06\xca\x5b\x35\xf3\xdd\x42\xda\xbb\x14\xd9\x24\xd1\x77\x94\xa3\x3a\x4f\x5c\x6b\xba\x38\x1d\xff\xb0\x1a\xf4\x7d\x55\x49\x7b\x8c\xac\xe8\x20\xac\x32\xc0\xb6\x08\x05\x34\x68\x01\x53\x56\x74\x41\x6c\xb3\xc2\x40\xb7\xe7\xa0\x2f\x0a\x2b\xcb\xf5\xf0\x21\x45\x71\xf5\xea\xe5\xc5\xcb\x79\x5b\x15\xf7\x8c\x4d\x7c\x86\xb1\xc9\x8b\x6a\x8d\xe3\x73\xb1\x4e\xe1\x37\x00\xf2\x98\x51\x6a\x46\xd8\xeb\x52\xef\x36\xfd\x35\x25\x6b\x46\x60\xbe\xae\xab\x2a\xe3\x50\x59\xaf\x4b\xf5\x9f\x05\x3a\xd7\x44\x42\x33\x0a\x59\x71\xb7\xe2\x30\x06\xf2\x35\x8c\x61\x5b\x87\x28\x0f\x79\x6e\x6a\x5a\xad\x60\x85\xb3\x9d\x0b\xe4\x39\x90\xee\x15\xa1\x6c\x5d\x8e\x65\xee\xae\xe9\x60\xa7\xc0\xc0\xc4\x08\xfa\xed\x5f\x95\xf8\xb1\x85\x16\x20\x13\x44\x20\x29\x97\xd8\xb7\x3d\xd3\xa1\x19\xc8\x89\xd0\xd7\x0d\x64\x4e\xcd\xe9\xdd\x25\x13\x02\x76\x24\xcf\x90\xfb\xd4\xf5\x4d\x9a\xe3\xda\xf4\x32\xe3\x26\x0b\x0e\x75\x83\xa6\x78\x8d\x30\xbe\xbb\x90\xa8\xf8\xb9\x7a\x04\x60\x3b\x52\x6c\x45\xf4\x4b\x0d\x65\x92\x29\x33\xda\x09\x6f\xda\x3b\xd2\xa6\x7a\x09\xd1\x72\x82\xe1\x7d\x01\xf1\xa6\x9f\x56\xf3\x18\xc3\xc7\x6d\xc4\x80\x57\xd6\x2b\x66\xbc\x86\x95\x0a\x19\xd5\x83\x03\xb3\xf5\xb5\x6b\x43\xa8\xc4\x58\x3d\xdb\x9d\x33\x39\xa1\x98\xec\x97\x8c\xd7\x52\xe4\x25\x35\x42\xbd\x8f\x09\x77\x80\xf6\x8e\x48\x90\xd8\x1d\x92\xe8\x8b\xd3\xd7\x3a\xa0\xb8\x6a\x0f\x04\xd2\x5b\x50\x5a\x4f\x8f\xf6\x4a\x9e\xfa\x9b\xda\x74\xcf\x0b\x75\x27\x0c\x0a\xf8\x18\x0b\xf4\xf7\x8d\xbb\x63\xb9\xd7\xc7\x8e\x3b\xde\x7f\x4a\xf5\x39\x74\x2c\x37\x8f\x46\x4f\x7a\x05\x60\xee\x6f\x3b\x5d\xc4\x69\x55\x41\x01\x85\xa1\x77\x1e\x7d\x9c\xfc\xf8\x69\x8c\xd1\x88\xe3\x38\xea\xdb\x5a\x74\x61\xff\xff\xb4\xa5\x49\xc2\xa0\xd1\x42\x2d\x83\x28\xdd\x0b\x28\x54\x74\xb1\xce\x17\xc0\x69\x1a\xc0\xf7\x4d\x5c\xbd\x88\xcb\xcd\x4f\xf2\xc5\x21\x76\x98\xc8\x6e\xe3\xca\x10\xdc\x9f\xbb\x78\xb9\x5b\xce\x26\xd2\xec\xa0\xe5\xe7\x9f\xc6\xf8\xcf\xaf\xbf\x8c\x49\x9a\x95\x14\x1e\x8e\xdd\x4e\xf3\x26\xbe\xaa\x40\x4f\x03\x82\x40\xd2\x92\xa5\x74\x9d\x35\xce\xd2\x8e\x1c\x67\x68\x73\xea\xad\x99\xb6\x09\xfe\xb0\x09\xc6\xc4\xd4\xda\xee\x9e\xed\xb2\xff\xf1\x5f\x25\x64\xed\x96\x70\x18\x00\x00"
 
 func graphqlTypeGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -142,7 +203,7 @@ func graphqlTypeGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _mssqlForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x4b\xc4\x30\x10\x85\xcf\xe6\x57\xbc\x83\xb0\xc9\xb2\xdb\xde\x05\x2f\xab\xe8\x41\x50\x10\x0f\x5e\xbb\xed\xd4\x16\xb7\x89\x4c\x52\xb5\x84\xfc\x77\x49\xda\xed\xd6\x65\x0f\x81\xe1\x9b\x37\x99\xf7\xc6\xfb\x2d\xae\x6d\x63\xd8\xe1\xe6\x16\x32\x55\xba\xe8\x08\xd9\xdb\xf0\x45\xd9\x73\xd1\x91\xc2\x36\x04\x91\xe7\xf0\x1e\x09\x20\x04\x30\xb9\x9e\xb5\x85\x6b\x28\xf1\x57\xaa\xe7\x81\xd8\x2f\xac\x35\x65\x5b\x38\xaa\xf0\xd3\xba\x66\xd6\x2d\x45\x2b\x9b\xd0\x43\x4b\x87\x6a\x1e\x94\x27\x74\x67\x0e\xf1\xf5\x9d\x9e\x9a\x2a\x13\x79\x1e\x9d\x3c\x92\x26\x4e\x9f\xd7\x6c\x3a\xd4\x86\xa9\xfd\xd0\xf8\xa4\x01\xab\x34\x3f\x82\x27\x1a\x16\xe5\x71\x6b\x26\xea\x5e\x97\x69\xd1\x94\x3c\x04\xac\xcf\xcd\xa9\x65\x5c\x59\xed\xf1\xfe\x72\xbf\x53\x90\xeb\x0b\x69\x37\x20\x66\xc3\x0a\x5e\x5c\x8d\x87\xb9\x74\x93\xdd\x30\xc1\x7f\x81\x65\xb5\xdf\x44\x75\x69\xf4\x37\xfd\xba\xa3\xa5\xf1\x04\x27\x79\x74\x24\x82\x10\x7f\x01\x00\x00\xff\xff\xea\x89\x96\x81\xb0\x01\x00\x00"
+var _mssqlForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x55\xdb\x6e\xdb\x46\x10\x7d\x26\xbf\x62\x4c\x14\x31\x15\xd3\x74\xfa\xea\x42\x79\x88\x2b\xb7\x45\x1d\x3b\xb5\x8d\x26\x80\x20\xc4\x2b\x6a\x29\x11\x59\x2d\xa5\x5d\xd2\x91\x20\xe8\xdf\x33\x33\xbb\x94\x28\x87\x49\x1c\xa0\x05\x24\xee\x85\x73\x39\x73\x39\xc3\xcd\xe6\x14\x7e\xb1\xb3\xd2\x54\x70\xde\x87\x98\x77\x5a\xcc\x25\xa4\xf7\xeb\x85\x4c\xaf\x71\xdb\x83\xd3\xed\x36\xdc\x90\xa0\xfa\xbe\x24\x44\xd2\x98\x08\x22\xbb\x54\xb6\xa2\xcd\xb2\x96\x66\x8d\xeb\x64\x4c\x07\xfc\x1b\x69\xf1\xf9\x49\xae\xfd\x42\xc2\x52\x6a\x5c\xc6\xa2\xca\x66\xb8\x7e\xb8\xb9\x2a\xa7\x51\xcb\xa7\xe9\xf4\x79\x2b\xf3\xff\xc1\x6d\x13\x61\xdb\x7d\xf9\xd9\xb2\xf3\x85\x29\x74\xb5\x4b\x41\x64\xdb\x18\xd1\x64\x85\x68\x58\xce\x48\xde\x12\xc2\xcb\x42\xaa\x09\xa7\xc7\xc9\x9e\x9d\xc1\x66\x03\x0e\xf3\x76\x0b\x28\x59\x1b\x6d\xa1\x9a\x49\xbe\x3f\x88\x09\xdf\x0b\x6b\xcb\xac\x10\x95\x9c\xc0\xe7\xa2\x9a\xed\xe4\xda\x42\xc7\x96\xaf\x9c\xa7\x46\x31\xde\x5f\x5d\x94\x8a\xfe\xf5\x5c\xfb\x97\xbd\x14\x61\x10\x92\x3f\xa4\x96\x86\x8d\xe7\xa6\x9c\x43\x5e\x1a\x59\x4c\x35\x60\x28\x70\xcc\xfa\xee\xe2\x6f\xb9\x6e\x6d\x1b\xaf\x69\x98\xd7\x3a\x63\x47\xbe\x7b\xd0\xed\xcb\xa7\xe0\x7a\xed\x70\x49\x36\xab\x56\x0b\x61\xc4\x1c\x8f\x93\x31\x7c\xb8\xf9\xfd\x4d\x0f\xe2\x97\x1d\xb1\x27\x80\x25\x2d\x0d\x1a\x08\x03\x97\xa6\xae\x0c\xbd\x59\xfb\xcb\x83\xf0\xbd\x23\x61\xa6\xec\x26\x21\xcd\xac\xd4\x8f\x72\x55\x35\x60\x5d\x72\xf6\xaa\x84\x35\xdc\x86\x4d\x81\xae\x4a\x31\x79\x66\x91\xec\xb3\xaa\x64\x8f\x7f\xb6\x4a\x09\x61\xc1\x5a\xa0\xd5\xf1\x9a\x6c\x16\x06\xba\x82\x4d\xa0\xd0\x20\xc0\x16\x7a\xaa\x24\x70\xe3\xc3\x42\x1a\xe0\xbe\x86\x32\x27\x1b\xf6\xbf\x2c\xf9\x93\xfc\x74\x57\x95\x73\xee\xa8\x83\x81\x0e\x47\x1d\x9d\x11\xcf\xc5\x62\x48\x52\x0d\x77\xb6\xdb\xd1\x8f\x3a\x01\x43\xc8\x4a\xa5\x64\x56\x71\x58\x61\xf0\x28\x0c\xef\xd0\x05\x92\x53\x9a\x5c\x64\x72\xb3\x0d\x03\x22\x37\x91\xb1\xc3\xc7\xb8\x2c\x15\x89\x60\xf0\xf0\xd1\xe1\x54\xbb\x0e\x46\x15\x23\xf4\x54\x1e\xc0\xdf\x30\xc7\x8b\x1c\x66\xc2\xbe\x33\x32\x2f\x56\x4d\xd9\x08\x27\x8f\x9d\xf4\xba\x56\x2a\x42\xe1\x30\x08\x50\xf0\xe8\xc0\x6a\xfa\x55\xe9\xd3\x7f\x85\x2a\x26\x14\x51\x10\x60\x67\x56\x85\xae\x25\xee\xdd\x30\x91\x7a\xb2\x33\x44\x95\x41\x4c\xed\x06\x56\xdf\xec\xe0\xdf\xe0\x88\xe2\x1e\xa2\xd2\xc8\xd9\xde\x1f\xfb\x50\x19\xf6\x11\x70\xba\xfa\x20\x16\x0b\x74\x14\xd3\x29\x21\x37\x3d\xf6\x8f\x3f\xce\x32\x86\xe4\x7a\x29\x24\x7c\xb6\x02\x37\x5a\x51\xef\xe1\x6e\x70\x35\xb8\xb8\x87\x07\x38\x41\x8d\x07\x46\xa6\x68\x24\xdb\x7d\xe5\x18\x10\xa7\xce\x4b\x5d\xde\xde\xbc\xa5\x20\x6c\x36\x93\x73\x01\xe9\x9d\x5f\x1b\x85\x7b\x31\x56\xfe\xd9\x90\xc3\x6b\xbe\xff\x73\x70\x3b\x80\xbd\x97\x16\x01\x90\x2f\x24\xf8\xd7\x35\x3c\x84\x34\x24\xec\x37\x0a\xde\xd5\x54\x4d\x03\x28\xa9\x39\x05\x3d\x78\x0d\xaf\x38\x67\x8e\x37\x68\xc9\x35\x18\x15\x81\x84\xf8\x9a\xa4\xd0\xd8\x5c\xb8\x76\xf7\xcd\x11\x34\x4a\x7d\x47\xba\xe1\xf9\x13\x99\x11\xa7\x16\x1f\x98\x58\x53\xeb\x26\xb1\x81\x23\x2b\xba\xf2\xc9\x3d\x81\x55\x89\x8d\x51\x4b\x1b\x47\x6c\xc2\x7e\x42\xed\x28\x81\x5f\x93\x16\x06\x2a\x14\x7f\xac\x62\xd6\x4f\x9c\xd3\x34\x4d\xe9\xc5\x92\xd9\x42\x36\x27\xe3\xd4\x31\x33\xd7\x10\xfd\xe3\xbe\x87\x4f\x46\x63\x87\x3e\x46\x4b\xfa\x47\x7d\xd0\x85\x72\xb1\xf9\xe9\x8b\x67\xb6\xdd\x8a\x45\xe1\x14\xc0\xf1\x68\x6b\x55\x51\xa6\x28\x9f\xcb\xf4\x1a\x9b\x34\xee\x39\x55\x26\x51\x9b\x5b\x5d\x95\x70\x82\xcc\xaf\xdd\xbb\x77\xa6\x98\x0b\xb3\xc6\xe1\xe3\x98\x10\x04\x1f\xe5\xaa\xb0\x95\x3d\xe7\x36\x4e\xbc\x71\x62\xca\xa9\x13\x60\x4c\xdc\xb9\x99\xd0\xb4\xa5\x30\xfa\x88\xe7\x0e\xcf\x14\x75\x4e\x3d\xd3\xdd\xa7\xfe\xcb\x1e\xbd\x88\x1a\xb8\x3d\xfe\x1e\x04\x9d\xf9\x08\x96\xe9\x85\x2a\xad\x8c\x59\xa0\x23\x3d\x1e\x0b\x26\x66\x78\x90\x80\xb4\x6b\x7c\x13\x33\x5f\x1c\x88\x39\x1e\xee\xf0\x0f\x8c\x61\x4f\x6d\xaf\xcf\xaf\x92\x27\x3b\x2d\xc3\x7d\x0b\x9d\x8f\x1c\xd1\xbd\x12\x22\x4d\x48\x93\x3e\x80\x5f\x00\x70\xd6\x95\x1b\x0f\x0a\x00\x00"
 
 func mssqlForeignkeyGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -162,7 +223,47 @@ func mssqlForeignkeyGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _mssqlIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdd\x4e\x1b\x3d\x10\xbd\xb6\x9f\x62\x3e\xeb\x13\x6c\xda\xb0\xb9\x47\xca\x45\x0b\xa1\xad\x4a\xa1\x05\xaa\x22\x21\xd4\x38\xbb\xb3\xb0\xd2\xc6\xce\xda\x5e\x20\xb2\xfc\xee\xd5\xd8\xbb\x94\x9f\x08\x41\x6f\x1c\xdb\xe3\x99\x73\xe6\x9c\xc9\x7a\xbf\x03\xff\xdb\x6b\x6d\x1c\xec\x4e\x21\x8b\x3b\x25\x97\x08\xf9\xd9\x7a\x85\xf9\x11\x6d\x05\x1a\x23\x40\xd8\xb6\xb1\x8e\x36\xe5\x42\x80\x68\x05\x08\x83\x56\x80\x38\x3f\x3e\xd4\x57\x02\xf2\x83\x1a\x9b\xd2\x8e\x60\x27\x04\x1e\xcb\x3a\xb9\x68\x30\x95\x2d\xae\x71\x29\x21\x3f\xed\x7f\x63\xed\x33\x0a\xa7\x95\x60\x52\xe2\x64\x02\xde\x43\x7e\xd0\xa9\x22\x62\x87\x00\x06\x9d\xa9\xf1\x06\x2d\x48\x30\xfa\x16\x2a\xa3\x97\xb0\xed\xfd\x00\x10\xc2\x36\x48\x0a\x52\xe2\x5f\xd6\x21\xe4\x7c\x32\xa1\x82\x9f\x50\xa1\x91\x0e\xcb\x94\x5a\xab\x12\xef\x62\x81\xfc\x0b\x6d\xd3\xda\xe7\x6c\xe7\xbc\xea\x54\xf1\x94\x44\x56\x2e\xe0\xfc\x78\xff\xa3\xf7\x70\xa5\x57\xd2\xc8\x65\x53\x5b\x37\xf4\x0c\xce\x74\x98\x96\x10\x46\x90\x79\x0f\x75\x05\x4a\xbb\x7b\x04\xfb\x53\xd5\x6d\x0c\x5f\x5c\x7a\x0f\xa8\x4a\x08\xe1\xdd\x53\xc2\x63\x40\x63\xb4\x19\x81\xe7\xec\x46\x1a\x3a\xa5\x1b\xce\xd9\x64\x02\xb6\x6d\xa0\xed\xd0\xac\x39\x2b\xb4\xb2\x0e\x92\x23\x30\x85\xf9\xe9\xec\x70\xb6\x77\x06\x73\x78\xcf\x19\x9b\x7b\x0f\x85\x6e\xc8\x46\xdb\x03\xf4\x3c\x43\x18\x9e\x1c\x9c\x1c\x7f\x83\x87\x1a\x0e\x81\x5f\x9f\x67\x27\x33\x78\x50\x21\x22\xde\x77\x2a\xe0\xc3\xd1\x3e\x08\x08\x61\x9e\x48\x99\x4e\x0d\xa4\xe2\x20\x64\x89\xd4\x4b\x42\x55\xb2\xb1\x51\xa9\x38\x26\x75\xb5\x41\x25\xce\x88\x5b\x9a\xcb\x10\x68\x86\x9e\x6a\xe5\xe9\x49\xca\x8e\xd7\xdf\x4d\xbd\x94\x66\xfd\x15\xd7\x31\x9d\xfd\xc6\xbb\xda\x3a\xbb\x1b\x21\xc7\xb1\x1e\xa9\x4e\x33\xc6\x02\xe7\x8c\xb4\x9d\x42\xb9\xc8\x7f\x10\xf9\x13\x7d\xfb\x16\xe2\xf9\x69\x21\x15\xd9\x5c\x51\x74\x83\xd0\xd9\xca\xd4\xca\x81\xd8\x12\x7d\x17\xa3\xd8\x2f\xab\xab\x68\xea\x7f\x53\x50\x75\x43\x36\x33\x83\xae\x33\x8a\x8e\xd1\xfd\x44\xae\xbf\xdc\x7a\x28\xc2\x98\xde\x44\xc5\x30\xb1\xe0\xac\x8d\x29\xa4\xce\xd0\xc7\x9b\xd4\x7f\x1d\x1b\x56\x62\x85\x06\xda\x7c\xaf\xd1\x16\xb3\x51\xb2\xbd\xd1\xb2\x04\x83\xb6\x6b\x9c\x25\xbe\x96\x58\x5c\x5c\x3e\x1b\x69\x1f\x38\xab\x34\xa5\x1f\xe1\x9d\xcb\xe2\x68\xbf\xc6\xdb\x97\xcd\x7d\xe6\xee\x23\x7b\xa3\x84\xf1\x0f\x53\x48\xc5\x59\x6f\x75\xfb\xcf\xa6\x6d\xd0\xe9\xb9\x50\x09\x94\x84\x98\x82\x5c\xad\x50\x95\x99\x41\x3b\x7e\xec\xe1\xe8\x91\xbd\x31\x7e\x6f\x6a\xfc\x24\xf0\xc0\xf9\x9f\x00\x00\x00\xff\xff\xc3\x4f\x76\x7d\x93\x05\x00\x00"
+var _mssqlGraphqlLoaderGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\x5b\x6f\x9b\x48\x14\x7e\x8e\x7f\xc5\xd4\xaa\x5a\xa8\x28\xe9\x73\x56\x79\xd8\x5e\xb2\x5b\x6d\x37\xad\xd2\xec\x45\x8a\xf2\x30\x86\x21\x46\xc6\xe0\x30\x60\x27\xb2\xf8\xef\xfb\x9d\x33\x03\x1e\xb0\x9d\x7a\xdb\x48\x5d\xa9\x1b\x09\x02\x33\xe7\x9c\xf9\xce\xed\x3b\x78\xbd\x7e\x29\x9e\x2e\x66\x37\xe2\xe4\x54\x84\x9f\x64\x34\x93\x37\x4a\xbc\x6c\x9a\xd1\x1a\x1b\x69\x22\xd4\xad\x08\x2f\xd5\x7c\x91\xc9\x4a\x89\xf1\x87\x8f\x3f\xbf\x7d\x77\xf1\x79\x2c\x20\x70\x7c\x2c\x3e\x14\x32\x56\xa5\x16\xb2\x54\xa2\x9a\x2a\x51\xaa\xdb\x5a\xe9\x4a\xe8\xa8\x58\xa8\x58\xc4\xb2\x92\x99\x11\x09\xc4\x44\x56\xd1\x34\xcd\x6f\x84\xcc\x63\x11\x49\xf3\x0c\x25\xb2\x93\x15\xc5\xac\x5e\x68\x31\x87\xac\x58\xa5\xd5\x94\xad\x69\x39\x57\x22\x2a\xf2\x4a\xdd\x55\xe1\xa8\xba\x5f\xa8\xee\x40\x5d\x95\x75\x54\x89\xf5\xe8\x28\x9e\x08\xfe\x5b\xaf\x8d\x1b\x4d\x13\xfe\xfd\xf1\xed\xeb\xd1\xd1\xbc\x36\x1b\xfa\x3e\x8f\xc2\xdf\x6b\x18\x19\x1d\x59\x30\x38\x67\x71\x05\x13\x40\x70\xfd\x62\x03\x32\x34\xd6\x47\xcd\x88\x30\xd9\x83\x7f\x53\xf7\x22\xd5\x8c\x87\x11\x14\x09\x3f\xdb\x5d\x31\x53\xf7\xda\xac\x41\x68\x61\xc2\x67\xb1\xba\x06\xf2\x6a\x64\xfc\xe4\xf3\x1d\x9b\x8e\x9d\xd6\xb4\xf5\x31\x1c\x61\x0f\xb1\x74\x74\x1c\x8b\xa7\xe2\x15\x5b\x7c\x57\x96\xe7\x45\x1b\x15\x6b\x53\x95\x65\x51\x22\x17\x55\x5d\xe6\xc8\xc2\x6a\xaa\x72\xb6\x42\x01\xe7\xe0\xca\xee\xd8\x6a\x2a\x2b\x31\x95\x5a\xe4\x85\x93\xcf\x70\xb4\x94\x65\xdf\xf4\xa9\xb1\xaa\xc3\x73\xb5\xf2\xc6\x16\xd3\x49\x67\xc7\x98\x68\xb1\x8e\x7d\xc6\x06\xd1\x37\x76\xdf\x80\xd1\x7c\xf2\x82\x3d\x8d\xaa\x3b\x03\x26\x57\xab\x2e\xad\xb5\x26\x8c\xf1\x24\x14\xef\x51\x44\xd3\xa2\xce\x62\x31\xe1\x0a\x89\x64\x96\xc1\x97\x22\x8f\x94\x58\xa8\xb2\xab\x34\x2f\x55\x01\xc2\x0b\xc3\xbf\x5e\x5e\x7e\x12\xf3\x34\x8e\x33\xb5\x42\x3d\xfa\x81\xd0\x85\xf1\xaf\x2c\x56\xa6\x44\xf3\xa2\x32\xb6\xa2\x29\x6c\xc9\xa8\x2c\xb4\x6e\x2d\xc1\xe9\xa4\xce\x23\x07\xb4\x47\x10\xdb\xf2\xb3\x6b\x01\xc0\x0d\x4b\xcd\x1f\x0a\x51\x59\x1a\x87\xbb\x9d\xbf\xe0\xe9\x9f\x32\xab\x15\x19\x0d\x9c\x9c\x06\xe2\x99\x75\x1e\x4a\x28\xe6\x13\x53\xb4\xf1\x24\xc0\x6b\x17\xe6\x07\xeb\x75\xdd\x40\xb6\xf1\x6d\xd9\x9e\x95\xc5\x7c\x18\x75\xa7\xaa\x6c\xe8\xad\xb3\x8e\xf0\x2e\x6f\x7d\xe1\xbd\xf8\xd0\xf5\x6f\x51\x64\x3e\xb9\x96\x05\xa2\x98\x11\x5d\x90\x1d\xe3\xd4\xc6\x1f\x3f\xec\x54\xfc\x2e\x0a\xac\x61\xf1\x91\x28\xdf\xb4\x98\x6d\x5a\x3d\x47\xab\xc7\x36\x2c\x83\x3e\xb0\x88\x03\x11\x95\x4a\x56\x3d\xd2\x60\xe9\xce\x06\x13\x8c\x60\xbf\xba\xda\x9f\xdc\x8b\x24\x37\x1d\x90\xa4\x25\xea\xa5\xd6\x2a\xb6\xce\x93\xfe\xee\x1c\x13\x1a\x61\xe2\x1d\x90\x3e\x89\x7b\x5b\x59\x77\x12\xf1\x9a\x8e\x3e\x83\x54\x00\xa7\xd0\xca\x08\x1c\x5a\x5e\x95\x89\x8c\x14\xd2\x63\x7a\xa7\x1f\xbc\x41\xe8\x11\x2c\x90\xed\x13\xec\x51\x1d\xd8\xb8\xe5\x29\xc4\xdd\x36\x44\x9e\x47\xb0\x11\xce\x6b\xe4\x3e\x9a\x79\xd0\xca\xe2\xd6\x62\x16\xda\x34\x5c\x11\xfe\xeb\x9e\x41\xf4\xd1\xa9\x0b\x18\x55\xce\x98\x55\x6c\x2c\x7b\x49\xee\x65\x61\x3c\xf1\x7d\x12\xee\x1b\x82\x66\x16\xd3\xc9\xe6\xe0\x3f\xf2\xcc\x1c\xbd\x49\x6f\xcc\x95\x68\x4a\x7b\xe6\x7b\x6d\x29\xce\x0c\xcd\x49\xe7\x60\x5e\x4b\x88\x9d\xe4\x2a\x68\x1b\x11\x49\x62\xba\xe7\x80\x23\x77\x8b\x52\x69\x95\x57\x48\x76\x91\x23\xfb\x64\x2a\x8d\x2d\xa9\x92\xfe\x86\xfc\xd3\x58\x08\x27\xd0\x40\x24\x57\xbd\x05\x03\xe4\xb3\xb1\xac\x61\x51\x27\xa9\x32\x0d\xe1\x44\xc3\x52\xb4\xd1\xb2\xd5\xe1\xd9\x44\x1a\x5d\xcf\x6f\xe1\x6d\x7a\x3b\x99\x57\xe1\xe7\x05\x16\x2b\x6f\x16\xa6\x71\xeb\xf5\x05\x20\x7c\xd5\x49\x50\xc4\x31\x0e\x7a\xe7\xac\x59\x08\xcf\x46\x66\x28\xab\x4c\x2b\x61\x07\xf4\x53\x0e\x0a\x8d\xee\x4b\x3c\x84\xe7\x54\xb8\xed\xf0\x7e\x0a\x02\x2d\x2b\xda\xf4\xf8\x89\xab\xda\x91\x1b\x23\x5f\x63\x31\x8e\x27\xb8\xa1\x40\x71\x47\xdc\xe9\x0e\xba\xc4\x3f\x9a\x6c\xf4\x0f\x57\x8a\x6b\x8e\x6b\x89\xab\xe0\x85\x98\x6f\xd8\xa7\x96\xf0\xbb\x23\x4b\x99\xe3\xeb\x21\x7c\x9f\xc7\xea\x0e\xce\xb7\x40\x10\x09\x14\x20\x01\x49\x69\x47\x84\x67\xb4\xa0\xc5\x2b\xbf\x13\xc1\x69\xad\x2b\xde\x6d\x2d\x33\x7e\xe1\x7e\x33\xda\x8c\xdb\xb7\x9f\x1d\x68\xc5\x90\xba\x8d\xdd\x68\x1a\x4b\x27\x14\x6a\x6a\x52\x56\xc5\x2a\xca\x2a\xad\x34\x2f\x19\x13\x56\xfc\xdb\x3f\x45\x8e\x8f\x49\xf2\x17\x95\xab\x12\x5f\x45\xb1\x48\xd0\xcb\xc2\xb8\xf6\x9c\xb0\xb1\xff\xe6\x6e\xcf\x7c\x6e\x73\x3d\x40\xbe\x9b\x7e\x50\xd5\x04\xba\x0d\x49\xd3\x10\x0f\x3b\xf4\xe3\x38\xe9\x52\xcb\x92\x5f\x98\x09\xba\x6e\x1c\x0f\x0e\x1c\x07\x64\x3a\x8b\x0c\x19\xba\x3b\x4c\x07\x01\x55\xe2\x3a\x8d\x09\x43\x63\x28\x89\x4c\x3e\x39\x25\x2a\xda\x62\x26\x6c\x19\x42\xb2\x8b\xcb\x70\x1f\x4c\x0c\x63\x68\xd8\x06\x79\x08\x40\x6f\x64\x39\x84\x8e\x19\x30\xf0\x64\x13\xd0\xbd\xc6\xbc\x5d\xb3\x7a\x17\x6b\xbb\x4d\x4d\x54\xbf\x33\x2b\xfc\xa5\xd7\x6f\x65\xed\x8b\xab\xde\x40\xbe\x50\xba\xce\x98\x99\x40\x4d\x9a\x52\x31\x97\x33\xe5\x5d\x5d\xf7\xf3\x89\x0f\x00\x95\x7b\x64\x90\xf9\x96\xf8\x30\xa5\xc9\x01\x79\xd3\x41\x7c\x16\x59\x21\x33\x57\x29\x51\xf0\x2c\x64\x7e\x08\xbd\x41\x69\x40\x88\x52\x70\xd4\x8e\x56\xea\x5e\xbc\xce\xbb\x62\xe8\x67\x84\x99\xba\xad\x3e\xbc\xc3\x55\x59\xd2\x26\xbe\x38\x90\x74\x4d\xb4\x8e\x58\xb8\xd8\xb7\x1d\xdc\x8d\x3f\x8d\x37\x0e\x90\xf7\x8c\x5f\xa3\x85\x90\xc4\x65\x3b\xa4\xe6\x57\x69\x7c\xfd\x93\xd9\x8b\xa4\x56\x4e\x7d\x9d\xd0\x1a\x9d\x6d\x1c\x7e\xb6\x75\xec\xfa\x1d\xd5\xfa\x09\xa9\x34\x9d\x3e\x26\xdc\xc1\x8a\xfa\x36\x0b\x79\x9c\x5e\x20\x48\x6c\x22\x56\x89\x84\xc0\x97\x2d\xbc\xc5\xca\x89\x58\xb2\x52\xd3\xc6\xdc\xd6\x0c\xf4\xa8\x0f\x88\xc9\x84\x02\xa3\xf4\xc9\xf0\xac\x28\x55\x7a\x93\x53\xb9\x74\x6c\xc7\x54\x4c\x54\xc7\xe3\xc3\xb6\x09\xf3\x93\x43\x71\x46\x6a\x48\x6f\x00\x94\x6c\x38\x1c\xbb\x52\xeb\x22\x4a\x99\x87\x3a\xba\x72\x5a\xef\xb9\x36\x9d\xe3\x32\xa0\xf0\x36\x4b\x6f\x8a\x8c\xae\x7a\x9e\xdb\x4d\x3f\xa0\xf3\xf7\x51\xe4\xd7\xf3\x63\x62\xe2\xc0\x13\x9c\x59\x72\x13\x18\xe7\x71\x9b\x2f\xdb\x30\xec\xee\x4a\x12\x30\x73\x0e\x6e\xed\x63\x9f\x2d\xfa\x1c\x06\xd1\xa1\x51\xfb\xb3\x17\x3f\x68\x3e\x95\x2a\x49\xdb\x61\xc5\xf3\x47\x8c\xa9\x82\xce\xeb\x2c\xe3\x1f\xc0\xfc\x81\xe5\x02\x08\xb7\x22\x4d\xdf\xc7\x44\xe7\x03\xe6\x24\x32\xa4\x12\xe2\x71\x6e\x2a\x66\x2f\x7d\xb7\xfe\xf7\xa9\xbb\x5d\x75\x68\x5b\x90\x35\x04\x68\x49\x9f\xfe\x16\x93\x41\xc3\xfe\x9a\x27\xf2\x75\x03\x19\x73\x5b\x3c\x06\xcf\x0f\xe3\xb9\x8f\xef\x7b\xa8\x1f\xe6\xfa\x56\x74\x9b\xe7\x7b\x46\xbe\x2b\xc7\xf3\x0f\x4b\x87\x28\xf7\xcd\xe8\x83\xd9\x9e\x0c\x0e\xe8\x7e\x5f\x4d\x3f\x26\xef\x93\xee\x37\x10\x7f\xaf\x07\x3b\xaf\x38\x38\x7b\x66\x00\x01\xf8\x62\xa1\xfe\x58\x63\xe2\x42\x2d\x0f\x9f\x14\x10\x3e\x6c\x58\xb8\x4d\xa9\x0f\x1c\x15\x6d\x0a\xfa\xd3\xa2\x5b\xfd\x31\x06\xc6\x56\x37\x87\x7b\xe6\xc5\x23\xf1\x76\x7f\x86\x0c\x53\xf0\x28\x9c\xfd\xb0\x4b\xff\x53\xf6\x7f\x8e\xb2\xd1\xe5\xdf\x91\xb5\xb7\xeb\xeb\x5f\xb1\xee\x11\x65\x2a\xcd\x6b\xd5\x52\xe1\x01\xbc\xc9\x83\xe1\xe1\x4e\xb8\x3e\x90\x55\xed\xe3\x3f\x63\x37\x07\x56\xde\x18\x00\x00"
+
+func mssqlGraphqlLoaderGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mssqlGraphqlLoaderGoTpl,
+		"mssql.graphql.loader.go.tpl",
+	)
+}
+
+func mssqlGraphqlLoaderGoTpl() (*asset, error) {
+	bytes, err := mssqlGraphqlLoaderGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mssql.graphql.loader.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mssqlHasmanyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x55\x4d\x6f\xdb\x46\x10\x3d\x93\xbf\x62\x42\x14\xb1\x98\xd0\x4c\x7b\x55\xc1\x1e\xea\xca\x6d\x51\xc7\x6e\x6d\xa3\x0d\x20\x08\xd1\x8a\x5a\x4a\x44\xa8\xa5\xb4\x4b\x39\x16\x04\xfe\xf7\xce\xcc\xf2\x4b\x16\x0b\x5b\x46\x0e\x3d\x88\x22\xf7\x63\xe6\xcd\xdb\x37\x6f\xf7\xfb\x73\xf8\xce\x2c\x73\x5d\xc0\x30\x82\x01\xbf\x29\xb1\x92\x10\xde\xca\xe4\x7e\xb7\x96\xe1\x35\x7d\x79\x52\x6b\x0f\x3c\xb3\xc9\x4c\x41\x2f\xf3\x19\x3e\x36\xf8\xd3\xd2\xe0\xf3\xd3\xcd\x55\xbe\xf0\x7c\x38\x2f\x4b\x77\x4f\x11\x75\x6f\xc8\x13\xe3\x55\xc0\x3a\x61\x0b\x31\xcb\xa4\x8d\x1a\x2f\xe5\x4a\x40\x78\x57\xfd\x73\xe8\x7b\x9a\xb6\x4f\xca\xd2\xd9\x98\x9d\x5e\xe2\x66\x2b\xf5\xee\x18\xda\x17\xb9\xab\xfe\x68\xb1\x94\x0a\xff\x66\xa2\x88\x97\x7d\x34\xc4\x27\xd2\xf0\xea\x9c\x75\x85\xdd\x23\xc8\xbf\x1a\xce\xbc\xd6\xa9\x2a\x1a\x0a\x3c\xd3\x05\x88\x21\x0b\x44\xc3\xeb\xb4\xe4\x57\x62\xe5\x32\x95\xd9\x9c\x71\xda\xb5\x1f\x3e\xc0\x7e\x4f\x33\x0f\x0c\xbb\x2c\x01\x17\x6f\xb5\x32\x50\x2c\x25\x4f\xb5\x35\x95\xa5\x01\x61\x4c\x1e\xa7\xa2\x90\x73\xf8\x9a\x16\xcb\x66\xd5\x01\xe1\x65\x79\x66\xea\x51\x9b\xb0\x0e\x3e\x38\x18\xbd\xc8\x33\xfa\x6d\x57\xaa\x9a\xf7\x43\x04\x44\x98\x7e\x95\x4a\x6a\xce\x92\xe8\x7c\x05\x49\xae\x65\xba\x50\x80\x45\xc1\x19\x85\xb8\xb4\x03\x7f\xc8\x5d\xe7\xb5\xce\x1d\xba\xc9\x56\xc5\x9c\xab\x6a\x00\xcc\xfc\xae\x07\xa5\xff\xa4\x76\xda\x11\x17\x8f\x6b\xa1\xc5\x0a\x3f\xe7\x33\xf8\x74\xf3\xcb\xcf\x3e\x0c\xc6\x93\x77\x4f\xa9\x08\x00\x4f\x38\xd7\x18\xc2\x75\x1e\x84\xa6\x2f\x3b\xe2\xba\x0e\x16\x80\x07\x0f\x7c\xe6\xae\x13\xe7\xca\x14\x60\x95\x00\x11\x4c\xef\x46\x57\xa3\x8b\x7b\x98\xc2\x7b\xd7\x71\xa6\x94\x32\xcf\x48\x3e\xa6\x4a\xc0\xdc\x18\xc2\x5c\x2d\xb9\xbc\xbd\xf9\x48\x48\xab\x26\x69\x27\xfe\xf9\x6d\x74\x3b\x82\x36\x02\x84\x0d\xaf\xb4\x2a\xa2\x29\x55\x2c\x6d\x3d\xdf\xe3\xd0\xd4\x82\xd3\x5b\x55\x83\x63\x91\x0d\x2c\xb8\x00\xba\x8c\x85\x7d\x07\xe8\xbb\xce\x86\x2b\x27\x5d\xcd\x67\xa1\x25\x2c\x51\xe0\xfd\x65\x05\xde\x90\x28\xf4\x82\x04\x73\x42\xe0\x34\xe1\xc0\x6f\x22\x50\x69\x46\xb4\x3a\x56\x8a\xf4\xc9\x39\x5d\xa7\x74\x9d\xb9\x4c\xa4\x86\x4d\x78\x91\xe5\x46\x0e\x7c\x5b\x50\x96\x8b\x39\x0a\xd7\x6c\xb3\xc2\xb8\xb8\x8d\xdb\xa3\xe7\xd0\xf6\x18\x00\xb5\x84\xdb\xaf\xe5\x63\x31\xe0\xc3\x73\x08\x9b\x6e\x74\x32\x8c\x8e\x54\x6f\x17\x9d\x03\x02\xb4\xe3\x7f\xea\x74\x25\xf4\x0e\x25\x87\xb3\x38\xe9\x7c\x96\x8f\xa9\x29\xcc\x10\x0a\xbd\x95\x81\x8d\x29\xd5\x9c\x3b\xcc\x41\xd4\xf8\x20\x4d\xc4\x42\xe1\x1b\x15\x19\x21\x84\x3b\xfc\x24\xb2\x12\xe2\xa1\x47\x00\x55\x7b\x7b\x6f\xbd\x1a\x9f\x6f\x89\xea\x61\xea\x98\x2a\x9b\x95\xa8\x88\x40\xac\xd7\x88\x06\x6d\xc0\x04\xf0\xf6\xa0\x5c\xdf\xe5\x75\xd5\x6e\x5e\x80\x21\x5c\x1c\x6b\x8d\xe1\x0a\xc9\xfd\xa6\xe6\x60\xce\x5e\x67\x0e\x01\x81\x42\x0b\xc0\xf0\xb3\x5d\x6f\x88\x00\x52\x05\x02\x4c\xaa\x16\xd8\x28\x2c\x71\x58\xa3\x5c\xd8\x52\x21\x4f\x68\xb7\xf9\x96\x1e\x73\x4c\x51\xbf\x87\xd8\x0e\x60\xe3\xc6\x42\x2b\x65\x1e\xb9\xd1\x60\x25\xd6\x63\x5a\x58\x9b\x77\x59\x4e\x9e\xb1\x1e\xac\x03\x9b\x3f\x93\x71\xc1\xb5\x59\x2f\xa2\x37\x4c\x82\xea\x91\x3a\x11\xb1\x24\xd9\xd3\xe5\x42\xe2\xee\x49\x31\xcb\xf3\xac\xee\x8c\xcf\x16\x69\xd6\xed\x07\x2d\xd4\x42\x1e\x14\xb0\xb7\x22\x24\xaa\x6c\xbf\xb4\x1b\x7a\xbb\xfb\x47\x78\x43\xf9\xc7\xb8\x61\x62\xf5\xda\x7e\x46\xdc\x34\x34\xc6\xb0\x1b\xb5\xd2\x57\x40\x29\x7c\x16\x33\xeb\xf4\x7f\x62\xac\xbf\x5f\xc3\xd4\x6d\x7c\xe6\x65\x87\x56\x13\x9c\x49\xc5\xa5\xf9\xf0\x13\x5a\x32\x71\x61\xc5\x89\x81\xec\x01\x12\xb1\xb4\x88\x87\x69\x15\x46\x5a\x09\x2b\xa8\x86\xfc\x6a\x53\x64\x95\x3d\x1e\x3e\x59\x33\xe9\xb8\x4e\xc7\xec\x1d\xdb\x11\x98\xaa\x22\xed\x3d\x3c\xe6\x7f\x8b\x6c\x2b\xcd\xc0\xe3\x10\xe6\x0b\xee\xf6\x02\xf8\x21\xe8\x60\xa0\x03\xb0\xf7\x04\xef\x0f\x6c\xd2\x30\x0c\x69\xe2\xa4\xeb\xa0\x67\xff\xcb\xbd\xec\xc8\xe7\x8f\xad\x9c\xbd\x3c\x7e\xce\xcb\x9f\x31\xf3\x63\x37\x3f\xb4\x73\x8b\xa6\x63\xe8\xaf\x72\xf4\xf8\xc0\xd1\xfb\x68\x70\xda\x4b\xce\xe9\x65\xa5\x02\xd2\x76\x21\x36\xc4\x03\x92\xd1\x50\x60\xf3\xb6\xed\x58\x15\x88\x04\xd6\xad\xd7\xde\x0c\x3c\x52\x5d\x0f\x71\xe7\x7a\xa0\xd6\x6b\x0a\x1c\x69\xcd\x68\xba\xc8\x5e\x7e\x80\x55\x7f\xd3\xdf\xb8\x55\xd7\x70\xf2\x9f\x77\xd0\xbf\xdc\x8e\x6c\x6a\xc3\x0c\x00\x00"
+
+func mssqlHasmanyGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mssqlHasmanyGoTpl,
+		"mssql.hasmany.go.tpl",
+	)
+}
+
+func mssqlHasmanyGoTpl() (*asset, error) {
+	bytes, err := mssqlHasmanyGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mssql.hasmany.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mssqlIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x58\xdb\x6e\xdb\x46\x10\x7d\xa6\xbe\x62\x42\x14\x31\x15\x33\x4c\xf2\x56\xa8\x71\x81\xc6\x91\x6b\xa3\x8e\x9d\xda\x6e\x93\x40\x30\xe2\xb5\xb4\xb2\x08\x53\xa4\xb4\x4b\xf9\x02\x81\xff\xde\xb9\x90\x14\x29\xca\xaa\x6c\x03\x4d\x1f\x44\x91\xcb\xdd\xb9\x9c\x99\x3d\x33\xcb\xf9\xfc\x35\xfc\x64\x47\x89\x49\xa1\xb3\x03\x1e\xdf\xc5\x6a\xac\x21\x38\xbb\x9f\xe8\xe0\x88\x6e\x5d\x6d\x8c\x0b\xae\x9d\x46\x36\xa5\x9b\xc1\x25\x5e\xa6\xf8\x33\xda\xe2\xf5\xeb\xf1\x61\x72\x45\x23\x33\x6d\xee\xf1\xff\x5a\xdf\x5b\xf9\xc3\xeb\xa5\x4a\xfb\x23\xfc\xbf\x51\x11\x0d\x4e\x94\x51\x63\xb9\xb9\xd2\xf8\xa7\x86\xa9\x26\x91\xc3\xd0\xd8\xd4\x85\x60\x2f\xd4\xd1\xc0\xb6\xe1\x75\x96\xb5\xe6\x64\x5a\xaa\x2e\x23\x2d\xa6\xf5\x47\x7a\xac\x20\x38\xcd\xff\xd9\xbe\x33\x7a\x2d\x57\x32\x55\x16\xbe\x79\x03\xf3\x39\xca\x9a\xc5\x7d\xb6\x3f\xcb\xc0\xe8\xd4\x84\xfa\x46\x5b\x50\x60\x92\x5b\x18\x9a\x64\x0c\x5b\x38\x2b\x57\x90\x65\x5b\xa0\xe8\x25\x2d\x5c\x78\x9e\x65\x01\x4a\x23\x81\xbf\xeb\x58\x1b\x95\xea\x81\x2c\x0d\xe3\x81\xbe\x63\x01\xc1\x01\xdd\xca\x35\x5f\xb3\x15\xb4\x86\xa8\x7b\xd9\x08\x0f\x9f\xfb\xe9\x1d\x43\x80\x8f\x83\x4b\xf8\x7a\xfc\xf1\x03\x0e\x5e\x25\x3c\x16\x85\x36\x2d\x10\x80\xd4\xcc\xb4\x5c\xb2\xac\x0d\xb4\x34\x1c\x42\x9c\xa4\xa5\x3e\xfb\x57\x1c\x4e\xf9\x75\xef\x1c\xdf\xea\x78\x80\xb7\xaf\x96\xcd\xf7\x01\x63\x97\x98\x36\xcc\x5b\xce\x8d\x32\xf4\x24\x23\xad\x96\x83\x5e\x61\x48\x81\xc3\xd6\x72\xfa\x49\x8c\xea\x25\xc6\xb0\x03\x17\xa7\xdd\xc3\xee\xee\x19\x5c\xc0\x76\xcb\x71\x2e\xc8\xf4\x24\xa2\xc4\xb0\xb9\x82\xdc\x4e\xc4\x36\x9f\xb2\x77\x72\xfc\x09\xaa\x88\x16\x2f\xbe\xec\x77\x4f\xba\x50\x91\xc0\x1a\x4b\x4f\x5d\xf8\xed\xe8\x23\x5e\xb3\xec\x42\x8c\x32\xb3\xb8\x30\x8a\x53\xcb\x13\xa3\xd6\x01\x35\xc4\xec\x62\xa4\x38\x69\x10\xa9\x26\x4a\x2d\x87\x6c\x93\x4c\x47\xdb\x30\xa3\x96\xb1\x9a\xd3\x14\x59\xcd\xc3\x9f\x4d\x38\x56\xe6\xfe\x0f\x7d\xcf\xcb\x9d\xef\xfa\x0e\x15\xdb\x0e\xab\xf4\x59\x1e\xa1\x4e\x19\xe7\x64\x68\x3a\x61\xbb\x03\x83\xcb\x40\xe2\x3c\x8c\xc1\xfd\x93\xdc\x38\x49\x6e\xdd\x45\xf8\x95\xb9\xc2\x87\x47\xb8\x84\x09\xaf\x62\x5a\x3c\xa4\xb7\x2b\x42\xe0\x4d\x4c\x18\xa7\xe0\xbe\x74\x73\xff\xda\x8c\x84\x83\x8e\x90\x49\x2f\x76\x20\x0e\x23\x4a\x00\x07\x77\xc1\xcc\xc4\xf4\xc8\x79\x21\x66\xe7\x83\x2f\xab\xf0\xf8\x34\x87\xb1\xd4\x62\x45\xcb\x99\xf2\x12\xc2\xad\xe9\xe1\x73\xdc\xdb\xd0\x4e\x67\xa0\x87\xda\xc0\x34\xd8\x8d\x12\xab\xbd\xb6\xa4\x4a\x94\xa8\x01\xee\x6d\x3b\x8b\x52\x4b\x9e\x58\xb2\xaf\x77\xde\xd8\x06\x73\x14\x30\x4c\x68\xf9\x91\xbe\x4b\x3d\xde\x0e\x9b\xe4\xc3\xfa\x84\x68\x64\x44\x2d\x25\x18\x5c\xde\x64\x18\x3f\xbc\x93\xf4\x98\x3e\x39\x9c\x2b\x70\x6a\x02\x25\x4a\x09\x88\x1d\x50\x93\x09\x1a\xe3\xe1\x83\x5f\x8f\x6e\xbb\x16\x78\x7e\x5f\x86\x9b\x69\xa4\x95\x95\xfb\xe8\x10\x11\xce\xf1\x10\x42\x66\xb3\x99\x90\x85\x03\x0b\xb3\xdf\xb6\xcb\x29\xc8\xfc\x29\x3a\xc4\x93\x50\x09\xdd\xca\x32\xf6\x93\xe7\x15\x1c\x5d\x11\x5f\xe1\xe8\x74\xa4\x89\xa5\xed\x43\x34\xbd\x1c\x29\xeb\x93\x3c\x54\x8b\xf4\x7c\x79\xcf\x24\x24\xfa\x4a\x16\x0c\x63\xe4\x76\x1b\xc6\x57\x28\x44\xe8\x67\x82\xd9\xc4\xc5\x09\x92\x21\x2d\xb5\xcf\x65\xfa\x8a\x2b\xab\x99\xde\x67\x35\xc0\x74\x5d\x62\xc4\xec\x3e\x56\x93\x5e\x7d\xb0\x99\xc2\x55\x26\xff\x51\xcc\x5d\xc0\xba\x9b\x44\x34\xeb\xe0\x08\x2e\x5a\xe5\xb6\xdb\xc4\x89\x62\x1f\x46\x3a\xf6\x08\x8c\x36\xfc\x0a\x6f\x39\x95\x25\x14\x28\x86\x86\x25\xd9\x69\x12\x0f\xd3\x2c\x94\x34\x56\x82\x28\x9b\xce\xe9\x2f\x8b\x76\x24\x8e\xbd\xce\xd2\x9c\xf3\xca\x1e\x64\xa2\xc0\x1e\x64\xa6\x49\x38\x35\x23\x62\xf2\xb5\xf6\x7a\xe7\xb8\xdd\xb4\x19\xaa\xbe\x9e\x23\xca\x0b\xad\xb4\xe7\xc8\xd8\x90\x03\x47\xf3\x8d\x8a\xaf\x74\x9e\x35\x6c\x00\x09\xea\x85\xe7\xc0\x66\x57\xd4\x55\x4a\x98\x23\xe9\x86\xab\xf3\x08\x6d\xc3\x5d\xf2\x37\x5b\xe2\xb9\x6c\xb1\xbd\x46\x63\x5d\x1f\xde\x2d\x2b\x97\xea\xc7\xeb\x7d\x32\xde\x06\x41\x40\xe3\x8f\xa2\xe2\xe6\xf2\xcd\x79\xa4\xc1\xb0\x4d\x12\xdd\x88\x45\xff\x85\x46\x9b\x3c\x5a\x27\x52\x31\xa6\x42\xa5\xcf\xe7\xd2\x55\x20\x38\x8b\xe2\xe2\xac\xc4\x24\xb7\x03\xd1\xe8\x55\xbd\x0e\x1a\x7c\x43\x09\x51\x63\x5c\x06\x74\x61\x76\xd7\x18\x56\x52\x55\xb8\x79\x54\x98\x45\x64\xa3\xf4\x16\x09\xd3\x39\x5f\xcd\xea\x59\x95\xd7\x8b\x38\x7c\xc6\xee\xbb\xc6\xea\x93\x6b\x8a\xdd\xaa\xf8\xf0\xeb\x28\x1c\x87\x7c\x40\x70\x0f\x0f\x3e\x1d\x9c\x71\xb7\x26\x6f\x98\xa6\xe9\x85\x5b\x91\x8f\x49\xe2\xe9\x29\xe7\x8e\x36\x4c\x06\xee\xd8\x62\xfa\xbb\xed\xc6\x78\xd2\x0f\x7f\x5e\x35\x6c\x94\xdb\x5e\x52\x8f\x4a\x8e\xf7\xf6\x4e\xbb\x67\x48\x19\x27\xc7\x5f\x4e\x61\xaf\x7b\xb6\xbb\x0f\x47\xdd\xaf\x0d\x83\x70\xaa\x4c\x39\x3e\x3a\xfc\x56\xbe\xcb\x41\x28\x6a\x4f\x05\x84\xda\xf9\x80\x8e\x26\x54\x14\x54\x0a\xe3\x04\x99\x95\xcf\x26\xeb\xea\x51\x21\xb0\x5e\x92\x10\x05\x74\x67\x51\x91\x26\xd7\x0b\x2a\x1f\x26\x51\x94\xdc\x62\x39\x2a\x4a\x1d\xe9\xa3\xdb\xfe\xcc\x58\x44\x8f\x8f\x45\xe0\x61\x9b\x8e\xa2\x59\x29\xbd\x2c\x0d\x81\xdb\x91\x8e\x41\x8f\x27\xe9\x7d\xdb\x07\x15\x25\x28\xe8\x36\x4c\x47\x3c\x8b\xcc\xdf\xb2\xf4\x17\xc6\x2a\x0d\x93\x98\x64\x84\x31\xee\xdc\x31\x3f\x3e\xb7\xce\x55\x60\x7b\xf2\x89\xc6\xcf\x3d\xdc\x65\x77\xfd\xdc\x33\xdc\xaa\x98\x09\x2b\xda\x37\x1f\x5e\x91\xd6\x03\x74\x62\xdd\x81\x06\x33\x4f\x04\xbd\xcf\x8b\x4a\x75\x03\x15\xbb\x28\x31\x16\xf9\xeb\xd6\x93\x23\x27\x20\x81\xd0\xa9\xea\x52\x43\xac\xaf\x10\x9f\x1b\xed\xe6\xed\x51\xbd\xc8\xe6\xe4\xdd\xf9\x51\x27\x23\x27\x2f\x6b\xdc\xdf\x56\x0a\xd6\x1c\x28\xb3\x57\xe1\x2d\x1d\xb6\x5c\x91\x47\x81\x7c\xe2\xd4\x46\x11\x2f\x0b\x38\xa9\x20\x23\x6c\x12\x8d\x17\xbc\x8d\xe7\x5c\x20\x0d\xd7\xbc\x3c\x6f\xcf\xa4\x9e\x97\x0c\xc6\xd3\x83\x81\xee\x27\x83\xa5\xfa\xf9\x08\x73\xb0\x29\x45\x15\x1b\x50\x5f\x8d\xff\x9c\x22\x12\xdb\x18\x09\xc6\xa7\xda\x9e\xa0\xad\x79\x6f\xc2\x1d\x43\x9c\x8e\x24\x37\x3d\xe4\xc9\xc5\xc7\x05\xc6\xb3\x00\xb4\xec\x93\xe5\x99\x6b\x7d\xbb\xe8\xdc\x8b\xf8\x17\xdb\x73\x62\xf4\x4d\x98\xcc\x2c\xb3\x01\xce\x91\xd6\x8b\x46\xeb\xbd\xd7\x6a\x9b\x24\xea\x0f\xe7\xc3\x23\x12\x62\x8d\xe7\xef\x77\xd6\xba\x5e\xea\x3a\x3e\xf9\xd8\x3d\x81\x0f\xdf\x1e\x10\x43\xf6\x09\xef\x66\xd9\x3b\x7a\x60\x02\x94\x43\x3a\x61\xd3\x1f\xe9\xfe\x35\x50\x3f\xb0\x0c\x8a\xb4\x2d\x39\x28\x3e\x08\xae\x79\xef\xf1\x98\x33\xf2\x0a\x09\x52\xf0\x8b\xbc\xb1\xc8\x79\x79\x27\xd6\x57\x98\x56\x2c\x9c\xfb\x2c\xaa\xb0\x47\x09\x0a\xb5\x9d\xea\x4b\xc9\xb0\xce\x9a\x04\xc3\x53\xa6\xc2\x56\x87\xa7\xd0\x66\x09\xf6\x95\xfd\x9c\xfb\x47\x7b\x06\x8d\x27\x0a\x93\x54\xcc\x59\xa2\xa8\x1d\xa0\x90\x93\xef\x52\xc3\x5f\x98\x7c\x18\x68\xdc\x15\xe3\x30\x26\x96\x0f\x39\x7d\x8c\x86\x90\x0a\x4c\x8c\xb3\x98\xa6\x5b\xb5\x64\x7e\x44\x40\x30\x86\x80\xeb\x30\xfd\x6e\x82\x83\x34\x51\x1e\x93\xd9\xf6\xbb\x76\xf9\x09\xa3\x08\x17\x4e\xbc\xa8\x46\xaf\xfc\x54\xb4\xf6\x5b\x4b\x3d\x6a\x4f\x38\xfa\xd7\x05\xac\x3f\xe1\xff\x0f\x8e\xf9\xcd\x0e\xf4\x3f\x3e\xbc\x3f\xe5\x04\x5f\xc8\x2c\x3b\xca\x5f\x36\x45\xb9\x55\x1c\xaf\x50\x32\x1d\xae\xa4\x14\xce\x4b\xbd\xd4\xe1\x76\x78\xf0\xbc\xb5\xd8\x07\x84\x69\x6d\x0f\xe4\x68\x7f\xf7\x61\x19\x63\x39\x28\x91\xb0\x79\x21\x40\x4a\x7d\x8d\x6f\x17\xa3\x3e\x9e\x89\xe4\xd6\x7b\xa0\x7b\xa8\x96\x0f\x6a\x08\x1a\x0d\xf8\xa2\xbd\x6a\xaf\xf8\xbe\x41\xca\x56\xf4\xc3\xff\x00\x73\x8a\xf2\xde\xfe\x16\x00\x00"
 
 func mssqlIndexGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -182,7 +283,47 @@ func mssqlIndexGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _mssqlQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x5f\x6b\xdb\x3e\x14\x7d\xb6\x3e\xc5\xfd\x89\x50\xec\xdf\x5c\xe7\xbd\xe0\x97\x75\x0c\x06\xa3\xd9\xbf\x87\x42\x29\x4c\x8d\xe5\x4c\xa0\x48\xb1\x24\x77\x0d\x42\xdf\x7d\x5c\xc9\x76\xec\xa6\x65\xa3\x6f\xca\xf1\xfd\x73\xce\x3d\xf7\xc6\xfb\x4b\x58\xd9\x5f\xda\x38\xb8\xaa\x21\x8f\x2f\xc5\xf6\x1c\xaa\x1f\xc7\x03\xaf\x6e\xf0\x49\xb9\x31\x14\xa8\xed\xa4\x75\xf8\x68\x1e\x28\xd0\x8e\x02\x35\xdc\x52\xa0\xb7\x9b\xcf\x7a\x47\xa1\xfa\xda\x73\x73\xfc\xc2\x0c\xdb\xdb\x02\x2e\x43\x20\xb1\x76\x87\xe8\xb5\xde\xef\xb9\x72\x16\x7b\xa4\xb8\x09\x19\x03\x45\x0b\xd5\x00\x46\x6c\xbd\x06\xef\x4f\xd0\x10\xc5\xa5\xe5\xf3\xcf\x91\x5f\x08\x60\x7a\x65\x81\xc1\xb6\xb7\x4e\xef\x21\xf6\x2c\xc1\x70\xd7\x1b\x25\xd4\x0e\x0c\xb7\xbd\x74\x16\x98\x8d\x59\x27\x69\x21\x54\xa9\xae\x6a\xb0\x45\xdb\xab\xed\xa2\x6e\xde\x3c\xc0\xed\xe6\xc3\x7b\xef\xc1\x30\xb5\xe3\x0b\x95\x10\x42\xb9\x88\x1e\x6b\x43\x08\xde\x0f\x35\x0b\xc8\xbd\x47\x75\x4a\x3b\xa8\x36\x4a\x1e\x37\x0a\x03\xee\xee\xa7\x90\xff\x9f\x73\x2a\x81\x1b\xa3\x4d\x01\x9e\x64\x8f\xcc\xe0\xaf\x84\x10\x92\xad\xd7\x60\x3b\x99\x24\x92\x2c\x95\xae\x3e\x29\xc7\xcd\x41\x4b\xe6\x30\xfd\x91\x19\xac\x8d\xa3\x0a\x61\xab\x95\x75\x53\x2b\x48\x26\x42\x0d\x93\xa2\x95\x28\x61\x25\x4f\xce\x24\xf2\xa2\x85\x95\xc0\x84\x77\x53\x6e\x42\x73\xa1\x1a\xfe\xf4\xdc\xd7\x95\x28\x30\x38\xb9\xf2\x4a\xc4\x7c\x2a\xb3\x0e\x28\x02\xc1\xcb\x10\x7e\x7a\x8f\x54\xd2\x63\xb0\x24\x2a\x36\xbd\x1a\x15\xc7\x6d\xcb\x93\x8c\xd7\x5c\x99\x0d\x7c\x39\x99\x85\x5d\x73\x32\x83\x57\xd3\x26\x9e\x7c\x4a\x0e\x20\xb1\x74\x25\x33\x9b\xc7\x42\x24\x43\x83\x6a\x68\x1e\x12\x8f\x6f\xfa\xf7\x5f\x08\xbe\xcc\xa3\xa8\xbe\x6f\x99\xc2\x75\x69\x05\x97\x0d\x9e\xa1\x1d\x3a\x7d\x44\xc0\x42\x7e\x30\x42\x39\xa0\x17\x74\xa0\x53\x44\xd6\x99\x68\xe3\x8e\xfc\x57\x83\x12\x12\xb7\x26\x4b\xbb\x8f\x3f\xe3\x32\x91\x0c\x27\x39\x80\x17\x73\x35\x25\xc6\x9c\x6e\x0b\xd5\x74\x31\x05\x37\x62\x54\xf4\x36\x39\xff\xc8\x2b\x6b\x78\xcb\x0d\x74\xd5\xb5\xd4\x96\xe7\x45\xb2\x5c\x6a\xd6\x8c\x77\x8b\xcc\xe3\x7f\xc7\xdd\xfd\xd9\xad\xf8\x40\xb2\x56\x63\xfa\x0d\x7f\x72\x79\xbc\x99\x6c\x61\xd7\x55\x7d\xe6\x98\xc7\x69\xc4\x53\xda\x32\x45\xb2\xc1\xbf\xee\xcd\xf3\x7f\x41\xe8\xb9\xd2\x68\x41\x54\x52\x03\x3b\x1c\xb8\x6a\x72\xc3\x6d\xb9\xb4\xa3\x58\x38\x15\xbf\x4f\xfe\xa4\x83\x08\x84\xfc\x09\x00\x00\xff\xff\xb6\xe2\x6b\x23\xb5\x05\x00\x00"
+var _mssqlManytomanyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x55\x4d\x6f\xda\x40\x10\x3d\xdb\xbf\x62\x6a\x55\x8d\x9d\x12\xa7\xb9\x46\xe2\x90\x26\xd0\x26\x4d\xa1\x05\xda\x46\xaa\xaa\x60\xf0\x1a\x4c\x8d\x17\x76\x4d\x0a\x42\xfe\xef\x9d\xd9\x35\x0e\x36\x4e\x9a\x8f\x43\xd4\x03\x66\x3f\x66\xde\xcc\xbe\x7d\x33\xbb\x5e\x1f\xc0\x6b\x39\xe6\x22\x81\xe3\x3a\xd8\x6a\x14\x7b\x53\x06\x6e\x6f\x35\x63\x6e\x8b\x86\x16\x13\xc2\x02\x4b\xce\x23\x99\xd0\xc0\x1f\xe0\x67\x8e\x3f\xc1\x24\x7e\xaf\xda\x97\x7c\x64\x39\x70\x90\xa6\xe6\x9a\xe0\x44\x25\x5e\x87\x05\x8f\x84\xcc\x12\xdb\x42\x0e\x42\x16\xf9\x04\xec\x36\xb9\x60\xe1\x28\xfe\xc4\x56\x04\xdc\x54\xeb\xb9\xd9\x84\x87\x71\xb3\xca\xb4\x64\x27\x36\x9e\x64\x46\x30\xf7\x81\xa2\xf1\x45\x01\xb7\xe8\x50\xb2\x4e\xbc\x41\xc4\x34\x05\xc3\x31\x9b\x7a\xe0\x76\xb3\xff\x0d\x0f\x3d\xb2\xd0\x5f\xa2\xc4\x29\xa6\xdf\xbb\xd3\x9f\x92\xb8\x1b\xe0\xf0\x10\xd6\x6b\xd0\x24\xa7\x29\x08\x96\x2c\x44\x2c\x21\x19\x33\xb5\x5e\xb8\x84\x34\x95\xe0\x49\xc9\x87\xa1\x97\x30\x1f\xfe\x84\xc9\x38\x37\xdc\xb6\xc2\x45\xc1\x17\xa3\x31\xec\xe1\xce\x56\x76\x69\xba\xe7\x62\x40\x8a\xf9\x81\xc5\x4c\x28\x94\x40\xf0\x29\x04\x9a\x17\xf8\xcd\x56\x52\x79\x15\x2e\xa1\x6a\x98\x85\xda\x03\x2f\xf6\xb5\x47\x89\xde\xfb\x9c\x5c\x33\x58\xc4\x43\xb0\x29\x3d\xad\x3d\x4c\x7a\xbf\x7c\x0c\x67\x9b\x19\xb2\x1d\x26\xcb\x99\x27\xbc\x29\x4e\xfd\x01\x5c\xb5\xcf\xde\x3b\x60\xff\xfc\xb5\x5f\x41\x54\x0d\x50\xb0\x5c\x20\x84\x69\xdc\x78\x82\x66\x7a\xc5\x34\x0d\x3c\x3e\xea\x18\xe6\x0b\x26\x56\xa6\x31\xe4\xb1\x4c\x40\x0b\x1b\xea\xd0\xef\x36\x2e\x1b\xa7\x3d\xe8\xc3\x5b\xd3\x30\xfa\x14\x95\x47\x33\xd4\x52\xb8\xa4\xb2\x90\xb7\x91\x94\x80\x24\x56\x80\x45\xd9\x67\xf6\xcd\x4e\xfb\x33\xa5\x9d\xe9\x89\x6e\x74\xb3\x75\xd1\x3e\x6f\x41\xf9\x42\x60\x02\xed\x16\x4c\x5c\x1d\x47\x15\x5e\x41\xb8\xee\x29\x8f\xc8\xac\x0e\xa2\x6c\x53\xd8\xcf\x62\xfc\xf8\xd8\xe8\x34\x4a\x70\x93\x5d\x2c\xdc\x8e\x93\xb1\xe6\xf2\x1d\x2e\xf5\x35\x2b\x62\x11\x6f\x58\x51\x05\x6d\x6b\x56\x6a\xb0\x7d\x4f\x84\xad\xeb\x3a\xbf\x27\xd3\x98\x2b\xbe\x49\xfe\xfe\xc0\xd5\x37\x15\xc4\x60\x7d\x25\x30\xeb\xf6\xf6\x3c\x31\x22\x11\x3f\x14\x35\x0c\x14\xea\xab\x3a\xc4\x61\x44\x37\x69\xe8\xf2\xa0\xa9\x0a\x68\x1a\xa9\x69\xf8\x2c\x60\x02\xe6\xee\x69\xc4\x25\xb3\x1d\x7d\x94\x88\x7b\x3e\x16\x93\x5c\x44\x89\x34\xd1\x4d\x52\x6e\xd5\x52\x59\x23\x06\xea\x1f\x11\x5a\x6c\x99\xd8\x4a\x32\x06\xa5\x23\x72\x69\x1e\xd7\xab\x8a\x51\xdb\x1d\x00\xa6\x99\x6f\x7d\x11\xe1\xd4\x13\x2b\xd4\x3a\x1a\xe0\xbe\x71\xcd\x96\xa1\x4c\xe4\x31\x24\x62\xc1\x6a\x1a\x99\xc5\xba\xf1\x18\x98\x3e\x7e\x48\x8f\x43\x2f\xc6\x11\x9d\xb6\x8e\x89\x74\x71\x4a\x94\x29\x42\xaa\x65\x67\xcf\x44\x18\x27\x60\xbd\xb1\x36\x89\x3a\x9a\xb4\x0a\xd6\x76\x69\xd3\x81\x89\x96\x3a\x78\xb3\x19\x26\x64\xe3\xa4\x06\x6f\x0a\xe7\x76\x4c\x65\x97\x79\x2b\x03\x84\x30\x71\x0d\x53\x3e\xf1\x7d\xe2\xe4\x3c\x61\xd3\x4d\xdb\xc9\x7b\xd3\x9d\xfd\xeb\x89\x3d\xeb\x61\xdd\x62\x37\xa3\xea\xa6\xa1\x95\x27\x8a\x50\xa5\x44\x1d\xdd\x2d\x1e\xdf\x3e\xce\x5b\xdd\x46\xa7\x07\xe7\xad\x5e\x7b\xb7\xd8\xed\x42\x57\xa9\x2e\x4f\x95\xdd\x7d\xbd\x20\xc3\x70\xe0\xfb\xc9\xe5\xb7\x46\x77\x1b\xb4\x50\xd4\xb5\x42\x99\x1f\x6d\x79\x3e\xaf\xdc\x4b\xf4\x29\x83\xbc\x1d\xdd\x16\xef\xb5\x6e\x09\xa5\x8e\xd0\x58\xb2\xe1\x13\x1b\xc2\x03\xe3\x66\x6a\x25\x99\x6b\xa1\x76\xd8\x94\xdf\xb0\xb2\x56\x85\x5a\xd5\x42\xdd\xe8\x36\xe4\x31\xf0\xe0\x45\xb4\x5b\x99\xe4\x0b\xc8\xf7\x0c\x5f\xbf\x5e\x03\xf2\x67\xac\x20\xdf\xc2\x23\xf3\xc8\x27\x06\x4e\x5a\x67\xff\x52\x76\xc9\xed\xe8\xd9\x2f\xd3\x7f\x25\xd5\xbf\x7f\x84\x0f\xa6\xda\x0b\x00\x00"
+
+func mssqlManytomanyGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mssqlManytomanyGoTpl,
+		"mssql.manytomany.go.tpl",
+	)
+}
+
+func mssqlManytomanyGoTpl() (*asset, error) {
+	bytes, err := mssqlManytomanyGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mssql.manytomany.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mssqlProcGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x54\x5b\x4b\xdc\x40\x14\x7e\xde\xfc\x8a\x43\x10\x4c\x24\xc6\x77\x61\x41\x5a\x7d\x28\xb4\xae\x55\x0b\x3e\x08\x75\x4c\x4e\x76\x03\x71\x66\x9d\x99\xe8\x4a\x98\xff\xde\x73\x66\xb2\xd9\x44\x6c\xb1\xb4\xd0\x3e\x24\x99\x73\xfb\xce\xed\x9b\x74\xdd\x21\xec\xad\xb5\x2a\xe0\x78\x0e\x89\x29\x56\xf8\x20\x20\xbf\xea\xbf\x17\x64\xf0\xaf\x73\xf1\x80\x29\x1c\x3a\x17\x75\x1c\x60\xea\x25\xfb\xc7\x31\x38\xd7\x75\xb0\x87\x1b\x2c\x26\x0a\xa1\x97\x66\xa2\x50\xad\x65\xb9\x12\x8d\xc1\x01\x47\x0b\xb9\x44\xd8\xab\x33\x2a\x81\xad\xf9\x85\xd0\xe2\xc1\x50\x48\x34\x1b\xf2\xcc\x61\xad\x6b\x69\x83\x90\x28\x0d\x89\x90\x25\x05\x41\x9c\x41\x9c\x52\x06\x7a\x4e\xe2\x3e\x36\xbc\xb9\x5a\x88\x61\xaa\xbc\x7e\x59\xe3\x0e\xd9\x57\x3c\x40\x7b\x69\x8a\xbd\x85\x86\xb7\xb1\xe7\x6f\xea\xb7\xf0\x75\x05\x2b\x61\xae\xda\xaa\xaa\x37\x13\xaf\x2f\xaa\xa4\xe8\xc5\xb7\xeb\xd8\xfb\xfe\xa4\xcb\x18\xc8\xe3\x22\x38\x0d\xe3\x9d\x16\xfb\xda\x85\xe7\x3b\x07\xab\x5b\x1c\x01\xfb\x2d\x0c\x71\x5e\xa2\xa1\x99\xc7\x26\xe7\x6a\xcb\xe4\x36\x7e\xab\xb7\xdb\xde\x67\xd1\xda\xee\x14\x8d\x3d\xe6\x41\x06\x53\x06\x9f\xe4\xb1\xcf\xe2\xd2\x78\xe8\x16\x79\xa7\x7f\x23\xeb\x90\x67\x0c\x4e\x0b\xe9\xe9\xc2\x47\xa6\xce\xd1\x11\x50\xcb\x79\x3f\x71\x28\x44\xd3\x18\xb0\x2b\x04\x63\x95\xc6\x12\x98\xce\x58\xb6\x1a\x61\x9f\x47\xe3\xd9\xed\x5c\xc2\x67\x9e\xae\x73\xe9\x3e\x28\x09\xe5\x7d\x1e\xf5\xcb\xf2\xe3\xf3\xc8\x0c\x7e\x4d\x50\x61\xba\xb0\xe6\x02\xd1\xa2\x36\x20\x08\x6f\x2d\x8c\xa1\x04\xc2\xc0\x5a\x51\x77\xa4\xce\x80\x19\x63\xd0\x82\x55\xbe\x86\x27\xd1\xb4\x68\x40\x55\x2c\x31\xda\x08\xe2\x79\x85\xd2\x3b\xed\x2a\xd4\x68\x5b\x2d\x4d\x1e\x8d\x7a\xad\x5a\x59\x8c\x3b\xe4\xca\x0b\xbb\xf1\x40\x24\x96\xf7\x70\xb3\x38\xfd\x40\xca\x70\x7f\x76\xb7\x26\x9b\xcc\x85\xce\xef\xe3\xe1\x01\x79\x86\xd4\x8c\x89\x96\x2f\x4a\xde\x5f\x97\xc1\x94\x02\x6a\x4d\x37\xa4\x8b\x66\x4f\x42\xb3\x10\x14\x51\x34\xa3\x26\x69\xbb\xf0\xd8\xa2\x7e\x89\x66\x85\x92\xc6\xb2\xc2\x58\x4d\x34\xb8\x3b\xbb\x39\xfb\x08\xa3\x3d\x0c\x84\x76\xee\x2e\x04\xeb\x56\x6e\x83\x6f\x16\x9f\xd5\x32\x09\xc1\xe4\xb8\x54\xbe\xe9\xa6\x26\xc4\x6d\x9b\x9e\xe3\xe1\x3f\x42\x55\x45\xb3\xef\x99\x2f\x66\xce\xfb\x0c\x83\xaa\x24\xc4\x67\x94\x21\xde\xcd\x8e\x58\x48\xc2\x80\x1b\x58\xe9\xc3\xc3\x02\x18\x22\x72\xd1\x2b\x6a\x5d\xaa\x67\xf3\x07\xf4\xca\x18\x2e\xe0\xd7\x72\xe9\x21\x34\x23\x12\x37\x6a\x6b\xc8\x62\xda\xc6\x32\x77\x0c\x24\xad\x09\xc6\xfc\x1c\x37\xf6\xd2\x5b\xae\x02\xa9\x44\xf9\x24\x64\x81\x74\x64\x38\x06\x91\xe4\x32\x8a\x4e\x7f\x9b\xc6\x4c\x57\xe5\x31\xb7\x25\xb1\xb6\x68\x14\x91\xfb\x97\x4c\xe4\x81\xfc\x27\x6c\x4c\x0e\xf8\x8f\xc2\x05\x65\x81\x88\x29\x53\xf3\xdf\x52\xb1\xe7\xd2\x84\x88\x5f\x19\xed\x3d\x4c\x74\xd1\x0f\x0d\xdc\x37\xa8\x8c\x07\x00\x00"
+
+func mssqlProcGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mssqlProcGoTpl,
+		"mssql.proc.go.tpl",
+	)
+}
+
+func mssqlProcGoTpl() (*asset, error) {
+	bytes, err := mssqlProcGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mssql.proc.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mssqlQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x54\x4b\x6b\xdc\x30\x10\x3e\xdb\xbf\x62\x62\x96\x60\xb7\x8e\x73\x0f\xf8\xd2\x84\x42\xa1\x64\xfb\x3a\x04\x42\xa0\xda\xb5\xbc\x35\xd8\x92\x2d\xc9\xed\x2e\xc6\xff\xbd\x33\x92\x9f\xd9\xa4\x94\xd2\xc3\x9a\x99\xd1\xbc\xbe\xf9\x66\xb6\xeb\xae\x60\xa3\x7f\x48\x65\xe0\x26\x85\xd0\x4a\x82\x55\x1c\x92\x6f\xa7\x9a\x27\xf7\x24\x06\x5c\xa9\x00\x02\xdd\x94\xda\x90\x90\xed\xf0\xd3\xe0\x4f\x71\x8d\xdf\x87\xed\x47\x79\x08\x20\xf9\xdc\x72\x75\xfa\xc4\x14\xab\x74\x04\x57\x7d\xef\x77\x94\xbb\x21\xeb\xad\xac\x2a\x2e\x8c\xa6\x1a\xce\x6f\xb2\x8c\x8e\x45\x0e\xc9\x60\xb4\xb6\xeb\x6b\xe8\xba\xd9\x34\x78\xf1\x52\xf3\xe5\xb3\xed\xaf\xef\x41\xb5\x42\x03\x83\x7d\xab\x8d\xac\xc0\xd6\x8c\x41\x71\xd3\x2a\x51\x88\x03\x4a\xba\x2d\xb1\x18\xd3\x36\x6a\x86\xd6\xf7\x89\xcb\x2b\x32\x2a\x91\xb7\x62\xbf\xca\x1b\xa2\xb2\x37\xc7\x9a\x50\xa1\x9e\xed\xe0\x61\x7b\xf7\x0e\x8d\x8a\x89\x03\x5f\x61\xc6\xe7\x78\x15\x3b\x56\x42\x19\x45\x57\x21\xb2\x19\x11\xab\x90\x06\x92\xad\x28\x4f\x5b\x41\x0e\x8f\x4f\x93\xcb\x9b\xe7\x1d\xc6\x80\xf3\x97\x2a\x82\xce\xf7\x7e\x32\x45\x9a\xb3\xf8\xbe\x87\x63\x40\x5a\x1c\x60\xdf\x73\xa9\x93\x0f\xc2\x70\x55\xcb\x92\x19\x0a\xc7\x10\xca\x4d\x83\xeb\xfb\xbd\x14\xda\x4c\xa5\xc0\x51\x0a\x29\x4c\x88\x36\x45\x0c\x9b\x72\xe6\xc9\x35\x8f\x59\x37\x05\x05\xbc\x9d\x62\x9d\x35\x2c\x44\xc6\x8f\xcf\x59\xde\x14\x11\x39\x3b\x8e\x5e\xf1\x58\x4e\x65\x51\x81\x40\x90\x11\x39\xfe\x8e\x66\x6c\xc5\x09\x03\x41\x16\x31\x92\x3d\x22\xb6\xbb\x17\x3a\x18\xaf\xb1\xb2\x18\xf8\x7a\x32\x2b\xba\x96\xcd\x0c\x5c\x4d\x7b\x39\xf3\xe4\x18\xa0\xc6\xdc\xcd\x2c\x68\x1e\x13\xf9\x1e\x11\x94\x42\xb6\x4b\xdc\xf2\xe4\x02\x02\xdb\xd1\x17\xf9\x2b\x40\x87\x61\xa7\x98\x3a\xa0\xf2\xe7\xd6\x5f\xee\x30\x4a\xbe\xee\x99\xa0\x34\x79\xc1\xcb\x8c\xce\x55\x0f\x3d\xbc\x27\x83\x86\xb0\x56\x05\x1e\x4d\x70\x19\x0c\x8d\x46\x16\x8f\x87\x60\xa8\xb9\x8b\x14\x44\x51\xd2\x3e\x79\xee\x46\x48\xb5\x6b\xe6\x7b\x34\xe3\xc1\x78\xb9\xc4\x19\x93\xcf\x7c\x83\x84\xb3\xb1\x21\xb4\x2b\xe7\x58\xff\x0f\xd0\xbf\xec\xd8\xcb\x78\xce\x15\x34\xc9\x6d\x29\x35\x0f\x23\xb7\x26\xa5\x64\xd9\x78\xf9\x84\xc9\xfe\xfb\x3c\x3e\x9d\xdd\x57\x87\x09\x72\x49\xe1\xf7\xfc\x68\x42\x7b\x67\xde\x8a\xe2\x9b\xf4\x8c\xe5\x8e\xe6\x64\xcf\x0f\xa9\x40\xc9\x71\xde\xfc\x33\x33\x2f\x00\x3d\x47\x6a\xc9\xb1\x48\x52\x60\x75\x8d\x43\x0a\x51\x89\xd7\x44\x45\x2b\x0e\xed\xfb\xc4\x9c\x3b\x22\x7c\xfe\x0d\x37\x03\x87\x60\xf7\x05\x00\x00"
 
 func mssqlQueryGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -202,7 +343,7 @@ func mssqlQueryGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _mssqlQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\xb1\x0e\x82\x30\x10\x86\x67\x79\x8a\x7f\x30\x41\x07\xca\x6e\xe2\x64\xe2\xe8\x22\x2f\x50\xe1\x50\x92\xb6\x90\xb6\xc4\x98\xcb\xbd\xbb\x29\xa0\xe2\xd0\x6b\x72\xff\xd7\xaf\x3f\x73\x81\x6d\xd4\x37\x43\x38\x1c\xb1\x0b\xf5\x83\xac\x86\xba\x2e\x77\x95\x92\x79\x5e\xb4\xa5\x3d\x0a\x91\x2c\xbd\xe9\x5a\xa8\x53\x6f\x2d\xb9\x38\xed\xca\x12\xcc\xbf\xd5\x42\x91\x09\xb4\x8e\x93\x03\x22\xf0\x34\x78\x0a\xe4\x62\x80\x86\xef\x9f\x68\x7d\x6f\x91\x33\x7f\xba\x88\xe4\x6a\x36\xb8\x26\xc9\xe2\x6b\xa0\x3f\x43\x88\x7e\xac\x23\x78\x82\xbc\x76\x77\x82\x3a\x77\x64\x9a\x90\xf0\xcd\x1a\x65\x86\xa7\x49\xa0\xaa\x34\x45\xf0\x6d\x6b\xd2\x19\xad\x5b\xd8\xf5\x97\x92\x65\xef\x00\x00\x00\xff\xff\x5b\x7f\x83\xf0\x1d\x01\x00\x00"
+var _mssqlQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x55\x8e\xb1\x0e\xc2\x30\x0c\x44\x67\xfa\x15\x37\x20\x15\x86\xa6\x3b\x12\x13\x12\x23\x0b\xfd\x81\x40\x5d\xa8\x94\xa4\x95\x93\x0a\xa1\x28\xff\x4e\xd2\x46\x50\x06\xdb\xd1\xdd\xf3\xc5\xde\x57\xd8\x3a\x79\x53\x84\xc3\x11\x3b\x7b\x7f\x92\x96\x10\xd7\x3c\x9b\xe4\x2c\xfd\x22\x35\xed\x51\x85\x50\xf8\xb8\xd3\x77\x10\xa7\x41\x6b\x32\x6e\xd6\xea\x1a\xde\xff\xa4\x4c\x91\xb2\xb4\xb6\x53\x46\xf4\xc0\x34\x32\xd9\x08\x5a\x48\xf0\xf0\x42\xc7\x83\x46\x19\x91\x7c\x4b\x08\xa5\x58\x12\x4c\x9b\xc2\xdc\x7b\xa4\xbf\x04\xeb\x78\xba\x3b\xf8\x19\x62\x69\x1e\x04\x71\xee\x49\xb5\x36\xe1\x9b\x35\x1a\xdf\x4c\x73\x80\x68\x52\x8f\xd2\xf7\x5a\x95\x6a\xd2\x26\xb3\xeb\x2f\x43\x51\x7c\x00\x5b\x7f\x83\xf0\x1d\x01\x00\x00"
 
 func mssqlQuerytypeGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -222,7 +363,7 @@ func mssqlQuerytypeGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _mssqlTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x4f\x6f\xdb\xb8\x13\x3d\x4b\x9f\x62\x2a\x14\x88\xfd\xfb\xb9\x32\xf6\x1a\xc0\x87\x6e\xe3\x62\x83\x4d\x93\x20\x71\x76\x7b\x8b\x69\x73\x54\x73\x23\x91\x09\x49\xb9\x31\x04\x7d\xf7\x05\xff\x48\x91\x2c\xc5\x91\x9b\x3d\xd4\xaa\xc4\xe1\xe3\x70\x66\xde\x9b\x49\x51\x7c\x82\x8f\x6a\x23\xa4\x86\xd3\x19\x8c\xec\xff\x38\xc9\x10\xe2\x4b\xf3\x1b\xa1\x94\x11\x44\x12\x55\x04\x91\x7a\x4a\x95\x36\xaf\x74\x15\x41\xf4\xfd\xea\x42\xfc\x88\xc6\xf0\xa9\x2c\x43\x8b\xa2\xc9\x2a\x45\x87\xb2\xde\x60\x46\x20\xbe\xf5\xcf\x85\x59\x71\xbf\x06\xf5\x65\x0f\x4b\x20\xfe\x22\xb2\x0c\xb9\xb6\xdf\xa6\x53\x28\x8a\x97\x4f\xde\x0a\x53\x85\xcd\x65\xeb\x59\x59\x82\xc4\x47\x89\x0a\xb9\x56\x40\x40\x8a\x9f\x90\x48\x91\xc1\x49\x51\x54\xbe\x94\xe5\x49\xec\x10\x38\x35\x60\x7a\xf7\x88\x2d\x04\xa5\x65\xbe\xd6\x50\x58\x23\x49\xf8\x0f\x84\xf8\x2b\xc3\x94\x2a\x63\x1e\x34\x4d\x8b\x02\x24\x5a\x80\x78\x61\x7e\xcb\x12\x96\xff\x28\xc1\x4f\x23\xe7\x71\x6a\xfe\xe5\x19\xf7\xf6\xd1\x12\xea\xcb\xec\x2d\x35\x3d\xaa\x82\x70\x2d\x59\x46\xe4\xee\x4f\xdc\x99\xaf\x61\x30\x9d\xc2\xb3\x80\xc4\xba\x12\x06\xf7\xf8\xcc\x94\x56\x13\xb8\xa7\x98\xa2\x46\x0a\x2b\x21\xd2\xb0\x28\x2a\x98\x32\x34\x2f\x5d\xa0\xe9\x14\xe6\x76\x2b\x50\xd4\x28\x33\xc6\x51\x19\x33\xbd\x69\xc7\xc1\xe1\x03\xe3\x76\x85\x12\x4d\x56\x44\x61\x1c\x26\x39\x5f\xc3\xc8\x04\xd4\x95\x48\x59\xc2\xff\x1a\xfb\xc6\x1e\x7d\x34\xb6\x0e\x41\x11\x06\x12\x75\x2e\x39\x34\xb7\xc4\xde\x7d\xe3\xe5\x74\x0a\x67\xfe\x0a\x8f\x52\x6c\x19\x35\xfe\xf0\x44\xc8\x8c\x68\x26\x78\x9f\x6f\x1b\xa2\x60\x85\xc8\xa1\xba\xbb\xcd\xf2\x91\x7e\xfa\x43\xdf\x72\xd4\x1f\xe1\x3d\x3d\xe7\x0a\xa5\x06\x66\x1f\xaa\xe3\x98\x16\xc7\x7a\xe1\x00\x47\x74\x05\xdf\xaf\xce\x7e\x1f\x03\x4a\x29\xa4\x71\x66\x4b\xa4\x79\x71\x1f\x5c\xfa\x59\x02\x24\x95\x48\xe8\xce\x65\x67\x02\x2b\xc2\xd2\x30\x60\x49\x6f\x70\x0d\x4a\x75\x27\x8b\xa2\xe2\x4b\xfc\x39\x8a\x9c\xf3\x90\x10\x96\x22\x3d\x6d\x43\xaa\x68\x1c\x06\x2f\xa5\xe3\x58\xfa\x8d\xf0\x9c\xa4\xd7\x0f\x60\x19\x30\x9d\x82\x7a\x4a\x7d\x08\xe0\x29\x47\xb9\x9b\xc0\xa3\xab\x31\x78\xc0\x1d\x64\xb9\xd2\xb0\xc2\x2a\x9b\x34\x0c\xd6\x82\x2b\x0d\x4e\x2b\x60\x06\xcb\xf3\xcb\xdb\xf9\xcd\x02\xce\x2f\x17\x57\xd0\xa4\x26\x8c\x96\xf0\xff\x30\x08\x96\x45\x01\x6b\x91\x1a\xd1\x51\x0d\xf6\xf9\xc5\x31\xfc\xf5\xf9\xe2\x6e\x7e\xbb\x67\xbd\x25\x69\x9f\xf1\xd2\xc5\x4e\xe6\xdc\xf9\x1a\x06\x56\xa5\x46\xce\x9b\x89\x39\xdf\x72\xaa\x7d\x58\x1d\xcc\x71\x18\xdc\x4f\x6c\x22\x66\x40\x57\xf1\xfc\x19\xd7\x47\x6c\x65\x89\xdd\xfa\x61\x06\x9c\xa5\x7b\xf9\xb0\x71\xb6\xd1\x44\xed\x82\x8f\x7c\x8d\x56\x61\xba\xa9\x9c\x81\x96\x39\x5a\x7a\x1b\xe5\x1b\x94\x87\x2a\xfe\xb0\xda\x01\xa3\xc8\x35\xd3\xbb\xff\x28\x17\x0d\x4d\xa9\x4a\xf9\x88\xe4\x1c\xd8\xfd\xae\x6c\xf5\xe0\x8e\x0d\xab\x95\x4b\xe0\xe9\x91\x19\xec\x87\x1b\x94\x52\x89\x5a\x32\xdc\x22\x30\x1a\x06\x8c\xd6\xe7\x4b\x54\xf1\x05\x51\xda\xb1\xfe\x9c\x8e\x8e\xa9\x91\x66\x6e\x09\xa7\xaf\xd6\x8c\xd1\x97\xae\xeb\x30\x83\xbd\x05\xdf\xb3\x46\x8c\x8e\xdf\xae\x3a\xd7\x54\x6a\x8d\xe4\x2c\x7d\xe9\x30\x1c\x61\x34\x3c\x8c\x63\x88\xa2\xaa\x80\xef\x1e\x29\xd1\x08\xb9\x7d\x74\xe5\xb4\xd3\x7c\x82\x37\xf5\xd4\x21\xf6\xe8\x69\x47\x50\xbd\xa2\x52\x81\x8a\x9f\xe8\xb6\xa2\x9a\xa4\x7c\x78\x55\x53\xfb\x44\xd5\x5d\xa1\x16\x55\x83\x0a\x5c\x78\x58\x23\xaa\x36\x93\xd5\x99\xae\xa7\x34\x4f\xeb\x6d\x3a\x43\x4f\xcb\x88\x7c\x30\x5d\x50\x48\x07\xcd\x04\x6f\x1d\x69\x74\xc2\xd3\xa9\xc3\xff\xbb\xeb\xb3\xcf\x8b\x79\x9b\xfa\xb7\xf3\x05\x38\x46\xb6\xe8\x6f\x21\xea\xf4\x46\x13\x88\x5e\xa7\x72\xb0\x84\xbf\xff\x98\xdf\x58\x60\xbf\xbf\x65\xfc\x45\xa4\xae\x28\x3f\x3a\x83\xb5\xc8\xb9\x3e\xa8\x10\xfe\x2e\x0d\x69\x78\xa7\x36\x4c\x60\x00\x6d\x4c\x18\x7f\xad\x01\xbc\xe7\xc4\x1e\x05\xb8\x25\x5b\x04\x45\xb6\x3d\x34\xe9\x4c\x1d\x6f\xd3\xc4\xa0\xf5\x91\x64\xbf\x12\xeb\x61\xae\x59\x89\x2d\x8b\x9a\x70\x75\xc1\xf5\x59\xd5\x63\x8e\x1d\x2f\xf6\xda\x98\x57\x01\xa5\x89\xc6\xcc\xce\xee\x22\x63\xda\xd4\x3f\xcd\xd1\xdc\x2e\x25\xeb\x07\x10\x89\x1f\x7e\x41\xe8\x0d\x4a\xd0\x1b\xc2\x9b\x9a\xd8\x94\xa9\x7a\xa6\xf4\x54\xeb\xc6\xec\xd7\x27\xc6\xc1\xb3\x5a\xaf\xb2\x1c\x14\x96\x86\xb8\x56\x69\xef\xaa\xc5\x41\xb1\xe8\x41\x68\x90\x7f\x9f\xfb\x67\xf3\x8b\xf9\x62\x0e\x5f\x6f\xae\xbe\xb5\x05\x60\x20\x75\x7f\x1b\xd0\xae\x07\x94\xfb\x21\x7e\x0d\xd8\x3e\xb8\x81\x56\xa3\x7c\xd0\x1f\x3f\xdf\xed\xf6\x7a\x5c\xe3\x2f\xb3\xf0\xdf\x00\x00\x00\xff\xff\x79\x34\xf0\x19\x1a\x0f\x00\x00"
+var _mssqlTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x59\x6d\x53\xdb\x38\x10\xfe\xec\xfc\x8a\xad\xa7\xd3\x3a\x47\xea\xc2\x57\x7a\xe9\x0c\x2d\x69\xcb\x1c\x04\x8e\x84\x5e\x67\x18\x86\x38\xb1\x02\x3e\x1c\x39\x48\x36\x0d\x47\xf3\xdf\x6f\x57\x92\x13\x39\x76\x5e\x78\xb9\x99\xfb\x10\x27\x92\xf7\x7d\x57\x8f\x56\xca\xc3\xc3\x3b\x78\x2d\xaf\x13\x91\xc2\x6e\x13\x3c\xf5\x8b\x07\x23\x06\x7e\x9b\x9e\x2e\x13\xc2\x05\x57\x30\x89\x4f\x79\x1b\xcb\x94\x86\x61\x1f\x1f\x3f\x8e\x0f\x93\x2b\xb7\x0e\xef\xa6\xd3\xda\x03\x49\x49\x83\x7e\xcc\xb4\x94\xc1\x35\x1b\x05\xe0\x77\xcc\x77\x97\xde\xe8\x27\x49\xb5\x78\xc6\x71\x26\x82\x58\x31\xe9\x9f\x73\xdd\x16\x55\xff\xb1\x06\xde\x66\x4c\xdc\x17\x0d\x05\xb7\x1f\xa4\x83\x6b\xfc\xbe\x0b\x62\x22\x8e\xa3\x11\x3e\x39\x7e\x22\xfa\x84\xea\x41\x2f\x6e\x6d\xa7\x44\xf2\x53\x6a\xf3\x44\xc4\xd3\x99\x29\xae\xb4\x88\xa2\x21\xf8\x9f\x93\xd1\x88\x21\x01\xcd\xbd\x7f\x0f\x0f\x0f\xf3\x29\x43\xc5\x62\xc9\xec\xd7\xca\xfc\xe9\x14\x04\x1b\xa3\xf5\x48\x28\x21\x00\x54\x07\x43\x91\x8c\xe0\x2d\x92\x98\x88\x4e\xa7\x6f\x7d\x2d\x81\x87\x24\x2c\xbd\x1f\xb3\x82\x04\xf4\x39\x1b\xa4\xf0\xa0\x88\x44\xc0\xaf\x30\x38\x5f\x22\x16\x87\x92\xc8\x1d\x9b\x14\x7f\x0b\xa6\x04\xf8\x5d\x7a\xe2\x54\xef\x6f\x99\xf0\x5d\x57\x5b\x1c\xd3\x27\x1b\x71\x43\xef\xf6\x60\xe6\xcc\xc2\x2b\xdb\xa2\x3c\x08\x27\x22\x1a\x05\xe2\xfe\x0f\x76\x4f\xb3\x35\x07\x79\x27\x09\x0c\x95\x29\x35\xe7\x92\x4d\x22\x99\xca\x06\x5c\x86\x2c\x66\x29\x0b\xa1\x9f\x24\x31\x32\xe7\x62\x90\x05\x07\x65\x41\x28\xa6\xa5\x58\x21\x44\x36\x31\x8a\x38\x93\x44\x96\x5e\x17\xe3\xa0\xe5\x43\xc4\xd5\x9b\x30\xc0\xf0\x05\x92\xf9\xb5\x61\xc6\x07\xe0\x51\x40\x75\xf2\x90\xf4\x37\x8b\xaf\x6e\xa4\x7b\x75\x65\x10\xc6\xd1\xc1\x18\x65\x82\x83\xcd\xe2\x1b\xf3\xc9\x4a\x34\x68\xdf\xb8\x30\x16\xc9\x5d\x14\x92\x3d\x7c\x98\x88\x51\x90\x46\x09\xaf\xb2\xed\x3a\x90\xd0\x67\x8c\x43\xee\xbb\xca\xf2\x23\xed\x34\x4a\xd7\x19\x6a\x54\x18\x4b\x0f\xb8\x64\xf8\x22\x52\x5f\xb2\x64\x58\x9a\x3c\xd6\x0a\x2d\x90\x28\x06\xe9\x64\x1c\x88\x60\x84\xd3\x61\x1f\x7e\x1c\xef\x7f\xaa\x03\xae\xc7\x44\x90\x69\x77\x81\xa0\x81\x9e\xd0\xc5\x80\x71\x09\x62\xc1\x82\xf0\x5e\xe7\xaa\x01\xfd\x20\x8a\x6b\x0e\xce\x57\x85\x9a\xa4\xe4\x1e\x2a\x29\xd2\x6f\xb3\x9f\x9e\xab\x5d\x81\x21\xf2\xb2\x70\xb7\x28\x12\xd7\x65\xcd\x99\x17\x92\x46\x9e\xa3\x80\x67\x41\x7c\x72\x03\x6a\x3d\xa0\x21\x08\x14\x26\x20\xa0\xa0\xa2\x81\x79\x54\x15\x07\x37\x58\x72\xa3\x4c\xa6\x98\xac\x3c\xb7\x61\xcd\x19\x24\x1c\xa7\x34\xbc\x40\x13\x7a\x07\xed\x4e\xeb\xb4\x0b\x07\xed\xee\x31\xd8\x0b\x15\xbc\x1e\x6c\xa1\xd1\x3d\x0a\x4e\xa2\xc0\x4c\x5a\x6b\xd1\xbc\xac\xc3\xf7\xbd\xc3\xb3\x56\x67\x81\x9a\x70\xa9\x82\xb8\xa7\x63\x27\x32\xae\x6d\xad\x39\x0a\xd0\x3c\x6d\x4d\x83\xf4\xab\x15\x56\x54\x36\x0b\x26\x86\xe3\xb2\xa1\x12\xd1\x84\xb0\xef\xeb\xac\x0d\x39\xb8\xad\x09\x1b\xb8\x48\x60\x12\x19\x88\x2b\x1c\x6c\x2e\x14\xa3\x4b\x42\x5f\x35\x81\x47\xf1\x42\xa6\x54\x06\x54\x9c\x59\xaa\xd3\xc2\xf8\x80\x29\x24\x2a\x27\xb9\x09\x08\x5f\x4c\xc1\x00\x21\xe4\x46\x19\xca\x33\x03\xfd\x7b\xc0\x6f\x9e\x46\xe9\xfd\x0b\x65\xc9\xc2\x9e\xbc\xe4\x1f\x91\xb6\x15\xdc\xcf\xca\x63\x85\xdc\x3a\xad\x7e\xa9\x53\xbb\xfb\x62\xb9\xad\x56\xb4\x51\xb2\x71\x4a\x44\xec\x8e\x61\x46\x90\x23\x9c\x59\x86\x56\xfa\x87\x81\x4c\x35\x6e\x1c\x20\x7c\x3d\xa2\x7a\xec\xac\x07\xb8\x4d\x2c\xab\x26\x42\xa8\xb2\xe9\x58\x05\x0b\x2f\xcc\xae\xe7\x45\x61\x7d\x7d\x3d\xea\x6d\x69\x86\xb2\x68\x6a\x01\x53\x89\xdd\x34\x30\xa8\x6a\x09\xc0\xca\x45\x84\x85\x4c\x46\xfc\x0a\x41\x26\x4e\xa3\x77\xb8\xdf\x93\x3c\xc3\xdb\xa0\xbd\x4b\xb5\x29\x98\x98\x64\x88\x88\xfa\x89\x06\x9d\xe8\x1f\x46\x9d\x81\x34\xe0\x5c\xa1\xbd\x1a\x8d\x55\xae\x75\x0b\x83\x06\x9e\x5f\x14\x71\x7c\x86\xd4\x2b\x90\x19\xf7\x34\xb8\xd4\x62\xfa\xb3\xed\x80\x72\xaa\xba\x0c\x5b\x3a\xe5\xd0\xe0\x78\xbf\x12\xc8\x9f\x84\xe4\x58\x0a\x0e\xf5\x17\xf0\x9a\xe3\x52\xd3\x9d\x58\x8c\xbb\xa8\xa9\xda\xba\xdd\x7d\x2c\x60\xfd\xff\x0b\xea\x7b\xf3\x1e\x30\x6f\x2d\xb5\x47\xe8\xd0\x15\x4b\x65\x1a\x88\x74\x90\x64\xd8\x2e\x56\x40\x89\xe5\x6c\xee\xd2\x88\x09\x4c\xc0\x86\xd0\xe8\xc3\x9e\xae\xcb\x44\x84\x4c\x50\x65\xe1\x40\x89\x4a\xb2\x74\x9c\xa5\xaa\xb8\x20\x92\x90\xf1\x90\x0d\xb1\xbd\xc2\xc5\x7b\xd4\x3a\xfd\xda\x52\x73\x12\x85\x61\x04\x53\x4c\x0d\xb1\xea\x80\x34\x00\x1b\x9a\x08\x53\x7b\x7c\xd6\x3d\x39\xeb\x2a\x61\x83\x80\x83\x49\x32\x29\x8b\x50\xd8\x84\x38\x58\x30\xb8\x06\x99\x64\x62\xa0\xca\xb8\x1c\x63\xad\xab\x1c\xe2\xb3\xce\x41\xfb\x2b\x78\xb3\x10\x5a\x8c\x62\x40\x8c\x75\xd8\xeb\x80\x04\x6f\x92\x5c\x46\xe1\x44\xd5\xe9\x26\x80\x5e\x87\xe3\x36\xec\xa0\x80\x6d\x30\x79\xfa\xeb\x5b\xab\x0d\xed\xe3\x2e\x1c\xed\x75\x3f\x7f\x6b\xed\x43\x97\x26\x4c\xee\xbd\x8d\xc5\x1a\x69\xf9\x3e\xa1\xf9\xb0\xbd\x1f\x46\x93\x22\x37\x9e\x1f\x56\x4a\xd0\x51\x05\xe9\xe7\x9e\x69\x53\x5a\xfb\xfe\xdc\x96\x82\x00\xec\xcd\x91\xff\x43\xcf\x6e\xcb\x55\x52\xf0\x98\x13\x99\x04\x8f\x31\xf7\xaa\x62\x72\x48\x1a\x05\x13\xe0\xd9\xa8\xaf\x6b\x42\x81\x07\x62\x10\xc1\x2c\xd1\xd7\x1c\xe4\xa5\x15\x67\xc1\x90\x42\x6e\x9a\xfe\x08\x3b\xdb\xdb\xdb\x6a\x61\xd3\xb0\xa9\x86\x7a\xad\x2e\x5b\x8d\xe6\x8d\xa9\x7b\xaa\x65\x1c\x71\x52\x80\x3e\xa1\x29\x5a\x3f\xe5\x5d\x1d\x39\x66\x74\x1f\x8c\x42\x6e\x69\xe3\xb9\x2a\xeb\x04\x32\x6b\x1f\x96\x8b\xf5\x6c\xb9\xb0\x05\x3b\xf5\x4d\x84\x6b\x18\x44\xd8\xf1\x2c\xc0\xab\x23\x93\xf6\x5f\x01\xb6\xd1\x97\xbf\xd5\x60\x48\x2c\xea\x2d\x11\x93\x6c\x85\x83\x9a\xbe\xa9\x81\xfe\x7c\x17\xe7\x2f\x14\xd0\xe1\x83\xf2\x95\xe0\x32\xc3\x9e\x22\x63\x98\x00\xd5\x44\xab\x06\xe3\xfc\x02\x8f\x9f\x4c\x0c\x83\x01\x7b\x58\x09\x79\x6b\x20\x5b\x2b\x57\x76\x28\xb9\x4d\x08\xc6\x63\xf4\xd3\xa3\xd1\xd2\xde\xa0\x6f\x35\x7e\xce\x42\xb4\x95\xc2\xe8\xa9\x0a\xa3\x75\x3a\xab\x1b\x92\xc5\x04\x15\x3b\xab\x55\xe1\xd1\x0b\x00\xcd\x33\xf0\xb3\x85\x47\xd4\xef\x2a\xdc\x9e\xab\x0a\x46\x12\xa1\xdb\xb0\x92\xd7\x28\x94\x23\x69\xd7\x9d\x9b\x41\x5f\x72\xc4\xf7\x7d\x9a\xbf\x7c\x44\x3b\x56\xe6\x2e\x37\x45\x85\xae\xa8\x14\xf8\x67\xbb\xb2\xb5\x53\x47\x26\x0d\xa7\x2b\xbc\xba\xad\xf6\xea\x4f\x7d\xc9\xf2\x02\x6e\x59\x95\x6f\x6d\x65\x92\xb6\xb0\xc2\x46\x82\x83\x48\xe8\x2d\x44\xad\x0c\x3c\x69\xf3\xd4\x54\xe0\x07\xb8\xc5\x9e\x62\x92\x7a\xb8\xa4\xf9\xd6\x56\x5e\x71\x58\x9a\x86\x48\x0f\xc2\x25\x0d\x21\x11\xe8\x43\xd2\xad\xdf\xc1\x8d\xcc\x7b\x83\x95\xf9\x46\x75\x89\x95\x1e\x38\xb7\xfe\xe7\x38\x91\xcc\x53\x04\x45\x87\x9c\xa9\x61\x8a\xe0\x77\xc4\x88\x5f\xbf\xf0\xc7\xc7\xa6\x8d\x06\xab\x45\x2c\x6b\x8f\xb0\x3f\x88\x43\xe0\x49\x3a\x6f\xb5\xed\x70\xa9\x7e\x49\xeb\xd6\xd0\x12\x5d\x2c\x6f\x8b\xa9\x47\x57\xb4\xb9\xd3\x2d\x21\xbc\x25\xd9\x2a\xd8\xb9\x90\xba\xb9\x80\x39\xcd\xfa\x84\x3b\x1a\xa3\x5f\x95\x83\xf2\x32\x51\xa8\x40\x87\x85\xa3\xe8\xe6\x30\x59\xdd\xd1\x9a\x53\x82\xa9\x5e\xbb\x11\x2e\xec\x03\xe7\x73\xff\x76\x2f\xf4\xc1\xa6\x78\x98\xd0\xf7\x14\x9c\x81\x37\xc7\x40\x75\x34\x58\x71\x30\xcb\xdb\x41\xd7\xcd\x3b\xc2\xb3\x31\x9e\x2e\xf0\x64\xa1\xbe\xca\x77\x3c\xa5\x1b\x31\x67\xed\x25\x8f\x96\xb8\xf6\x92\xa7\x74\xcb\x63\x0e\x13\x61\xc2\x24\x7f\x9b\x16\x0f\x13\x94\xf4\x57\x4b\x2f\x7a\xaa\x52\xaf\x1d\x9a\xa5\x9e\xa4\xaa\xcc\x2b\x36\x93\xe9\xb9\x4e\x7d\xed\x65\x6b\xab\xbc\x17\xdb\x54\x1b\x46\xfb\x86\x2e\xea\xd0\x53\xc5\x19\x25\xbc\xa0\x92\xda\x70\x73\x92\x2f\x75\xb4\x67\x27\xfb\x7b\xdd\x56\xb1\x9b\xed\xb4\xba\xa6\xbd\x2b\x1c\x1a\x94\x88\x62\xca\x11\xb2\x5d\x04\x8e\x52\xca\x67\xe7\x0a\xa7\x07\xd8\xb1\x9e\xb6\x60\x89\x9c\x12\xa3\x0b\x7b\xed\x7d\x7c\x6e\x7e\xd6\xa0\xa5\x8b\xea\x4a\x7b\xab\xb3\xea\xda\x62\xb3\xca\x45\xb9\xa5\x5d\xbf\x44\x63\xdf\x35\x3d\xfb\x06\xeb\xbf\xb2\xab\xe2\xd2\xa2\x13\x20\x20\x49\x7c\x6c\x70\xd5\xba\x7e\x19\x92\xb4\xf5\x8b\x70\xb1\xd2\x67\xf7\xd9\x76\xa5\x17\x28\x0a\xcb\x5b\x07\x2e\xec\xcf\x8a\xbb\x8a\xa3\x70\xeb\x6b\x71\x4c\x17\x6f\xee\x0c\x16\x61\x95\xa5\x6c\xa4\xfe\xd6\x48\xf0\x04\x42\xeb\x2e\xcc\x18\xc5\x20\x0e\x06\x37\xb4\x9b\xeb\xff\x05\x20\xc1\x98\x08\x0c\x0c\x1e\x1e\x2d\x1c\xb7\xef\x5f\x66\xd7\xed\x66\x89\x97\x23\xfb\xf4\xcb\xf4\x27\x5e\x63\x57\xe2\xdb\x4a\x78\xb3\x60\x3f\x2f\x95\x32\x66\xad\x84\xac\x0a\x09\x16\x04\x2d\x22\xd0\x7e\xeb\xb0\x85\x08\xf4\xe5\xf4\xf8\xa8\x08\x43\xd5\xc0\xb1\x02\x33\x34\x0a\x3c\xe2\xea\x72\xe5\x9a\x79\xfe\x65\xf4\x4a\xf1\x1b\x5f\x2c\xe6\x7f\x92\x38\xd5\x01\x37\xfb\xfb\xc2\x76\x6d\x77\x15\xff\x02\xa4\xae\x9c\x6f\x3a\x1d\x00\x00"
 
 func mssqlTypeGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -242,7 +383,7 @@ func mssqlTypeGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _mysqlEnumGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\xc1\x6a\xdc\x30\x10\x3d\x5b\x5f\x31\x15\x85\x58\x21\xf1\xd2\x4b\x0f\x81\x3d\x95\x1e\x9b\x43\xd3\xe6\x52\x7a\xd0\x7a\x47\x59\x11\x5b\x6e\x24\x79\x93\x60\xf4\xef\x65\x24\x99\x95\xd3\x4d\x4b\x0a\x7b\x59\xc4\x68\xfc\xe6\xcd\x7b\x4f\xec\x34\x5d\xc2\x7b\xff\xfc\x0b\xe1\x6a\x0d\xcd\xb5\xec\x11\x2e\x43\x60\xb1\xec\x76\x83\xf5\x54\xaf\xe3\xc9\xd0\x65\xea\xe5\x68\xc6\xfe\x56\x76\x1c\xb8\xc7\x27\xcf\x81\x6f\x46\xc5\x81\x0f\xf7\x1c\xb8\xb3\x2d\x17\x07\x14\x8b\x7b\xb4\x0e\x09\xda\xc5\x21\x5f\x53\xe1\xd3\x60\x9c\x4f\x55\xea\x5d\xad\x60\x9a\x32\x7c\x08\xa0\x1d\xf8\x1d\xc2\xd9\x34\x41\xf3\xd9\x8c\x7d\xfc\x89\xf4\x42\x38\x03\x1a\x0f\xb1\x55\xd9\xa1\x07\xd7\xee\xb0\x97\xa9\xf9\x26\x9d\xa9\xad\x61\xb1\xa5\x84\x1d\xb5\xf1\x1f\x3e\x32\xd6\xd2\x70\xa8\x23\x43\x2b\xcd\x1d\x42\x73\x2b\xbb\x11\x1d\x84\xc0\xaa\xc4\x45\xab\x17\xe4\x43\xa0\x01\x99\x44\x81\x3a\x4d\x80\x9d\xfb\xb3\x58\xb4\xa2\xd9\xbe\xdc\xea\x56\x76\x71\xa9\x38\x37\x6e\x55\x7c\xdd\xb0\xea\x34\x0c\xd6\xe5\x94\x7a\xe6\x11\xbd\x98\x89\x08\x96\xdb\xc9\x16\xc1\xc8\x99\x1b\x6f\xb5\xb9\x03\x8b\x7e\xb4\x26\xed\xe0\x52\x69\x1f\x3f\x1a\x54\xac\x2d\x16\x50\xa3\x69\x81\x26\xe4\x1c\x85\x50\xde\x8b\x8c\x59\x8b\x19\x69\x62\xd5\x5e\x5a\xc8\xc9\xca\x55\xc6\x2a\xf7\xa8\x7d\xbb\x83\x25\xd0\x2b\xc6\xb5\xd2\xe1\x69\xac\xbb\x62\x55\x35\x53\x5b\x03\x3f\x66\x20\x2f\x75\xab\x02\x63\x55\xd2\x6b\x5e\x89\x85\xa8\xe5\x17\x69\xdd\x4e\x76\xdf\xf0\xc9\x43\x9f\xce\x6e\x19\x7d\xe3\x07\xa0\x67\xf5\x6f\x0d\x0b\xac\x5a\x40\xfd\xe3\xe7\xe6\xd9\xe3\x05\xa0\xb5\x83\x15\xa4\x68\x66\x90\x2e\x16\x40\xcd\xac\xbf\xb8\x00\xa3\x67\x72\xdf\x4d\x5f\xd0\x1b\xcd\x51\x82\xf1\xcd\xbd\x4a\xf0\x7c\xc1\x70\x01\x58\xd3\x47\x99\x8c\x48\x2c\x89\x64\x76\x38\x39\x1e\x7b\x44\xf5\x57\x87\x8f\xcb\x4f\x16\x9d\x2f\xa8\xac\x4f\x93\x05\x76\x38\xb1\x6a\x8b\x4a\x8e\x9d\xa7\xe1\xb3\xdd\xb4\x97\x6b\xae\xf1\xb1\xe6\xda\xec\x65\xa7\xb7\xa5\x7c\x5c\x2c\xc2\x71\xd0\x3e\x2d\xe2\xa4\xd7\x4e\x69\xcc\xaf\xec\xa1\x5b\x6d\xad\xde\xa3\x4d\x22\x58\x4a\x07\x5a\x25\x5b\x04\x45\xea\xbd\xe5\xc5\x45\x04\xca\x49\x89\x78\x24\x2d\x47\x63\x52\xa6\xe4\xa6\x95\xe6\x05\xd1\xad\xf4\x72\x23\x1d\xae\xdc\x43\xd7\xd0\xbd\x79\x33\xd7\x65\x70\x08\xa3\x76\xb6\x3d\x80\x4c\xa1\xc8\xcc\x66\x54\x17\x30\xdc\xd3\x1f\x8a\xb3\x6d\x93\xa3\x2f\x58\xa5\x15\xbc\x1b\xee\xa9\x05\x00\xfe\xcb\x91\xc5\xfa\xcb\xfc\x6e\x46\x25\x48\x83\xdf\x01\x00\x00\xff\xff\x33\x36\x2f\x65\x36\x07\x00\x00"
+var _mysqlEnumGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x56\xb1\x6e\xdb\x30\x10\x9d\xc5\xaf\x60\x84\x02\x91\x02\x47\x46\x97\x0e\x29\x3c\x15\x1d\x9b\xa1\x6e\xbb\x04\x19\x18\x99\x8a\x89\xc8\x54\x4b\x52\x4e\x03\x43\xff\xde\xbb\x23\x55\x91\xb6\x93\x20\x45\xbd\xd0\xd4\xf1\x78\xf7\xee\xbd\x23\xe9\xdd\xee\x92\xbf\x73\x4f\x3f\x25\xbf\x5a\xf0\xea\x5a\x6c\x24\xbf\x1c\x06\xb6\x43\xb3\x5d\x77\xc6\xa1\xbd\xa0\x99\xc6\x45\xef\x9b\x4b\xdd\x6f\x7e\x88\x36\xe7\xb9\x93\xbf\x1d\xfc\xdc\xf5\x0d\x8c\xdd\x03\x0c\xd6\xd4\x30\x4a\x63\x60\x54\xf8\xed\x8c\x1f\x2d\xfc\x6c\x69\x13\x8c\x36\x2f\xa7\x4c\x46\x6e\xa5\xb1\x12\xd3\x5b\x02\xf2\xd5\x1b\x3e\x75\xda\x3a\x6f\x45\xdf\xf9\x9c\xef\x76\x01\xc2\x30\x70\x65\xb9\x5b\x4b\x7e\x0e\xb6\xea\x33\x00\xa2\x81\x4a\x18\x86\x73\x8e\x10\x39\xb9\x36\xa6\xdb\x70\x5b\xaf\xe5\x46\x78\xe7\xa5\x9f\xa3\x5b\xc5\xc8\x25\x0e\xdb\x2b\xed\xde\x7f\x60\xac\xc6\xe4\xbc\x20\x84\x46\xe8\x7b\xc9\x2b\x28\xb9\x07\x2c\x00\x25\xf3\x58\x54\xb3\x07\x7e\x18\x30\x41\x00\x11\x45\x85\xa9\x6c\xed\xa1\x31\x72\x95\x7a\xb5\x5f\x15\xe4\xa3\xa2\x28\x2f\x55\x15\xed\xae\x58\x76\x1a\x04\x8b\x38\x4b\x31\xe2\x20\x2d\x46\x20\x25\x0b\xee\x28\x4b\xc9\x50\x99\xa5\x33\x4a\xdf\x73\x23\x5d\x6f\xb4\xaf\xc1\x7a\xd3\x96\x36\x75\x0d\xd9\x92\x02\x9a\x5e\xd7\x1c\x33\x84\x5e\x83\xe4\xd1\x7a\x19\x62\x16\xe5\x18\x69\xc7\xb2\xad\x30\x3c\x74\x5f\xb0\x32\x96\xd9\x47\xe5\xea\x35\x4f\x03\x3d\x23\x5c\x2d\xac\x3c\x8d\x74\x57\x2c\xcb\x46\x68\x0b\x9e\x1f\x13\x30\x8f\x79\xcb\x06\x80\xee\xf9\x1a\x4b\x62\x03\x71\xf9\x45\x18\xbb\x16\xed\x37\x38\x5b\x7c\xe3\xe7\x36\x6d\x7d\xed\x3a\x8e\x47\xef\x75\x0e\xa3\x58\x40\x64\x71\x73\x7b\xf7\xe4\xe4\x8c\xc3\xf9\xec\x4c\x89\x8c\x06\x04\x7e\x21\x09\x54\x8d\xfc\x97\x33\xae\xd5\x08\xee\xbb\xde\x44\xf0\x7a\x7d\x14\x20\x9d\xb9\x67\x01\x5e\x24\x08\x93\x80\x05\x6e\x0a\x60\x4a\x8f\x12\x41\x06\x85\xbd\xe2\xe4\x53\x66\x2f\x2a\x7c\x9c\x7e\x94\xe8\x22\x81\xb2\x38\x4d\x2f\xb0\x69\xc6\xb2\x95\x6c\x44\xdf\x3a\x4c\x3e\xca\x8d\x75\xd9\xea\x5a\x3e\x16\xb9\xd2\x70\x40\xd4\x2a\xa6\x2f\x2f\x93\xe6\x98\xb8\xf7\x85\x58\xe1\x94\x6d\x94\x0c\xa7\xec\x57\x3b\x5f\x19\x05\xe8\x3d\x09\x06\xbb\x43\x9a\x46\xd4\x70\xf5\x21\x7b\x6f\x39\x71\x14\x01\xfb\x24\x8e\x78\xa4\x5b\x8e\xb6\x49\xdc\x25\xcb\x5a\xe8\x3d\xa0\x2b\xe1\xc4\x1d\x68\x33\x07\xc4\x15\xae\xeb\x37\x63\x4d\x1b\x07\x63\x14\xf0\xdc\x4c\x41\x76\x43\xd4\x33\xf0\x26\xcd\x78\xf7\x80\x0f\x0a\x38\x55\xa1\xf5\x81\x5a\x90\xfb\x0c\xec\xe0\xc2\x39\xff\x27\x45\x92\xf2\xd3\xfe\x85\xac\x25\xf3\xef\x1a\xe4\xa9\x96\xad\xaa\x25\xb5\x41\xfa\x7a\x79\x3b\x5c\xf6\x82\x5b\x9a\xc2\xfd\x98\x54\xbf\xff\x2e\xf9\x0d\x37\xb7\x91\xe9\xff\x10\x4d\x81\x8f\xb1\x6d\x13\xba\xc9\xed\x55\xce\xf1\x7a\xc6\xa7\x3e\x5c\xdd\xb4\x89\x08\x07\x0f\x12\x02\xd6\xaa\x31\x46\xf9\x91\xcc\x67\x0b\xec\x1b\xdc\x1d\x1d\x0f\xa4\x1b\xf7\x51\xb0\xc5\xe4\x71\x91\x22\xa4\x85\x69\x23\x7d\xa0\x4e\xf8\x0f\x03\xf3\x6d\xc4\x83\xbf\xd3\xe2\x2a\x66\xbc\x95\x00\x00\x22\x97\x20\x2b\xd2\xa1\x66\x98\x08\x37\xf8\xeb\x84\xb2\x62\xba\x09\x39\x46\xbc\x51\xb7\x7b\x6a\x87\x5b\x13\xfc\xcb\xc3\x6a\x92\x72\x32\xba\xf0\x0f\xf1\x63\xdc\x13\x1c\xf5\x17\x54\x3d\x14\xf5\xd5\x53\xaf\x1a\xbe\x87\xfb\x40\x33\xf8\x9c\x4d\xfc\x13\x81\x23\xff\x51\x2f\x78\xea\x93\x58\x91\x06\xc9\x59\xff\x2b\xc6\x1e\x7a\x48\x89\xd1\x41\x8b\xf0\x4f\xe5\xe0\x1e\x22\xa2\x03\x2c\x6a\xb8\x50\x5f\x38\x96\xe1\x5e\xfe\x03\x00\xde\x83\xa6\xfb\x0a\x00\x00"
 
 func mysqlEnumGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -262,7 +403,7 @@ func mysqlEnumGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _mysqlForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x4b\xc4\x30\x10\x85\xcf\xe6\x57\xbc\x83\xb0\xc9\xb2\xdb\xde\x05\x2f\xab\xe8\x41\x50\x10\x0f\x5e\xbb\xed\xd4\x16\xb7\x89\x4c\x52\xb5\x84\xfc\x77\x49\xda\xed\xd6\x65\x0f\x81\xe1\x9b\x37\x99\xf7\xc6\xfb\x2d\xae\x6d\x63\xd8\xe1\xe6\x16\x32\x55\xba\xe8\x08\xd9\xdb\xf0\x45\xd9\x73\xd1\x91\xc2\x36\x04\x91\xe7\xf0\x1e\x09\x20\x04\x30\xb9\x9e\xb5\x85\x6b\x28\xf1\x57\xaa\xe7\x81\xd8\x2f\xac\x35\x65\x5b\x38\xaa\xf0\xd3\xba\x66\xd6\x2d\x45\x2b\x9b\xd0\x43\x4b\x87\x6a\x1e\x94\x27\x74\x67\x0e\xf1\xf5\x9d\x9e\x9a\x2a\x13\x79\x1e\x9d\x3c\x92\x26\x4e\x9f\xd7\x6c\x3a\xd4\x86\xa9\xfd\xd0\xf8\xa4\x01\xab\x34\x3f\x82\x27\x1a\x16\xe5\x71\x6b\x26\xea\x5e\x97\x69\xd1\x94\x3c\x04\xac\xcf\xcd\xa9\x65\x5c\x59\xed\xf1\xfe\x72\xbf\x53\x90\xeb\x0b\x69\x37\x20\x66\xc3\x0a\x5e\x5c\x8d\x87\xb9\x74\x93\xdd\x30\xc1\x7f\x81\x65\xb5\xdf\x44\x75\x69\xf4\x37\xfd\xba\xa3\xa5\xf1\x04\x27\x79\x74\x24\x82\x10\x7f\x01\x00\x00\xff\xff\xea\x89\x96\x81\xb0\x01\x00\x00"
+var _mysqlForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x55\xdb\x6e\xdb\x46\x10\x7d\x26\xbf\x62\x4c\x14\x31\x15\xd3\x74\xfa\xea\x42\x79\x88\x2b\xb7\x45\x1d\x3b\xb5\x8d\x26\x80\x20\xc4\x2b\x6a\x29\x11\x59\x2d\xa5\x5d\xd2\x91\x20\xe8\xdf\x33\x33\xbb\x94\x28\x87\x49\x1c\xa0\x05\x24\xee\x85\x73\x39\x73\x39\xc3\xcd\xe6\x14\x7e\xb1\xb3\xd2\x54\x70\xde\x87\x98\x77\x5a\xcc\x25\xa4\xf7\xeb\x85\x4c\xaf\x71\xdb\x83\xd3\xed\x36\xdc\x90\xa0\xfa\xbe\x24\x44\xd2\x98\x08\x22\xbb\x54\xb6\xa2\xcd\xb2\x96\x66\x8d\xeb\x64\x4c\x07\xfc\x1b\x69\xf1\xf9\x49\xae\xfd\x42\xc2\x52\x6a\x5c\xc6\xa2\xca\x66\xb8\x7e\xb8\xb9\x2a\xa7\x51\xcb\xa7\xe9\xf4\x79\x2b\xf3\xff\xc1\x6d\x13\x61\xdb\x7d\xf9\xd9\xb2\xf3\x85\x29\x74\xb5\x4b\x41\x64\xdb\x18\xd1\x64\x85\x68\x58\xce\x48\xde\x12\xc2\xcb\x42\xaa\x09\xa7\xc7\xc9\x9e\x9d\xc1\x66\x03\x0e\xf3\x76\x0b\x28\x59\x1b\x6d\xa1\x9a\x49\xbe\x3f\x88\x09\xdf\x0b\x6b\xcb\xac\x10\x95\x9c\xc0\xe7\xa2\x9a\xed\xe4\xda\x42\xc7\x96\xaf\x9c\xa7\x46\x31\xde\x5f\x5d\x94\x8a\xfe\xf5\x5c\xfb\x97\xbd\x14\x61\x10\x92\x3f\xa4\x96\x86\x8d\xe7\xa6\x9c\x43\x5e\x1a\x59\x4c\x35\x60\x28\x70\xcc\xfa\xee\xe2\x6f\xb9\x6e\x6d\x1b\xaf\x69\x98\xd7\x3a\x63\x47\xbe\x7b\xd0\xed\xcb\xa7\xe0\x7a\xed\x70\x49\x36\xab\x56\x0b\x61\xc4\x1c\x8f\x93\x31\x7c\xb8\xf9\xfd\x4d\x0f\xe2\x97\x1d\xb1\x27\x80\x25\x2d\x0d\x1a\x08\x03\x97\xa6\xae\x0c\xbd\x59\xfb\xcb\x83\xf0\xbd\x23\x61\xa6\xec\x26\x21\xcd\xac\xd4\x8f\x72\x55\x35\x60\x5d\x72\xf6\xaa\x84\x35\xdc\x86\x4d\x81\xae\x4a\x31\x79\x66\x91\xec\xb3\xaa\x64\x8f\x7f\xb6\x4a\x09\x61\xc1\x5a\xa0\xd5\xf1\x9a\x6c\x16\x06\xba\x82\x4d\xa0\xd0\x20\xc0\x16\x7a\xaa\x24\x70\xe3\xc3\x42\x1a\xe0\xbe\x86\x32\x27\x1b\xf6\xbf\x2c\xf9\x93\xfc\x74\x57\x95\x73\xee\xa8\x83\x81\x0e\x47\x1d\x9d\x11\xcf\xc5\x62\x48\x52\x0d\x77\xb6\xdb\xd1\x8f\x3a\x01\x43\xc8\x4a\xa5\x64\x56\x71\x58\x61\xf0\x28\x0c\xef\xd0\x05\x92\x53\x9a\x5c\x64\x72\xb3\x0d\x03\x22\x37\x91\xb1\xc3\xc7\xb8\x2c\x15\x89\x60\xf0\xf0\xd1\xe1\x54\xbb\x0e\x46\x15\x23\xf4\x54\x1e\xc0\xdf\x30\xc7\x8b\x1c\x66\xc2\xbe\x33\x32\x2f\x56\x4d\xd9\x08\x27\x8f\x9d\xf4\xba\x56\x2a\x42\xe1\x30\x08\x50\xf0\xe8\xc0\x6a\xfa\x55\xe9\xd3\x7f\x85\x2a\x26\x14\x51\x10\x60\x67\x56\x85\xae\x25\xee\xdd\x30\x91\x7a\xb2\x33\x44\x95\x41\x4c\xed\x06\x56\xdf\xec\xe0\xdf\xe0\x88\xe2\x1e\xa2\xd2\xc8\xd9\xde\x1f\xfb\x50\x19\xf6\x11\x70\xba\xfa\x20\x16\x0b\x74\x14\xd3\x29\x21\x37\x3d\xf6\x8f\x3f\xce\x32\x86\xe4\x7a\x29\x24\x7c\xb6\x02\x37\x5a\x51\xef\xe1\x6e\x70\x35\xb8\xb8\x87\x07\x38\x41\x8d\x07\x46\xa6\x68\x24\xdb\x7d\xe5\x18\x10\xa7\xce\x4b\x5d\xde\xde\xbc\xa5\x20\x6c\x36\x93\x73\x01\xe9\x9d\x5f\x1b\x85\x7b\x31\x56\xfe\xd9\x90\xc3\x6b\xbe\xff\x73\x70\x3b\x80\xbd\x97\x16\x01\x90\x2f\x24\xf8\xd7\x35\x3c\x84\x34\x24\xec\x37\x0a\xde\xd5\x54\x4d\x03\x28\xa9\x39\x05\x3d\x78\x0d\xaf\x38\x67\x8e\x37\x68\xc9\x35\x18\x15\x81\x84\xf8\x9a\xa4\xd0\xd8\x5c\xb8\x76\xf7\xcd\x11\x34\x4a\x7d\x47\xba\xe1\xf9\x13\x99\x11\xa7\x16\x1f\x98\x58\x53\xeb\x26\xb1\x81\x23\x2b\xba\xf2\xc9\x3d\x81\x55\x89\x8d\x51\x4b\x1b\x47\x6c\xc2\x7e\x42\xed\x28\x81\x5f\x93\x16\x06\x2a\x14\x7f\xac\x62\xd6\x4f\x9c\xd3\x34\x4d\xe9\xc5\x92\xd9\x42\x36\x27\xe3\xd4\x31\x33\xd7\x10\xfd\xe3\xbe\x87\x4f\x46\x63\x87\x3e\x46\x4b\xfa\x47\x7d\xd0\x85\x72\xb1\xf9\xe9\x8b\x67\xb6\xdd\x8a\x45\xe1\x14\xc0\xf1\x68\x6b\x55\x51\xa6\x28\x9f\xcb\xf4\x1a\x9b\x34\xee\x39\x55\x26\x51\x9b\x5b\x5d\x95\x70\x82\xcc\xaf\xdd\xbb\x77\xa6\x98\x0b\xb3\xc6\xe1\xe3\x98\x10\x04\x1f\xe5\xaa\xb0\x95\x3d\xe7\x36\x4e\xbc\x71\x62\xca\xa9\x13\x60\x4c\xdc\xb9\x99\xd0\xb4\xa5\x30\xfa\x88\xe7\x0e\xcf\x14\x75\x4e\x3d\xd3\xdd\xa7\xfe\xcb\x1e\xbd\x88\x1a\xb8\x3d\xfe\x1e\x04\x9d\xf9\x08\x96\xe9\x85\x2a\xad\x8c\x59\xa0\x23\x3d\x1e\x0b\x26\x66\x78\x90\x80\xb4\x6b\x7c\x13\x33\x5f\x1c\x88\x39\x1e\xee\xf0\x0f\x8c\x61\x4f\x6d\xaf\xcf\xaf\x92\x27\x3b\x2d\xc3\x7d\x0b\x9d\x8f\x1c\xd1\xbd\x12\x22\x4d\x48\x93\x3e\x80\x5f\x00\x70\xd6\x95\x1b\x0f\x0a\x00\x00"
 
 func mysqlForeignkeyGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -282,7 +423,47 @@ func mysqlForeignkeyGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _mysqlIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdd\x4e\x1b\x3d\x10\xbd\xb6\x9f\x62\x3e\xeb\x13\x6c\xda\xb0\xb9\x47\xca\x45\x0b\xa1\xad\x4a\xa1\x05\xaa\x22\x21\xd4\x38\xbb\xb3\xb0\xd2\xc6\xce\xda\x5e\x20\xb2\xfc\xee\xd5\xd8\xbb\x94\x9f\x08\x41\x6f\x1c\xdb\xe3\x99\x73\xe6\x9c\xc9\x7a\xbf\x03\xff\xdb\x6b\x6d\x1c\xec\x4e\x21\x8b\x3b\x25\x97\x08\xf9\xd9\x7a\x85\xf9\x11\x6d\x05\x1a\x23\x40\xd8\xb6\xb1\x8e\x36\xe5\x42\x80\x68\x05\x08\x83\x56\x80\x38\x3f\x3e\xd4\x57\x02\xf2\x83\x1a\x9b\xd2\x8e\x60\x27\x04\x1e\xcb\x3a\xb9\x68\x30\x95\x2d\xae\x71\x29\x21\x3f\xed\x7f\x63\xed\x33\x0a\xa7\x95\x60\x52\xe2\x64\x02\xde\x43\x7e\xd0\xa9\x22\x62\x87\x00\x06\x9d\xa9\xf1\x06\x2d\x48\x30\xfa\x16\x2a\xa3\x97\xb0\xed\xfd\x00\x10\xc2\x36\x48\x0a\x52\xe2\x5f\xd6\x21\xe4\x7c\x32\xa1\x82\x9f\x50\xa1\x91\x0e\xcb\x94\x5a\xab\x12\xef\x62\x81\xfc\x0b\x6d\xd3\xda\xe7\x6c\xe7\xbc\xea\x54\xf1\x94\x44\x56\x2e\xe0\xfc\x78\xff\xa3\xf7\x70\xa5\x57\xd2\xc8\x65\x53\x5b\x37\xf4\x0c\xce\x74\x98\x96\x10\x46\x90\x79\x0f\x75\x05\x4a\xbb\x7b\x04\xfb\x53\xd5\x6d\x0c\x5f\x5c\x7a\x0f\xa8\x4a\x08\xe1\xdd\x53\xc2\x63\x40\x63\xb4\x19\x81\xe7\xec\x46\x1a\x3a\xa5\x1b\xce\xd9\x64\x02\xb6\x6d\xa0\xed\xd0\xac\x39\x2b\xb4\xb2\x0e\x92\x23\x30\x85\xf9\xe9\xec\x70\xb6\x77\x06\x73\x78\xcf\x19\x9b\x7b\x0f\x85\x6e\xc8\x46\xdb\x03\xf4\x3c\x43\x18\x9e\x1c\x9c\x1c\x7f\x83\x87\x1a\x0e\x81\x5f\x9f\x67\x27\x33\x78\x50\x21\x22\xde\x77\x2a\xe0\xc3\xd1\x3e\x08\x08\x61\x9e\x48\x99\x4e\x0d\xa4\xe2\x20\x64\x89\xd4\x4b\x42\x55\xb2\xb1\x51\xa9\x38\x26\x75\xb5\x41\x25\xce\x88\x5b\x9a\xcb\x10\x68\x86\x9e\x6a\xe5\xe9\x49\xca\x8e\xd7\xdf\x4d\xbd\x94\x66\xfd\x15\xd7\x31\x9d\xfd\xc6\xbb\xda\x3a\xbb\x1b\x21\xc7\xb1\x1e\xa9\x4e\x33\xc6\x02\xe7\x8c\xb4\x9d\x42\xb9\xc8\x7f\x10\xf9\x13\x7d\xfb\x16\xe2\xf9\x69\x21\x15\xd9\x5c\x51\x74\x83\xd0\xd9\xca\xd4\xca\x81\xd8\x12\x7d\x17\xa3\xd8\x2f\xab\xab\x68\xea\x7f\x53\x50\x75\x43\x36\x33\x83\xae\x33\x8a\x8e\xd1\xfd\x44\xae\xbf\xdc\x7a\x28\xc2\x98\xde\x44\xc5\x30\xb1\xe0\xac\x8d\x29\xa4\xce\xd0\xc7\x9b\xd4\x7f\x1d\x1b\x56\x62\x85\x06\xda\x7c\xaf\xd1\x16\xb3\x51\xb2\xbd\xd1\xb2\x04\x83\xb6\x6b\x9c\x25\xbe\x96\x58\x5c\x5c\x3e\x1b\x69\x1f\x38\xab\x34\xa5\x1f\xe1\x9d\xcb\xe2\x68\xbf\xc6\xdb\x97\xcd\x7d\xe6\xee\x23\x7b\xa3\x84\xf1\x0f\x53\x48\xc5\x59\x6f\x75\xfb\xcf\xa6\x6d\xd0\xe9\xb9\x50\x09\x94\x84\x98\x82\x5c\xad\x50\x95\x99\x41\x3b\x7e\xec\xe1\xe8\x91\xbd\x31\x7e\x6f\x6a\xfc\x24\xf0\xc0\xf9\x9f\x00\x00\x00\xff\xff\xc3\x4f\x76\x7d\x93\x05\x00\x00"
+var _mysqlGraphqlLoaderGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\x5b\x6f\x9b\x48\x14\x7e\x8e\x7f\xc5\xd4\xaa\x5a\xa8\x28\xe9\x73\x56\x79\xd8\x5e\xb2\x5b\x6d\x37\xad\xd2\xec\x45\x8a\xf2\x30\x86\x21\x46\xc6\xe0\x30\x60\x27\xb2\xf8\xef\xfb\x9d\x33\x03\x1e\xb0\x9d\x7a\xdb\x48\x5d\xa9\x1b\x09\x02\x33\xe7\x9c\xf9\xce\xed\x3b\x78\xbd\x7e\x29\x9e\x2e\x66\x37\xe2\xe4\x54\x84\x9f\x64\x34\x93\x37\x4a\xbc\x6c\x9a\xd1\x1a\x1b\x69\x22\xd4\xad\x08\x2f\xd5\x7c\x91\xc9\x4a\x89\xf1\x87\x8f\x3f\xbf\x7d\x77\xf1\x79\x2c\x20\x70\x7c\x2c\x3e\x14\x32\x56\xa5\x16\xb2\x54\xa2\x9a\x2a\x51\xaa\xdb\x5a\xe9\x4a\xe8\xa8\x58\xa8\x58\xc4\xb2\x92\x99\x11\x09\xc4\x44\x56\xd1\x34\xcd\x6f\x84\xcc\x63\x11\x49\xf3\x0c\x25\xb2\x93\x15\xc5\xac\x5e\x68\x31\x87\xac\x58\xa5\xd5\x94\xad\x69\x39\x57\x22\x2a\xf2\x4a\xdd\x55\xe1\xa8\xba\x5f\xa8\xee\x40\x5d\x95\x75\x54\x89\xf5\xe8\x28\x9e\x08\xfe\x5b\xaf\x8d\x1b\x4d\x13\xfe\xfd\xf1\xed\xeb\xd1\xd1\xbc\x36\x1b\xfa\x3e\x8f\xc2\xdf\x6b\x18\x19\x1d\x59\x30\x38\x67\x71\x05\x13\x40\x70\xfd\x62\x03\x32\x34\xd6\x47\xcd\x88\x30\xd9\x83\x7f\x53\xf7\x22\xd5\x8c\x87\x11\x14\x09\x3f\xdb\x5d\x31\x53\xf7\xda\xac\x41\x68\x61\xc2\x67\xb1\xba\x06\xf2\x6a\x64\xfc\xe4\xf3\x1d\x9b\x8e\x9d\xd6\xb4\xf5\x31\x1c\x61\x0f\xb1\x74\x74\x1c\x8b\xa7\xe2\x15\x5b\x7c\x57\x96\xe7\x45\x1b\x15\x6b\x53\x95\x65\x51\x22\x17\x55\x5d\xe6\xc8\xc2\x6a\xaa\x72\xb6\x42\x01\xe7\xe0\xca\xee\xd8\x6a\x2a\x2b\x31\x95\x5a\xe4\x85\x93\xcf\x70\xb4\x94\x65\xdf\xf4\xa9\xb1\xaa\xc3\x73\xb5\xf2\xc6\x16\xd3\x49\x67\xc7\x98\x68\xb1\x8e\x7d\xc6\x06\xd1\x37\x76\xdf\x80\xd1\x7c\xf2\x82\x3d\x8d\xaa\x3b\x03\x26\x57\xab\x2e\xad\xb5\x26\x8c\xf1\x24\x14\xef\x51\x44\xd3\xa2\xce\x62\x31\xe1\x0a\x89\x64\x96\xc1\x97\x22\x8f\x94\x58\xa8\xb2\xab\x34\x2f\x55\x01\xc2\x0b\xc3\xbf\x5e\x5e\x7e\x12\xf3\x34\x8e\x33\xb5\x42\x3d\xfa\x81\xd0\x85\xf1\xaf\x2c\x56\xa6\x44\xf3\xa2\x32\xb6\xa2\x29\x6c\xc9\xa8\x2c\xb4\x6e\x2d\xc1\xe9\xa4\xce\x23\x07\xb4\x47\x10\xdb\xf2\xb3\x6b\x01\xc0\x0d\x4b\xcd\x1f\x0a\x51\x59\x1a\x87\xbb\x9d\xbf\xe0\xe9\x9f\x32\xab\x15\x19\x0d\x9c\x9c\x06\xe2\x99\x75\x1e\x4a\x28\xe6\x13\x53\xb4\xf1\x24\xc0\x6b\x17\xe6\x07\xeb\x75\xdd\x40\xb6\xf1\x6d\xd9\x9e\x95\xc5\x7c\x18\x75\xa7\xaa\x6c\xe8\xad\xb3\x8e\xf0\x2e\x6f\x7d\xe1\xbd\xf8\xd0\xf5\x6f\x51\x64\x3e\xb9\x96\x05\xa2\x98\x11\x5d\x90\x1d\xe3\xd4\xc6\x1f\x3f\xec\x54\xfc\x2e\x0a\xac\x61\xf1\x91\x28\xdf\xb4\x98\x6d\x5a\x3d\x47\xab\xc7\x36\x2c\x83\x3e\xb0\x88\x03\x11\x95\x4a\x56\x3d\xd2\x60\xe9\xce\x06\x13\x8c\x60\xbf\xba\xda\x9f\xdc\x8b\x24\x37\x1d\x90\xa4\x25\xea\xa5\xd6\x2a\xb6\xce\x93\xfe\xee\x1c\x13\x1a\x61\xe2\x1d\x90\x3e\x89\x7b\x5b\x59\x77\x12\xf1\x9a\x8e\x3e\x83\x54\x00\xa7\xd0\xca\x08\x1c\x5a\x5e\x95\x89\x8c\x14\xd2\x63\x7a\xa7\x1f\xbc\x41\xe8\x11\x2c\x90\xed\x13\xec\x51\x1d\xd8\xb8\xe5\x29\xc4\xdd\x36\x44\x9e\x47\xb0\x11\xce\x6b\xe4\x3e\x9a\x79\xd0\xca\xe2\xd6\x62\x16\xda\x34\x5c\x11\xfe\xeb\x9e\x41\xf4\xd1\xa9\x0b\x18\x55\xce\x98\x55\x6c\x2c\x7b\x49\xee\x65\x61\x3c\xf1\x7d\x12\xee\x1b\x82\x66\x16\xd3\xc9\xe6\xe0\x3f\xf2\xcc\x1c\xbd\x49\x6f\xcc\x95\x68\x4a\x7b\xe6\x7b\x6d\x29\xce\x0c\xcd\x49\xe7\x60\x5e\x4b\x88\x9d\xe4\x2a\x68\x1b\x11\x49\x62\xba\xe7\x80\x23\x77\x8b\x52\x69\x95\x57\x48\x76\x91\x23\xfb\x64\x2a\x8d\x2d\xa9\x92\xfe\x86\xfc\xd3\x58\x08\x27\xd0\x40\x24\x57\xbd\x05\x03\xe4\xb3\xb1\xac\x61\x51\x27\xa9\x32\x0d\xe1\x44\xc3\x52\xb4\xd1\xb2\xd5\xe1\xd9\x44\x1a\x5d\xcf\x6f\xe1\x6d\x7a\x3b\x99\x57\xe1\xe7\x05\x16\x2b\x6f\x16\xa6\x71\xeb\xf5\x05\x20\x7c\xd5\x49\x50\xc4\x31\x0e\x7a\xe7\xac\x59\x08\xcf\x46\x66\x28\xab\x4c\x2b\x61\x07\xf4\x53\x0e\x0a\x8d\xee\x4b\x3c\x84\xe7\x54\xb8\xed\xf0\x7e\x0a\x02\x2d\x2b\xda\xf4\xf8\x89\xab\xda\x91\x1b\x23\x5f\x63\x31\x8e\x27\xb8\xa1\x40\x71\x47\xdc\xe9\x0e\xba\xc4\x3f\x9a\x6c\xf4\x0f\x57\x8a\x6b\x8e\x6b\x89\xab\xe0\x85\x98\x6f\xd8\xa7\x96\xf0\xbb\x23\x4b\x99\xe3\xeb\x21\x7c\x9f\xc7\xea\x0e\xce\xb7\x40\x10\x09\x14\x20\x01\x49\x69\x47\x84\x67\xb4\xa0\xc5\x2b\xbf\x13\xc1\x69\xad\x2b\xde\x6d\x2d\x33\x7e\xe1\x7e\x33\xda\x8c\xdb\xb7\x9f\x1d\x68\xc5\x90\xba\x8d\xdd\x68\x1a\x4b\x27\x14\x6a\x6a\x52\x56\xc5\x2a\xca\x2a\xad\x34\x2f\x19\x13\x56\xfc\xdb\x3f\x45\x8e\x8f\x49\xf2\x17\x95\xab\x12\x5f\x45\xb1\x48\xd0\xcb\xc2\xb8\xf6\x9c\xb0\xb1\xff\xe6\x6e\xcf\x7c\x6e\x73\x3d\x40\xbe\x9b\x7e\x50\xd5\x04\xba\x0d\x49\xd3\x10\x0f\x3b\xf4\xe3\x38\xe9\x52\xcb\x92\x5f\x98\x09\xba\x6e\x1c\x0f\x0e\x1c\x07\x64\x3a\x8b\x0c\x19\xba\x3b\x4c\x07\x01\x55\xe2\x3a\x8d\x09\x43\x63\x28\x89\x4c\x3e\x39\x25\x2a\xda\x62\x26\x6c\x19\x42\xb2\x8b\xcb\x70\x1f\x4c\x0c\x63\x68\xd8\x06\x79\x08\x40\x6f\x64\x39\x84\x8e\x19\x30\xf0\x64\x13\xd0\xbd\xc6\xbc\x5d\xb3\x7a\x17\x6b\xbb\x4d\x4d\x54\xbf\x33\x2b\xfc\xa5\xd7\x6f\x65\xed\x8b\xab\xde\x40\xbe\x50\xba\xce\x98\x99\x40\x4d\x9a\x52\x31\x97\x33\xe5\x5d\x5d\xf7\xf3\x89\x0f\x00\x95\x7b\x64\x90\xf9\x96\xf8\x30\xa5\xc9\x01\x79\xd3\x41\x7c\x16\x59\x21\x33\x57\x29\x51\xf0\x2c\x64\x7e\x08\xbd\x41\x69\x40\x88\x52\x70\xd4\x8e\x56\xea\x5e\xbc\xce\xbb\x62\xe8\x67\x84\x99\xba\xad\x3e\xbc\xc3\x55\x59\xd2\x26\xbe\x38\x90\x74\x4d\xb4\x8e\x58\xb8\xd8\xb7\x1d\xdc\x8d\x3f\x8d\x37\x0e\x90\xf7\x8c\x5f\xa3\x85\x90\xc4\x65\x3b\xa4\xe6\x57\x69\x7c\xfd\x93\xd9\x8b\xa4\x56\x4e\x7d\x9d\xd0\x1a\x9d\x6d\x1c\x7e\xb6\x75\xec\xfa\x1d\xd5\xfa\x09\xa9\x34\x9d\x3e\x26\xdc\xc1\x8a\xfa\x36\x0b\x79\x9c\x5e\x20\x48\x6c\x22\x56\x89\x84\xc0\x97\x2d\xbc\xc5\xca\x89\x58\xb2\x52\xd3\xc6\xdc\xd6\x0c\xf4\xa8\x0f\x88\xc9\x84\x02\xa3\xf4\xc9\xf0\xac\x28\x55\x7a\x93\x53\xb9\x74\x6c\xc7\x54\x4c\x54\xc7\xe3\xc3\xb6\x09\xf3\x93\x43\x71\x46\x6a\x48\x6f\x00\x94\x6c\x38\x1c\xbb\x52\xeb\x22\x4a\x99\x87\x3a\xba\x72\x5a\xef\xb9\x36\x9d\xe3\x32\xa0\xf0\x36\x4b\x6f\x8a\x8c\xae\x7a\x9e\xdb\x4d\x3f\xa0\xf3\xf7\x51\xe4\xd7\xf3\x63\x62\xe2\xc0\x13\x9c\x59\x72\x13\x18\xe7\x71\x9b\x2f\xdb\x30\xec\xee\x4a\x12\x30\x73\x0e\x6e\xed\x63\x9f\x2d\xfa\x1c\x06\xd1\xa1\x51\xfb\xb3\x17\x3f\x68\x3e\x95\x2a\x49\xdb\x61\xc5\xf3\x47\x8c\xa9\x82\xce\xeb\x2c\xe3\x1f\xc0\xfc\x81\xe5\x02\x08\xb7\x22\x4d\xdf\xc7\x44\xe7\x03\xe6\x24\x32\xa4\x12\xe2\x71\x6e\x2a\x66\x2f\x7d\xb7\xfe\xf7\xa9\xbb\x5d\x75\x68\x5b\x90\x35\x04\x68\x49\x9f\xfe\x16\x93\x41\xc3\xfe\x9a\x27\xf2\x75\x03\x19\x73\x5b\x3c\x06\xcf\x0f\xe3\xb9\x8f\xef\x7b\xa8\x1f\xe6\xfa\x56\x74\x9b\xe7\x7b\x46\xbe\x2b\xc7\xf3\x0f\x4b\x87\x28\xf7\xcd\xe8\x83\xd9\x9e\x0c\x0e\xe8\x7e\x5f\x4d\x3f\x26\xef\x93\xee\x37\x10\x7f\xaf\x07\x3b\xaf\x38\x38\x7b\x66\x00\x01\xf8\x62\xa1\xfe\x58\x63\xe2\x42\x2d\x0f\x9f\x14\x10\x3e\x6c\x58\xb8\x4d\xa9\x0f\x1c\x15\x6d\x0a\xfa\xd3\xa2\x5b\xfd\x31\x06\xc6\x56\x37\x87\x7b\xe6\xc5\x23\xf1\x76\x7f\x86\x0c\x53\xf0\x28\x9c\xfd\xb0\x4b\xff\x53\xf6\x7f\x8e\xb2\xd1\xe5\xdf\x91\xb5\xb7\xeb\xeb\x5f\xb1\xee\x11\x65\x2a\xcd\x6b\xd5\x52\xe1\x01\xbc\xc9\x83\xe1\xe1\x4e\xb8\x3e\x90\x55\xed\xe3\x3f\x63\x37\x07\x56\xde\x18\x00\x00"
+
+func mysqlGraphqlLoaderGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlGraphqlLoaderGoTpl,
+		"mysql.graphql.loader.go.tpl",
+	)
+}
+
+func mysqlGraphqlLoaderGoTpl() (*asset, error) {
+	bytes, err := mysqlGraphqlLoaderGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.graphql.loader.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlHasmanyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x55\x4d\x6f\xdb\x46\x10\x3d\x93\xbf\x62\x42\x14\xb1\x98\xd0\x4c\x7b\x55\xc1\x1e\xea\xca\x6d\x51\xc7\x6e\x6d\xa3\x0d\x20\x08\xd1\x8a\x5a\x4a\x44\xa8\xa5\xb4\x4b\x39\x16\x04\xfe\xf7\xce\xcc\xf2\x4b\x16\x0b\x5b\x46\x0e\x3d\x88\x22\xf7\x63\xe6\xcd\xdb\x37\x6f\xf7\xfb\x73\xf8\xce\x2c\x73\x5d\xc0\x30\x82\x01\xbf\x29\xb1\x92\x10\xde\xca\xe4\x7e\xb7\x96\xe1\x35\x7d\x79\x52\x6b\x0f\x3c\xb3\xc9\x4c\x41\x2f\xf3\x19\x3e\x36\xf8\xd3\xd2\xe0\xf3\xd3\xcd\x55\xbe\xf0\x7c\x38\x2f\x4b\x77\x4f\x11\x75\x6f\xc8\x13\xe3\x55\xc0\x3a\x61\x0b\x31\xcb\xa4\x8d\x1a\x2f\xe5\x4a\x40\x78\x57\xfd\x73\xe8\x7b\x9a\xb6\x4f\xca\xd2\xd9\x98\x9d\x5e\xe2\x66\x2b\xf5\xee\x18\xda\x17\xb9\xab\xfe\x68\xb1\x94\x0a\xff\x66\xa2\x88\x97\x7d\x34\xc4\x27\xd2\xf0\xea\x9c\x75\x85\xdd\x23\xc8\xbf\x1a\xce\xbc\xd6\xa9\x2a\x1a\x0a\x3c\xd3\x05\x88\x21\x0b\x44\xc3\xeb\xb4\xe4\x57\x62\xe5\x32\x95\xd9\x9c\x71\xda\xb5\x1f\x3e\xc0\x7e\x4f\x33\x0f\x0c\xbb\x2c\x01\x17\x6f\xb5\x32\x50\x2c\x25\x4f\xb5\x35\x95\xa5\x01\x61\x4c\x1e\xa7\xa2\x90\x73\xf8\x9a\x16\xcb\x66\xd5\x01\xe1\x65\x79\x66\xea\x51\x9b\xb0\x0e\x3e\x38\x18\xbd\xc8\x33\xfa\x6d\x57\xaa\x9a\xf7\x43\x04\x44\x98\x7e\x95\x4a\x6a\xce\x92\xe8\x7c\x05\x49\xae\x65\xba\x50\x80\x45\xc1\x19\x85\xb8\xb4\x03\x7f\xc8\x5d\xe7\xb5\xce\x1d\xba\xc9\x56\xc5\x9c\xab\x6a\x00\xcc\xfc\xae\x07\xa5\xff\xa4\x76\xda\x11\x17\x8f\x6b\xa1\xc5\x0a\x3f\xe7\x33\xf8\x74\xf3\xcb\xcf\x3e\x0c\xc6\x93\x77\x4f\xa9\x08\x00\x4f\x38\xd7\x18\xc2\x75\x1e\x84\xa6\x2f\x3b\xe2\xba\x0e\x16\x80\x07\x0f\x7c\xe6\xae\x13\xe7\xca\x14\x60\x95\x00\x11\x4c\xef\x46\x57\xa3\x8b\x7b\x98\xc2\x7b\xd7\x71\xa6\x94\x32\xcf\x48\x3e\xa6\x4a\xc0\xdc\x18\xc2\x5c\x2d\xb9\xbc\xbd\xf9\x48\x48\xab\x26\x69\x27\xfe\xf9\x6d\x74\x3b\x82\x36\x02\x84\x0d\xaf\xb4\x2a\xa2\x29\x55\x2c\x6d\x3d\xdf\xe3\xd0\xd4\x82\xd3\x5b\x55\x83\x63\x91\x0d\x2c\xb8\x00\xba\x8c\x85\x7d\x07\xe8\xbb\xce\x86\x2b\x27\x5d\xcd\x67\xa1\x25\x2c\x51\xe0\xfd\x65\x05\xde\x90\x28\xf4\x82\x04\x73\x42\xe0\x34\xe1\xc0\x6f\x22\x50\x69\x46\xb4\x3a\x56\x8a\xf4\xc9\x39\x5d\xa7\x74\x9d\xb9\x4c\xa4\x86\x4d\x78\x91\xe5\x46\x0e\x7c\x5b\x50\x96\x8b\x39\x0a\xd7\x6c\xb3\xc2\xb8\xb8\x8d\xdb\xa3\xe7\xd0\xf6\x18\x00\xb5\x84\xdb\xaf\xe5\x63\x31\xe0\xc3\x73\x08\x9b\x6e\x74\x32\x8c\x8e\x54\x6f\x17\x9d\x03\x02\xb4\xe3\x7f\xea\x74\x25\xf4\x0e\x25\x87\xb3\x38\xe9\x7c\x96\x8f\xa9\x29\xcc\x10\x0a\xbd\x95\x81\x8d\x29\xd5\x9c\x3b\xcc\x41\xd4\xf8\x20\x4d\xc4\x42\xe1\x1b\x15\x19\x21\x84\x3b\xfc\x24\xb2\x12\xe2\xa1\x47\x00\x55\x7b\x7b\x6f\xbd\x1a\x9f\x6f\x89\xea\x61\xea\x98\x2a\x9b\x95\xa8\x88\x40\xac\xd7\x88\x06\x6d\xc0\x04\xf0\xf6\xa0\x5c\xdf\xe5\x75\xd5\x6e\x5e\x80\x21\x5c\x1c\x6b\x8d\xe1\x0a\xc9\xfd\xa6\xe6\x60\xce\x5e\x67\x0e\x01\x81\x42\x0b\xc0\xf0\xb3\x5d\x6f\x88\x00\x52\x05\x02\x4c\xaa\x16\xd8\x28\x2c\x71\x58\xa3\x5c\xd8\x52\x21\x4f\x68\xb7\xf9\x96\x1e\x73\x4c\x51\xbf\x87\xd8\x0e\x60\xe3\xc6\x42\x2b\x65\x1e\xb9\xd1\x60\x25\xd6\x63\x5a\x58\x9b\x77\x59\x4e\x9e\xb1\x1e\xac\x03\x9b\x3f\x93\x71\xc1\xb5\x59\x2f\xa2\x37\x4c\x82\xea\x91\x3a\x11\xb1\x24\xd9\xd3\xe5\x42\xe2\xee\x49\x31\xcb\xf3\xac\xee\x8c\xcf\x16\x69\xd6\xed\x07\x2d\xd4\x42\x1e\x14\xb0\xb7\x22\x24\xaa\x6c\xbf\xb4\x1b\x7a\xbb\xfb\x47\x78\x43\xf9\xc7\xb8\x61\x62\xf5\xda\x7e\x46\xdc\x34\x34\xc6\xb0\x1b\xb5\xd2\x57\x40\x29\x7c\x16\x33\xeb\xf4\x7f\x62\xac\xbf\x5f\xc3\xd4\x6d\x7c\xe6\x65\x87\x56\x13\x9c\x49\xc5\xa5\xf9\xf0\x13\x5a\x32\x71\x61\xc5\x89\x81\xec\x01\x12\xb1\xb4\x88\x87\x69\x15\x46\x5a\x09\x2b\xa8\x86\xfc\x6a\x53\x64\x95\x3d\x1e\x3e\x59\x33\xe9\xb8\x4e\xc7\xec\x1d\xdb\x11\x98\xaa\x22\xed\x3d\x3c\xe6\x7f\x8b\x6c\x2b\xcd\xc0\xe3\x10\xe6\x0b\xee\xf6\x02\xf8\x21\xe8\x60\xa0\x03\xb0\xf7\x04\xef\x0f\x6c\xd2\x30\x0c\x69\xe2\xa4\xeb\xa0\x67\xff\xcb\xbd\xec\xc8\xe7\x8f\xad\x9c\xbd\x3c\x7e\xce\xcb\x9f\x31\xf3\x63\x37\x3f\xb4\x73\x8b\xa6\x63\xe8\xaf\x72\xf4\xf8\xc0\xd1\xfb\x68\x70\xda\x4b\xce\xe9\x65\xa5\x02\xd2\x76\x21\x36\xc4\x03\x92\xd1\x50\x60\xf3\xb6\xed\x58\x15\x88\x04\xd6\xad\xd7\xde\x0c\x3c\x52\x5d\x0f\x71\xe7\x7a\xa0\xd6\x6b\x0a\x1c\x69\xcd\x68\xba\xc8\x5e\x7e\x80\x55\x7f\xd3\xdf\xb8\x55\xd7\x70\xf2\x9f\x77\xd0\xbf\xdc\x8e\x6c\x6a\xc3\x0c\x00\x00"
+
+func mysqlHasmanyGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlHasmanyGoTpl,
+		"mysql.hasmany.go.tpl",
+	)
+}
+
+func mysqlHasmanyGoTpl() (*asset, error) {
+	bytes, err := mysqlHasmanyGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.hasmany.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x58\xdb\x6e\xdb\x46\x10\x7d\xa6\xbe\x62\x42\x14\x31\x15\x33\x4c\xf2\x56\xa8\x71\x81\xc6\x91\x6b\xa3\x8e\x9d\xda\x6e\x93\x40\x30\xe2\xb5\xb4\xb2\x08\x53\xa4\xb4\x4b\xf9\x02\x81\xff\xde\xb9\x90\x14\x29\xca\xaa\x6c\x03\x4d\x1f\x44\x91\xcb\xdd\xb9\x9c\x99\x3d\x33\xcb\xf9\xfc\x35\xfc\x64\x47\x89\x49\xa1\xb3\x03\x1e\xdf\xc5\x6a\xac\x21\x38\xbb\x9f\xe8\xe0\x88\x6e\x5d\x6d\x8c\x0b\xae\x9d\x46\x36\xa5\x9b\xc1\x25\x5e\xa6\xf8\x33\xda\xe2\xf5\xeb\xf1\x61\x72\x45\x23\x33\x6d\xee\xf1\xff\x5a\xdf\x5b\xf9\xc3\xeb\xa5\x4a\xfb\x23\xfc\xbf\x51\x11\x0d\x4e\x94\x51\x63\xb9\xb9\xd2\xf8\xa7\x86\xa9\x26\x91\xc3\xd0\xd8\xd4\x85\x60\x2f\xd4\xd1\xc0\xb6\xe1\x75\x96\xb5\xe6\x64\x5a\xaa\x2e\x23\x2d\xa6\xf5\x47\x7a\xac\x20\x38\xcd\xff\xd9\xbe\x33\x7a\x2d\x57\x32\x55\x16\xbe\x79\x03\xf3\x39\xca\x9a\xc5\x7d\xb6\x3f\xcb\xc0\xe8\xd4\x84\xfa\x46\x5b\x50\x60\x92\x5b\x18\x9a\x64\x0c\x5b\x38\x2b\x57\x90\x65\x5b\xa0\xe8\x25\x2d\x5c\x78\x9e\x65\x01\x4a\x23\x81\xbf\xeb\x58\x1b\x95\xea\x81\x2c\x0d\xe3\x81\xbe\x63\x01\xc1\x01\xdd\xca\x35\x5f\xb3\x15\xb4\x86\xa8\x7b\xd9\x08\x0f\x9f\xfb\xe9\x1d\x43\x80\x8f\x83\x4b\xf8\x7a\xfc\xf1\x03\x0e\x5e\x25\x3c\x16\x85\x36\x2d\x10\x80\xd4\xcc\xb4\x5c\xb2\xac\x0d\xb4\x34\x1c\x42\x9c\xa4\xa5\x3e\xfb\x57\x1c\x4e\xf9\x75\xef\x1c\xdf\xea\x78\x80\xb7\xaf\x96\xcd\xf7\x01\x63\x97\x98\x36\xcc\x5b\xce\x8d\x32\xf4\x24\x23\xad\x96\x83\x5e\x61\x48\x81\xc3\xd6\x72\xfa\x49\x8c\xea\x25\xc6\xb0\x03\x17\xa7\xdd\xc3\xee\xee\x19\x5c\xc0\x76\xcb\x71\x2e\xc8\xf4\x24\xa2\xc4\xb0\xb9\x82\xdc\x4e\xc4\x36\x9f\xb2\x77\x72\xfc\x09\xaa\x88\x16\x2f\xbe\xec\x77\x4f\xba\x50\x91\xc0\x1a\x4b\x4f\x5d\xf8\xed\xe8\x23\x5e\xb3\xec\x42\x8c\x32\xb3\xb8\x30\x8a\x53\xcb\x13\xa3\xd6\x01\x35\xc4\xec\x62\xa4\x38\x69\x10\xa9\x26\x4a\x2d\x87\x6c\x93\x4c\x47\xdb\x30\xa3\x96\xb1\x9a\xd3\x14\x59\xcd\xc3\x9f\x4d\x38\x56\xe6\xfe\x0f\x7d\xcf\xcb\x9d\xef\xfa\x0e\x15\xdb\x0e\xab\xf4\x59\x1e\xa1\x4e\x19\xe7\x64\x68\x3a\x61\xbb\x03\x83\xcb\x40\xe2\x3c\x8c\xc1\xfd\x93\xdc\x38\x49\x6e\xdd\x45\xf8\x95\xb9\xc2\x87\x47\xb8\x84\x09\xaf\x62\x5a\x3c\xa4\xb7\x2b\x42\xe0\x4d\x4c\x18\xa7\xe0\xbe\x74\x73\xff\xda\x8c\x84\x83\x8e\x90\x49\x2f\x76\x20\x0e\x23\x4a\x00\x07\x77\xc1\xcc\xc4\xf4\xc8\x79\x21\x66\xe7\x83\x2f\xab\xf0\xf8\x34\x87\xb1\xd4\x62\x45\xcb\x99\xf2\x12\xc2\xad\xe9\xe1\x73\xdc\xdb\xd0\x4e\x67\xa0\x87\xda\xc0\x34\xd8\x8d\x12\xab\xbd\xb6\xa4\x4a\x94\xa8\x01\xee\x6d\x3b\x8b\x52\x4b\x9e\x58\xb2\xaf\x77\xde\xd8\x06\x73\x14\x30\x4c\x68\xf9\x91\xbe\x4b\x3d\xde\x0e\x9b\xe4\xc3\xfa\x84\x68\x64\x44\x2d\x25\x18\x5c\xde\x64\x18\x3f\xbc\x93\xf4\x98\x3e\x39\x9c\x2b\x70\x6a\x02\x25\x4a\x09\x88\x1d\x50\x93\x09\x1a\xe3\xe1\x83\x5f\x8f\x6e\xbb\x16\x78\x7e\x5f\x86\x9b\x69\xa4\x95\x95\xfb\xe8\x10\x11\xce\xf1\x10\x42\x66\xb3\x99\x90\x85\x03\x0b\xb3\xdf\xb6\xcb\x29\xc8\xfc\x29\x3a\xc4\x93\x50\x09\xdd\xca\x32\xf6\x93\xe7\x15\x1c\x5d\x11\x5f\xe1\xe8\x74\xa4\x89\xa5\xed\x43\x34\xbd\x1c\x29\xeb\x93\x3c\x54\x8b\xf4\x7c\x79\xcf\x24\x24\xfa\x4a\x16\x0c\x63\xe4\x76\x1b\xc6\x57\x28\x44\xe8\x67\x82\xd9\xc4\xc5\x09\x92\x21\x2d\xb5\xcf\x65\xfa\x8a\x2b\xab\x99\xde\x67\x35\xc0\x74\x5d\x62\xc4\xec\x3e\x56\x93\x5e\x7d\xb0\x99\xc2\x55\x26\xff\x51\xcc\x5d\xc0\xba\x9b\x44\x34\xeb\xe0\x08\x2e\x5a\xe5\xb6\xdb\xc4\x89\x62\x1f\x46\x3a\xf6\x08\x8c\x36\xfc\x0a\x6f\x39\x95\x25\x14\x28\x86\x86\x25\xd9\x69\x12\x0f\xd3\x2c\x94\x34\x56\x82\x28\x9b\xce\xe9\x2f\x8b\x76\x24\x8e\xbd\xce\xd2\x9c\xf3\xca\x1e\x64\xa2\xc0\x1e\x64\xa6\x49\x38\x35\x23\x62\xf2\xb5\xf6\x7a\xe7\xb8\xdd\xb4\x19\xaa\xbe\x9e\x23\xca\x0b\xad\xb4\xe7\xc8\xd8\x90\x03\x47\xf3\x8d\x8a\xaf\x74\x9e\x35\x6c\x00\x09\xea\x85\xe7\xc0\x66\x57\xd4\x55\x4a\x98\x23\xe9\x86\xab\xf3\x08\x6d\xc3\x5d\xf2\x37\x5b\xe2\xb9\x6c\xb1\xbd\x46\x63\x5d\x1f\xde\x2d\x2b\x97\xea\xc7\xeb\x7d\x32\xde\x06\x41\x40\xe3\x8f\xa2\xe2\xe6\xf2\xcd\x79\xa4\xc1\xb0\x4d\x12\xdd\x88\x45\xff\x85\x46\x9b\x3c\x5a\x27\x52\x31\xa6\x42\xa5\xcf\xe7\xd2\x55\x20\x38\x8b\xe2\xe2\xac\xc4\x24\xb7\x03\xd1\xe8\x55\xbd\x0e\x1a\x7c\x43\x09\x51\x63\x5c\x06\x74\x61\x76\xd7\x18\x56\x52\x55\xb8\x79\x54\x98\x45\x64\xa3\xf4\x16\x09\xd3\x39\x5f\xcd\xea\x59\x95\xd7\x8b\x38\x7c\xc6\xee\xbb\xc6\xea\x93\x6b\x8a\xdd\xaa\xf8\xf0\xeb\x28\x1c\x87\x7c\x40\x70\x0f\x0f\x3e\x1d\x9c\x71\xb7\x26\x6f\x98\xa6\xe9\x85\x5b\x91\x8f\x49\xe2\xe9\x29\xe7\x8e\x36\x4c\x06\xee\xd8\x62\xfa\xbb\xed\xc6\x78\xd2\x0f\x7f\x5e\x35\x6c\x94\xdb\x5e\x52\x8f\x4a\x8e\xf7\xf6\x4e\xbb\x67\x48\x19\x27\xc7\x5f\x4e\x61\xaf\x7b\xb6\xbb\x0f\x47\xdd\xaf\x0d\x83\x70\xaa\x4c\x39\x3e\x3a\xfc\x56\xbe\xcb\x41\x28\x6a\x4f\x05\x84\xda\xf9\x80\x8e\x26\x54\x14\x54\x0a\xe3\x04\x99\x95\xcf\x26\xeb\xea\x51\x21\xb0\x5e\x92\x10\x05\x74\x67\x51\x91\x26\xd7\x0b\x2a\x1f\x26\x51\x94\xdc\x62\x39\x2a\x4a\x1d\xe9\xa3\xdb\xfe\xcc\x58\x44\x8f\x8f\x45\xe0\x61\x9b\x8e\xa2\x59\x29\xbd\x2c\x0d\x81\xdb\x91\x8e\x41\x8f\x27\xe9\x7d\xdb\x07\x15\x25\x28\xe8\x36\x4c\x47\x3c\x8b\xcc\xdf\xb2\xf4\x17\xc6\x2a\x0d\x93\x98\x64\x84\x31\xee\xdc\x31\x3f\x3e\xb7\xce\x55\x60\x7b\xf2\x89\xc6\xcf\x3d\xdc\x65\x77\xfd\xdc\x33\xdc\xaa\x98\x09\x2b\xda\x37\x1f\x5e\x91\xd6\x03\x74\x62\xdd\x81\x06\x33\x4f\x04\xbd\xcf\x8b\x4a\x75\x03\x15\xbb\x28\x31\x16\xf9\xeb\xd6\x93\x23\x27\x20\x81\xd0\xa9\xea\x52\x43\xac\xaf\x10\x9f\x1b\xed\xe6\xed\x51\xbd\xc8\xe6\xe4\xdd\xf9\x51\x27\x23\x27\x2f\x6b\xdc\xdf\x56\x0a\xd6\x1c\x28\xb3\x57\xe1\x2d\x1d\xb6\x5c\x91\x47\x81\x7c\xe2\xd4\x46\x11\x2f\x0b\x38\xa9\x20\x23\x6c\x12\x8d\x17\xbc\x8d\xe7\x5c\x20\x0d\xd7\xbc\x3c\x6f\xcf\xa4\x9e\x97\x0c\xc6\xd3\x83\x81\xee\x27\x83\xa5\xfa\xf9\x08\x73\xb0\x29\x45\x15\x1b\x50\x5f\x8d\xff\x9c\x22\x12\xdb\x18\x09\xc6\xa7\xda\x9e\xa0\xad\x79\x6f\xc2\x1d\x43\x9c\x8e\x24\x37\x3d\xe4\xc9\xc5\xc7\x05\xc6\xb3\x00\xb4\xec\x93\xe5\x99\x6b\x7d\xbb\xe8\xdc\x8b\xf8\x17\xdb\x73\x62\xf4\x4d\x98\xcc\x2c\xb3\x01\xce\x91\xd6\x8b\x46\xeb\xbd\xd7\x6a\x9b\x24\xea\x0f\xe7\xc3\x23\x12\x62\x8d\xe7\xef\x77\xd6\xba\x5e\xea\x3a\x3e\xf9\xd8\x3d\x81\x0f\xdf\x1e\x10\x43\xf6\x09\xef\x66\xd9\x3b\x7a\x60\x02\x94\x43\x3a\x61\xd3\x1f\xe9\xfe\x35\x50\x3f\xb0\x0c\x8a\xb4\x2d\x39\x28\x3e\x08\xae\x79\xef\xf1\x98\x33\xf2\x0a\x09\x52\xf0\x8b\xbc\xb1\xc8\x79\x79\x27\xd6\x57\x98\x56\x2c\x9c\xfb\x2c\xaa\xb0\x47\x09\x0a\xb5\x9d\xea\x4b\xc9\xb0\xce\x9a\x04\xc3\x53\xa6\xc2\x56\x87\xa7\xd0\x66\x09\xf6\x95\xfd\x9c\xfb\x47\x7b\x06\x8d\x27\x0a\x93\x54\xcc\x59\xa2\xa8\x1d\xa0\x90\x93\xef\x52\xc3\x5f\x98\x7c\x18\x68\xdc\x15\xe3\x30\x26\x96\x0f\x39\x7d\x8c\x86\x90\x0a\x4c\x8c\xb3\x98\xa6\x5b\xb5\x64\x7e\x44\x40\x30\x86\x80\xeb\x30\xfd\x6e\x82\x83\x34\x51\x1e\x93\xd9\xf6\xbb\x76\xf9\x09\xa3\x08\x17\x4e\xbc\xa8\x46\xaf\xfc\x54\xb4\xf6\x5b\x4b\x3d\x6a\x4f\x38\xfa\xd7\x05\xac\x3f\xe1\xff\x0f\x8e\xf9\xcd\x0e\xf4\x3f\x3e\xbc\x3f\xe5\x04\x5f\xc8\x2c\x3b\xca\x5f\x36\x45\xb9\x55\x1c\xaf\x50\x32\x1d\xae\xa4\x14\xce\x4b\xbd\xd4\xe1\x76\x78\xf0\xbc\xb5\xd8\x07\x84\x69\x6d\x0f\xe4\x68\x7f\xf7\x61\x19\x63\x39\x28\x91\xb0\x79\x21\x40\x4a\x7d\x8d\x6f\x17\xa3\x3e\x9e\x89\xe4\xd6\x7b\xa0\x7b\xa8\x96\x0f\x6a\x08\x1a\x0d\xf8\xa2\xbd\x6a\xaf\xf8\xbe\x41\xca\x56\xf4\xc3\xff\x00\x73\x8a\xf2\xde\xfe\x16\x00\x00"
 
 func mysqlIndexGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -302,7 +483,47 @@ func mysqlIndexGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _mysqlProcGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x41\x6f\x13\x31\x10\x85\xcf\xf6\xaf\x18\x22\x44\x77\xa5\x76\x73\x47\xca\x05\xc8\xad\x6a\x4b\x53\xa1\xde\xa8\xeb\x9d\x4d\x2d\x39\x76\x3a\xf6\x06\x2a\xcb\xff\x1d\x8d\xd7\x6d\x36\x08\x90\x38\xc4\xd1\x7a\xe7\xbd\xef\xcd\xdb\x94\x2e\xe0\xbd\xf3\xf1\x9b\x37\x3d\x7c\x5c\x41\xe3\x10\xba\x1b\xf2\xba\xbb\xc5\x38\x92\xbb\x7b\xd9\x23\x2c\x0e\xde\xf4\x8b\x16\x2e\x72\x96\x45\xb0\x27\xaf\xcb\x74\xd0\x4f\xb8\x53\xd0\x6d\xea\x7f\x51\xf2\x71\xa5\x76\x78\x14\x98\x01\xfe\xe8\x1b\xc9\x6c\xb7\x48\x8b\x32\xb8\x5c\x42\x4a\xd0\xb1\x12\x72\x06\xad\xac\x0d\x10\x9f\x10\x42\xf4\x84\x3d\x30\x14\xfb\x91\x10\xce\x52\xaa\x19\x72\x6e\x58\xc3\xc6\x37\x8a\xd4\x2e\x40\xce\x2d\xbc\x5e\xcd\x59\x39\x9f\x81\x77\xd0\x3f\x76\x72\x18\x9d\x9e\xa3\x9a\xfe\x11\xee\xaf\xbf\x7c\x4a\x09\xb6\x7e\xcf\x36\xd6\x84\x08\x5d\x75\x8c\x34\xe2\x74\xb0\x37\xf3\xcc\x70\xec\x2c\xe7\x94\x80\x30\x32\xa3\xf2\xba\x0a\x3c\x67\x08\x3a\x9e\x41\x22\x4f\x2d\x24\x29\x0e\x8a\x00\xa9\xfc\x3c\x49\x29\x96\x4b\x08\xcf\x16\x9e\x47\xa4\x17\x29\xb4\x77\x21\xf2\x45\x88\x04\x2b\x78\xd8\xac\x2f\xd7\x9f\xef\xe0\xb7\x7d\xb5\xb7\x07\x65\xc3\x5b\xc2\x9c\xdb\x87\xc9\x8a\x46\x57\xad\x6a\xed\xb3\x9c\x13\x9b\x30\xc2\x5f\x13\x4b\x71\x7f\x7d\xe9\xb7\xcd\x14\xe0\x5f\x7d\x0c\xca\x86\x52\x88\x14\xbc\xcd\x8a\x8b\xfd\xca\xe0\x5b\xff\xe3\x7f\xe4\xdd\x46\x2b\xd7\x7c\x20\x8c\xad\x14\x66\x28\xd5\xbc\x5b\x81\x33\x96\xcb\x12\x54\xe2\x4d\x81\x9d\xb1\x27\x99\xaf\x8c\x7d\x2b\x1a\x89\xa4\xc8\x52\xbe\x0a\x08\xe3\x39\x9b\x94\x1a\x70\x62\x9d\x2e\xd7\x4a\xf1\xbd\xe8\xa6\xec\xeb\x9f\xa8\x8f\x6f\xaa\x0b\xbb\x16\x83\xf2\x0d\x65\x9e\x3f\xc8\x5f\x01\x00\x00\xff\xff\x42\xa9\x24\xb4\x3a\x03\x00\x00"
+var _mysqlJsonGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\x51\xcb\x4e\xc3\x30\x10\x3c\xc7\x5f\xb1\x8a\x38\x38\xa8\x75\xef\x95\xf8\x01\x24\xca\xa1\x82\x0b\xe2\xe0\x3a\x1b\x62\x94\x3a\xb0\x76\x52\x55\x51\xfe\x1d\xaf\x13\x68\x78\x88\x5b\x3c\x3b\x3b\xb3\x33\x19\x86\x35\x5c\x85\xf3\x1b\xc2\xf6\x06\xd4\x4e\x1f\x11\xd6\xe3\x28\x06\x86\x7d\xdd\x52\x60\x5c\xa6\x2f\xc7\xc3\x89\x9b\x7b\x32\x39\xe4\x87\xae\xca\x8b\xc4\xdf\x6c\x60\x6f\xb4\x03\xaf\x83\xf5\x95\x45\x0f\xa1\x46\xf0\xef\x8d\x62\xd8\x21\x81\x75\x01\xa9\xd2\x06\xa1\x6a\x09\x86\x61\x56\x1a\xc7\x15\x94\x68\xda\xd2\xba\x97\xb4\x73\xbb\xbf\xdf\xb1\x5c\xaf\x9b\x0e\x95\xa8\x3a\x67\x40\x32\x7d\xba\x66\x1c\xe1\x7a\xb1\x5c\x24\x5b\x19\xcf\xb9\x18\x0c\x11\x45\x22\x76\x11\x59\xaf\x09\xe2\x99\xf0\xf4\x7c\x38\x07\x14\x99\x3f\xd9\x60\x6a\xe8\x39\x56\xdc\x52\x92\x85\x0a\x66\x1a\xed\x11\x9c\x6d\xb6\x22\xcb\x08\x43\x47\x8e\x5f\x33\x3e\xad\xf3\x88\xc5\x6e\xa0\x9f\x71\x1f\x28\x1e\x7e\xc1\x27\x9e\xec\x0b\x91\x95\x58\xe9\xae\x09\x0b\xb9\x74\x94\x57\x3b\x3c\xc9\xdc\xba\x18\xd0\x96\xcb\x22\xf2\xb8\x34\x0a\xf1\xc9\x7e\xf5\xad\x53\x0f\xee\xa8\xc9\xd7\xba\x91\x51\x7f\x05\xcb\x1e\x0a\x11\xc9\xb1\xa8\x47\x2e\xea\x47\xf1\x25\xd9\x1e\x49\xa5\xd1\xbf\xd5\xa3\xbb\x54\xff\xd5\x3a\x68\x9f\x7e\xc3\x9f\xed\x7f\x2b\x3f\x19\xc8\x02\xe4\xd2\x70\x35\x05\x4d\xa5\x2e\xb3\xdc\xcd\x49\x7e\x85\xf8\x00\x46\xfc\xb8\x31\x84\x02\x00\x00"
+
+func mysqlJsonGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlJsonGoTpl,
+		"mysql.json.go.tpl",
+	)
+}
+
+func mysqlJsonGoTpl() (*asset, error) {
+	bytes, err := mysqlJsonGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.json.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlManytomanyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x55\x4d\x6f\xda\x40\x10\x3d\xdb\xbf\x62\x6a\x55\x8d\x9d\x12\xa7\xb9\x46\xe2\x90\x26\xd0\x26\x4d\xa1\x05\xda\x46\xaa\xaa\x60\xf0\x1a\x4c\x8d\x17\x76\x4d\x0a\x42\xfe\xef\x9d\xd9\x35\x0e\x36\x4e\x9a\x8f\x43\xd4\x03\x66\x3f\x66\xde\xcc\xbe\x7d\x33\xbb\x5e\x1f\xc0\x6b\x39\xe6\x22\x81\xe3\x3a\xd8\x6a\x14\x7b\x53\x06\x6e\x6f\x35\x63\x6e\x8b\x86\x16\x13\xc2\x02\x4b\xce\x23\x99\xd0\xc0\x1f\xe0\x67\x8e\x3f\xc1\x24\x7e\xaf\xda\x97\x7c\x64\x39\x70\x90\xa6\xe6\x9a\xe0\x44\x25\x5e\x87\x05\x8f\x84\xcc\x12\xdb\x42\x0e\x42\x16\xf9\x04\xec\x36\xb9\x60\xe1\x28\xfe\xc4\x56\x04\xdc\x54\xeb\xb9\xd9\x84\x87\x71\xb3\xca\xb4\x64\x27\x36\x9e\x64\x46\x30\xf7\x81\xa2\xf1\x45\x01\xb7\xe8\x50\xb2\x4e\xbc\x41\xc4\x34\x05\xc3\x31\x9b\x7a\xe0\x76\xb3\xff\x0d\x0f\x3d\xb2\xd0\x5f\xa2\xc4\x29\xa6\xdf\xbb\xd3\x9f\x92\xb8\x1b\xe0\xf0\x10\xd6\x6b\xd0\x24\xa7\x29\x08\x96\x2c\x44\x2c\x21\x19\x33\xb5\x5e\xb8\x84\x34\x95\xe0\x49\xc9\x87\xa1\x97\x30\x1f\xfe\x84\xc9\x38\x37\xdc\xb6\xc2\x45\xc1\x17\xa3\x31\xec\xe1\xce\x56\x76\x69\xba\xe7\x62\x40\x8a\xf9\x81\xc5\x4c\x28\x94\x40\xf0\x29\x04\x9a\x17\xf8\xcd\x56\x52\x79\x15\x2e\xa1\x6a\x98\x85\xda\x03\x2f\xf6\xb5\x47\x89\xde\xfb\x9c\x5c\x33\x58\xc4\x43\xb0\x29\x3d\xad\x3d\x4c\x7a\xbf\x7c\x0c\x67\x9b\x19\xb2\x1d\x26\xcb\x99\x27\xbc\x29\x4e\xfd\x01\x5c\xb5\xcf\xde\x3b\x60\xff\xfc\xb5\x5f\x41\x54\x0d\x50\xb0\x5c\x20\x84\x69\xdc\x78\x82\x66\x7a\xc5\x34\x0d\x3c\x3e\xea\x18\xe6\x0b\x26\x56\xa6\x31\xe4\xb1\x4c\x40\x0b\x1b\xea\xd0\xef\x36\x2e\x1b\xa7\x3d\xe8\xc3\x5b\xd3\x30\xfa\x14\x95\x47\x33\xd4\x52\xb8\xa4\xb2\x90\xb7\x91\x94\x80\x24\x56\x80\x45\xd9\x67\xf6\xcd\x4e\xfb\x33\xa5\x9d\xe9\x89\x6e\x74\xb3\x75\xd1\x3e\x6f\x41\xf9\x42\x60\x02\xed\x16\x4c\x5c\x1d\x47\x15\x5e\x41\xb8\xee\x29\x8f\xc8\xac\x0e\xa2\x6c\x53\xd8\xcf\x62\xfc\xf8\xd8\xe8\x34\x4a\x70\x93\x5d\x2c\xdc\x8e\x93\xb1\xe6\xf2\x1d\x2e\xf5\x35\x2b\x62\x11\x6f\x58\x51\x05\x6d\x6b\x56\x6a\xb0\x7d\x4f\x84\xad\xeb\x3a\xbf\x27\xd3\x98\x2b\xbe\x49\xfe\xfe\xc0\xd5\x37\x15\xc4\x60\x7d\x25\x30\xeb\xf6\xf6\x3c\x31\x22\x11\x3f\x14\x35\x0c\x14\xea\xab\x3a\xc4\x61\x44\x37\x69\xe8\xf2\xa0\xa9\x0a\x68\x1a\xa9\x69\xf8\x2c\x60\x02\xe6\xee\x69\xc4\x25\xb3\x1d\x7d\x94\x88\x7b\x3e\x16\x93\x5c\x44\x89\x34\xd1\x4d\x52\x6e\xd5\x52\x59\x23\x06\xea\x1f\x11\x5a\x6c\x99\xd8\x4a\x32\x06\xa5\x23\x72\x69\x1e\xd7\xab\x8a\x51\xdb\x1d\x00\xa6\x99\x6f\x7d\x11\xe1\xd4\x13\x2b\xd4\x3a\x1a\xe0\xbe\x71\xcd\x96\xa1\x4c\xe4\x31\x24\x62\xc1\x6a\x1a\x99\xc5\xba\xf1\x18\x98\x3e\x7e\x48\x8f\x43\x2f\xc6\x11\x9d\xb6\x8e\x89\x74\x71\x4a\x94\x29\x42\xaa\x65\x67\xcf\x44\x18\x27\x60\xbd\xb1\x36\x89\x3a\x9a\xb4\x0a\xd6\x76\x69\xd3\x81\x89\x96\x3a\x78\xb3\x19\x26\x64\xe3\xa4\x06\x6f\x0a\xe7\x76\x4c\x65\x97\x79\x2b\x03\x84\x30\x71\x0d\x53\x3e\xf1\x7d\xe2\xe4\x3c\x61\xd3\x4d\xdb\xc9\x7b\xd3\x9d\xfd\xeb\x89\x3d\xeb\x61\xdd\x62\x37\xa3\xea\xa6\xa1\x95\x27\x8a\x50\xa5\x44\x1d\xdd\x2d\x1e\xdf\x3e\xce\x5b\xdd\x46\xa7\x07\xe7\xad\x5e\x7b\xb7\xd8\xed\x42\x57\xa9\x2e\x4f\x95\xdd\x7d\xbd\x20\xc3\x70\xe0\xfb\xc9\xe5\xb7\x46\x77\x1b\xb4\x50\xd4\xb5\x42\x99\x1f\x6d\x79\x3e\xaf\xdc\x4b\xf4\x29\x83\xbc\x1d\xdd\x16\xef\xb5\x6e\x09\xa5\x8e\xd0\x58\xb2\xe1\x13\x1b\xc2\x03\xe3\x66\x6a\x25\x99\x6b\xa1\x76\xd8\x94\xdf\xb0\xb2\x56\x85\x5a\xd5\x42\xdd\xe8\x36\xe4\x31\xf0\xe0\x45\xb4\x5b\x99\xe4\x0b\xc8\xf7\x0c\x5f\xbf\x5e\x03\xf2\x67\xac\x20\xdf\xc2\x23\xf3\xc8\x27\x06\x4e\x5a\x67\xff\x52\x76\xc9\xed\xe8\xd9\x2f\xd3\x7f\x25\xd5\xbf\x7f\x84\x0f\xa6\xda\x0b\x00\x00"
+
+func mysqlManytomanyGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlManytomanyGoTpl,
+		"mysql.manytomany.go.tpl",
+	)
+}
+
+func mysqlManytomanyGoTpl() (*asset, error) {
+	bytes, err := mysqlManytomanyGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.manytomany.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlProcGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbd\x56\x51\x6f\xd3\x30\x10\x7e\x4e\x7e\xc5\x11\xa1\x92\xa2\x12\xde\x91\xfa\xc2\x18\x12\x02\x56\xd8\x06\x9a\x84\xd0\xe6\x25\x6e\x17\x29\xb5\x5b\x3b\xe9\x56\x55\xf9\xef\xdc\xd9\x49\xe3\xa4\x69\x57\x36\xc1\x4b\x1a\x5f\xce\xf7\xdd\xf7\xdd\x9d\xdd\xcd\xe6\x0d\xbc\x14\x32\xff\x29\xd3\x04\xde\x8d\x21\x14\x1c\xa2\x6f\x4a\xc6\xd1\x39\xcf\x0b\x25\x2e\xd7\x0b\x0e\xc1\x0a\xbf\x06\x43\x78\x53\x96\xfe\x86\x36\x2c\xd0\xc1\x78\xeb\xf8\x8e\xcf\x19\x44\x17\xd5\xaf\xd9\x49\x8f\x33\x36\xe7\xce\x06\xcd\x73\xf2\x77\x23\xeb\x0b\xb4\x6d\x1d\x14\xd7\x26\xe0\x42\xa5\x22\x87\x88\xb6\x43\x28\x15\x84\x4c\x24\x76\x7b\x70\x2e\xef\x31\x87\xe0\x9c\xeb\x22\xcb\x83\x61\x13\x3d\x9d\x42\x6f\xd6\xb9\x4a\x67\x33\xae\x02\xd7\x31\x9a\x14\xf9\xa2\xc8\xb5\x6b\x33\xe1\x69\xfd\xf6\x2d\x6c\x36\x36\x97\xb2\x84\x54\x03\x03\x25\xef\x41\x99\x98\x3c\x81\xdb\x35\xe4\x77\x1c\x74\x2e\x15\xae\x48\x04\x9e\x14\x8a\xc3\x2b\xda\x65\x34\x29\xcb\x57\x23\xb8\x93\x59\x92\x8a\x19\x85\x4b\x11\x69\xf2\xe3\x12\x62\x99\x15\x73\xa1\x23\x03\xc9\x33\xcd\xfb\xf1\x28\xba\x32\xfc\x40\x4e\x9f\x8a\xb5\x60\x8a\xcd\x6b\x28\x54\x0f\x81\x72\xd2\xc3\x81\xd2\xb9\x2a\xe2\x1c\x36\xc6\x47\x31\x31\xe3\x8d\x30\xe8\xee\xa1\xab\x2d\x01\xfa\xe2\x3b\x0a\x40\x01\x22\x23\x6b\x59\xa2\x85\x94\xfc\x46\x38\xf6\x59\xfb\x5a\x42\x3d\x5f\xd0\x5a\xa5\xe2\x64\x55\xfa\x7e\x65\x6f\xc4\xd8\xc2\xc6\x2c\xcb\xf4\xe3\x12\x84\x06\x0f\xdf\x0d\x1a\x65\x3f\x84\xda\xe4\x36\x03\x8a\x05\x52\x40\x72\x1b\xb5\xaa\x6e\x70\x09\xfa\x92\x94\x97\xf7\x7a\xb7\xd8\xe8\x65\x6d\x28\x33\x4c\x0b\x11\xe7\x29\x06\x62\x8a\x37\xae\x8c\x3a\x45\x67\x69\xcc\x5b\xaa\x93\xb3\xcb\x89\x72\x8d\xf3\x07\x53\x1f\x5c\x26\xb7\x70\x35\xf9\xf0\x1e\x8d\x33\x69\x6c\x59\xaa\xb1\xf5\x3f\x09\x53\x06\xac\x10\xb7\x8f\x8a\x12\xa6\xbc\x9d\x05\xa7\x58\xe1\xaf\xdf\xaf\x9b\xca\x8e\x80\x2b\x25\xd5\x90\x74\xa5\x2e\x6b\x6d\xb2\x72\x7c\x4c\x79\x96\x38\x3b\x2b\x73\x5d\xdc\x9e\x08\x95\x52\xe8\xef\xf4\xc2\xa3\xbb\xdc\x1c\x0f\x67\xb8\x93\xd8\x91\x69\xd5\xc7\x96\x55\xf6\xb8\xc4\xca\xd2\xac\xb7\xfd\x88\x33\xe0\xad\x98\x22\x2f\xeb\xe9\xfb\x1e\xb6\x83\x5e\x66\xb0\x2c\xb8\x5a\xfb\x5e\x2c\x05\x96\x05\x0d\x38\x34\x30\x86\x9b\x8b\xd3\x2f\xa7\x27\x97\x55\x45\xf0\x84\xda\xf2\xec\xd2\x78\x0d\x1f\xcf\x27\x5f\x61\x0b\xd5\xe9\x5a\x3c\x12\x56\x0c\x5b\xbc\x2e\x38\x96\xf9\xc6\x82\xab\x42\xd4\xe0\x57\x93\x2f\x72\x16\x5a\xf0\x43\x8d\x32\x65\x96\xdc\xb0\xdb\xdd\xde\xd2\x28\x40\x67\x2b\xf6\xbe\x6d\xc0\xa9\x80\xe0\x3b\xc5\x0f\x9a\xa6\x64\x6a\x86\x8b\xbf\x01\xf2\x10\x85\x22\xbf\x18\x83\x48\x33\xd2\xd1\xb3\x03\x41\x4b\x03\xea\x7b\x88\x9f\xf0\x29\x57\xb0\x8c\x4e\x32\xa9\x79\x38\xb4\x0c\x33\xc9\x92\xea\xa0\xd3\x3b\x27\x33\x65\x5d\x5d\x07\xad\xde\xde\xa0\x7d\x2a\x29\xd6\x19\x7f\xc8\xc3\xa1\x85\x24\xb7\x96\x0f\x1a\xa9\x80\x31\x13\xf8\x46\x09\x8e\x71\xc7\x05\x2e\x89\xe9\x94\x8a\x23\x70\x1c\x75\x83\x17\x0c\x54\x60\x19\xf5\x50\xda\xe5\xe4\x19\x08\x82\x1b\x03\x5b\x2c\xb0\xb6\x21\x2e\x46\x30\x50\x43\x22\xbc\x3d\xe0\x7b\x1a\xbb\xc3\xab\xd3\xaa\x07\xf9\x75\x7d\xf1\x53\xad\x5b\x75\xca\xb1\xdb\xcc\x39\x9c\x1d\x23\x9e\x85\xe9\x9c\xa9\xf5\x67\xbe\x36\x39\x78\xde\x35\x7f\xc0\xc2\xea\x77\xa6\xa4\x23\x13\xaa\xe9\xd1\xfa\x3c\xae\x88\x1e\xa7\x65\x8b\xe8\x3f\x12\xb4\xa5\xdf\xde\x81\xef\x55\x91\x26\x7c\x05\x7b\xf7\x1c\xe0\x39\x58\x3d\x97\xc6\xaa\x61\x61\xaf\x05\xbf\xde\x6b\x3e\x63\x80\x76\xcf\xb8\x63\x40\x79\x2b\xa7\xbd\xfd\x2a\xb9\xdd\x51\xa6\x3f\x47\xcf\x98\xe6\x23\x07\xe4\xa8\x91\xdf\xf2\x1b\xa8\x1e\x7a\xbb\x23\xb1\xb7\xc3\x9f\xdc\xe0\xdd\xfe\xee\x6d\xef\xff\x2c\xe6\x9e\x09\x79\xbe\xa2\xce\x35\x58\x35\x0c\x9e\xfc\xfb\x5b\xfd\xdf\x92\x1e\x20\xea\x21\x56\x26\x2f\xb4\xb5\x52\x3b\x4b\x33\xf7\xb6\x6e\x31\xc6\x9f\x0e\x65\xe2\x70\x6d\x2f\xb5\x0e\x8d\xd3\x07\x1e\x3f\xef\x4a\xab\x40\x29\x89\xd6\xff\x54\x67\xf1\x07\x9f\x60\x00\xa8\x31\x0d\x00\x00"
 
 func mysqlProcGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -322,7 +543,7 @@ func mysqlProcGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _mysqlQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x5f\x6b\xdb\x3e\x14\x7d\xb6\x3e\xc5\xfd\x89\x50\xec\xdf\x5c\xe7\xbd\xe0\x97\x75\x0c\x06\xa3\xd9\xbf\x87\x42\x29\x4c\x8d\xe5\x4c\xa0\x48\xb1\x24\x77\x0d\x42\xdf\x7d\x5c\xc9\x76\xec\xa6\x65\xa3\x6f\xca\xf1\xfd\x73\xce\x3d\xf7\xc6\xfb\x4b\x58\xd9\x5f\xda\x38\xb8\xaa\x21\x8f\x2f\xc5\xf6\x1c\xaa\x1f\xc7\x03\xaf\x6e\xf0\x49\xb9\x31\x14\xa8\xed\xa4\x75\xf8\x68\x1e\x28\xd0\x8e\x02\x35\xdc\x52\xa0\xb7\x9b\xcf\x7a\x47\xa1\xfa\xda\x73\x73\xfc\xc2\x0c\xdb\xdb\x02\x2e\x43\x20\xb1\x76\x87\xe8\xb5\xde\xef\xb9\x72\x16\x7b\xa4\xb8\x09\x19\x03\x45\x0b\xd5\x00\x46\x6c\xbd\x06\xef\x4f\xd0\x10\xc5\xa5\xe5\xf3\xcf\x91\x5f\x08\x60\x7a\x65\x81\xc1\xb6\xb7\x4e\xef\x21\xf6\x2c\xc1\x70\xd7\x1b\x25\xd4\x0e\x0c\xb7\xbd\x74\x16\x98\x8d\x59\x27\x69\x21\x54\xa9\xae\x6a\xb0\x45\xdb\xab\xed\xa2\x6e\xde\x3c\xc0\xed\xe6\xc3\x7b\xef\xc1\x30\xb5\xe3\x0b\x95\x10\x42\xb9\x88\x1e\x6b\x43\x08\xde\x0f\x35\x0b\xc8\xbd\x47\x75\x4a\x3b\xa8\x36\x4a\x1e\x37\x0a\x03\xee\xee\xa7\x90\xff\x9f\x73\x2a\x81\x1b\xa3\x4d\x01\x9e\x64\x8f\xcc\xe0\xaf\x84\x10\x92\xad\xd7\x60\x3b\x99\x24\x92\x2c\x95\xae\x3e\x29\xc7\xcd\x41\x4b\xe6\x30\xfd\x91\x19\xac\x8d\xa3\x0a\x61\xab\x95\x75\x53\x2b\x48\x26\x42\x0d\x93\xa2\x95\x28\x61\x25\x4f\xce\x24\xf2\xa2\x85\x95\xc0\x84\x77\x53\x6e\x42\x73\xa1\x1a\xfe\xf4\xdc\xd7\x95\x28\x30\x38\xb9\xf2\x4a\xc4\x7c\x2a\xb3\x0e\x28\x02\xc1\xcb\x10\x7e\x7a\x8f\x54\xd2\x63\xb0\x24\x2a\x36\xbd\x1a\x15\xc7\x6d\xcb\x93\x8c\xd7\x5c\x99\x0d\x7c\x39\x99\x85\x5d\x73\x32\x83\x57\xd3\x26\x9e\x7c\x4a\x0e\x20\xb1\x74\x25\x33\x9b\xc7\x42\x24\x43\x83\x6a\x68\x1e\x12\x8f\x6f\xfa\xf7\x5f\x08\xbe\xcc\xa3\xa8\xbe\x6f\x99\xc2\x75\x69\x05\x97\x0d\x9e\xa1\x1d\x3a\x7d\x44\xc0\x42\x7e\x30\x42\x39\xa0\x17\x74\xa0\x53\x44\xd6\x99\x68\xe3\x8e\xfc\x57\x83\x12\x12\xb7\x26\x4b\xbb\x8f\x3f\xe3\x32\x91\x0c\x27\x39\x80\x17\x73\x35\x25\xc6\x9c\x6e\x0b\xd5\x74\x31\x05\x37\x62\x54\xf4\x36\x39\xff\xc8\x2b\x6b\x78\xcb\x0d\x74\xd5\xb5\xd4\x96\xe7\x45\xb2\x5c\x6a\xd6\x8c\x77\x8b\xcc\xe3\x7f\xc7\xdd\xfd\xd9\xad\xf8\x40\xb2\x56\x63\xfa\x0d\x7f\x72\x79\xbc\x99\x6c\x61\xd7\x55\x7d\xe6\x98\xc7\x69\xc4\x53\xda\x32\x45\xb2\xc1\xbf\xee\xcd\xf3\x7f\x41\xe8\xb9\xd2\x68\x41\x54\x52\x03\x3b\x1c\xb8\x6a\x72\xc3\x6d\xb9\xb4\xa3\x58\x38\x15\xbf\x4f\xfe\xa4\x83\x08\x84\xfc\x09\x00\x00\xff\xff\xb6\xe2\x6b\x23\xb5\x05\x00\x00"
+var _mysqlQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x54\x4b\x6b\xdc\x30\x10\x3e\xdb\xbf\x62\x62\x96\x60\xb7\x8e\x73\x0f\xf8\xd2\x84\x42\xa1\x64\xfb\x3a\x04\x42\xa0\xda\xb5\xbc\x35\xd8\x92\x2d\xc9\xed\x2e\xc6\xff\xbd\x33\x92\x9f\xd9\xa4\x94\xd2\xc3\x9a\x99\xd1\xbc\xbe\xf9\x66\xb6\xeb\xae\x60\xa3\x7f\x48\x65\xe0\x26\x85\xd0\x4a\x82\x55\x1c\x92\x6f\xa7\x9a\x27\xf7\x24\x06\x5c\xa9\x00\x02\xdd\x94\xda\x90\x90\xed\xf0\xd3\xe0\x4f\x71\x8d\xdf\x87\xed\x47\x79\x08\x20\xf9\xdc\x72\x75\xfa\xc4\x14\xab\x74\x04\x57\x7d\xef\x77\x94\xbb\x21\xeb\xad\xac\x2a\x2e\x8c\xa6\x1a\xce\x6f\xb2\x8c\x8e\x45\x0e\xc9\x60\xb4\xb6\xeb\x6b\xe8\xba\xd9\x34\x78\xf1\x52\xf3\xe5\xb3\xed\xaf\xef\x41\xb5\x42\x03\x83\x7d\xab\x8d\xac\xc0\xd6\x8c\x41\x71\xd3\x2a\x51\x88\x03\x4a\xba\x2d\xb1\x18\xd3\x36\x6a\x86\xd6\xf7\x89\xcb\x2b\x32\x2a\x91\xb7\x62\xbf\xca\x1b\xa2\xb2\x37\xc7\x9a\x50\xa1\x9e\xed\xe0\x61\x7b\xf7\x0e\x8d\x8a\x89\x03\x5f\x61\xc6\xe7\x78\x15\x3b\x56\x42\x19\x45\x57\x21\xb2\x19\x11\xab\x90\x06\x92\xad\x28\x4f\x5b\x41\x0e\x8f\x4f\x93\xcb\x9b\xe7\x1d\xc6\x80\xf3\x97\x2a\x82\xce\xf7\x7e\x32\x45\x9a\xb3\xf8\xbe\x87\x63\x40\x5a\x1c\x60\xdf\x73\xa9\x93\x0f\xc2\x70\x55\xcb\x92\x19\x0a\xc7\x10\xca\x4d\x83\xeb\xfb\xbd\x14\xda\x4c\xa5\xc0\x51\x0a\x29\x4c\x88\x36\x45\x0c\x9b\x72\xe6\xc9\x35\x8f\x59\x37\x05\x05\xbc\x9d\x62\x9d\x35\x2c\x44\xc6\x8f\xcf\x59\xde\x14\x11\x39\x3b\x8e\x5e\xf1\x58\x4e\x65\x51\x81\x40\x90\x11\x39\xfe\x8e\x66\x6c\xc5\x09\x03\x41\x16\x31\x92\x3d\x22\xb6\xbb\x17\x3a\x18\xaf\xb1\xb2\x18\xf8\x7a\x32\x2b\xba\x96\xcd\x0c\x5c\x4d\x7b\x39\xf3\xe4\x18\xa0\xc6\xdc\xcd\x2c\x68\x1e\x13\xf9\x1e\x11\x94\x42\xb6\x4b\xdc\xf2\xe4\x02\x02\xdb\xd1\x17\xf9\x2b\x40\x87\x61\xa7\x98\x3a\xa0\xf2\xe7\xd6\x5f\xee\x30\x4a\xbe\xee\x99\xa0\x34\x79\xc1\xcb\x8c\xce\x55\x0f\x3d\xbc\x27\x83\x86\xb0\x56\x05\x1e\x4d\x70\x19\x0c\x8d\x46\x16\x8f\x87\x60\xa8\xb9\x8b\x14\x44\x51\xd2\x3e\x79\xee\x46\x48\xb5\x6b\xe6\x7b\x34\xe3\xc1\x78\xb9\xc4\x19\x93\xcf\x7c\x83\x84\xb3\xb1\x21\xb4\x2b\xe7\x58\xff\x0f\xd0\xbf\xec\xd8\xcb\x78\xce\x15\x34\xc9\x6d\x29\x35\x0f\x23\xb7\x26\xa5\x64\xd9\x78\xf9\x84\xc9\xfe\xfb\x3c\x3e\x9d\xdd\x57\x87\x09\x72\x49\xe1\xf7\xfc\x68\x42\x7b\x67\xde\x8a\xe2\x9b\xf4\x8c\xe5\x8e\xe6\x64\xcf\x0f\xa9\x40\xc9\x71\xde\xfc\x33\x33\x2f\x00\x3d\x47\x6a\xc9\xb1\x48\x52\x60\x75\x8d\x43\x0a\x51\x89\xd7\x44\x45\x2b\x0e\xed\xfb\xc4\x9c\x3b\x22\x7c\xfe\x0d\x37\x03\x87\x60\xf7\x05\x00\x00"
 
 func mysqlQueryGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -342,7 +563,7 @@ func mysqlQueryGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _mysqlQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\xb1\x0e\x82\x30\x10\x86\x67\x79\x8a\x7f\x30\x41\x07\xca\x6e\xe2\x64\xe2\xe8\x22\x2f\x50\xe1\x50\x92\xb6\x90\xb6\xc4\x98\xcb\xbd\xbb\x29\xa0\xe2\xd0\x6b\x72\xff\xd7\xaf\x3f\x73\x81\x6d\xd4\x37\x43\x38\x1c\xb1\x0b\xf5\x83\xac\x86\xba\x2e\x77\x95\x92\x79\x5e\xb4\xa5\x3d\x0a\x91\x2c\xbd\xe9\x5a\xa8\x53\x6f\x2d\xb9\x38\xed\xca\x12\xcc\xbf\xd5\x42\x91\x09\xb4\x8e\x93\x03\x22\xf0\x34\x78\x0a\xe4\x62\x80\x86\xef\x9f\x68\x7d\x6f\x91\x33\x7f\xba\x88\xe4\x6a\x36\xb8\x26\xc9\xe2\x6b\xa0\x3f\x43\x88\x7e\xac\x23\x78\x82\xbc\x76\x77\x82\x3a\x77\x64\x9a\x90\xf0\xcd\x1a\x65\x86\xa7\x49\xa0\xaa\x34\x45\xf0\x6d\x6b\xd2\x19\xad\x5b\xd8\xf5\x97\x92\x65\xef\x00\x00\x00\xff\xff\x5b\x7f\x83\xf0\x1d\x01\x00\x00"
+var _mysqlQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x55\x8e\xb1\x0e\xc2\x30\x0c\x44\x67\xfa\x15\x37\x20\x15\x86\xa6\x3b\x12\x13\x12\x23\x0b\xfd\x81\x40\x5d\xa8\x94\xa4\x95\x93\x0a\xa1\x28\xff\x4e\xd2\x46\x50\x06\xdb\xd1\xdd\xf3\xc5\xde\x57\xd8\x3a\x79\x53\x84\xc3\x11\x3b\x7b\x7f\x92\x96\x10\xd7\x3c\x9b\xe4\x2c\xfd\x22\x35\xed\x51\x85\x50\xf8\xb8\xd3\x77\x10\xa7\x41\x6b\x32\x6e\xd6\xea\x1a\xde\xff\xa4\x4c\x91\xb2\xb4\xb6\x53\x46\xf4\xc0\x34\x32\xd9\x08\x5a\x48\xf0\xf0\x42\xc7\x83\x46\x19\x91\x7c\x4b\x08\xa5\x58\x12\x4c\x9b\xc2\xdc\x7b\xa4\xbf\x04\xeb\x78\xba\x3b\xf8\x19\x62\x69\x1e\x04\x71\xee\x49\xb5\x36\xe1\x9b\x35\x1a\xdf\x4c\x73\x80\x68\x52\x8f\xd2\xf7\x5a\x95\x6a\xd2\x26\xb3\xeb\x2f\x43\x51\x7c\x00\x5b\x7f\x83\xf0\x1d\x01\x00\x00"
 
 func mysqlQuerytypeGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -362,7 +583,7 @@ func mysqlQuerytypeGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _mysqlTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x5d\x6f\xdb\x36\x14\x7d\x96\x7e\xc5\xad\x30\x20\xf6\xe6\xca\xef\x01\x8c\xa1\x6b\x5c\x2c\x58\x9a\x04\x89\xb3\xf5\x2d\xa6\xcc\xab\x9a\x8b\x44\x26\x24\xe5\xc6\x10\xf4\xdf\x07\x7e\x48\x91\x2c\x25\xb6\x9b\x3d\xd4\xaa\x44\xf2\xf0\xf0\xde\x7b\xce\x65\xca\xf2\x23\xfc\xa2\xd6\x42\x6a\x38\x9d\xc1\xc8\xfe\x8f\x93\x1c\x21\xbe\x34\xbf\x11\x4a\x19\x41\x24\x51\x45\x10\xa9\xa7\x4c\x69\xf3\x4a\x93\x08\xa2\x6f\x57\x17\xe2\x7b\x34\x86\x8f\x55\x15\x5a\x14\x4d\x92\x0c\x1d\xca\x6a\x8d\x39\x81\xf8\xd6\x3f\x17\x66\xc4\xfd\x1a\xd4\x97\x35\x2c\x85\xf8\xb3\xc8\x73\xe4\xda\x7e\x9b\x4e\xa1\x2c\x5f\x3e\xf9\x59\x98\x29\x6c\x0f\x5b\x66\x55\x05\x12\x1f\x25\x2a\xe4\x5a\x01\x01\x29\x7e\x40\x2a\x45\x0e\x27\x65\x59\x73\xa9\xaa\x93\xd8\x21\x70\x6a\xc0\xf4\xf6\x11\x3b\x08\x4a\xcb\x62\xa5\xa1\xb4\x93\x24\xe1\xdf\x11\xe2\x2f\x0c\x33\xaa\xcc\xf4\xa0\x3d\xb5\x2c\x41\xa2\x05\x88\x17\xe6\xb7\xaa\x60\xf9\xaf\x12\xfc\x34\x72\x8c\x33\xf3\xaf\xc8\xb9\x9f\x1f\x2d\xa1\x39\xcc\xce\x50\x9b\x51\x1d\x84\x6b\xc9\x72\x22\xb7\x7f\xe1\xd6\x7c\x0d\x83\xe9\x14\x9e\x05\xa4\x96\x4a\x18\xdc\xe3\x33\x53\x5a\x4d\xe0\x9e\x62\x86\x1a\x29\x24\x42\x64\x61\x59\xd6\x30\x55\x68\x5e\xfa\x40\xd3\x29\xcc\xed\x52\xa0\xa8\x51\xe6\x8c\xa3\x32\xd3\xf4\xba\x1b\x07\x87\x0f\x8c\xdb\x11\x4a\x34\x49\x88\xc2\x38\x4c\x0b\xbe\x82\x91\x09\xa8\x2b\x91\xaa\x82\x5f\x5b\xeb\xc6\x1e\x7d\x34\xb6\x84\xa0\x0c\x03\x89\xba\x90\x1c\xda\x4b\x62\x4f\xdf\xb0\x9c\x4e\xe1\xcc\x1f\xe1\x51\x8a\x0d\xa3\x86\x0f\x4f\x85\xcc\x89\x66\x82\x0f\x71\x5b\x13\x05\x09\x22\x87\xfa\xec\x36\xcb\x47\xf2\xf4\x9b\xee\x23\xea\xb7\xf0\x4c\xcf\xb9\x42\xa9\x81\xd9\x87\xea\x11\xd3\xe2\x58\x16\x0e\x70\x44\x13\xf8\x76\x75\xf6\xc7\x18\x50\x4a\x21\x0d\x99\x0d\x91\xe6\xc5\x7d\x70\xe9\x67\x29\x90\x4c\x22\xa1\x5b\x97\x9d\x09\x24\x84\x65\x61\xc0\xd2\xc1\xe0\x1a\x94\xfa\x4c\x16\x45\xc5\x97\xf8\x63\x14\x39\xf2\x90\x12\x96\x21\x3d\xed\x42\xaa\x68\x1c\x06\x55\xd8\xd4\x8e\x93\xe9\x57\xc2\x0b\x92\x5d\x3f\x80\x95\xc0\x74\x0a\xea\x29\xf3\x31\x80\xa7\x02\xe5\x76\x02\x8f\xae\xc8\xe0\x01\xb7\x90\x17\x4a\x43\x82\x75\x3a\x69\x18\xac\x04\x57\x1a\x9c\x59\xc0\x0c\x96\xe7\x97\xb7\xf3\x9b\x05\x9c\x5f\x2e\xae\xa0\xad\x4d\x18\x2d\xe1\xb7\x30\x08\x96\x65\x09\x2b\x91\x19\xd7\x51\x2d\xf9\xf9\xc1\x31\xfc\xfd\xe9\xe2\x6e\x7e\xbb\x33\x7b\x43\xb2\xa1\xc9\x4b\x17\x3c\x59\x70\xc7\x35\x0c\xac\x4d\x8d\x1c\x9b\x89\xd9\xdf\x8a\xaa\xbb\x59\x13\xcd\x71\x18\xdc\x4f\x6c\x26\x66\x40\x93\x78\xfe\x8c\xab\x23\x96\xb2\xd4\x2e\xfd\x30\x03\xce\xb2\x9d\x84\xd8\x40\xdb\x68\xa2\x76\xd1\x47\xbe\x42\x6b\x31\xfd\x5c\xce\x40\xcb\x02\xad\xbe\x8d\xf5\x1d\x94\x87\x3a\xfe\x90\x6c\x81\x14\x5a\x30\xbe\x92\x68\x5c\xf4\x7f\x4a\x48\xcb\x59\xea\x82\x3e\x22\x43\x6f\xac\x7e\x57\xca\x06\x70\xc7\x46\xdb\xca\x65\xf1\xf4\xc8\x34\x0e\xc3\x1d\x94\x57\x89\x5a\x32\xdc\x20\x30\x1a\x06\x8c\x36\xfb\x4b\x54\xf1\x05\x51\xda\x69\xff\x9c\x8e\x8e\x29\x94\x76\x82\x09\xa7\xaf\x16\x8e\x71\x99\x3e\x75\x98\xc1\xce\x80\xef\x5c\x23\x46\xc7\xfb\x4b\xcf\xb5\x96\xc6\x29\x39\xcb\x5e\xfa\x0c\x47\x18\x1d\x1e\xc6\x31\x44\x51\x5d\xc5\x77\x8f\x94\x68\x84\xc2\x3e\xfa\xa6\xda\x6b\x41\xc1\x5e\x57\x75\x88\x03\xae\xda\xb3\x55\xef\xab\x54\xa0\xe2\x27\xba\xeb\xab\x26\x29\x1f\x5e\x75\xd6\x21\x6b\x75\x47\x68\xac\xd5\xa0\x02\x17\x1e\xd6\x58\xab\xcd\x64\xbd\xa7\xeb\x2c\xed\xdd\x06\x5b\xcf\xa1\xbb\xe5\x44\x3e\x98\x5e\x28\xa4\x83\x66\x82\x77\xb6\x34\x66\xe1\xe5\xd4\xd3\xff\xdd\xf5\xd9\xa7\xc5\xbc\x2b\xfd\xdb\xf9\x02\x9c\x22\x3b\xf2\xb7\x10\x4d\x7a\xa3\x09\x44\xaf\x4b\x39\x58\xc2\x3f\x7f\xce\x6f\x2c\xb0\x5f\xdf\x99\xfc\x59\x64\xae\x28\x7f\x5f\x7a\x92\x2d\xcd\xbf\x53\xf4\x13\x38\x40\x0f\x26\x3e\x3f\x67\xef\xef\xd9\x71\x40\xda\xb7\x64\x83\xa0\xc8\x66\xa0\xfe\x7b\x97\x8a\xfd\xf5\x6f\xd0\x86\xaa\x7f\xb7\xc4\x9a\xbb\x5a\xbb\xc4\x3a\x33\x1a\x25\x35\x95\x34\x34\xab\xb9\xc5\xd8\xdb\xc3\x4e\x93\xf2\xf2\x56\x9a\x68\xdb\x7d\x14\x88\x9c\x69\x53\xd8\xb4\x40\x73\xba\x8c\xac\x1e\x40\xa4\xfe\x6e\x0b\x42\xaf\x51\x82\x5e\x13\xde\x36\xbb\xb6\xff\x34\x57\x46\xaf\xa1\x7e\xcc\x7e\xfe\x42\x78\xf0\x55\x6c\xd0\x32\xde\x74\x8c\x96\x6b\xd6\x69\xef\xdb\xc0\x9b\x2e\x30\x80\xd0\x52\xf5\xae\xa8\xcf\xe6\x17\xf3\xc5\x1c\xbe\xdc\x5c\x7d\xed\x2a\xfb\x08\x4d\xee\x69\xc3\x07\x54\xfb\x5b\xf2\x3a\x60\xf9\xc1\x8d\xb1\xbe\xa8\x07\xc3\xe1\xf3\x5d\x6c\xa7\x77\xb5\xfe\xee\x0a\xff\x0b\x00\x00\xff\xff\xdc\x0f\x91\x53\xf8\x0e\x00\x00"
+var _mysqlTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x58\xdd\x6f\xdb\x36\x10\x7f\x96\xff\x8a\xab\x50\xa0\xf6\xea\xba\x7b\x18\xf6\x90\x21\x03\xda\xc5\xdd\x82\xa5\x4e\x17\x3b\x5d\x81\x20\x88\x29\x8b\x4e\xb4\x48\x94\x4b\x4a\x69\x3c\xc3\xff\xfb\xee\x48\x4a\xa6\x3e\xe2\xd8\x69\x07\x6c\x0f\xa6\x25\xea\xee\x78\xbc\x8f\xdf\x1d\xb9\x5a\xbd\x82\xe7\xea\x26\x95\x19\x1c\x1c\x42\x57\x3f\x09\x96\x70\x18\x8c\x68\xf4\xb9\x94\x3e\xf8\x92\x2b\x1c\xd5\xe7\x58\x65\xf4\x1a\x06\x38\x7c\x3a\x3d\x49\xaf\xfd\x1e\xbc\x5a\xaf\x3b\x2b\x92\x92\xb1\x20\xe6\x46\xca\xec\x86\x27\x0c\x06\x63\xfb\x3f\xa1\x2f\x66\x24\xa9\x0e\xcf\x22\xce\x25\x8b\x35\x93\x79\xdc\xac\xed\x50\x05\xfb\x2a\xf8\x39\xe7\x72\x59\x55\x14\xfc\x80\x65\xb3\x1b\xfc\xbf\x63\x31\x11\xc7\x51\x82\xa3\xc0\x5f\x44\xbf\x50\x0f\xf4\xe1\xb3\xbb\x29\x99\x7e\x51\x46\x3d\x19\x89\xac\x54\xc5\x57\x0e\x51\x34\x87\xc1\x2f\x69\x92\x70\x24\xa0\xb9\xd7\xaf\x61\xb5\xda\x4c\x59\x2a\x1e\x2b\xee\x7e\xd6\xea\xaf\xd7\x20\xf9\x02\xb5\x47\x42\x05\x0c\x70\x39\x98\xcb\x34\x81\x17\x48\x62\x2d\xba\x5e\xbf\x18\x18\x09\x22\x24\x61\xd9\x72\xc1\x2b\x12\x70\xcf\xf9\x2c\x83\x95\x26\x92\x4c\x5c\xa3\x71\xde\x45\x3c\x0e\x15\x91\x7b\x2e\x29\x3e\x4b\xae\x05\x0c\x26\x34\xe2\xd4\xf4\x2f\x95\x8a\x03\xdf\x68\x1c\xd3\x2f\x4f\x84\xa5\xf7\xa7\x50\x6e\xa6\xf6\xc9\xd5\xa8\x30\xc2\x07\x19\x25\x4c\x2e\x7f\xe7\x4b\x9a\xed\x78\xc8\x7b\x9f\xc2\x5c\xab\xd2\xf1\xae\xf8\x7d\xa4\x32\xd5\x87\xab\x90\xc7\x3c\xe3\x21\x04\x69\x1a\x23\x73\x21\x06\x59\xf0\xa5\x29\x08\xc5\x0c\x35\x2b\x84\xc8\x26\x93\x48\x70\x45\x64\xd9\x4d\xd5\x0e\x46\x3e\x44\x42\x7f\x09\x19\x9a\x8f\x29\x3e\xe8\xcc\x73\x31\x83\x2e\x19\xd4\x38\x0f\x49\xbf\x73\xf8\x7a\x56\x7a\xb7\xa7\x15\x42\x3b\x7a\x68\xa3\x5c\x0a\x70\x59\x06\x56\x7d\xd2\x12\x15\x3a\xb2\x5b\x58\xc8\xf4\x2e\x0a\x49\x1f\x31\x4f\x65\xc2\xb2\x28\x15\x6d\xba\xdd\x30\x05\x01\xe7\x02\x8a\xbd\x6b\x2f\xef\xa9\xa7\x5d\xf4\x31\x45\xed\x12\x56\xd3\x63\xa1\x38\x7e\x88\xf4\x9f\x6a\x28\x96\xa5\xfb\x6a\x61\x04\x12\xc5\x2c\xbb\x5f\x30\xc9\x12\x9c\x0e\x03\xf8\x74\x7a\xf4\xb6\x07\x98\x8f\xa9\x24\xd5\xee\x98\xa4\x17\x33\x61\x82\x01\xed\xc2\x62\xc9\x59\xb8\x34\xbe\xea\x43\xc0\xa2\xb8\xe3\xe1\x7c\x9b\xa9\x49\x4a\xb1\x43\x2d\x45\x0d\x46\xfc\x4b\xd7\x37\x5b\x81\x39\xf2\xf2\xf0\xa0\x2a\x12\xf3\xb2\xe3\xe1\xc6\x8b\x48\x32\xd0\xf3\x9e\x89\x9c\xc5\x1f\x6e\x41\x27\x04\x6a\x82\x48\x61\x2d\x02\x1a\x2b\xfa\xe8\x48\x1d\x72\x70\x8b\x31\x97\xe4\x2a\x43\x6f\x15\xce\x0d\x3b\xde\x2c\x15\x38\x65\xf0\x05\x0e\x61\x7a\x3c\x1a\x0f\xcf\x26\x70\x3c\x9a\x9c\x82\x9b\xa9\xd0\x9d\xc2\x4b\xd4\x7a\x4a\xd6\x49\x35\x9a\x29\x27\x19\xed\xc7\x1e\x7c\x7c\x73\x72\x3e\x1c\xd7\xa8\x09\x98\x5a\x88\xa7\xc6\x78\x32\x17\x46\xd7\x8e\xa7\x11\xad\x6b\xb4\xe9\xd3\xfa\x3a\xc5\xaa\x8b\x95\xd6\x44\x7b\x5c\xf5\xb5\x27\x0e\x21\x0c\x06\xc6\x6d\x73\x01\xfe\xf0\x9e\xcf\x7c\x24\xb0\x9e\x64\xf2\x1a\x5f\x76\x17\x8a\xd6\x25\xa1\xcf\x0e\x41\x44\x71\xcd\x55\xda\x05\xda\xce\x3c\x33\x7e\xe1\x62\xc6\x35\x14\x35\xbd\x7c\x08\x88\x5f\x5c\xe3\x00\x41\xe4\x4e\x1e\x2a\x3c\x03\xc1\x12\x58\x9e\xa5\x91\x98\x49\x4e\x68\xfb\x8d\x5c\xe5\x20\x50\x11\xf8\x7b\xf8\x6e\x0b\xf7\x57\x39\xb3\x45\x6e\x8f\x30\x40\x19\xff\x1e\x7c\x33\x07\xb7\x2f\xb4\x93\xc7\x71\x4a\x46\xfc\x8e\x43\x84\x69\x13\x85\xa5\x66\xa8\xe5\xe0\x84\xa9\xcc\xa0\xc7\x31\x82\xd8\x1e\x21\xe4\xba\x9e\x61\xb1\x78\x28\xa4\x08\xa7\x9a\xaa\x63\x14\xd4\x3e\xd8\xda\xd7\x8d\xc2\xde\xe3\x41\x69\x8a\x53\x89\xb5\xa8\x6a\x05\x59\x89\xdd\xb6\x31\xb8\xd4\x03\x30\xab\xea\x38\x0b\xb9\x8a\xc4\x35\x22\x4d\x9c\x45\xaf\xb0\xea\x93\x3c\xcb\xdb\xa7\x0a\xa6\x9b\x15\x74\x4c\x3a\x47\x5c\x7d\x4b\x2f\xe3\xe8\x6f\x4e\xfd\x81\xb2\x10\xdd\xb2\x7a\x3b\x26\x6b\x5f\x9b\x46\x06\x15\xbc\xb8\xac\xa2\x79\x89\xd7\x5b\xf0\x19\x2b\x1b\x5c\x19\x31\x41\x59\x14\xc8\xa7\xba\xd7\x70\xa5\x93\x0f\x2d\x9a\x07\xad\x70\xfe\x24\x3c\xc7\x50\xf0\xa8\xcb\x80\xe7\x02\x53\xcd\xf4\x63\x31\xd6\x52\x1b\xb5\x3d\xb7\x07\xa9\x01\xfe\x7f\x0b\xef\xa7\x9b\x4e\xb0\x68\x30\xcd\x8e\x70\x43\xd7\x3c\x53\x19\x93\xd9\x2c\xcd\xb1\x69\x6c\x81\x12\x67\xb3\xff\x0b\x80\x9c\xba\x2d\xa2\x56\x19\x5b\xee\x28\xd3\x31\x0c\x0b\x2e\x8d\xda\x45\x62\x24\xec\x1e\x44\x9e\x04\x38\x8f\x31\xaf\x43\x18\x7b\x46\xe4\x20\x6f\x3b\x29\x50\x38\xda\x1a\x8e\x8c\x81\x6f\x82\xa8\x50\x4f\x94\x62\x58\x49\x7f\xdd\xb9\x96\x74\x3f\xd1\xf2\xf0\x33\x92\x52\x1c\xd2\x33\xc2\x8e\x89\x2b\x47\x4b\x0a\x75\x0c\xad\xae\x13\xd4\x3d\x64\xfa\x5e\x33\xe9\xa4\xb4\x2b\x15\x5f\x4d\xc0\x13\x8b\xfe\x4a\xc4\x24\x5b\xc7\xba\xa1\x3f\x34\xc9\x7c\x71\x80\xf3\x97\x3a\x98\x71\x20\x6b\xa4\x2c\x04\xac\x1b\x39\xc7\x8d\xea\x76\x49\x17\x91\x8b\x4b\x3c\x68\x70\x39\x67\x33\xbe\x22\xe9\xdb\x93\xcf\x2c\xb1\xda\x16\xfe\x24\x5b\x87\x18\x5b\x2c\x70\x9f\x5d\x7a\x7b\x10\xff\x83\x4d\x85\x77\x43\xf5\x49\x42\x5a\xab\x88\x63\xed\x8d\x29\x9c\x72\xe8\x99\xa8\xc0\x0d\xda\x08\x7d\x89\x67\x88\x8f\xda\x4a\x5d\x5f\x7b\x58\xd1\xb6\xfc\xbe\x63\xf3\x7e\xc5\xd1\x04\x18\xa6\xa8\xda\xbc\x20\x5d\x07\x83\x41\x6f\xab\x91\xae\xf6\xa8\xa1\x75\xb9\x5e\x4b\x25\xab\x94\x32\x6f\x5d\xb3\xe6\x7e\x35\xfb\x29\xeb\x59\xc3\x6e\xaa\x31\xa5\x15\x25\xda\x3c\x92\x4a\x27\x21\x89\xd9\x5e\x9f\x77\x5e\xa6\x56\xa3\x71\x6f\x4c\xa9\x3c\xa1\x0a\x47\x4b\x12\x00\x41\x89\x40\x36\xe6\x0b\x7d\x28\x8f\x8c\x14\x26\x39\x10\x38\xf1\x59\x9e\x45\xa8\x73\xf7\xfd\x72\xfc\xc7\x89\x3e\x3a\x89\x14\xce\x86\x93\xf3\xb3\xd1\xf1\xe8\x57\x98\xc5\x2c\x57\xbc\x67\x73\x23\x7a\x34\x37\x50\xeb\x6a\x41\xda\xbf\x4d\xc0\x28\xc4\xac\xfc\xf1\x87\x6e\xd4\xeb\x6d\xdc\x59\x80\x46\x4b\xa7\xbb\x63\xda\x36\x54\xab\xf5\x1f\xd6\xc4\x6e\x89\xad\xa0\xcf\xc5\x26\x09\x0e\x2e\x4d\xcb\x54\x6d\x53\xcc\x31\x48\xa0\x31\x37\x89\xaa\x9b\x8e\x2d\x2d\x5f\x51\x68\x7c\xbf\xa8\x35\xe7\x0b\xec\x5b\xb0\x67\xd1\x7f\xcd\x33\x64\xe3\xc4\xed\x3d\x7a\x88\x34\x12\x1f\x3d\x44\x36\x4e\x91\xb6\x4d\x09\x53\xae\xc4\x8b\xac\xda\xa6\x50\xb8\x3e\x7b\xf0\x20\xd9\xd6\x79\x98\x0d\x95\x9d\x07\x49\xc5\x50\xb3\x62\x6d\xe7\xb1\x59\xd3\x1c\xab\xdd\xd5\x5a\xcf\xdd\xbb\xae\x86\xd6\xbe\xa5\x8b\x00\xdc\xa9\xe6\x8c\x52\x51\x59\x92\x0a\x7c\x01\x8a\xf5\x9a\x7d\xfe\xe1\xe8\xcd\x64\x58\x2d\xd7\xe3\xe1\x04\x4c\x0d\xae\x94\x6c\x2d\xa2\xea\x72\x04\x4f\x1f\x4b\x5a\xc3\xe5\x65\x11\xf7\xa6\xf0\xe7\x6f\xc3\x33\xbd\x40\x9b\x9c\x06\xa3\x0f\x6f\x46\x47\x38\xee\xde\xc5\x10\xa0\xe0\x72\x2d\x05\x60\xcb\x81\x68\xb7\xc8\x45\xb9\x8d\xd2\xd4\xa0\x71\x8f\xb2\x5f\x7d\x40\xfe\xb7\xf4\x6a\x39\x0e\x8d\x19\x22\xa3\xc2\x61\x87\xab\x9c\xc7\xd3\x90\xa4\x3d\x9e\x84\xf5\x48\x2f\xef\xcb\xdc\x48\xaf\x50\x54\xd2\xdb\x18\x2e\x0c\xca\xe0\x6e\xe3\xa8\xdc\x2a\x39\x1c\xeb\xfa\xc5\x80\xc5\x22\x8c\xb2\x4c\x97\x13\x2c\x24\xd8\x55\x52\xde\x85\x39\x27\x1b\xc4\x6c\x76\x4b\xc5\xc5\xdc\x3b\x42\x8a\x36\x91\x68\x18\x26\xdc\x12\xe5\x9e\xec\xca\xeb\x3c\x9b\xe2\x4d\xcb\x3e\xfd\xb2\xee\x89\xd7\x64\xad\xf8\xb6\x15\xde\x1c\xd8\x2f\x42\xa5\x89\x59\x5b\x21\xab\x45\x82\x03\x41\x75\x04\x3a\x1a\x9e\x0c\x11\x81\xde\x9d\x9d\xbe\xaf\xc2\x50\x3b\x70\x6c\xc1\x0c\x83\x02\x7b\x5c\x8a\x6c\xcd\x99\xaf\xbf\xeb\xda\x2a\x7e\xe7\x2b\x8b\xe2\x12\xd6\x6b\x37\xb8\xad\xef\xb5\x72\xed\x76\x15\xff\x00\xc7\xd1\xea\x42\x9a\x19\x00\x00"
 
 func mysqlTypeGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -382,7 +603,7 @@ func mysqlTypeGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _oracleForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x4b\xc4\x30\x10\x85\xcf\xe6\x57\xbc\x83\xb0\xc9\xb2\xdb\xde\x05\x2f\xab\xe8\x41\x50\x10\x0f\x5e\xbb\xed\xd4\x16\xb7\x89\x4c\x52\xb5\x84\xfc\x77\x49\xda\xed\xd6\x65\x0f\x81\xe1\x9b\x37\x99\xf7\xc6\xfb\x2d\xae\x6d\x63\xd8\xe1\xe6\x16\x32\x55\xba\xe8\x08\xd9\xdb\xf0\x45\xd9\x73\xd1\x91\xc2\x36\x04\x91\xe7\xf0\x1e\x09\x20\x04\x30\xb9\x9e\xb5\x85\x6b\x28\xf1\x57\xaa\xe7\x81\xd8\x2f\xac\x35\x65\x5b\x38\xaa\xf0\xd3\xba\x66\xd6\x2d\x45\x2b\x9b\xd0\x43\x4b\x87\x6a\x1e\x94\x27\x74\x67\x0e\xf1\xf5\x9d\x9e\x9a\x2a\x13\x79\x1e\x9d\x3c\x92\x26\x4e\x9f\xd7\x6c\x3a\xd4\x86\xa9\xfd\xd0\xf8\xa4\x01\xab\x34\x3f\x82\x27\x1a\x16\xe5\x71\x6b\x26\xea\x5e\x97\x69\xd1\x94\x3c\x04\xac\xcf\xcd\xa9\x65\x5c\x59\xed\xf1\xfe\x72\xbf\x53\x90\xeb\x0b\x69\x37\x20\x66\xc3\x0a\x5e\x5c\x8d\x87\xb9\x74\x93\xdd\x30\xc1\x7f\x81\x65\xb5\xdf\x44\x75\x69\xf4\x37\xfd\xba\xa3\xa5\xf1\x04\x27\x79\x74\x24\x82\x10\x7f\x01\x00\x00\xff\xff\xea\x89\x96\x81\xb0\x01\x00\x00"
+var _oracleForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x55\xdb\x6e\xdb\x46\x10\x7d\x26\xbf\x62\x4c\x14\x31\x15\xd3\x74\xfa\xea\x42\x79\x88\x2b\xb7\x45\x1d\x3b\xb5\x8d\x26\x80\x20\xc4\x2b\x6a\x29\x11\x59\x2d\xa5\x5d\xd2\x91\x20\xe8\xdf\x33\x33\xbb\x94\x28\x87\x49\x1c\xa0\x05\x24\xee\x85\x73\x39\x73\x39\xc3\xcd\xe6\x14\x7e\xb1\xb3\xd2\x54\x70\xde\x87\x98\x77\x5a\xcc\x25\xa4\xf7\xeb\x85\x4c\xaf\x71\xdb\x83\xd3\xed\x36\xdc\x90\xa0\xfa\xbe\x24\x44\xd2\x98\x08\x22\xbb\x54\xb6\xa2\xcd\xb2\x96\x66\x8d\xeb\x64\x4c\x07\xfc\x1b\x69\xf1\xf9\x49\xae\xfd\x42\xc2\x52\x6a\x5c\xc6\xa2\xca\x66\xb8\x7e\xb8\xb9\x2a\xa7\x51\xcb\xa7\xe9\xf4\x79\x2b\xf3\xff\xc1\x6d\x13\x61\xdb\x7d\xf9\xd9\xb2\xf3\x85\x29\x74\xb5\x4b\x41\x64\xdb\x18\xd1\x64\x85\x68\x58\xce\x48\xde\x12\xc2\xcb\x42\xaa\x09\xa7\xc7\xc9\x9e\x9d\xc1\x66\x03\x0e\xf3\x76\x0b\x28\x59\x1b\x6d\xa1\x9a\x49\xbe\x3f\x88\x09\xdf\x0b\x6b\xcb\xac\x10\x95\x9c\xc0\xe7\xa2\x9a\xed\xe4\xda\x42\xc7\x96\xaf\x9c\xa7\x46\x31\xde\x5f\x5d\x94\x8a\xfe\xf5\x5c\xfb\x97\xbd\x14\x61\x10\x92\x3f\xa4\x96\x86\x8d\xe7\xa6\x9c\x43\x5e\x1a\x59\x4c\x35\x60\x28\x70\xcc\xfa\xee\xe2\x6f\xb9\x6e\x6d\x1b\xaf\x69\x98\xd7\x3a\x63\x47\xbe\x7b\xd0\xed\xcb\xa7\xe0\x7a\xed\x70\x49\x36\xab\x56\x0b\x61\xc4\x1c\x8f\x93\x31\x7c\xb8\xf9\xfd\x4d\x0f\xe2\x97\x1d\xb1\x27\x80\x25\x2d\x0d\x1a\x08\x03\x97\xa6\xae\x0c\xbd\x59\xfb\xcb\x83\xf0\xbd\x23\x61\xa6\xec\x26\x21\xcd\xac\xd4\x8f\x72\x55\x35\x60\x5d\x72\xf6\xaa\x84\x35\xdc\x86\x4d\x81\xae\x4a\x31\x79\x66\x91\xec\xb3\xaa\x64\x8f\x7f\xb6\x4a\x09\x61\xc1\x5a\xa0\xd5\xf1\x9a\x6c\x16\x06\xba\x82\x4d\xa0\xd0\x20\xc0\x16\x7a\xaa\x24\x70\xe3\xc3\x42\x1a\xe0\xbe\x86\x32\x27\x1b\xf6\xbf\x2c\xf9\x93\xfc\x74\x57\x95\x73\xee\xa8\x83\x81\x0e\x47\x1d\x9d\x11\xcf\xc5\x62\x48\x52\x0d\x77\xb6\xdb\xd1\x8f\x3a\x01\x43\xc8\x4a\xa5\x64\x56\x71\x58\x61\xf0\x28\x0c\xef\xd0\x05\x92\x53\x9a\x5c\x64\x72\xb3\x0d\x03\x22\x37\x91\xb1\xc3\xc7\xb8\x2c\x15\x89\x60\xf0\xf0\xd1\xe1\x54\xbb\x0e\x46\x15\x23\xf4\x54\x1e\xc0\xdf\x30\xc7\x8b\x1c\x66\xc2\xbe\x33\x32\x2f\x56\x4d\xd9\x08\x27\x8f\x9d\xf4\xba\x56\x2a\x42\xe1\x30\x08\x50\xf0\xe8\xc0\x6a\xfa\x55\xe9\xd3\x7f\x85\x2a\x26\x14\x51\x10\x60\x67\x56\x85\xae\x25\xee\xdd\x30\x91\x7a\xb2\x33\x44\x95\x41\x4c\xed\x06\x56\xdf\xec\xe0\xdf\xe0\x88\xe2\x1e\xa2\xd2\xc8\xd9\xde\x1f\xfb\x50\x19\xf6\x11\x70\xba\xfa\x20\x16\x0b\x74\x14\xd3\x29\x21\x37\x3d\xf6\x8f\x3f\xce\x32\x86\xe4\x7a\x29\x24\x7c\xb6\x02\x37\x5a\x51\xef\xe1\x6e\x70\x35\xb8\xb8\x87\x07\x38\x41\x8d\x07\x46\xa6\x68\x24\xdb\x7d\xe5\x18\x10\xa7\xce\x4b\x5d\xde\xde\xbc\xa5\x20\x6c\x36\x93\x73\x01\xe9\x9d\x5f\x1b\x85\x7b\x31\x56\xfe\xd9\x90\xc3\x6b\xbe\xff\x73\x70\x3b\x80\xbd\x97\x16\x01\x90\x2f\x24\xf8\xd7\x35\x3c\x84\x34\x24\xec\x37\x0a\xde\xd5\x54\x4d\x03\x28\xa9\x39\x05\x3d\x78\x0d\xaf\x38\x67\x8e\x37\x68\xc9\x35\x18\x15\x81\x84\xf8\x9a\xa4\xd0\xd8\x5c\xb8\x76\xf7\xcd\x11\x34\x4a\x7d\x47\xba\xe1\xf9\x13\x99\x11\xa7\x16\x1f\x98\x58\x53\xeb\x26\xb1\x81\x23\x2b\xba\xf2\xc9\x3d\x81\x55\x89\x8d\x51\x4b\x1b\x47\x6c\xc2\x7e\x42\xed\x28\x81\x5f\x93\x16\x06\x2a\x14\x7f\xac\x62\xd6\x4f\x9c\xd3\x34\x4d\xe9\xc5\x92\xd9\x42\x36\x27\xe3\xd4\x31\x33\xd7\x10\xfd\xe3\xbe\x87\x4f\x46\x63\x87\x3e\x46\x4b\xfa\x47\x7d\xd0\x85\x72\xb1\xf9\xe9\x8b\x67\xb6\xdd\x8a\x45\xe1\x14\xc0\xf1\x68\x6b\x55\x51\xa6\x28\x9f\xcb\xf4\x1a\x9b\x34\xee\x39\x55\x26\x51\x9b\x5b\x5d\x95\x70\x82\xcc\xaf\xdd\xbb\x77\xa6\x98\x0b\xb3\xc6\xe1\xe3\x98\x10\x04\x1f\xe5\xaa\xb0\x95\x3d\xe7\x36\x4e\xbc\x71\x62\xca\xa9\x13\x60\x4c\xdc\xb9\x99\xd0\xb4\xa5\x30\xfa\x88\xe7\x0e\xcf\x14\x75\x4e\x3d\xd3\xdd\xa7\xfe\xcb\x1e\xbd\x88\x1a\xb8\x3d\xfe\x1e\x04\x9d\xf9\x08\x96\xe9\x85\x2a\xad\x8c\x59\xa0\x23\x3d\x1e\x0b\x26\x66\x78\x90\x80\xb4\x6b\x7c\x13\x33\x5f\x1c\x88\x39\x1e\xee\xf0\x0f\x8c\x61\x4f\x6d\xaf\xcf\xaf\x92\x27\x3b\x2d\xc3\x7d\x0b\x9d\x8f\x1c\xd1\xbd\x12\x22\x4d\x48\x93\x3e\x80\x5f\x00\x70\xd6\x95\x1b\x0f\x0a\x00\x00"
 
 func oracleForeignkeyGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -402,7 +623,47 @@ func oracleForeignkeyGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _oracleIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdd\x4e\x1b\x3d\x10\xbd\xb6\x9f\x62\x3e\xeb\x13\x6c\xda\xb0\xb9\x47\xca\x45\x0b\xa1\xad\x4a\xa1\x05\xaa\x22\x21\xd4\x38\xbb\xb3\xb0\xd2\xc6\xce\xda\x5e\x20\xb2\xfc\xee\xd5\xd8\xbb\x94\x9f\x08\x41\x6f\x1c\xdb\xe3\x99\x73\xe6\x9c\xc9\x7a\xbf\x03\xff\xdb\x6b\x6d\x1c\xec\x4e\x21\x8b\x3b\x25\x97\x08\xf9\xd9\x7a\x85\xf9\x11\x6d\x05\x1a\x23\x40\xd8\xb6\xb1\x8e\x36\xe5\x42\x80\x68\x05\x08\x83\x56\x80\x38\x3f\x3e\xd4\x57\x02\xf2\x83\x1a\x9b\xd2\x8e\x60\x27\x04\x1e\xcb\x3a\xb9\x68\x30\x95\x2d\xae\x71\x29\x21\x3f\xed\x7f\x63\xed\x33\x0a\xa7\x95\x60\x52\xe2\x64\x02\xde\x43\x7e\xd0\xa9\x22\x62\x87\x00\x06\x9d\xa9\xf1\x06\x2d\x48\x30\xfa\x16\x2a\xa3\x97\xb0\xed\xfd\x00\x10\xc2\x36\x48\x0a\x52\xe2\x5f\xd6\x21\xe4\x7c\x32\xa1\x82\x9f\x50\xa1\x91\x0e\xcb\x94\x5a\xab\x12\xef\x62\x81\xfc\x0b\x6d\xd3\xda\xe7\x6c\xe7\xbc\xea\x54\xf1\x94\x44\x56\x2e\xe0\xfc\x78\xff\xa3\xf7\x70\xa5\x57\xd2\xc8\x65\x53\x5b\x37\xf4\x0c\xce\x74\x98\x96\x10\x46\x90\x79\x0f\x75\x05\x4a\xbb\x7b\x04\xfb\x53\xd5\x6d\x0c\x5f\x5c\x7a\x0f\xa8\x4a\x08\xe1\xdd\x53\xc2\x63\x40\x63\xb4\x19\x81\xe7\xec\x46\x1a\x3a\xa5\x1b\xce\xd9\x64\x02\xb6\x6d\xa0\xed\xd0\xac\x39\x2b\xb4\xb2\x0e\x92\x23\x30\x85\xf9\xe9\xec\x70\xb6\x77\x06\x73\x78\xcf\x19\x9b\x7b\x0f\x85\x6e\xc8\x46\xdb\x03\xf4\x3c\x43\x18\x9e\x1c\x9c\x1c\x7f\x83\x87\x1a\x0e\x81\x5f\x9f\x67\x27\x33\x78\x50\x21\x22\xde\x77\x2a\xe0\xc3\xd1\x3e\x08\x08\x61\x9e\x48\x99\x4e\x0d\xa4\xe2\x20\x64\x89\xd4\x4b\x42\x55\xb2\xb1\x51\xa9\x38\x26\x75\xb5\x41\x25\xce\x88\x5b\x9a\xcb\x10\x68\x86\x9e\x6a\xe5\xe9\x49\xca\x8e\xd7\xdf\x4d\xbd\x94\x66\xfd\x15\xd7\x31\x9d\xfd\xc6\xbb\xda\x3a\xbb\x1b\x21\xc7\xb1\x1e\xa9\x4e\x33\xc6\x02\xe7\x8c\xb4\x9d\x42\xb9\xc8\x7f\x10\xf9\x13\x7d\xfb\x16\xe2\xf9\x69\x21\x15\xd9\x5c\x51\x74\x83\xd0\xd9\xca\xd4\xca\x81\xd8\x12\x7d\x17\xa3\xd8\x2f\xab\xab\x68\xea\x7f\x53\x50\x75\x43\x36\x33\x83\xae\x33\x8a\x8e\xd1\xfd\x44\xae\xbf\xdc\x7a\x28\xc2\x98\xde\x44\xc5\x30\xb1\xe0\xac\x8d\x29\xa4\xce\xd0\xc7\x9b\xd4\x7f\x1d\x1b\x56\x62\x85\x06\xda\x7c\xaf\xd1\x16\xb3\x51\xb2\xbd\xd1\xb2\x04\x83\xb6\x6b\x9c\x25\xbe\x96\x58\x5c\x5c\x3e\x1b\x69\x1f\x38\xab\x34\xa5\x1f\xe1\x9d\xcb\xe2\x68\xbf\xc6\xdb\x97\xcd\x7d\xe6\xee\x23\x7b\xa3\x84\xf1\x0f\x53\x48\xc5\x59\x6f\x75\xfb\xcf\xa6\x6d\xd0\xe9\xb9\x50\x09\x94\x84\x98\x82\x5c\xad\x50\x95\x99\x41\x3b\x7e\xec\xe1\xe8\x91\xbd\x31\x7e\x6f\x6a\xfc\x24\xf0\xc0\xf9\x9f\x00\x00\x00\xff\xff\xc3\x4f\x76\x7d\x93\x05\x00\x00"
+var _oracleGraphqlLoaderGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\x5b\x6f\x9b\x48\x14\x7e\x8e\x7f\xc5\xd4\xaa\x5a\xa8\x28\xe9\x73\x56\x79\xd8\x5e\xb2\x5b\x6d\x37\xad\xd2\xec\x45\x8a\xf2\x30\x86\x21\x46\xc6\xe0\x30\x60\x27\xb2\xf8\xef\xfb\x9d\x33\x03\x1e\xb0\x9d\x7a\xdb\x48\x5d\xa9\x1b\x09\x02\x33\xe7\x9c\xf9\xce\xed\x3b\x78\xbd\x7e\x29\x9e\x2e\x66\x37\xe2\xe4\x54\x84\x9f\x64\x34\x93\x37\x4a\xbc\x6c\x9a\xd1\x1a\x1b\x69\x22\xd4\xad\x08\x2f\xd5\x7c\x91\xc9\x4a\x89\xf1\x87\x8f\x3f\xbf\x7d\x77\xf1\x79\x2c\x20\x70\x7c\x2c\x3e\x14\x32\x56\xa5\x16\xb2\x54\xa2\x9a\x2a\x51\xaa\xdb\x5a\xe9\x4a\xe8\xa8\x58\xa8\x58\xc4\xb2\x92\x99\x11\x09\xc4\x44\x56\xd1\x34\xcd\x6f\x84\xcc\x63\x11\x49\xf3\x0c\x25\xb2\x93\x15\xc5\xac\x5e\x68\x31\x87\xac\x58\xa5\xd5\x94\xad\x69\x39\x57\x22\x2a\xf2\x4a\xdd\x55\xe1\xa8\xba\x5f\xa8\xee\x40\x5d\x95\x75\x54\x89\xf5\xe8\x28\x9e\x08\xfe\x5b\xaf\x8d\x1b\x4d\x13\xfe\xfd\xf1\xed\xeb\xd1\xd1\xbc\x36\x1b\xfa\x3e\x8f\xc2\xdf\x6b\x18\x19\x1d\x59\x30\x38\x67\x71\x05\x13\x40\x70\xfd\x62\x03\x32\x34\xd6\x47\xcd\x88\x30\xd9\x83\x7f\x53\xf7\x22\xd5\x8c\x87\x11\x14\x09\x3f\xdb\x5d\x31\x53\xf7\xda\xac\x41\x68\x61\xc2\x67\xb1\xba\x06\xf2\x6a\x64\xfc\xe4\xf3\x1d\x9b\x8e\x9d\xd6\xb4\xf5\x31\x1c\x61\x0f\xb1\x74\x74\x1c\x8b\xa7\xe2\x15\x5b\x7c\x57\x96\xe7\x45\x1b\x15\x6b\x53\x95\x65\x51\x22\x17\x55\x5d\xe6\xc8\xc2\x6a\xaa\x72\xb6\x42\x01\xe7\xe0\xca\xee\xd8\x6a\x2a\x2b\x31\x95\x5a\xe4\x85\x93\xcf\x70\xb4\x94\x65\xdf\xf4\xa9\xb1\xaa\xc3\x73\xb5\xf2\xc6\x16\xd3\x49\x67\xc7\x98\x68\xb1\x8e\x7d\xc6\x06\xd1\x37\x76\xdf\x80\xd1\x7c\xf2\x82\x3d\x8d\xaa\x3b\x03\x26\x57\xab\x2e\xad\xb5\x26\x8c\xf1\x24\x14\xef\x51\x44\xd3\xa2\xce\x62\x31\xe1\x0a\x89\x64\x96\xc1\x97\x22\x8f\x94\x58\xa8\xb2\xab\x34\x2f\x55\x01\xc2\x0b\xc3\xbf\x5e\x5e\x7e\x12\xf3\x34\x8e\x33\xb5\x42\x3d\xfa\x81\xd0\x85\xf1\xaf\x2c\x56\xa6\x44\xf3\xa2\x32\xb6\xa2\x29\x6c\xc9\xa8\x2c\xb4\x6e\x2d\xc1\xe9\xa4\xce\x23\x07\xb4\x47\x10\xdb\xf2\xb3\x6b\x01\xc0\x0d\x4b\xcd\x1f\x0a\x51\x59\x1a\x87\xbb\x9d\xbf\xe0\xe9\x9f\x32\xab\x15\x19\x0d\x9c\x9c\x06\xe2\x99\x75\x1e\x4a\x28\xe6\x13\x53\xb4\xf1\x24\xc0\x6b\x17\xe6\x07\xeb\x75\xdd\x40\xb6\xf1\x6d\xd9\x9e\x95\xc5\x7c\x18\x75\xa7\xaa\x6c\xe8\xad\xb3\x8e\xf0\x2e\x6f\x7d\xe1\xbd\xf8\xd0\xf5\x6f\x51\x64\x3e\xb9\x96\x05\xa2\x98\x11\x5d\x90\x1d\xe3\xd4\xc6\x1f\x3f\xec\x54\xfc\x2e\x0a\xac\x61\xf1\x91\x28\xdf\xb4\x98\x6d\x5a\x3d\x47\xab\xc7\x36\x2c\x83\x3e\xb0\x88\x03\x11\x95\x4a\x56\x3d\xd2\x60\xe9\xce\x06\x13\x8c\x60\xbf\xba\xda\x9f\xdc\x8b\x24\x37\x1d\x90\xa4\x25\xea\xa5\xd6\x2a\xb6\xce\x93\xfe\xee\x1c\x13\x1a\x61\xe2\x1d\x90\x3e\x89\x7b\x5b\x59\x77\x12\xf1\x9a\x8e\x3e\x83\x54\x00\xa7\xd0\xca\x08\x1c\x5a\x5e\x95\x89\x8c\x14\xd2\x63\x7a\xa7\x1f\xbc\x41\xe8\x11\x2c\x90\xed\x13\xec\x51\x1d\xd8\xb8\xe5\x29\xc4\xdd\x36\x44\x9e\x47\xb0\x11\xce\x6b\xe4\x3e\x9a\x79\xd0\xca\xe2\xd6\x62\x16\xda\x34\x5c\x11\xfe\xeb\x9e\x41\xf4\xd1\xa9\x0b\x18\x55\xce\x98\x55\x6c\x2c\x7b\x49\xee\x65\x61\x3c\xf1\x7d\x12\xee\x1b\x82\x66\x16\xd3\xc9\xe6\xe0\x3f\xf2\xcc\x1c\xbd\x49\x6f\xcc\x95\x68\x4a\x7b\xe6\x7b\x6d\x29\xce\x0c\xcd\x49\xe7\x60\x5e\x4b\x88\x9d\xe4\x2a\x68\x1b\x11\x49\x62\xba\xe7\x80\x23\x77\x8b\x52\x69\x95\x57\x48\x76\x91\x23\xfb\x64\x2a\x8d\x2d\xa9\x92\xfe\x86\xfc\xd3\x58\x08\x27\xd0\x40\x24\x57\xbd\x05\x03\xe4\xb3\xb1\xac\x61\x51\x27\xa9\x32\x0d\xe1\x44\xc3\x52\xb4\xd1\xb2\xd5\xe1\xd9\x44\x1a\x5d\xcf\x6f\xe1\x6d\x7a\x3b\x99\x57\xe1\xe7\x05\x16\x2b\x6f\x16\xa6\x71\xeb\xf5\x05\x20\x7c\xd5\x49\x50\xc4\x31\x0e\x7a\xe7\xac\x59\x08\xcf\x46\x66\x28\xab\x4c\x2b\x61\x07\xf4\x53\x0e\x0a\x8d\xee\x4b\x3c\x84\xe7\x54\xb8\xed\xf0\x7e\x0a\x02\x2d\x2b\xda\xf4\xf8\x89\xab\xda\x91\x1b\x23\x5f\x63\x31\x8e\x27\xb8\xa1\x40\x71\x47\xdc\xe9\x0e\xba\xc4\x3f\x9a\x6c\xf4\x0f\x57\x8a\x6b\x8e\x6b\x89\xab\xe0\x85\x98\x6f\xd8\xa7\x96\xf0\xbb\x23\x4b\x99\xe3\xeb\x21\x7c\x9f\xc7\xea\x0e\xce\xb7\x40\x10\x09\x14\x20\x01\x49\x69\x47\x84\x67\xb4\xa0\xc5\x2b\xbf\x13\xc1\x69\xad\x2b\xde\x6d\x2d\x33\x7e\xe1\x7e\x33\xda\x8c\xdb\xb7\x9f\x1d\x68\xc5\x90\xba\x8d\xdd\x68\x1a\x4b\x27\x14\x6a\x6a\x52\x56\xc5\x2a\xca\x2a\xad\x34\x2f\x19\x13\x56\xfc\xdb\x3f\x45\x8e\x8f\x49\xf2\x17\x95\xab\x12\x5f\x45\xb1\x48\xd0\xcb\xc2\xb8\xf6\x9c\xb0\xb1\xff\xe6\x6e\xcf\x7c\x6e\x73\x3d\x40\xbe\x9b\x7e\x50\xd5\x04\xba\x0d\x49\xd3\x10\x0f\x3b\xf4\xe3\x38\xe9\x52\xcb\x92\x5f\x98\x09\xba\x6e\x1c\x0f\x0e\x1c\x07\x64\x3a\x8b\x0c\x19\xba\x3b\x4c\x07\x01\x55\xe2\x3a\x8d\x09\x43\x63\x28\x89\x4c\x3e\x39\x25\x2a\xda\x62\x26\x6c\x19\x42\xb2\x8b\xcb\x70\x1f\x4c\x0c\x63\x68\xd8\x06\x79\x08\x40\x6f\x64\x39\x84\x8e\x19\x30\xf0\x64\x13\xd0\xbd\xc6\xbc\x5d\xb3\x7a\x17\x6b\xbb\x4d\x4d\x54\xbf\x33\x2b\xfc\xa5\xd7\x6f\x65\xed\x8b\xab\xde\x40\xbe\x50\xba\xce\x98\x99\x40\x4d\x9a\x52\x31\x97\x33\xe5\x5d\x5d\xf7\xf3\x89\x0f\x00\x95\x7b\x64\x90\xf9\x96\xf8\x30\xa5\xc9\x01\x79\xd3\x41\x7c\x16\x59\x21\x33\x57\x29\x51\xf0\x2c\x64\x7e\x08\xbd\x41\x69\x40\x88\x52\x70\xd4\x8e\x56\xea\x5e\xbc\xce\xbb\x62\xe8\x67\x84\x99\xba\xad\x3e\xbc\xc3\x55\x59\xd2\x26\xbe\x38\x90\x74\x4d\xb4\x8e\x58\xb8\xd8\xb7\x1d\xdc\x8d\x3f\x8d\x37\x0e\x90\xf7\x8c\x5f\xa3\x85\x90\xc4\x65\x3b\xa4\xe6\x57\x69\x7c\xfd\x93\xd9\x8b\xa4\x56\x4e\x7d\x9d\xd0\x1a\x9d\x6d\x1c\x7e\xb6\x75\xec\xfa\x1d\xd5\xfa\x09\xa9\x34\x9d\x3e\x26\xdc\xc1\x8a\xfa\x36\x0b\x79\x9c\x5e\x20\x48\x6c\x22\x56\x89\x84\xc0\x97\x2d\xbc\xc5\xca\x89\x58\xb2\x52\xd3\xc6\xdc\xd6\x0c\xf4\xa8\x0f\x88\xc9\x84\x02\xa3\xf4\xc9\xf0\xac\x28\x55\x7a\x93\x53\xb9\x74\x6c\xc7\x54\x4c\x54\xc7\xe3\xc3\xb6\x09\xf3\x93\x43\x71\x46\x6a\x48\x6f\x00\x94\x6c\x38\x1c\xbb\x52\xeb\x22\x4a\x99\x87\x3a\xba\x72\x5a\xef\xb9\x36\x9d\xe3\x32\xa0\xf0\x36\x4b\x6f\x8a\x8c\xae\x7a\x9e\xdb\x4d\x3f\xa0\xf3\xf7\x51\xe4\xd7\xf3\x63\x62\xe2\xc0\x13\x9c\x59\x72\x13\x18\xe7\x71\x9b\x2f\xdb\x30\xec\xee\x4a\x12\x30\x73\x0e\x6e\xed\x63\x9f\x2d\xfa\x1c\x06\xd1\xa1\x51\xfb\xb3\x17\x3f\x68\x3e\x95\x2a\x49\xdb\x61\xc5\xf3\x47\x8c\xa9\x82\xce\xeb\x2c\xe3\x1f\xc0\xfc\x81\xe5\x02\x08\xb7\x22\x4d\xdf\xc7\x44\xe7\x03\xe6\x24\x32\xa4\x12\xe2\x71\x6e\x2a\x66\x2f\x7d\xb7\xfe\xf7\xa9\xbb\x5d\x75\x68\x5b\x90\x35\x04\x68\x49\x9f\xfe\x16\x93\x41\xc3\xfe\x9a\x27\xf2\x75\x03\x19\x73\x5b\x3c\x06\xcf\x0f\xe3\xb9\x8f\xef\x7b\xa8\x1f\xe6\xfa\x56\x74\x9b\xe7\x7b\x46\xbe\x2b\xc7\xf3\x0f\x4b\x87\x28\xf7\xcd\xe8\x83\xd9\x9e\x0c\x0e\xe8\x7e\x5f\x4d\x3f\x26\xef\x93\xee\x37\x10\x7f\xaf\x07\x3b\xaf\x38\x38\x7b\x66\x00\x01\xf8\x62\xa1\xfe\x58\x63\xe2\x42\x2d\x0f\x9f\x14\x10\x3e\x6c\x58\xb8\x4d\xa9\x0f\x1c\x15\x6d\x0a\xfa\xd3\xa2\x5b\xfd\x31\x06\xc6\x56\x37\x87\x7b\xe6\xc5\x23\xf1\x76\x7f\x86\x0c\x53\xf0\x28\x9c\xfd\xb0\x4b\xff\x53\xf6\x7f\x8e\xb2\xd1\xe5\xdf\x91\xb5\xb7\xeb\xeb\x5f\xb1\xee\x11\x65\x2a\xcd\x6b\xd5\x52\xe1\x01\xbc\xc9\x83\xe1\xe1\x4e\xb8\x3e\x90\x55\xed\xe3\x3f\x63\x37\x07\x56\xde\x18\x00\x00"
+
+func oracleGraphqlLoaderGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleGraphqlLoaderGoTpl,
+		"oracle.graphql.loader.go.tpl",
+	)
+}
+
+func oracleGraphqlLoaderGoTpl() (*asset, error) {
+	bytes, err := oracleGraphqlLoaderGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.graphql.loader.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleHasmanyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x55\x4d\x6f\xdb\x46\x10\x3d\x93\xbf\x62\x42\x14\xb1\x98\xd0\x4c\x7b\x55\xc1\x1e\xea\xca\x6d\x51\xc7\x6e\x6d\xa3\x0d\x20\x08\xd1\x8a\x5a\x4a\x44\xa8\xa5\xb4\x4b\x39\x16\x04\xfe\xf7\xce\xcc\xf2\x4b\x16\x0b\x5b\x46\x0e\x3d\x88\x22\xf7\x63\xe6\xcd\xdb\x37\x6f\xf7\xfb\x73\xf8\xce\x2c\x73\x5d\xc0\x30\x82\x01\xbf\x29\xb1\x92\x10\xde\xca\xe4\x7e\xb7\x96\xe1\x35\x7d\x79\x52\x6b\x0f\x3c\xb3\xc9\x4c\x41\x2f\xf3\x19\x3e\x36\xf8\xd3\xd2\xe0\xf3\xd3\xcd\x55\xbe\xf0\x7c\x38\x2f\x4b\x77\x4f\x11\x75\x6f\xc8\x13\xe3\x55\xc0\x3a\x61\x0b\x31\xcb\xa4\x8d\x1a\x2f\xe5\x4a\x40\x78\x57\xfd\x73\xe8\x7b\x9a\xb6\x4f\xca\xd2\xd9\x98\x9d\x5e\xe2\x66\x2b\xf5\xee\x18\xda\x17\xb9\xab\xfe\x68\xb1\x94\x0a\xff\x66\xa2\x88\x97\x7d\x34\xc4\x27\xd2\xf0\xea\x9c\x75\x85\xdd\x23\xc8\xbf\x1a\xce\xbc\xd6\xa9\x2a\x1a\x0a\x3c\xd3\x05\x88\x21\x0b\x44\xc3\xeb\xb4\xe4\x57\x62\xe5\x32\x95\xd9\x9c\x71\xda\xb5\x1f\x3e\xc0\x7e\x4f\x33\x0f\x0c\xbb\x2c\x01\x17\x6f\xb5\x32\x50\x2c\x25\x4f\xb5\x35\x95\xa5\x01\x61\x4c\x1e\xa7\xa2\x90\x73\xf8\x9a\x16\xcb\x66\xd5\x01\xe1\x65\x79\x66\xea\x51\x9b\xb0\x0e\x3e\x38\x18\xbd\xc8\x33\xfa\x6d\x57\xaa\x9a\xf7\x43\x04\x44\x98\x7e\x95\x4a\x6a\xce\x92\xe8\x7c\x05\x49\xae\x65\xba\x50\x80\x45\xc1\x19\x85\xb8\xb4\x03\x7f\xc8\x5d\xe7\xb5\xce\x1d\xba\xc9\x56\xc5\x9c\xab\x6a\x00\xcc\xfc\xae\x07\xa5\xff\xa4\x76\xda\x11\x17\x8f\x6b\xa1\xc5\x0a\x3f\xe7\x33\xf8\x74\xf3\xcb\xcf\x3e\x0c\xc6\x93\x77\x4f\xa9\x08\x00\x4f\x38\xd7\x18\xc2\x75\x1e\x84\xa6\x2f\x3b\xe2\xba\x0e\x16\x80\x07\x0f\x7c\xe6\xae\x13\xe7\xca\x14\x60\x95\x00\x11\x4c\xef\x46\x57\xa3\x8b\x7b\x98\xc2\x7b\xd7\x71\xa6\x94\x32\xcf\x48\x3e\xa6\x4a\xc0\xdc\x18\xc2\x5c\x2d\xb9\xbc\xbd\xf9\x48\x48\xab\x26\x69\x27\xfe\xf9\x6d\x74\x3b\x82\x36\x02\x84\x0d\xaf\xb4\x2a\xa2\x29\x55\x2c\x6d\x3d\xdf\xe3\xd0\xd4\x82\xd3\x5b\x55\x83\x63\x91\x0d\x2c\xb8\x00\xba\x8c\x85\x7d\x07\xe8\xbb\xce\x86\x2b\x27\x5d\xcd\x67\xa1\x25\x2c\x51\xe0\xfd\x65\x05\xde\x90\x28\xf4\x82\x04\x73\x42\xe0\x34\xe1\xc0\x6f\x22\x50\x69\x46\xb4\x3a\x56\x8a\xf4\xc9\x39\x5d\xa7\x74\x9d\xb9\x4c\xa4\x86\x4d\x78\x91\xe5\x46\x0e\x7c\x5b\x50\x96\x8b\x39\x0a\xd7\x6c\xb3\xc2\xb8\xb8\x8d\xdb\xa3\xe7\xd0\xf6\x18\x00\xb5\x84\xdb\xaf\xe5\x63\x31\xe0\xc3\x73\x08\x9b\x6e\x74\x32\x8c\x8e\x54\x6f\x17\x9d\x03\x02\xb4\xe3\x7f\xea\x74\x25\xf4\x0e\x25\x87\xb3\x38\xe9\x7c\x96\x8f\xa9\x29\xcc\x10\x0a\xbd\x95\x81\x8d\x29\xd5\x9c\x3b\xcc\x41\xd4\xf8\x20\x4d\xc4\x42\xe1\x1b\x15\x19\x21\x84\x3b\xfc\x24\xb2\x12\xe2\xa1\x47\x00\x55\x7b\x7b\x6f\xbd\x1a\x9f\x6f\x89\xea\x61\xea\x98\x2a\x9b\x95\xa8\x88\x40\xac\xd7\x88\x06\x6d\xc0\x04\xf0\xf6\xa0\x5c\xdf\xe5\x75\xd5\x6e\x5e\x80\x21\x5c\x1c\x6b\x8d\xe1\x0a\xc9\xfd\xa6\xe6\x60\xce\x5e\x67\x0e\x01\x81\x42\x0b\xc0\xf0\xb3\x5d\x6f\x88\x00\x52\x05\x02\x4c\xaa\x16\xd8\x28\x2c\x71\x58\xa3\x5c\xd8\x52\x21\x4f\x68\xb7\xf9\x96\x1e\x73\x4c\x51\xbf\x87\xd8\x0e\x60\xe3\xc6\x42\x2b\x65\x1e\xb9\xd1\x60\x25\xd6\x63\x5a\x58\x9b\x77\x59\x4e\x9e\xb1\x1e\xac\x03\x9b\x3f\x93\x71\xc1\xb5\x59\x2f\xa2\x37\x4c\x82\xea\x91\x3a\x11\xb1\x24\xd9\xd3\xe5\x42\xe2\xee\x49\x31\xcb\xf3\xac\xee\x8c\xcf\x16\x69\xd6\xed\x07\x2d\xd4\x42\x1e\x14\xb0\xb7\x22\x24\xaa\x6c\xbf\xb4\x1b\x7a\xbb\xfb\x47\x78\x43\xf9\xc7\xb8\x61\x62\xf5\xda\x7e\x46\xdc\x34\x34\xc6\xb0\x1b\xb5\xd2\x57\x40\x29\x7c\x16\x33\xeb\xf4\x7f\x62\xac\xbf\x5f\xc3\xd4\x6d\x7c\xe6\x65\x87\x56\x13\x9c\x49\xc5\xa5\xf9\xf0\x13\x5a\x32\x71\x61\xc5\x89\x81\xec\x01\x12\xb1\xb4\x88\x87\x69\x15\x46\x5a\x09\x2b\xa8\x86\xfc\x6a\x53\x64\x95\x3d\x1e\x3e\x59\x33\xe9\xb8\x4e\xc7\xec\x1d\xdb\x11\x98\xaa\x22\xed\x3d\x3c\xe6\x7f\x8b\x6c\x2b\xcd\xc0\xe3\x10\xe6\x0b\xee\xf6\x02\xf8\x21\xe8\x60\xa0\x03\xb0\xf7\x04\xef\x0f\x6c\xd2\x30\x0c\x69\xe2\xa4\xeb\xa0\x67\xff\xcb\xbd\xec\xc8\xe7\x8f\xad\x9c\xbd\x3c\x7e\xce\xcb\x9f\x31\xf3\x63\x37\x3f\xb4\x73\x8b\xa6\x63\xe8\xaf\x72\xf4\xf8\xc0\xd1\xfb\x68\x70\xda\x4b\xce\xe9\x65\xa5\x02\xd2\x76\x21\x36\xc4\x03\x92\xd1\x50\x60\xf3\xb6\xed\x58\x15\x88\x04\xd6\xad\xd7\xde\x0c\x3c\x52\x5d\x0f\x71\xe7\x7a\xa0\xd6\x6b\x0a\x1c\x69\xcd\x68\xba\xc8\x5e\x7e\x80\x55\x7f\xd3\xdf\xb8\x55\xd7\x70\xf2\x9f\x77\xd0\xbf\xdc\x8e\x6c\x6a\xc3\x0c\x00\x00"
+
+func oracleHasmanyGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleHasmanyGoTpl,
+		"oracle.hasmany.go.tpl",
+	)
+}
+
+func oracleHasmanyGoTpl() (*asset, error) {
+	bytes, err := oracleHasmanyGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.hasmany.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x58\xdb\x6e\xdb\x46\x10\x7d\xa6\xbe\x62\x42\x14\x31\x15\x33\x4c\xf2\x56\xa8\x71\x81\xc6\x91\x6b\xa3\x8e\x9d\xda\x6e\x93\x40\x30\xe2\xb5\xb4\xb2\x08\x53\xa4\xb4\x4b\xf9\x02\x81\xff\xde\xb9\x90\x14\x29\xca\xaa\x6c\x03\x4d\x1f\x44\x91\xcb\xdd\xb9\x9c\x99\x3d\x33\xcb\xf9\xfc\x35\xfc\x64\x47\x89\x49\xa1\xb3\x03\x1e\xdf\xc5\x6a\xac\x21\x38\xbb\x9f\xe8\xe0\x88\x6e\x5d\x6d\x8c\x0b\xae\x9d\x46\x36\xa5\x9b\xc1\x25\x5e\xa6\xf8\x33\xda\xe2\xf5\xeb\xf1\x61\x72\x45\x23\x33\x6d\xee\xf1\xff\x5a\xdf\x5b\xf9\xc3\xeb\xa5\x4a\xfb\x23\xfc\xbf\x51\x11\x0d\x4e\x94\x51\x63\xb9\xb9\xd2\xf8\xa7\x86\xa9\x26\x91\xc3\xd0\xd8\xd4\x85\x60\x2f\xd4\xd1\xc0\xb6\xe1\x75\x96\xb5\xe6\x64\x5a\xaa\x2e\x23\x2d\xa6\xf5\x47\x7a\xac\x20\x38\xcd\xff\xd9\xbe\x33\x7a\x2d\x57\x32\x55\x16\xbe\x79\x03\xf3\x39\xca\x9a\xc5\x7d\xb6\x3f\xcb\xc0\xe8\xd4\x84\xfa\x46\x5b\x50\x60\x92\x5b\x18\x9a\x64\x0c\x5b\x38\x2b\x57\x90\x65\x5b\xa0\xe8\x25\x2d\x5c\x78\x9e\x65\x01\x4a\x23\x81\xbf\xeb\x58\x1b\x95\xea\x81\x2c\x0d\xe3\x81\xbe\x63\x01\xc1\x01\xdd\xca\x35\x5f\xb3\x15\xb4\x86\xa8\x7b\xd9\x08\x0f\x9f\xfb\xe9\x1d\x43\x80\x8f\x83\x4b\xf8\x7a\xfc\xf1\x03\x0e\x5e\x25\x3c\x16\x85\x36\x2d\x10\x80\xd4\xcc\xb4\x5c\xb2\xac\x0d\xb4\x34\x1c\x42\x9c\xa4\xa5\x3e\xfb\x57\x1c\x4e\xf9\x75\xef\x1c\xdf\xea\x78\x80\xb7\xaf\x96\xcd\xf7\x01\x63\x97\x98\x36\xcc\x5b\xce\x8d\x32\xf4\x24\x23\xad\x96\x83\x5e\x61\x48\x81\xc3\xd6\x72\xfa\x49\x8c\xea\x25\xc6\xb0\x03\x17\xa7\xdd\xc3\xee\xee\x19\x5c\xc0\x76\xcb\x71\x2e\xc8\xf4\x24\xa2\xc4\xb0\xb9\x82\xdc\x4e\xc4\x36\x9f\xb2\x77\x72\xfc\x09\xaa\x88\x16\x2f\xbe\xec\x77\x4f\xba\x50\x91\xc0\x1a\x4b\x4f\x5d\xf8\xed\xe8\x23\x5e\xb3\xec\x42\x8c\x32\xb3\xb8\x30\x8a\x53\xcb\x13\xa3\xd6\x01\x35\xc4\xec\x62\xa4\x38\x69\x10\xa9\x26\x4a\x2d\x87\x6c\x93\x4c\x47\xdb\x30\xa3\x96\xb1\x9a\xd3\x14\x59\xcd\xc3\x9f\x4d\x38\x56\xe6\xfe\x0f\x7d\xcf\xcb\x9d\xef\xfa\x0e\x15\xdb\x0e\xab\xf4\x59\x1e\xa1\x4e\x19\xe7\x64\x68\x3a\x61\xbb\x03\x83\xcb\x40\xe2\x3c\x8c\xc1\xfd\x93\xdc\x38\x49\x6e\xdd\x45\xf8\x95\xb9\xc2\x87\x47\xb8\x84\x09\xaf\x62\x5a\x3c\xa4\xb7\x2b\x42\xe0\x4d\x4c\x18\xa7\xe0\xbe\x74\x73\xff\xda\x8c\x84\x83\x8e\x90\x49\x2f\x76\x20\x0e\x23\x4a\x00\x07\x77\xc1\xcc\xc4\xf4\xc8\x79\x21\x66\xe7\x83\x2f\xab\xf0\xf8\x34\x87\xb1\xd4\x62\x45\xcb\x99\xf2\x12\xc2\xad\xe9\xe1\x73\xdc\xdb\xd0\x4e\x67\xa0\x87\xda\xc0\x34\xd8\x8d\x12\xab\xbd\xb6\xa4\x4a\x94\xa8\x01\xee\x6d\x3b\x8b\x52\x4b\x9e\x58\xb2\xaf\x77\xde\xd8\x06\x73\x14\x30\x4c\x68\xf9\x91\xbe\x4b\x3d\xde\x0e\x9b\xe4\xc3\xfa\x84\x68\x64\x44\x2d\x25\x18\x5c\xde\x64\x18\x3f\xbc\x93\xf4\x98\x3e\x39\x9c\x2b\x70\x6a\x02\x25\x4a\x09\x88\x1d\x50\x93\x09\x1a\xe3\xe1\x83\x5f\x8f\x6e\xbb\x16\x78\x7e\x5f\x86\x9b\x69\xa4\x95\x95\xfb\xe8\x10\x11\xce\xf1\x10\x42\x66\xb3\x99\x90\x85\x03\x0b\xb3\xdf\xb6\xcb\x29\xc8\xfc\x29\x3a\xc4\x93\x50\x09\xdd\xca\x32\xf6\x93\xe7\x15\x1c\x5d\x11\x5f\xe1\xe8\x74\xa4\x89\xa5\xed\x43\x34\xbd\x1c\x29\xeb\x93\x3c\x54\x8b\xf4\x7c\x79\xcf\x24\x24\xfa\x4a\x16\x0c\x63\xe4\x76\x1b\xc6\x57\x28\x44\xe8\x67\x82\xd9\xc4\xc5\x09\x92\x21\x2d\xb5\xcf\x65\xfa\x8a\x2b\xab\x99\xde\x67\x35\xc0\x74\x5d\x62\xc4\xec\x3e\x56\x93\x5e\x7d\xb0\x99\xc2\x55\x26\xff\x51\xcc\x5d\xc0\xba\x9b\x44\x34\xeb\xe0\x08\x2e\x5a\xe5\xb6\xdb\xc4\x89\x62\x1f\x46\x3a\xf6\x08\x8c\x36\xfc\x0a\x6f\x39\x95\x25\x14\x28\x86\x86\x25\xd9\x69\x12\x0f\xd3\x2c\x94\x34\x56\x82\x28\x9b\xce\xe9\x2f\x8b\x76\x24\x8e\xbd\xce\xd2\x9c\xf3\xca\x1e\x64\xa2\xc0\x1e\x64\xa6\x49\x38\x35\x23\x62\xf2\xb5\xf6\x7a\xe7\xb8\xdd\xb4\x19\xaa\xbe\x9e\x23\xca\x0b\xad\xb4\xe7\xc8\xd8\x90\x03\x47\xf3\x8d\x8a\xaf\x74\x9e\x35\x6c\x00\x09\xea\x85\xe7\xc0\x66\x57\xd4\x55\x4a\x98\x23\xe9\x86\xab\xf3\x08\x6d\xc3\x5d\xf2\x37\x5b\xe2\xb9\x6c\xb1\xbd\x46\x63\x5d\x1f\xde\x2d\x2b\x97\xea\xc7\xeb\x7d\x32\xde\x06\x41\x40\xe3\x8f\xa2\xe2\xe6\xf2\xcd\x79\xa4\xc1\xb0\x4d\x12\xdd\x88\x45\xff\x85\x46\x9b\x3c\x5a\x27\x52\x31\xa6\x42\xa5\xcf\xe7\xd2\x55\x20\x38\x8b\xe2\xe2\xac\xc4\x24\xb7\x03\xd1\xe8\x55\xbd\x0e\x1a\x7c\x43\x09\x51\x63\x5c\x06\x74\x61\x76\xd7\x18\x56\x52\x55\xb8\x79\x54\x98\x45\x64\xa3\xf4\x16\x09\xd3\x39\x5f\xcd\xea\x59\x95\xd7\x8b\x38\x7c\xc6\xee\xbb\xc6\xea\x93\x6b\x8a\xdd\xaa\xf8\xf0\xeb\x28\x1c\x87\x7c\x40\x70\x0f\x0f\x3e\x1d\x9c\x71\xb7\x26\x6f\x98\xa6\xe9\x85\x5b\x91\x8f\x49\xe2\xe9\x29\xe7\x8e\x36\x4c\x06\xee\xd8\x62\xfa\xbb\xed\xc6\x78\xd2\x0f\x7f\x5e\x35\x6c\x94\xdb\x5e\x52\x8f\x4a\x8e\xf7\xf6\x4e\xbb\x67\x48\x19\x27\xc7\x5f\x4e\x61\xaf\x7b\xb6\xbb\x0f\x47\xdd\xaf\x0d\x83\x70\xaa\x4c\x39\x3e\x3a\xfc\x56\xbe\xcb\x41\x28\x6a\x4f\x05\x84\xda\xf9\x80\x8e\x26\x54\x14\x54\x0a\xe3\x04\x99\x95\xcf\x26\xeb\xea\x51\x21\xb0\x5e\x92\x10\x05\x74\x67\x51\x91\x26\xd7\x0b\x2a\x1f\x26\x51\x94\xdc\x62\x39\x2a\x4a\x1d\xe9\xa3\xdb\xfe\xcc\x58\x44\x8f\x8f\x45\xe0\x61\x9b\x8e\xa2\x59\x29\xbd\x2c\x0d\x81\xdb\x91\x8e\x41\x8f\x27\xe9\x7d\xdb\x07\x15\x25\x28\xe8\x36\x4c\x47\x3c\x8b\xcc\xdf\xb2\xf4\x17\xc6\x2a\x0d\x93\x98\x64\x84\x31\xee\xdc\x31\x3f\x3e\xb7\xce\x55\x60\x7b\xf2\x89\xc6\xcf\x3d\xdc\x65\x77\xfd\xdc\x33\xdc\xaa\x98\x09\x2b\xda\x37\x1f\x5e\x91\xd6\x03\x74\x62\xdd\x81\x06\x33\x4f\x04\xbd\xcf\x8b\x4a\x75\x03\x15\xbb\x28\x31\x16\xf9\xeb\xd6\x93\x23\x27\x20\x81\xd0\xa9\xea\x52\x43\xac\xaf\x10\x9f\x1b\xed\xe6\xed\x51\xbd\xc8\xe6\xe4\xdd\xf9\x51\x27\x23\x27\x2f\x6b\xdc\xdf\x56\x0a\xd6\x1c\x28\xb3\x57\xe1\x2d\x1d\xb6\x5c\x91\x47\x81\x7c\xe2\xd4\x46\x11\x2f\x0b\x38\xa9\x20\x23\x6c\x12\x8d\x17\xbc\x8d\xe7\x5c\x20\x0d\xd7\xbc\x3c\x6f\xcf\xa4\x9e\x97\x0c\xc6\xd3\x83\x81\xee\x27\x83\xa5\xfa\xf9\x08\x73\xb0\x29\x45\x15\x1b\x50\x5f\x8d\xff\x9c\x22\x12\xdb\x18\x09\xc6\xa7\xda\x9e\xa0\xad\x79\x6f\xc2\x1d\x43\x9c\x8e\x24\x37\x3d\xe4\xc9\xc5\xc7\x05\xc6\xb3\x00\xb4\xec\x93\xe5\x99\x6b\x7d\xbb\xe8\xdc\x8b\xf8\x17\xdb\x73\x62\xf4\x4d\x98\xcc\x2c\xb3\x01\xce\x91\xd6\x8b\x46\xeb\xbd\xd7\x6a\x9b\x24\xea\x0f\xe7\xc3\x23\x12\x62\x8d\xe7\xef\x77\xd6\xba\x5e\xea\x3a\x3e\xf9\xd8\x3d\x81\x0f\xdf\x1e\x10\x43\xf6\x09\xef\x66\xd9\x3b\x7a\x60\x02\x94\x43\x3a\x61\xd3\x1f\xe9\xfe\x35\x50\x3f\xb0\x0c\x8a\xb4\x2d\x39\x28\x3e\x08\xae\x79\xef\xf1\x98\x33\xf2\x0a\x09\x52\xf0\x8b\xbc\xb1\xc8\x79\x79\x27\xd6\x57\x98\x56\x2c\x9c\xfb\x2c\xaa\xb0\x47\x09\x0a\xb5\x9d\xea\x4b\xc9\xb0\xce\x9a\x04\xc3\x53\xa6\xc2\x56\x87\xa7\xd0\x66\x09\xf6\x95\xfd\x9c\xfb\x47\x7b\x06\x8d\x27\x0a\x93\x54\xcc\x59\xa2\xa8\x1d\xa0\x90\x93\xef\x52\xc3\x5f\x98\x7c\x18\x68\xdc\x15\xe3\x30\x26\x96\x0f\x39\x7d\x8c\x86\x90\x0a\x4c\x8c\xb3\x98\xa6\x5b\xb5\x64\x7e\x44\x40\x30\x86\x80\xeb\x30\xfd\x6e\x82\x83\x34\x51\x1e\x93\xd9\xf6\xbb\x76\xf9\x09\xa3\x08\x17\x4e\xbc\xa8\x46\xaf\xfc\x54\xb4\xf6\x5b\x4b\x3d\x6a\x4f\x38\xfa\xd7\x05\xac\x3f\xe1\xff\x0f\x8e\xf9\xcd\x0e\xf4\x3f\x3e\xbc\x3f\xe5\x04\x5f\xc8\x2c\x3b\xca\x5f\x36\x45\xb9\x55\x1c\xaf\x50\x32\x1d\xae\xa4\x14\xce\x4b\xbd\xd4\xe1\x76\x78\xf0\xbc\xb5\xd8\x07\x84\x69\x6d\x0f\xe4\x68\x7f\xf7\x61\x19\x63\x39\x28\x91\xb0\x79\x21\x40\x4a\x7d\x8d\x6f\x17\xa3\x3e\x9e\x89\xe4\xd6\x7b\xa0\x7b\xa8\x96\x0f\x6a\x08\x1a\x0d\xf8\xa2\xbd\x6a\xaf\xf8\xbe\x41\xca\x56\xf4\xc3\xff\x00\x73\x8a\xf2\xde\xfe\x16\x00\x00"
 
 func oracleIndexGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -422,7 +683,47 @@ func oracleIndexGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _oracleQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x5f\x6b\xdb\x3e\x14\x7d\xb6\x3e\xc5\xfd\x89\x50\xec\xdf\x5c\xe7\xbd\xe0\x97\x75\x0c\x06\xa3\xd9\xbf\x87\x42\x29\x4c\x8d\xe5\x4c\xa0\x48\xb1\x24\x77\x0d\x42\xdf\x7d\x5c\xc9\x76\xec\xa6\x65\xa3\x6f\xca\xf1\xfd\x73\xce\x3d\xf7\xc6\xfb\x4b\x58\xd9\x5f\xda\x38\xb8\xaa\x21\x8f\x2f\xc5\xf6\x1c\xaa\x1f\xc7\x03\xaf\x6e\xf0\x49\xb9\x31\x14\xa8\xed\xa4\x75\xf8\x68\x1e\x28\xd0\x8e\x02\x35\xdc\x52\xa0\xb7\x9b\xcf\x7a\x47\xa1\xfa\xda\x73\x73\xfc\xc2\x0c\xdb\xdb\x02\x2e\x43\x20\xb1\x76\x87\xe8\xb5\xde\xef\xb9\x72\x16\x7b\xa4\xb8\x09\x19\x03\x45\x0b\xd5\x00\x46\x6c\xbd\x06\xef\x4f\xd0\x10\xc5\xa5\xe5\xf3\xcf\x91\x5f\x08\x60\x7a\x65\x81\xc1\xb6\xb7\x4e\xef\x21\xf6\x2c\xc1\x70\xd7\x1b\x25\xd4\x0e\x0c\xb7\xbd\x74\x16\x98\x8d\x59\x27\x69\x21\x54\xa9\xae\x6a\xb0\x45\xdb\xab\xed\xa2\x6e\xde\x3c\xc0\xed\xe6\xc3\x7b\xef\xc1\x30\xb5\xe3\x0b\x95\x10\x42\xb9\x88\x1e\x6b\x43\x08\xde\x0f\x35\x0b\xc8\xbd\x47\x75\x4a\x3b\xa8\x36\x4a\x1e\x37\x0a\x03\xee\xee\xa7\x90\xff\x9f\x73\x2a\x81\x1b\xa3\x4d\x01\x9e\x64\x8f\xcc\xe0\xaf\x84\x10\x92\xad\xd7\x60\x3b\x99\x24\x92\x2c\x95\xae\x3e\x29\xc7\xcd\x41\x4b\xe6\x30\xfd\x91\x19\xac\x8d\xa3\x0a\x61\xab\x95\x75\x53\x2b\x48\x26\x42\x0d\x93\xa2\x95\x28\x61\x25\x4f\xce\x24\xf2\xa2\x85\x95\xc0\x84\x77\x53\x6e\x42\x73\xa1\x1a\xfe\xf4\xdc\xd7\x95\x28\x30\x38\xb9\xf2\x4a\xc4\x7c\x2a\xb3\x0e\x28\x02\xc1\xcb\x10\x7e\x7a\x8f\x54\xd2\x63\xb0\x24\x2a\x36\xbd\x1a\x15\xc7\x6d\xcb\x93\x8c\xd7\x5c\x99\x0d\x7c\x39\x99\x85\x5d\x73\x32\x83\x57\xd3\x26\x9e\x7c\x4a\x0e\x20\xb1\x74\x25\x33\x9b\xc7\x42\x24\x43\x83\x6a\x68\x1e\x12\x8f\x6f\xfa\xf7\x5f\x08\xbe\xcc\xa3\xa8\xbe\x6f\x99\xc2\x75\x69\x05\x97\x0d\x9e\xa1\x1d\x3a\x7d\x44\xc0\x42\x7e\x30\x42\x39\xa0\x17\x74\xa0\x53\x44\xd6\x99\x68\xe3\x8e\xfc\x57\x83\x12\x12\xb7\x26\x4b\xbb\x8f\x3f\xe3\x32\x91\x0c\x27\x39\x80\x17\x73\x35\x25\xc6\x9c\x6e\x0b\xd5\x74\x31\x05\x37\x62\x54\xf4\x36\x39\xff\xc8\x2b\x6b\x78\xcb\x0d\x74\xd5\xb5\xd4\x96\xe7\x45\xb2\x5c\x6a\xd6\x8c\x77\x8b\xcc\xe3\x7f\xc7\xdd\xfd\xd9\xad\xf8\x40\xb2\x56\x63\xfa\x0d\x7f\x72\x79\xbc\x99\x6c\x61\xd7\x55\x7d\xe6\x98\xc7\x69\xc4\x53\xda\x32\x45\xb2\xc1\xbf\xee\xcd\xf3\x7f\x41\xe8\xb9\xd2\x68\x41\x54\x52\x03\x3b\x1c\xb8\x6a\x72\xc3\x6d\xb9\xb4\xa3\x58\x38\x15\xbf\x4f\xfe\xa4\x83\x08\x84\xfc\x09\x00\x00\xff\xff\xb6\xe2\x6b\x23\xb5\x05\x00\x00"
+var _oracleManytomanyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x55\x4d\x6f\xda\x40\x10\x3d\xdb\xbf\x62\x6a\x55\x8d\x9d\x12\xa7\xb9\x46\xe2\x90\x26\xd0\x26\x4d\xa1\x05\xda\x46\xaa\xaa\x60\xf0\x1a\x4c\x8d\x17\x76\x4d\x0a\x42\xfe\xef\x9d\xd9\x35\x0e\x36\x4e\x9a\x8f\x43\xd4\x03\x66\x3f\x66\xde\xcc\xbe\x7d\x33\xbb\x5e\x1f\xc0\x6b\x39\xe6\x22\x81\xe3\x3a\xd8\x6a\x14\x7b\x53\x06\x6e\x6f\x35\x63\x6e\x8b\x86\x16\x13\xc2\x02\x4b\xce\x23\x99\xd0\xc0\x1f\xe0\x67\x8e\x3f\xc1\x24\x7e\xaf\xda\x97\x7c\x64\x39\x70\x90\xa6\xe6\x9a\xe0\x44\x25\x5e\x87\x05\x8f\x84\xcc\x12\xdb\x42\x0e\x42\x16\xf9\x04\xec\x36\xb9\x60\xe1\x28\xfe\xc4\x56\x04\xdc\x54\xeb\xb9\xd9\x84\x87\x71\xb3\xca\xb4\x64\x27\x36\x9e\x64\x46\x30\xf7\x81\xa2\xf1\x45\x01\xb7\xe8\x50\xb2\x4e\xbc\x41\xc4\x34\x05\xc3\x31\x9b\x7a\xe0\x76\xb3\xff\x0d\x0f\x3d\xb2\xd0\x5f\xa2\xc4\x29\xa6\xdf\xbb\xd3\x9f\x92\xb8\x1b\xe0\xf0\x10\xd6\x6b\xd0\x24\xa7\x29\x08\x96\x2c\x44\x2c\x21\x19\x33\xb5\x5e\xb8\x84\x34\x95\xe0\x49\xc9\x87\xa1\x97\x30\x1f\xfe\x84\xc9\x38\x37\xdc\xb6\xc2\x45\xc1\x17\xa3\x31\xec\xe1\xce\x56\x76\x69\xba\xe7\x62\x40\x8a\xf9\x81\xc5\x4c\x28\x94\x40\xf0\x29\x04\x9a\x17\xf8\xcd\x56\x52\x79\x15\x2e\xa1\x6a\x98\x85\xda\x03\x2f\xf6\xb5\x47\x89\xde\xfb\x9c\x5c\x33\x58\xc4\x43\xb0\x29\x3d\xad\x3d\x4c\x7a\xbf\x7c\x0c\x67\x9b\x19\xb2\x1d\x26\xcb\x99\x27\xbc\x29\x4e\xfd\x01\x5c\xb5\xcf\xde\x3b\x60\xff\xfc\xb5\x5f\x41\x54\x0d\x50\xb0\x5c\x20\x84\x69\xdc\x78\x82\x66\x7a\xc5\x34\x0d\x3c\x3e\xea\x18\xe6\x0b\x26\x56\xa6\x31\xe4\xb1\x4c\x40\x0b\x1b\xea\xd0\xef\x36\x2e\x1b\xa7\x3d\xe8\xc3\x5b\xd3\x30\xfa\x14\x95\x47\x33\xd4\x52\xb8\xa4\xb2\x90\xb7\x91\x94\x80\x24\x56\x80\x45\xd9\x67\xf6\xcd\x4e\xfb\x33\xa5\x9d\xe9\x89\x6e\x74\xb3\x75\xd1\x3e\x6f\x41\xf9\x42\x60\x02\xed\x16\x4c\x5c\x1d\x47\x15\x5e\x41\xb8\xee\x29\x8f\xc8\xac\x0e\xa2\x6c\x53\xd8\xcf\x62\xfc\xf8\xd8\xe8\x34\x4a\x70\x93\x5d\x2c\xdc\x8e\x93\xb1\xe6\xf2\x1d\x2e\xf5\x35\x2b\x62\x11\x6f\x58\x51\x05\x6d\x6b\x56\x6a\xb0\x7d\x4f\x84\xad\xeb\x3a\xbf\x27\xd3\x98\x2b\xbe\x49\xfe\xfe\xc0\xd5\x37\x15\xc4\x60\x7d\x25\x30\xeb\xf6\xf6\x3c\x31\x22\x11\x3f\x14\x35\x0c\x14\xea\xab\x3a\xc4\x61\x44\x37\x69\xe8\xf2\xa0\xa9\x0a\x68\x1a\xa9\x69\xf8\x2c\x60\x02\xe6\xee\x69\xc4\x25\xb3\x1d\x7d\x94\x88\x7b\x3e\x16\x93\x5c\x44\x89\x34\xd1\x4d\x52\x6e\xd5\x52\x59\x23\x06\xea\x1f\x11\x5a\x6c\x99\xd8\x4a\x32\x06\xa5\x23\x72\x69\x1e\xd7\xab\x8a\x51\xdb\x1d\x00\xa6\x99\x6f\x7d\x11\xe1\xd4\x13\x2b\xd4\x3a\x1a\xe0\xbe\x71\xcd\x96\xa1\x4c\xe4\x31\x24\x62\xc1\x6a\x1a\x99\xc5\xba\xf1\x18\x98\x3e\x7e\x48\x8f\x43\x2f\xc6\x11\x9d\xb6\x8e\x89\x74\x71\x4a\x94\x29\x42\xaa\x65\x67\xcf\x44\x18\x27\x60\xbd\xb1\x36\x89\x3a\x9a\xb4\x0a\xd6\x76\x69\xd3\x81\x89\x96\x3a\x78\xb3\x19\x26\x64\xe3\xa4\x06\x6f\x0a\xe7\x76\x4c\x65\x97\x79\x2b\x03\x84\x30\x71\x0d\x53\x3e\xf1\x7d\xe2\xe4\x3c\x61\xd3\x4d\xdb\xc9\x7b\xd3\x9d\xfd\xeb\x89\x3d\xeb\x61\xdd\x62\x37\xa3\xea\xa6\xa1\x95\x27\x8a\x50\xa5\x44\x1d\xdd\x2d\x1e\xdf\x3e\xce\x5b\xdd\x46\xa7\x07\xe7\xad\x5e\x7b\xb7\xd8\xed\x42\x57\xa9\x2e\x4f\x95\xdd\x7d\xbd\x20\xc3\x70\xe0\xfb\xc9\xe5\xb7\x46\x77\x1b\xb4\x50\xd4\xb5\x42\x99\x1f\x6d\x79\x3e\xaf\xdc\x4b\xf4\x29\x83\xbc\x1d\xdd\x16\xef\xb5\x6e\x09\xa5\x8e\xd0\x58\xb2\xe1\x13\x1b\xc2\x03\xe3\x66\x6a\x25\x99\x6b\xa1\x76\xd8\x94\xdf\xb0\xb2\x56\x85\x5a\xd5\x42\xdd\xe8\x36\xe4\x31\xf0\xe0\x45\xb4\x5b\x99\xe4\x0b\xc8\xf7\x0c\x5f\xbf\x5e\x03\xf2\x67\xac\x20\xdf\xc2\x23\xf3\xc8\x27\x06\x4e\x5a\x67\xff\x52\x76\xc9\xed\xe8\xd9\x2f\xd3\x7f\x25\xd5\xbf\x7f\x84\x0f\xa6\xda\x0b\x00\x00"
+
+func oracleManytomanyGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleManytomanyGoTpl,
+		"oracle.manytomany.go.tpl",
+	)
+}
+
+func oracleManytomanyGoTpl() (*asset, error) {
+	bytes, err := oracleManytomanyGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.manytomany.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleProcGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbd\x54\x4d\x6f\xdb\x30\x0c\x3d\xdb\xbf\x82\x33\x8a\x2d\x19\x5c\x17\xbb\xa6\xc8\xa5\x68\x31\x14\xd8\x92\x62\xcd\x86\xde\x56\xd5\x96\x13\x03\xae\xe5\x4a\x72\xd7\xc2\xd0\x7f\x1f\x49\x39\xfe\x68\x1b\x14\xd8\x61\x08\x62\x5b\x14\xc9\xf7\xf8\x48\xa9\x6d\x8f\xe1\xa8\x52\xf6\x97\x2a\x32\x58\x2c\x61\x56\x49\x48\xae\xb4\x4a\x93\x1f\xd2\x36\xba\xda\x3c\xd7\x12\xa2\x47\xdc\x8d\xe6\x70\xec\x5c\xd8\x52\x40\x8d\x0e\xec\x6d\xd2\x9d\xbc\x17\x90\x5c\x77\x6f\x8e\xa4\xc7\x4a\xdc\xcb\x51\x80\x29\xb6\xe4\x1f\x45\xe0\x5c\xdb\xc2\x51\x2a\xca\x72\x62\x10\x7a\x6b\x26\x06\xd5\x58\x5a\xe7\xa2\x34\xb2\xcf\xa3\x45\xb5\x95\x70\x54\xc4\x48\x81\x76\x93\x2b\xa1\xc5\xbd\xc1\x90\x30\xe8\x71\x96\x50\xeb\xa2\xb2\x7e\x31\x53\x1a\x66\xa2\xca\x30\x08\xa2\x18\xb0\x88\x08\xff\x3e\xce\x3f\x89\x29\x44\xf8\x9b\x69\x59\x97\x22\x95\x93\xdd\xef\x2a\xc3\xdd\xcb\xd5\xfa\xe7\x26\xa2\x37\xf0\xc7\x97\x39\x47\x8c\x1d\x59\xa8\x9e\x07\xd7\xd7\x13\xe1\xd5\xdb\x4c\xa2\x05\xa6\x61\x0a\xfb\xd8\x22\x07\xf9\xf0\x06\x07\x06\x26\x27\x8f\xc0\x82\xf5\x08\xbc\xc2\xac\xe6\xa1\x4c\xd6\x8d\x6d\xcf\xa5\xb1\x0b\xd8\xa7\x8e\xdc\x58\xd5\x25\x58\xdd\x0c\x78\x92\x04\x3e\x04\xda\x15\xfe\x8f\xb0\x31\x5c\x56\x0b\x46\x7b\x97\xc0\x7b\x08\x2f\x55\x92\x28\x64\x37\x14\xf4\x49\x03\x72\x72\x02\x88\xb0\x77\x03\x12\xdd\x80\xdd\x49\x30\x56\x69\x99\xd1\x26\x96\xd9\x0f\xbb\x73\x79\x53\xa5\xb6\x50\x15\x7c\x22\x66\x3c\xd3\xce\xcd\xe8\x9b\x26\xc7\xb9\x39\xe7\x7b\x79\x18\x9c\x23\xf7\x8e\x34\x05\xc9\xac\xd1\xf2\x60\x0e\x76\x66\xb2\x80\x48\xd9\x5d\x12\x76\x4d\x66\x29\x98\x36\x31\xdf\x20\x4f\x94\x1a\x68\x40\xfc\x94\x41\x4d\x6d\x90\x56\x6a\x03\x02\x01\x6a\x61\x0c\x56\x21\x0c\xd4\x0a\xa5\x41\x73\xcc\xde\x46\x5a\xb0\x8a\x0b\x7d\x14\x65\x23\x0d\xa5\x53\x39\x1b\x46\x29\xfe\xec\x64\xc5\xb6\x83\x32\xbc\x51\xd5\xc0\x5d\xb3\x00\x26\x09\x47\xe2\x53\xe0\x58\x72\x2a\x3b\xb5\x4f\x0c\x8a\xcb\xec\x0e\x6e\xd6\xe7\x67\x68\xf4\xc7\x76\x38\xac\xf1\xa4\x51\x9e\xd0\x4e\x98\xeb\x26\xcf\x8b\xa7\xc3\xa3\xff\xb9\xa7\x43\x39\xa5\xa5\x6e\x24\x5d\x4f\xfa\xad\xf9\xeb\x02\x67\x23\x77\xdf\xc8\x7d\x54\x0c\x52\x6b\xa5\xe7\x43\xe9\xbc\x1e\xca\x6e\xc3\xe0\x51\x68\xf2\xf2\x9e\x61\x18\xa0\xba\x38\xee\xf0\xd0\x48\xfd\x1c\x06\xa9\xaa\x8c\x25\x83\xb1\x1a\xa7\xf6\xf6\xec\xe2\x2b\xb6\xef\x15\x85\x05\xe2\xd3\x85\x35\xae\x60\x32\x2d\x7c\x45\x20\xfb\x53\xb8\x58\x9d\x9f\xde\x7a\x1c\xdd\x54\x1d\xce\x7e\x66\x86\x8c\x9e\x18\xa5\x3d\x58\x5d\x18\xdc\xac\xbf\xa9\xed\xcc\xb3\x43\xb7\xad\xe2\xde\x94\x05\x52\xde\x77\x83\x8f\xa2\xbf\x65\x11\x3e\x0c\x7e\xb3\x26\x58\x0a\x8e\xaa\xef\x67\x5e\x41\x74\xf1\x24\xd3\x68\x68\x31\x9e\x4a\x5c\xf8\xbc\x2f\x4f\xff\x47\x24\x33\x5c\xe9\x9c\x93\x6e\x17\xcc\xf9\x61\x09\x55\x51\x92\xa6\x81\x1f\x27\x4f\x1d\x6d\x13\xf6\xab\xa2\x1c\xb7\x27\x0c\x1c\xaa\xd1\x05\xe0\x2b\xa6\x24\xe1\xe4\xe2\xf8\x1f\x65\x4e\x2b\xea\xe8\x10\xbd\xd1\x81\x70\xe1\x5f\x10\x2d\xd7\x1a\x4f\x07\x00\x00"
+
+func oracleProcGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleProcGoTpl,
+		"oracle.proc.go.tpl",
+	)
+}
+
+func oracleProcGoTpl() (*asset, error) {
+	bytes, err := oracleProcGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.proc.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x54\x4b\x6b\xdc\x30\x10\x3e\xdb\xbf\x62\x62\x96\x60\xb7\x8e\x73\x0f\xf8\xd2\x84\x42\xa1\x64\xfb\x3a\x04\x42\xa0\xda\xb5\xbc\x35\xd8\x92\x2d\xc9\xed\x2e\xc6\xff\xbd\x33\x92\x9f\xd9\xa4\x94\xd2\xc3\x9a\x99\xd1\xbc\xbe\xf9\x66\xb6\xeb\xae\x60\xa3\x7f\x48\x65\xe0\x26\x85\xd0\x4a\x82\x55\x1c\x92\x6f\xa7\x9a\x27\xf7\x24\x06\x5c\xa9\x00\x02\xdd\x94\xda\x90\x90\xed\xf0\xd3\xe0\x4f\x71\x8d\xdf\x87\xed\x47\x79\x08\x20\xf9\xdc\x72\x75\xfa\xc4\x14\xab\x74\x04\x57\x7d\xef\x77\x94\xbb\x21\xeb\xad\xac\x2a\x2e\x8c\xa6\x1a\xce\x6f\xb2\x8c\x8e\x45\x0e\xc9\x60\xb4\xb6\xeb\x6b\xe8\xba\xd9\x34\x78\xf1\x52\xf3\xe5\xb3\xed\xaf\xef\x41\xb5\x42\x03\x83\x7d\xab\x8d\xac\xc0\xd6\x8c\x41\x71\xd3\x2a\x51\x88\x03\x4a\xba\x2d\xb1\x18\xd3\x36\x6a\x86\xd6\xf7\x89\xcb\x2b\x32\x2a\x91\xb7\x62\xbf\xca\x1b\xa2\xb2\x37\xc7\x9a\x50\xa1\x9e\xed\xe0\x61\x7b\xf7\x0e\x8d\x8a\x89\x03\x5f\x61\xc6\xe7\x78\x15\x3b\x56\x42\x19\x45\x57\x21\xb2\x19\x11\xab\x90\x06\x92\xad\x28\x4f\x5b\x41\x0e\x8f\x4f\x93\xcb\x9b\xe7\x1d\xc6\x80\xf3\x97\x2a\x82\xce\xf7\x7e\x32\x45\x9a\xb3\xf8\xbe\x87\x63\x40\x5a\x1c\x60\xdf\x73\xa9\x93\x0f\xc2\x70\x55\xcb\x92\x19\x0a\xc7\x10\xca\x4d\x83\xeb\xfb\xbd\x14\xda\x4c\xa5\xc0\x51\x0a\x29\x4c\x88\x36\x45\x0c\x9b\x72\xe6\xc9\x35\x8f\x59\x37\x05\x05\xbc\x9d\x62\x9d\x35\x2c\x44\xc6\x8f\xcf\x59\xde\x14\x11\x39\x3b\x8e\x5e\xf1\x58\x4e\x65\x51\x81\x40\x90\x11\x39\xfe\x8e\x66\x6c\xc5\x09\x03\x41\x16\x31\x92\x3d\x22\xb6\xbb\x17\x3a\x18\xaf\xb1\xb2\x18\xf8\x7a\x32\x2b\xba\x96\xcd\x0c\x5c\x4d\x7b\x39\xf3\xe4\x18\xa0\xc6\xdc\xcd\x2c\x68\x1e\x13\xf9\x1e\x11\x94\x42\xb6\x4b\xdc\xf2\xe4\x02\x02\xdb\xd1\x17\xf9\x2b\x40\x87\x61\xa7\x98\x3a\xa0\xf2\xe7\xd6\x5f\xee\x30\x4a\xbe\xee\x99\xa0\x34\x79\xc1\xcb\x8c\xce\x55\x0f\x3d\xbc\x27\x83\x86\xb0\x56\x05\x1e\x4d\x70\x19\x0c\x8d\x46\x16\x8f\x87\x60\xa8\xb9\x8b\x14\x44\x51\xd2\x3e\x79\xee\x46\x48\xb5\x6b\xe6\x7b\x34\xe3\xc1\x78\xb9\xc4\x19\x93\xcf\x7c\x83\x84\xb3\xb1\x21\xb4\x2b\xe7\x58\xff\x0f\xd0\xbf\xec\xd8\xcb\x78\xce\x15\x34\xc9\x6d\x29\x35\x0f\x23\xb7\x26\xa5\x64\xd9\x78\xf9\x84\xc9\xfe\xfb\x3c\x3e\x9d\xdd\x57\x87\x09\x72\x49\xe1\xf7\xfc\x68\x42\x7b\x67\xde\x8a\xe2\x9b\xf4\x8c\xe5\x8e\xe6\x64\xcf\x0f\xa9\x40\xc9\x71\xde\xfc\x33\x33\x2f\x00\x3d\x47\x6a\xc9\xb1\x48\x52\x60\x75\x8d\x43\x0a\x51\x89\xd7\x44\x45\x2b\x0e\xed\xfb\xc4\x9c\x3b\x22\x7c\xfe\x0d\x37\x03\x87\x60\xf7\x05\x00\x00"
 
 func oracleQueryGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -442,7 +743,7 @@ func oracleQueryGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _oracleQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\xb1\x0e\x82\x30\x10\x86\x67\x79\x8a\x7f\x30\x41\x07\xca\x6e\xe2\x64\xe2\xe8\x22\x2f\x50\xe1\x50\x92\xb6\x90\xb6\xc4\x98\xcb\xbd\xbb\x29\xa0\xe2\xd0\x6b\x72\xff\xd7\xaf\x3f\x73\x81\x6d\xd4\x37\x43\x38\x1c\xb1\x0b\xf5\x83\xac\x86\xba\x2e\x77\x95\x92\x79\x5e\xb4\xa5\x3d\x0a\x91\x2c\xbd\xe9\x5a\xa8\x53\x6f\x2d\xb9\x38\xed\xca\x12\xcc\xbf\xd5\x42\x91\x09\xb4\x8e\x93\x03\x22\xf0\x34\x78\x0a\xe4\x62\x80\x86\xef\x9f\x68\x7d\x6f\x91\x33\x7f\xba\x88\xe4\x6a\x36\xb8\x26\xc9\xe2\x6b\xa0\x3f\x43\x88\x7e\xac\x23\x78\x82\xbc\x76\x77\x82\x3a\x77\x64\x9a\x90\xf0\xcd\x1a\x65\x86\xa7\x49\xa0\xaa\x34\x45\xf0\x6d\x6b\xd2\x19\xad\x5b\xd8\xf5\x97\x92\x65\xef\x00\x00\x00\xff\xff\x5b\x7f\x83\xf0\x1d\x01\x00\x00"
+var _oracleQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x55\x8e\xb1\x0e\xc2\x30\x0c\x44\x67\xfa\x15\x37\x20\x15\x86\xa6\x3b\x12\x13\x12\x23\x0b\xfd\x81\x40\x5d\xa8\x94\xa4\x95\x93\x0a\xa1\x28\xff\x4e\xd2\x46\x50\x06\xdb\xd1\xdd\xf3\xc5\xde\x57\xd8\x3a\x79\x53\x84\xc3\x11\x3b\x7b\x7f\x92\x96\x10\xd7\x3c\x9b\xe4\x2c\xfd\x22\x35\xed\x51\x85\x50\xf8\xb8\xd3\x77\x10\xa7\x41\x6b\x32\x6e\xd6\xea\x1a\xde\xff\xa4\x4c\x91\xb2\xb4\xb6\x53\x46\xf4\xc0\x34\x32\xd9\x08\x5a\x48\xf0\xf0\x42\xc7\x83\x46\x19\x91\x7c\x4b\x08\xa5\x58\x12\x4c\x9b\xc2\xdc\x7b\xa4\xbf\x04\xeb\x78\xba\x3b\xf8\x19\x62\x69\x1e\x04\x71\xee\x49\xb5\x36\xe1\x9b\x35\x1a\xdf\x4c\x73\x80\x68\x52\x8f\xd2\xf7\x5a\x95\x6a\xd2\x26\xb3\xeb\x2f\x43\x51\x7c\x00\x5b\x7f\x83\xf0\x1d\x01\x00\x00"
 
 func oracleQuerytypeGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -462,7 +763,7 @@ func oracleQuerytypeGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _oracleTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x51\x73\xa3\x36\x10\x7e\x86\x5f\xb1\xc7\x74\xe6\xec\xd4\x07\xd3\x57\xcf\xf8\xe1\x7a\xe1\xda\x4c\x53\xe7\x26\x71\xda\x7b\x8b\x65\x6b\x39\xab\x01\xc9\x91\x84\x2f\x1e\x86\xff\xde\x91\x04\x18\x0c\x97\x70\xbd\x3e\x04\x02\x48\x9f\xbe\x5d\xed\xf7\x69\x5d\x14\xef\xe0\x27\xb5\x13\x52\xc3\x7c\x01\x13\xfb\x1f\x27\x19\x42\xb8\x34\xd7\x00\xa5\x0c\x20\x90\xa8\x02\x08\xd4\x53\xaa\xb4\x79\xa4\x9b\x00\x82\xcf\x37\xd7\xe2\x4b\x30\x85\x77\x65\xe9\x5b\x14\x4d\x36\x29\x3a\x94\xed\x0e\x33\x02\xe1\x5d\x75\x5f\x99\x2f\xee\x6a\x50\x4f\x73\x58\x02\xe1\x07\x91\x65\xc8\xb5\x7d\x17\x45\x50\x14\xa7\x57\xd5\x28\x4c\x15\xb6\x3f\x5b\x66\x65\x09\x12\xf7\x12\x15\x72\xad\x80\x80\x14\x5f\x21\x91\x22\x83\xb7\x45\x51\x73\x29\xcb\xb7\xa1\x43\xe0\xd4\x80\xe9\xe3\x1e\x3b\x08\x4a\xcb\x7c\xab\xa1\xb0\x83\x24\xe1\x5f\x10\xc2\x8f\x0c\x53\xaa\xcc\x70\xaf\x3d\xb4\x28\x40\xa2\x05\x08\x57\xe6\x5a\x96\xb0\xfe\x47\x09\x3e\x0f\x1c\xe3\xd4\xfc\xe5\x19\xaf\xc6\x07\x6b\x68\x82\x39\xfb\xd4\x66\x54\x27\xe1\x93\x64\x19\x91\xc7\x3f\xf0\x68\xde\xfa\x5e\x14\xc1\xb3\x80\xc4\x52\xf1\xbd\x07\x7c\x66\x4a\xab\x19\x3c\x50\x4c\x51\x23\x85\x8d\x10\xa9\x5f\x14\x35\x4c\xe9\x9b\x87\x3e\x50\x14\x41\x6c\xa7\x02\x45\x8d\x32\x63\x1c\x95\x19\xa6\x77\xdd\x3c\x38\x7c\x60\xdc\x7e\xa1\x44\x93\x0d\x51\x18\xfa\x49\xce\xb7\x30\x31\x09\x75\x25\x52\x96\x70\xd1\x9a\x37\xad\xd0\x27\x53\x4b\x08\x0a\xdf\x93\xa8\x73\xc9\xa1\x3d\x25\xac\xe8\x1b\x96\x51\x04\x97\x55\x08\x7b\x29\x0e\x8c\x1a\x3e\x3c\x11\x32\x23\x9a\x09\x3e\xc4\x6d\x47\x14\x6c\x10\x39\xd4\xb1\xdb\x5d\xfe\x4e\x9e\xd5\xa2\xaf\x11\xad\x96\xa8\x98\x5e\x71\x85\x52\x03\xb3\x37\xd5\x23\xa6\xc5\xf7\xb2\x70\x80\x13\xba\x81\xcf\x37\x97\xbf\x4e\x01\xa5\x14\xd2\x90\x39\x10\x69\x1e\xdc\x0b\xb7\xfd\x2c\x01\x92\x4a\x24\xf4\xe8\x76\x67\x06\x1b\xc2\x52\xdf\x63\xc9\x60\x72\x0d\x4a\x1d\x93\x45\x51\xe1\x12\xbf\x4e\x02\x47\x1e\x12\xc2\x52\xa4\xf3\x2e\xa4\x0a\xa6\xbe\x57\x55\x9b\x7a\x4a\xe1\x29\x47\x79\xf4\xbd\xad\xe0\x4a\x83\x13\x3b\x2c\x60\x7d\xb5\xbc\x8b\x6f\x57\x70\xb5\x5c\xdd\x40\x5b\x5b\x30\x59\xc3\xcf\xbe\xe7\xad\x8b\x02\xb6\x22\x35\xae\xa1\x1a\xf9\xb4\x0a\xb1\x8e\xbf\x1a\x3d\x85\xbf\xde\x5f\xdf\xc7\x77\x67\xd3\x0f\x24\x1d\x37\xfb\x36\x5e\xdd\xdf\x2e\xaf\x96\xbf\xc1\x69\xdd\xce\x84\x0f\x22\x35\xec\xa2\x8b\x94\x28\xed\x52\x7e\x45\x2f\x22\x17\xc0\x7c\xff\xb8\x76\x11\xcb\x9c\xd7\x11\x5b\x2b\x9b\xb8\x88\x67\x06\xd6\x0a\xaf\x1b\x50\x95\xf1\x01\x66\x33\xe0\x2c\x9d\x9a\x8a\x52\x33\xbb\x8b\xf3\x05\xd0\x4d\x18\x3f\xe3\xf6\x87\x31\x59\x62\x11\xdf\x2c\xcc\xf3\xd9\x1e\x37\x7b\x27\x51\x4b\x86\x07\x04\x46\x7d\x8f\xd1\x86\x84\x44\x15\x5e\xb7\x72\x30\x19\x0b\xa8\x50\xc3\xde\x71\x82\x47\x3c\x02\xe1\xd4\x55\x0c\xf2\x2d\x5a\x5b\x3c\xd5\x9f\x29\xf0\x3e\x7f\x58\xc0\xd9\x87\xca\x34\x27\x8c\x4e\xcf\x10\xea\x0a\x5e\x80\x96\x39\xfa\x8d\x34\x39\x4b\x4f\xc6\xc6\x11\x26\xe3\x33\x38\x85\x20\xb0\x0e\x1e\x45\x70\xbf\xa7\x44\x23\xe4\xf6\xd6\x57\x71\xcf\xf3\xbc\x57\x65\xec\x10\x07\x64\xdc\xd3\x71\x25\x64\x2a\x50\xf1\xb7\xba\x2b\x64\xb3\x15\x6f\xbe\x29\xe5\x21\x2d\xbb\x10\x1a\x2d\x1b\x54\xe0\xa2\x82\x35\x5a\xb6\xfb\x57\xaf\xe9\xac\xac\xbd\xda\xa0\xd7\x8d\x5d\x2d\x23\xf2\xd1\x98\xaf\x90\x0e\x9a\x09\xde\x59\xb2\x65\x20\x3d\x07\xb9\xff\x74\xf9\x7e\x15\x77\xcd\xe3\x2e\x5e\x81\xd3\x74\xc7\x40\x2c\x44\xb3\xbd\xc1\x0c\x82\x6f\x9b\x81\xb7\x86\xbf\x7f\x8f\x6f\xe3\x57\x8c\x60\x01\x73\x37\x60\x2b\x72\xae\x5f\xf4\x98\x2a\x96\x96\x35\xfc\xb0\x37\x8c\x10\x8b\x49\xe3\x83\x53\xed\xff\xe1\x1c\x23\x57\x1c\xd0\xfd\x1d\x39\x20\x28\x72\x18\x90\x49\xef\xb0\x7b\x5d\x26\x06\x6d\x48\x24\xe7\x95\xd8\xf4\x10\xed\x4a\xec\x8c\x68\x04\xd7\x14\xdc\xd0\xa8\xe6\x74\xb5\xa7\x9a\x69\x8e\x4c\xdf\xd8\x75\x01\xa5\x89\xc6\xcc\xb6\x8c\x22\x63\xda\xd4\x3f\xcd\xd1\x44\x97\x92\xed\x23\x88\xa4\xea\xb9\x40\xe8\x1d\x4a\xd0\x3b\xc2\xdb\x4e\xd8\x6a\xb9\x4e\xad\x4c\x25\xb5\x7e\xce\xfe\x7b\xa3\x32\xba\x45\x18\x74\x96\x17\x8d\xa5\x65\xae\xf5\xb6\xf7\xdd\xe2\x45\xb3\x18\x40\x78\xa1\x7b\xb8\x8c\xaf\xe3\x55\x0c\x1f\x6f\x6f\xfe\xec\x1a\xc0\x48\xe9\xfe\x32\xe2\xb8\x1e\x51\xee\x2f\xe9\x6b\xc4\xf4\xd1\xc7\x66\xdd\x41\x7a\xc3\xf9\x1b\x3e\xe3\x5a\x3f\x08\xfc\x7f\x03\x00\x00\xff\xff\x1b\x84\xd0\x48\x91\x0d\x00\x00"
+var _oracleTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x57\x4b\x73\xe2\x38\x10\x3e\x9b\x5f\xd1\xe3\xda\xaa\x98\x2c\xe3\xcc\x99\x2a\x0e\xd9\xc5\xb3\x9b\xda\x0c\x49\x01\xd9\x9d\x5b\x10\x58\x24\x5e\x6c\x89\x91\xe4\x4c\x28\x8a\xff\xbe\xad\x87\xc1\xc6\x0e\x8f\xc9\xec\x01\x19\x4b\xdd\xad\x4f\xfd\xf8\x5a\x5e\xaf\x3f\xc2\x2f\xf2\x99\x0b\x05\xdd\x1e\x04\xe6\x1f\x23\x19\x85\x70\xa0\x47\x9f\x0a\xe1\x83\x2f\xa8\xc4\x51\x7e\x4b\xa5\xd2\xaf\xf1\x14\x87\xaf\x77\xb7\xfc\xc9\x6f\xc3\xc7\xcd\xa6\xb5\xd6\x56\x14\x99\xa6\xd4\x5a\x99\x3d\xd3\x8c\x40\x38\x72\xcf\xb1\x5e\xb1\xa3\xb6\xba\xd3\x49\xe6\x10\xfe\xce\xb3\x8c\x32\x65\xe6\xae\xae\x60\xbd\xde\x4d\x39\x29\x9a\x4a\x5a\x5e\x36\xc8\x36\x1b\x10\x74\x89\xc0\x50\x50\x02\x01\xc1\xbf\xc3\x5c\xf0\x0c\x2e\x50\xc4\x61\xd9\x6c\x2e\x42\x6b\x81\xc5\xda\x98\x5a\x2d\x69\xc5\x02\x1e\x27\x9f\x29\x58\x1b\x21\x41\xd8\x13\x9e\xfb\x73\x42\xd3\x58\x6a\x71\xaf\x2c\x8a\xff\x05\x35\x06\xc2\xb1\x1e\x71\x6a\xf2\xaf\xe4\xac\xeb\x5b\xc4\xa9\xfe\xe5\x19\x73\xf2\xfe\x04\xb6\x87\xd9\x5b\x2a\x23\x2a\x9c\x70\x2f\x92\x8c\x88\xd5\x5f\x74\xa5\x67\x5b\x1e\xea\xbe\x72\x98\x1b\x28\x2d\xef\x91\xbe\x26\x52\xc9\x0e\x3c\xc6\x34\xa5\x8a\xc6\x30\xe5\x3c\x45\xe5\xc2\x0c\xaa\xe0\x4b\xdd\x10\x9a\x89\x8c\x2a\xc4\xa8\x26\xb2\x84\x51\xa9\xc5\xd4\x73\xd5\x0f\xd6\x3e\x24\xcc\xac\xc4\x04\xdd\x47\x24\x0d\x5b\xf3\x9c\xcd\x20\xd0\x0e\xb5\x29\x82\xa2\x97\x25\xbd\xb6\xb3\x1e\xb4\x0d\x20\xf4\xa3\x87\x3e\xca\x05\x83\xb2\x4a\xe8\xe0\x6b\x94\x08\xa8\xef\x8e\xb0\x14\xfc\x25\x89\x35\x1e\x36\xe7\x22\x23\x2a\xe1\xac\x09\xdb\x33\x91\x30\xa5\x94\x41\x71\x76\x13\xe5\x33\x71\xba\x4d\x8f\x01\x75\x5b\x38\xa4\x37\x4c\x52\x5c\x48\xcc\x43\xd6\x80\x29\x7e\x2e\x0a\x6b\x50\x4b\xcc\xd4\xeb\x92\x08\x92\xe1\x74\x3c\x85\xaf\x77\xfd\xdf\xda\x80\xa5\xc6\x85\x86\xf6\x42\x84\x7e\xb1\x13\x36\x19\xd0\x2f\x24\x15\x94\xc4\x2b\x1b\xab\x0e\x4c\x49\x92\xb6\x3c\x9c\x6f\x72\xb5\xb6\x52\x9c\xd0\x58\x91\xe1\x80\x7e\x0f\x7c\x7b\x14\x98\xa3\x2e\x8d\xbb\x55\x93\xd2\x6f\xb7\xbc\x5d\x22\xd9\x9a\xfd\x42\x58\x4e\xd2\xfb\x85\x29\x07\xc4\x81\x14\xe0\xfc\x01\xdf\x72\x2a\x56\x1d\x0c\xa3\x49\x38\x58\x60\xc6\x65\xb9\x54\x18\xab\x22\xb4\x71\xcb\x9b\x71\x86\x53\x96\x38\xa0\x07\x93\x9b\xc1\x28\x1a\x8e\xe1\x66\x30\xbe\x83\x72\x9d\x42\x30\x81\x5f\x11\xf3\x44\xfb\x86\xa7\x9a\x81\x64\xa9\x14\xdd\x62\x1b\xfe\xbe\xbe\x7d\x88\x46\x7b\xd2\x2f\x24\x6d\x12\x9e\x58\xd7\x89\x9c\x59\xac\x2d\xcf\x50\x56\x60\xd1\x74\xf4\xfe\xa6\xc0\xaa\x9b\x6d\x7d\x89\xde\x78\xec\x98\x38\xf4\x20\x9e\x86\x36\x68\x73\x06\x7e\xf4\x4a\x67\x3e\x0a\xb8\x38\x12\xf1\x84\x2f\xa7\x1b\x45\xe7\x6a\xa3\x1f\x7a\xc0\x92\x74\x2f\x50\x26\x00\xc6\xcf\x54\xd9\xa8\x50\x36\xa3\x86\x88\xea\x31\xee\x01\xb2\x17\x35\x2c\xa0\x09\xf2\xa4\x08\x15\x91\x81\xe9\x0a\xf0\xc9\x54\xa2\x56\x3f\x29\x4a\x25\xea\x29\x32\xfe\x8c\xb0\x1d\xd2\x1e\x46\xe3\x87\xe1\xe0\x66\xf0\x07\xec\xf6\xad\x28\x20\xbd\x6a\x74\x57\x97\x29\x91\xca\x16\xd9\x4d\x7c\x79\x65\x0f\xd0\x5d\x2e\xde\x95\x09\x0d\xc8\x3a\x3a\x74\x6d\xcd\x21\xd2\x66\x48\xf7\xa7\xa5\xc8\x81\xdd\x4e\x4a\x1c\x9c\x12\x09\x7d\xa1\x18\x5d\xd4\x88\xb7\xf0\x10\x6a\x78\x5b\xf2\x4e\x70\x4e\x26\x96\x33\x88\x60\xc7\x79\x2b\x33\x35\xd9\xd5\xf1\x63\x46\xed\x2d\xb8\x06\x1a\x24\x71\xfb\x78\x6e\xdb\x0e\xb7\x25\x6c\x84\xba\x6b\x77\x8c\x42\xb0\xf3\x65\x96\xa7\x2a\x39\xe0\x50\xbb\xd0\x06\xdf\x2f\x8a\xe5\x61\x89\xec\x4d\x21\x37\x8f\x3a\xc3\xd7\xfa\xa1\x77\x94\xe2\xad\xc5\xa3\x14\x5f\xe3\x78\x47\xf2\x31\xa7\x92\x5d\xa8\x2a\xc9\xeb\x40\x7d\x78\x93\xe6\x9b\x78\xde\x1e\x68\xcb\xf3\xda\x2a\x30\xee\xcc\x6a\x9e\x37\xd1\x2d\xf6\xb4\x4d\xaf\xbc\x5b\x63\x57\x3c\x75\x37\xf4\xf6\x42\xb7\x69\x3c\xa9\xd1\xc4\xbe\x5e\xd9\x52\x33\x94\x2b\xc3\x1a\xf3\x3c\xdc\xf7\xaf\xc7\x51\x95\x74\x46\xd1\x18\x2c\x17\x54\x88\xc7\x98\xa8\x86\xdc\xef\x80\x0f\x9f\xea\x21\xdf\x92\x89\x37\x81\x7f\xfe\x8c\x86\x11\xbc\x61\xa7\xa6\xe8\xc3\xf5\xa0\x8f\x63\xf0\x44\x95\x54\x44\xa8\x19\xcf\xf1\x62\xda\xc0\x5a\x45\x72\xe9\x52\xc2\xed\xdc\x59\x4b\x94\x73\x88\x73\x4e\xcb\x5c\xc3\x05\x7b\xec\x51\x93\x29\xb7\x9a\x77\x37\xb0\xff\x0b\x57\x03\xcf\x8c\x08\x92\x96\xc4\xe1\x84\x8b\xd6\xf1\x32\xd4\xd6\x8e\x17\xe1\x7e\xa6\x6f\x6f\xb3\xe5\x4c\xaf\x48\x54\xca\xdb\x3a\x2e\x9e\x6e\x93\xbb\x49\xa3\x72\xe7\x2b\x69\x6c\xf6\x1b\xb7\xe3\x22\xcc\x32\x45\x33\xf3\x51\xc3\xb3\x44\xe9\xba\x8b\x73\xaa\x7d\x90\x92\xd9\x02\xf8\xdc\x7d\x15\x00\x47\x9f\x08\x74\x0c\x61\x65\x7e\x2e\x53\xe6\xf6\xb2\xed\x4a\xbc\xee\xd9\x1f\xbf\x4a\xff\xe0\x25\xb6\x91\xdf\x0e\xd2\x5b\x89\xf6\x8b\x54\xa9\x73\xd6\x41\xca\x6a\xb0\x50\xa2\xa0\x7d\x06\xea\x47\xb7\x11\x32\xd0\xe7\xe1\xdd\x97\x2a\x0d\x35\x13\xc7\x01\xce\xb0\x2c\x70\xc6\xbd\xe3\x60\xcd\xbc\xff\x2e\x7a\xd0\xfc\xc9\x77\x81\xe2\x13\xc9\x6b\x76\xb8\x6b\xdc\x7b\xed\xba\xf4\xc5\xdb\xfa\x0f\x61\x2c\x48\x3d\x72\x10\x00\x00"
 
 func oracleTypeGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -482,7 +783,27 @@ func oracleTypeGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _postgresEnumGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\xc1\x6a\xdc\x30\x10\x3d\x5b\x5f\x31\x15\x85\x58\x21\xf1\xd2\x4b\x0f\x81\x3d\x95\x1e\x9b\x43\xd3\xe6\x52\x7a\xd0\x7a\x47\x59\x11\x5b\x6e\x24\x79\x93\x60\xf4\xef\x65\x24\x99\x95\xd3\x4d\x4b\x0a\x7b\x59\xc4\x68\xfc\xe6\xcd\x7b\x4f\xec\x34\x5d\xc2\x7b\xff\xfc\x0b\xe1\x6a\x0d\xcd\xb5\xec\x11\x2e\x43\x60\xb1\xec\x76\x83\xf5\x54\xaf\xe3\xc9\xd0\x65\xea\xe5\x68\xc6\xfe\x56\x76\x1c\xb8\xc7\x27\xcf\x81\x6f\x46\xc5\x81\x0f\xf7\x1c\xb8\xb3\x2d\x17\x07\x14\x8b\x7b\xb4\x0e\x09\xda\xc5\x21\x5f\x53\xe1\xd3\x60\x9c\x4f\x55\xea\x5d\xad\x60\x9a\x32\x7c\x08\xa0\x1d\xf8\x1d\xc2\xd9\x34\x41\xf3\xd9\x8c\x7d\xfc\x89\xf4\x42\x38\x03\x1a\x0f\xb1\x55\xd9\xa1\x07\xd7\xee\xb0\x97\xa9\xf9\x26\x9d\xa9\xad\x61\xb1\xa5\x84\x1d\xb5\xf1\x1f\x3e\x32\xd6\xd2\x70\xa8\x23\x43\x2b\xcd\x1d\x42\x73\x2b\xbb\x11\x1d\x84\xc0\xaa\xc4\x45\xab\x17\xe4\x43\xa0\x01\x99\x44\x81\x3a\x4d\x80\x9d\xfb\xb3\x58\xb4\xa2\xd9\xbe\xdc\xea\x56\x76\x71\xa9\x38\x37\x6e\x55\x7c\xdd\xb0\xea\x34\x0c\xd6\xe5\x94\x7a\xe6\x11\xbd\x98\x89\x08\x96\xdb\xc9\x16\xc1\xc8\x99\x1b\x6f\xb5\xb9\x03\x8b\x7e\xb4\x26\xed\xe0\x52\x69\x1f\x3f\x1a\x54\xac\x2d\x16\x50\xa3\x69\x81\x26\xe4\x1c\x85\x50\xde\x8b\x8c\x59\x8b\x19\x69\x62\xd5\x5e\x5a\xc8\xc9\xca\x55\xc6\x2a\xf7\xa8\x7d\xbb\x83\x25\xd0\x2b\xc6\xb5\xd2\xe1\x69\xac\xbb\x62\x55\x35\x53\x5b\x03\x3f\x66\x20\x2f\x75\xab\x02\x63\x55\xd2\x6b\x5e\x89\x85\xa8\xe5\x17\x69\xdd\x4e\x76\xdf\xf0\xc9\x43\x9f\xce\x6e\x19\x7d\xe3\x07\xa0\x67\xf5\x6f\x0d\x0b\xac\x5a\x40\xfd\xe3\xe7\xe6\xd9\xe3\x05\xa0\xb5\x83\x15\xa4\x68\x66\x90\x2e\x16\x40\xcd\xac\xbf\xb8\x00\xa3\x67\x72\xdf\x4d\x5f\xd0\x1b\xcd\x51\x82\xf1\xcd\xbd\x4a\xf0\x7c\xc1\x70\x01\x58\xd3\x47\x99\x8c\x48\x2c\x89\x64\x76\x38\x39\x1e\x7b\x44\xf5\x57\x87\x8f\xcb\x4f\x16\x9d\x2f\xa8\xac\x4f\x93\x05\x76\x38\xb1\x6a\x8b\x4a\x8e\x9d\xa7\xe1\xb3\xdd\xb4\x97\x6b\xae\xf1\xb1\xe6\xda\xec\x65\xa7\xb7\xa5\x7c\x5c\x2c\xc2\x71\xd0\x3e\x2d\xe2\xa4\xd7\x4e\x69\xcc\xaf\xec\xa1\x5b\x6d\xad\xde\xa3\x4d\x22\x58\x4a\x07\x5a\x25\x5b\x04\x45\xea\xbd\xe5\xc5\x45\x04\xca\x49\x89\x78\x24\x2d\x47\x63\x52\xa6\xe4\xa6\x95\xe6\x05\xd1\xad\xf4\x72\x23\x1d\xae\xdc\x43\xd7\xd0\xbd\x79\x33\xd7\x65\x70\x08\xa3\x76\xb6\x3d\x80\x4c\xa1\xc8\xcc\x66\x54\x17\x30\xdc\xd3\x1f\x8a\xb3\x6d\x93\xa3\x2f\x58\xa5\x15\xbc\x1b\xee\xa9\x05\x00\xfe\xcb\x91\xc5\xfa\xcb\xfc\x6e\x46\x25\x48\x83\xdf\x01\x00\x00\xff\xff\x33\x36\x2f\x65\x36\x07\x00\x00"
+var _postgresCompositeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x53\xc1\x6e\xdb\x30\x0c\x3d\xdb\x5f\xc1\x19\xc1\x6a\x07\xae\x7a\x1f\xe0\xd3\x80\x1d\x7b\x58\x87\x5d\x8a\x1e\x04\x9b\x6e\x84\xd9\x72\x26\x39\x1e\x06\xc3\xff\x3e\x92\x52\x17\x25\x2d\xba\x1d\x1c\x2b\x14\xf9\xf8\xc8\xf7\xbc\xae\xb7\xb0\x9b\x7f\x1f\x11\x3e\x35\xa0\xee\xf5\x88\x70\xbb\x6d\xf9\xca\x61\x7f\x98\xdc\xcc\xf1\x52\x4e\x96\x2f\x43\x6e\xe1\x5d\x5b\x40\xd1\x1b\x1c\x3a\x4f\x07\x74\x8e\x7e\x97\xa2\x92\xe2\xbb\x3b\x58\xd7\x98\xb9\x6d\xe0\xf0\xe8\xd0\xa3\x9d\x3d\xcc\x07\x84\x76\x1a\x8f\x93\x37\x33\x82\x24\xdc\x50\xaa\x6f\x0f\x38\x6a\x50\x0f\xf1\xfd\xf9\x25\xe5\x7c\x12\x66\xdb\x76\xa3\x72\xa9\x4a\xf1\xfd\xec\x4e\xed\x0c\xab\x90\x76\xda\x3e\x23\xa8\x2f\xc2\x8c\x6e\xf3\x8c\x52\x55\xac\xe6\x32\x87\x52\xa7\xbe\xc5\xea\x40\x96\x1a\x0d\xfc\x9c\x46\x1b\x73\x05\x0d\x6d\xc7\xc7\x2d\xe7\x99\x1e\x5a\x6d\xc1\xeb\xd9\x78\x9a\x3b\xcc\xe2\x7f\x0e\x8a\xc3\x16\x1d\x18\x3b\xa3\xeb\x75\x8b\xd0\x4f\x2e\x25\x58\xc3\x51\x3b\x6f\xec\xb3\x94\x38\x6c\x27\xd7\x31\xde\x40\x63\x39\x3d\xa8\xbc\x3f\xd9\x16\x4a\xae\x08\x1b\x27\x56\xfb\xa4\xbe\x92\xce\x25\xad\xfc\xdc\x63\xa5\x28\x2d\x9d\x1b\xe5\x99\xe9\x81\x2f\x9b\x06\xac\x19\x38\x90\xed\x2f\xc0\x9a\x94\xcd\x4a\x2b\xc9\x68\x09\x27\x67\x39\x3d\xcf\x68\xb8\x2c\x08\x59\x33\x24\xeb\xcd\x7c\xf1\xab\x10\xe5\xb6\x35\x14\x09\x40\x51\x49\x47\x4e\xfd\x70\xee\x18\x11\x29\xca\x88\x9c\x30\xa0\x2d\x03\x6e\xc5\x89\x84\x40\x91\x44\x99\xab\xb2\xc9\x79\x75\x8f\xbf\xca\xc2\xd8\x45\x0f\xa6\x83\xab\x96\x4c\x73\x61\x72\x97\xb3\x9c\x35\xdf\x99\x1a\x76\xbd\xd8\x38\x51\x9f\x69\x36\x64\x30\x6d\xc3\x3c\x72\x55\x7e\x5c\x54\x62\x8b\x1a\x02\xcf\x47\x86\x36\x14\x78\xfa\x8f\x11\x13\x7b\xbc\x5a\xf7\x42\x5c\x93\x15\x07\xfb\x7c\xd7\xc3\x09\xaf\xfc\xd3\x39\xb3\xa0\x53\x72\xf5\xae\x83\xd0\xb6\x53\x17\x2d\xc4\x60\x8b\x80\x69\x0f\x3a\x1a\xea\x5d\x37\x5d\x98\x49\x9a\x95\x15\x94\x69\xf3\x3a\x48\x50\xf1\xa0\x8b\x76\x32\xba\x44\x5e\xbc\xc1\x7b\x1d\xf5\x0f\x2c\x1f\x9f\xf6\xf4\xc1\x11\x95\xfa\xb5\xa4\xd5\x3f\xe5\xb8\x5e\x74\xb0\x5c\x13\x87\x90\xc4\xc0\x2f\xe5\x9f\x6a\xf5\x9e\x32\xf4\xb7\x7e\x43\x9e\xbf\x5a\xd0\x56\x47\x3d\x47\x5f\x47\x6b\xd6\x51\xa1\x3f\x51\x38\x09\x9c\x08\x05\x00\x00"
+
+func postgresCompositeGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_postgresCompositeGoTpl,
+		"postgres.composite.go.tpl",
+	)
+}
+
+func postgresCompositeGoTpl() (*asset, error) {
+	bytes, err := postgresCompositeGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "postgres.composite.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _postgresEnumGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x56\xb1\x6e\xdb\x30\x10\x9d\xc5\xaf\x60\x84\x02\x91\x02\x47\x46\x97\x0e\x29\x3c\x15\x1d\x9b\xa1\x6e\xbb\x04\x19\x18\x99\x8a\x89\xc8\x54\x4b\x52\x4e\x03\x43\xff\xde\xbb\x23\x55\x91\xb6\x93\x20\x45\xbd\xd0\xd4\xf1\x78\xf7\xee\xbd\x23\xe9\xdd\xee\x92\xbf\x73\x4f\x3f\x25\xbf\x5a\xf0\xea\x5a\x6c\x24\xbf\x1c\x06\xb6\x43\xb3\x5d\x77\xc6\xa1\xbd\xa0\x99\xc6\x45\xef\x9b\x4b\xdd\x6f\x7e\x88\x36\xe7\xb9\x93\xbf\x1d\xfc\xdc\xf5\x0d\x8c\xdd\x03\x0c\xd6\xd4\x30\x4a\x63\x60\x54\xf8\xed\x8c\x1f\x2d\xfc\x6c\x69\x13\x8c\x36\x2f\xa7\x4c\x46\x6e\xa5\xb1\x12\xd3\x5b\x02\xf2\xd5\x1b\x3e\x75\xda\x3a\x6f\x45\xdf\xf9\x9c\xef\x76\x01\xc2\x30\x70\x65\xb9\x5b\x4b\x7e\x0e\xb6\xea\x33\x00\xa2\x81\x4a\x18\x86\x73\x8e\x10\x39\xb9\x36\xa6\xdb\x70\x5b\xaf\xe5\x46\x78\xe7\xa5\x9f\xa3\x5b\xc5\xc8\x25\x0e\xdb\x2b\xed\xde\x7f\x60\xac\xc6\xe4\xbc\x20\x84\x46\xe8\x7b\xc9\x2b\x28\xb9\x07\x2c\x00\x25\xf3\x58\x54\xb3\x07\x7e\x18\x30\x41\x00\x11\x45\x85\xa9\x6c\xed\xa1\x31\x72\x95\x7a\xb5\x5f\x15\xe4\xa3\xa2\x28\x2f\x55\x15\xed\xae\x58\x76\x1a\x04\x8b\x38\x4b\x31\xe2\x20\x2d\x46\x20\x25\x0b\xee\x28\x4b\xc9\x50\x99\xa5\x33\x4a\xdf\x73\x23\x5d\x6f\xb4\xaf\xc1\x7a\xd3\x96\x36\x75\x0d\xd9\x92\x02\x9a\x5e\xd7\x1c\x33\x84\x5e\x83\xe4\xd1\x7a\x19\x62\x16\xe5\x18\x69\xc7\xb2\xad\x30\x3c\x74\x5f\xb0\x32\x96\xd9\x47\xe5\xea\x35\x4f\x03\x3d\x23\x5c\x2d\xac\x3c\x8d\x74\x57\x2c\xcb\x46\x68\x0b\x9e\x1f\x13\x30\x8f\x79\xcb\x06\x80\xee\xf9\x1a\x4b\x62\x03\x71\xf9\x45\x18\xbb\x16\xed\x37\x38\x5b\x7c\xe3\xe7\x36\x6d\x7d\xed\x3a\x8e\x47\xef\x75\x0e\xa3\x58\x40\x64\x71\x73\x7b\xf7\xe4\xe4\x8c\xc3\xf9\xec\x4c\x89\x8c\x06\x04\x7e\x21\x09\x54\x8d\xfc\x97\x33\xae\xd5\x08\xee\xbb\xde\x44\xf0\x7a\x7d\x14\x20\x9d\xb9\x67\x01\x5e\x24\x08\x93\x80\x05\x6e\x0a\x60\x4a\x8f\x12\x41\x06\x85\xbd\xe2\xe4\x53\x66\x2f\x2a\x7c\x9c\x7e\x94\xe8\x22\x81\xb2\x38\x4d\x2f\xb0\x69\xc6\xb2\x95\x6c\x44\xdf\x3a\x4c\x3e\xca\x8d\x75\xd9\xea\x5a\x3e\x16\xb9\xd2\x70\x40\xd4\x2a\xa6\x2f\x2f\x93\xe6\x98\xb8\xf7\x85\x58\xe1\x94\x6d\x94\x0c\xa7\xec\x57\x3b\x5f\x19\x05\xe8\x3d\x09\x06\xbb\x43\x9a\x46\xd4\x70\xf5\x21\x7b\x6f\x39\x71\x14\x01\xfb\x24\x8e\x78\xa4\x5b\x8e\xb6\x49\xdc\x25\xcb\x5a\xe8\x3d\xa0\x2b\xe1\xc4\x1d\x68\x33\x07\xc4\x15\xae\xeb\x37\x63\x4d\x1b\x07\x63\x14\xf0\xdc\x4c\x41\x76\x43\xd4\x33\xf0\x26\xcd\x78\xf7\x80\x0f\x0a\x38\x55\xa1\xf5\x81\x5a\x90\xfb\x0c\xec\xe0\xc2\x39\xff\x27\x45\x92\xf2\xd3\xfe\x85\xac\x25\xf3\xef\x1a\xe4\xa9\x96\xad\xaa\x25\xb5\x41\xfa\x7a\x79\x3b\x5c\xf6\x82\x5b\x9a\xc2\xfd\x98\x54\xbf\xff\x2e\xf9\x0d\x37\xb7\x91\xe9\xff\x10\x4d\x81\x8f\xb1\x6d\x13\xba\xc9\xed\x55\xce\xf1\x7a\xc6\xa7\x3e\x5c\xdd\xb4\x89\x08\x07\x0f\x12\x02\xd6\xaa\x31\x46\xf9\x91\xcc\x67\x0b\xec\x1b\xdc\x1d\x1d\x0f\xa4\x1b\xf7\x51\xb0\xc5\xe4\x71\x91\x22\xa4\x85\x69\x23\x7d\xa0\x4e\xf8\x0f\x03\xf3\x6d\xc4\x83\xbf\xd3\xe2\x2a\x66\xbc\x95\x00\x00\x22\x97\x20\x2b\xd2\xa1\x66\x98\x08\x37\xf8\xeb\x84\xb2\x62\xba\x09\x39\x46\xbc\x51\xb7\x7b\x6a\x87\x5b\x13\xfc\xcb\xc3\x6a\x92\x72\x32\xba\xf0\x0f\xf1\x63\xdc\x13\x1c\xf5\x17\x54\x3d\x14\xf5\xd5\x53\xaf\x1a\xbe\x87\xfb\x40\x33\xf8\x9c\x4d\xfc\x13\x81\x23\xff\x51\x2f\x78\xea\x93\x58\x91\x06\xc9\x59\xff\x2b\xc6\x1e\x7a\x48\x89\xd1\x41\x8b\xf0\x4f\xe5\xe0\x1e\x22\xa2\x03\x2c\x6a\xb8\x50\x5f\x38\x96\xe1\x5e\xfe\x03\x00\xde\x83\xa6\xfb\x0a\x00\x00"
 
 func postgresEnumGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -502,7 +823,7 @@ func postgresEnumGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _postgresForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x4b\xc4\x30\x10\x85\xcf\xe6\x57\xbc\x83\xb0\xc9\xb2\xdb\xde\x05\x2f\xab\xe8\x41\x50\x10\x0f\x5e\xbb\xed\xd4\x16\xb7\x89\x4c\x52\xb5\x84\xfc\x77\x49\xda\xed\xd6\x65\x0f\x81\xe1\x9b\x37\x99\xf7\xc6\xfb\x2d\xae\x6d\x63\xd8\xe1\xe6\x16\x32\x55\xba\xe8\x08\xd9\xdb\xf0\x45\xd9\x73\xd1\x91\xc2\x36\x04\x91\xe7\xf0\x1e\x09\x20\x04\x30\xb9\x9e\xb5\x85\x6b\x28\xf1\x57\xaa\xe7\x81\xd8\x2f\xac\x35\x65\x5b\x38\xaa\xf0\xd3\xba\x66\xd6\x2d\x45\x2b\x9b\xd0\x43\x4b\x87\x6a\x1e\x94\x27\x74\x67\x0e\xf1\xf5\x9d\x9e\x9a\x2a\x13\x79\x1e\x9d\x3c\x92\x26\x4e\x9f\xd7\x6c\x3a\xd4\x86\xa9\xfd\xd0\xf8\xa4\x01\xab\x34\x3f\x82\x27\x1a\x16\xe5\x71\x6b\x26\xea\x5e\x97\x69\xd1\x94\x3c\x04\xac\xcf\xcd\xa9\x65\x5c\x59\xed\xf1\xfe\x72\xbf\x53\x90\xeb\x0b\x69\x37\x20\x66\xc3\x0a\x5e\x5c\x8d\x87\xb9\x74\x93\xdd\x30\xc1\x7f\x81\x65\xb5\xdf\x44\x75\x69\xf4\x37\xfd\xba\xa3\xa5\xf1\x04\x27\x79\x74\x24\x82\x10\x7f\x01\x00\x00\xff\xff\xea\x89\x96\x81\xb0\x01\x00\x00"
+var _postgresForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x55\xdb\x6e\xdb\x46\x10\x7d\x26\xbf\x62\x4c\x14\x31\x15\xd3\x74\xfa\xea\x42\x79\x88\x2b\xb7\x45\x1d\x3b\xb5\x8d\x26\x80\x20\xc4\x2b\x6a\x29\x11\x59\x2d\xa5\x5d\xd2\x91\x20\xe8\xdf\x33\x33\xbb\x94\x28\x87\x49\x1c\xa0\x05\x24\xee\x85\x73\x39\x73\x39\xc3\xcd\xe6\x14\x7e\xb1\xb3\xd2\x54\x70\xde\x87\x98\x77\x5a\xcc\x25\xa4\xf7\xeb\x85\x4c\xaf\x71\xdb\x83\xd3\xed\x36\xdc\x90\xa0\xfa\xbe\x24\x44\xd2\x98\x08\x22\xbb\x54\xb6\xa2\xcd\xb2\x96\x66\x8d\xeb\x64\x4c\x07\xfc\x1b\x69\xf1\xf9\x49\xae\xfd\x42\xc2\x52\x6a\x5c\xc6\xa2\xca\x66\xb8\x7e\xb8\xb9\x2a\xa7\x51\xcb\xa7\xe9\xf4\x79\x2b\xf3\xff\xc1\x6d\x13\x61\xdb\x7d\xf9\xd9\xb2\xf3\x85\x29\x74\xb5\x4b\x41\x64\xdb\x18\xd1\x64\x85\x68\x58\xce\x48\xde\x12\xc2\xcb\x42\xaa\x09\xa7\xc7\xc9\x9e\x9d\xc1\x66\x03\x0e\xf3\x76\x0b\x28\x59\x1b\x6d\xa1\x9a\x49\xbe\x3f\x88\x09\xdf\x0b\x6b\xcb\xac\x10\x95\x9c\xc0\xe7\xa2\x9a\xed\xe4\xda\x42\xc7\x96\xaf\x9c\xa7\x46\x31\xde\x5f\x5d\x94\x8a\xfe\xf5\x5c\xfb\x97\xbd\x14\x61\x10\x92\x3f\xa4\x96\x86\x8d\xe7\xa6\x9c\x43\x5e\x1a\x59\x4c\x35\x60\x28\x70\xcc\xfa\xee\xe2\x6f\xb9\x6e\x6d\x1b\xaf\x69\x98\xd7\x3a\x63\x47\xbe\x7b\xd0\xed\xcb\xa7\xe0\x7a\xed\x70\x49\x36\xab\x56\x0b\x61\xc4\x1c\x8f\x93\x31\x7c\xb8\xf9\xfd\x4d\x0f\xe2\x97\x1d\xb1\x27\x80\x25\x2d\x0d\x1a\x08\x03\x97\xa6\xae\x0c\xbd\x59\xfb\xcb\x83\xf0\xbd\x23\x61\xa6\xec\x26\x21\xcd\xac\xd4\x8f\x72\x55\x35\x60\x5d\x72\xf6\xaa\x84\x35\xdc\x86\x4d\x81\xae\x4a\x31\x79\x66\x91\xec\xb3\xaa\x64\x8f\x7f\xb6\x4a\x09\x61\xc1\x5a\xa0\xd5\xf1\x9a\x6c\x16\x06\xba\x82\x4d\xa0\xd0\x20\xc0\x16\x7a\xaa\x24\x70\xe3\xc3\x42\x1a\xe0\xbe\x86\x32\x27\x1b\xf6\xbf\x2c\xf9\x93\xfc\x74\x57\x95\x73\xee\xa8\x83\x81\x0e\x47\x1d\x9d\x11\xcf\xc5\x62\x48\x52\x0d\x77\xb6\xdb\xd1\x8f\x3a\x01\x43\xc8\x4a\xa5\x64\x56\x71\x58\x61\xf0\x28\x0c\xef\xd0\x05\x92\x53\x9a\x5c\x64\x72\xb3\x0d\x03\x22\x37\x91\xb1\xc3\xc7\xb8\x2c\x15\x89\x60\xf0\xf0\xd1\xe1\x54\xbb\x0e\x46\x15\x23\xf4\x54\x1e\xc0\xdf\x30\xc7\x8b\x1c\x66\xc2\xbe\x33\x32\x2f\x56\x4d\xd9\x08\x27\x8f\x9d\xf4\xba\x56\x2a\x42\xe1\x30\x08\x50\xf0\xe8\xc0\x6a\xfa\x55\xe9\xd3\x7f\x85\x2a\x26\x14\x51\x10\x60\x67\x56\x85\xae\x25\xee\xdd\x30\x91\x7a\xb2\x33\x44\x95\x41\x4c\xed\x06\x56\xdf\xec\xe0\xdf\xe0\x88\xe2\x1e\xa2\xd2\xc8\xd9\xde\x1f\xfb\x50\x19\xf6\x11\x70\xba\xfa\x20\x16\x0b\x74\x14\xd3\x29\x21\x37\x3d\xf6\x8f\x3f\xce\x32\x86\xe4\x7a\x29\x24\x7c\xb6\x02\x37\x5a\x51\xef\xe1\x6e\x70\x35\xb8\xb8\x87\x07\x38\x41\x8d\x07\x46\xa6\x68\x24\xdb\x7d\xe5\x18\x10\xa7\xce\x4b\x5d\xde\xde\xbc\xa5\x20\x6c\x36\x93\x73\x01\xe9\x9d\x5f\x1b\x85\x7b\x31\x56\xfe\xd9\x90\xc3\x6b\xbe\xff\x73\x70\x3b\x80\xbd\x97\x16\x01\x90\x2f\x24\xf8\xd7\x35\x3c\x84\x34\x24\xec\x37\x0a\xde\xd5\x54\x4d\x03\x28\xa9\x39\x05\x3d\x78\x0d\xaf\x38\x67\x8e\x37\x68\xc9\x35\x18\x15\x81\x84\xf8\x9a\xa4\xd0\xd8\x5c\xb8\x76\xf7\xcd\x11\x34\x4a\x7d\x47\xba\xe1\xf9\x13\x99\x11\xa7\x16\x1f\x98\x58\x53\xeb\x26\xb1\x81\x23\x2b\xba\xf2\xc9\x3d\x81\x55\x89\x8d\x51\x4b\x1b\x47\x6c\xc2\x7e\x42\xed\x28\x81\x5f\x93\x16\x06\x2a\x14\x7f\xac\x62\xd6\x4f\x9c\xd3\x34\x4d\xe9\xc5\x92\xd9\x42\x36\x27\xe3\xd4\x31\x33\xd7\x10\xfd\xe3\xbe\x87\x4f\x46\x63\x87\x3e\x46\x4b\xfa\x47\x7d\xd0\x85\x72\xb1\xf9\xe9\x8b\x67\xb6\xdd\x8a\x45\xe1\x14\xc0\xf1\x68\x6b\x55\x51\xa6\x28\x9f\xcb\xf4\x1a\x9b\x34\xee\x39\x55\x26\x51\x9b\x5b\x5d\x95\x70\x82\xcc\xaf\xdd\xbb\x77\xa6\x98\x0b\xb3\xc6\xe1\xe3\x98\x10\x04\x1f\xe5\xaa\xb0\x95\x3d\xe7\x36\x4e\xbc\x71\x62\xca\xa9\x13\x60\x4c\xdc\xb9\x99\xd0\xb4\xa5\x30\xfa\x88\xe7\x0e\xcf\x14\x75\x4e\x3d\xd3\xdd\xa7\xfe\xcb\x1e\xbd\x88\x1a\xb8\x3d\xfe\x1e\x04\x9d\xf9\x08\x96\xe9\x85\x2a\xad\x8c\x59\xa0\x23\x3d\x1e\x0b\x26\x66\x78\x90\x80\xb4\x6b\x7c\x13\x33\x5f\x1c\x88\x39\x1e\xee\xf0\x0f\x8c\x61\x4f\x6d\xaf\xcf\xaf\x92\x27\x3b\x2d\xc3\x7d\x0b\x9d\x8f\x1c\xd1\xbd\x12\x22\x4d\x48\x93\x3e\x80\x5f\x00\x70\xd6\x95\x1b\x0f\x0a\x00\x00"
 
 func postgresForeignkeyGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -522,7 +843,7 @@ func postgresForeignkeyGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _postgresGraphqlBundleGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\xcd\x6e\xc2\x30\x10\x84\xcf\xc9\x53\x8c\xac\xaa\x80\x14\xcc\x3d\x12\x27\xaa\xf6\x82\x40\x55\x39\xf4\x1a\xc8\xe6\xa7\x4a\xec\x60\xbb\x45\xc8\xf2\xbb\x57\x76\x02\x49\x55\x0e\x71\xe2\xd9\x9d\x6f\x33\x6b\xed\x12\x4f\xba\x92\xca\x20\x5d\x63\x1e\xbe\x44\xd6\x12\xf8\xce\x9f\x8c\x94\x62\x60\x8a\x34\x03\xd3\xe7\x46\x1b\x7f\xcd\x8f\x0c\xec\x73\xbf\x95\x25\x5b\x60\xe9\x5c\x1c\x28\x26\x3b\x36\xd4\x53\x4e\x15\xb5\x19\xf8\xc7\xf0\x3e\xf8\x4a\x7f\x7a\xea\xc4\xa3\x47\xd3\xe3\xae\x78\xb5\x82\xb5\xc3\xdf\x38\x77\xb8\x76\x84\x5a\xc3\x54\x84\x37\x95\x75\xd5\xfb\x16\xc3\xb4\x42\xaa\x20\x4f\xba\x61\xae\x1d\xf1\xf8\x27\x53\xff\x18\x6b\x94\xde\x7e\x6e\xf8\x8e\x2e\xfb\xe3\x17\x9d\xcc\xfc\xa6\xf4\xd7\x8d\x14\x45\x5d\xda\x38\x8a\x22\x6f\x4c\xc1\x26\x0c\x96\x78\xdd\x47\xa8\x0b\xf0\x8d\x6c\x5b\x12\x06\xce\x79\xf5\x85\xf4\x49\xd5\x9d\xa9\xa5\x18\x4c\x63\x9d\x8f\x46\x6a\x34\x3d\x74\x1c\x42\x88\x30\xc9\x39\x28\xea\x14\x69\x12\x46\x23\x83\x92\x17\x14\x4a\xb6\x98\x59\x7b\x5f\x9e\x73\xb3\x29\x56\xe4\x03\xf5\xb5\xa6\x26\xd7\xe9\x3d\x68\x7f\x0f\x81\x42\xa7\xca\x44\x49\x18\xe4\xc1\x13\x45\xcc\x97\xf8\x46\x36\xfe\xf9\x6e\xc5\x2d\x70\x8a\xe7\x3f\x20\x0b\xbf\xc7\x91\x6e\x2d\xca\x73\xe3\x17\x0e\x1e\x36\xec\x5c\x02\x97\xdc\xa7\x91\xc8\xfb\x11\x2e\x89\xdd\x22\xfe\x0d\x00\x00\xff\xff\xe5\x9e\x9f\x54\x78\x02\x00\x00"
+var _postgresGraphqlBundleGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6d\x51\x4d\x6f\xc2\x30\x0c\x3d\xb7\xbf\xc2\x8a\xa6\x01\x12\x94\x7b\x25\x4e\xa0\xed\x82\x40\xd3\x38\xec\x1a\xc0\xfd\x98\xda\xa4\x24\xd9\xd0\x14\xe5\xbf\xcf\x6e\x0b\xed\x34\x0e\x75\xe3\x17\xbf\x67\x3f\xc7\xfb\x05\x3c\xd9\x42\x1b\x07\xe9\x0a\xa6\xed\x49\xc9\x1a\x21\xd9\x71\x14\x68\x8c\x00\x61\xd0\x52\xb4\x97\xca\x3a\x4e\xcf\x47\x0a\x1f\xfb\xad\xce\xc5\x0c\x16\x21\xc4\x9e\x55\x9c\x3c\x56\xd8\xa9\x9c\x0a\xac\x25\x24\xef\xfd\xff\xc0\x37\x5d\x64\xd5\x11\xc7\x0e\xa4\xc7\x55\xf1\x72\x09\xde\xf7\xd3\x84\x70\xf8\x69\x10\x4a\x0b\xae\x40\x78\x35\xb2\x29\xde\xb6\xd0\x77\xcb\xb4\x69\xe1\x51\x35\x38\x2a\x4f\xe2\x6f\x69\xfe\x69\xac\x20\x67\xfa\xa5\x4a\x76\x78\xdd\x1f\x3f\xf1\xe4\xa6\x37\xa4\x4b\xd7\x5a\x65\x65\xee\xe3\x28\x8a\x98\x98\x82\x18\x69\x88\x39\xe3\x6c\xa1\xcc\x20\x59\xeb\xba\x46\xe5\x08\x67\x74\x83\xf6\x64\xca\xc6\x95\x5a\xf5\xa4\xe1\x3e\x19\x88\x58\x59\x7c\xc8\x38\xb4\x26\xda\x4e\x64\xc1\x60\x43\xdb\x27\xb6\x05\x09\x46\x5f\x21\x33\xba\x86\x09\xc9\xde\x96\x17\xc2\x64\x2c\xab\xce\xbd\xea\x4b\x89\xd5\xd9\xa6\x77\xa3\x5d\xde\x1a\x6a\x2b\x8d\x54\x39\xbd\x73\x07\xf7\x9c\x28\x12\x7c\x45\x13\x57\xfc\x7d\xd5\xea\x66\x38\x85\xe7\x3f\x42\x1e\x78\x8f\x83\x3a\x0d\x94\x5f\x2a\x5e\x38\xbd\x37\xc7\x10\xe6\x10\xe6\xf7\x6e\x34\x57\xd7\x82\xb0\x30\x8b\x7f\x01\xe5\x9e\x9f\x54\x78\x02\x00\x00"
 
 func postgresGraphqlBundleGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -542,7 +863,7 @@ func postgresGraphqlBundleGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _postgresGraphqlLoaderGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe2\x02\x04\x00\x00\xff\xff\x93\x06\xd7\x32\x01\x00\x00\x00"
+var _postgresGraphqlLoaderGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\x5b\x6f\x9b\x48\x14\x7e\x8e\x7f\xc5\xd4\xaa\x5a\xa8\x28\xe9\x73\x56\x79\xd8\x5e\xb2\x5b\x6d\x37\xad\xd2\xec\x45\x8a\xf2\x30\x86\x21\x46\xc6\xe0\x30\x60\x27\xb2\xf8\xef\xfb\x9d\x33\x03\x1e\xb0\x9d\x7a\xdb\x48\x5d\xa9\x1b\x09\x02\x33\xe7\x9c\xf9\xce\xed\x3b\x78\xbd\x7e\x29\x9e\x2e\x66\x37\xe2\xe4\x54\x84\x9f\x64\x34\x93\x37\x4a\xbc\x6c\x9a\xd1\x1a\x1b\x69\x22\xd4\xad\x08\x2f\xd5\x7c\x91\xc9\x4a\x89\xf1\x87\x8f\x3f\xbf\x7d\x77\xf1\x79\x2c\x20\x70\x7c\x2c\x3e\x14\x32\x56\xa5\x16\xb2\x54\xa2\x9a\x2a\x51\xaa\xdb\x5a\xe9\x4a\xe8\xa8\x58\xa8\x58\xc4\xb2\x92\x99\x11\x09\xc4\x44\x56\xd1\x34\xcd\x6f\x84\xcc\x63\x11\x49\xf3\x0c\x25\xb2\x93\x15\xc5\xac\x5e\x68\x31\x87\xac\x58\xa5\xd5\x94\xad\x69\x39\x57\x22\x2a\xf2\x4a\xdd\x55\xe1\xa8\xba\x5f\xa8\xee\x40\x5d\x95\x75\x54\x89\xf5\xe8\x28\x9e\x08\xfe\x5b\xaf\x8d\x1b\x4d\x13\xfe\xfd\xf1\xed\xeb\xd1\xd1\xbc\x36\x1b\xfa\x3e\x8f\xc2\xdf\x6b\x18\x19\x1d\x59\x30\x38\x67\x71\x05\x13\x40\x70\xfd\x62\x03\x32\x34\xd6\x47\xcd\x88\x30\xd9\x83\x7f\x53\xf7\x22\xd5\x8c\x87\x11\x14\x09\x3f\xdb\x5d\x31\x53\xf7\xda\xac\x41\x68\x61\xc2\x67\xb1\xba\x06\xf2\x6a\x64\xfc\xe4\xf3\x1d\x9b\x8e\x9d\xd6\xb4\xf5\x31\x1c\x61\x0f\xb1\x74\x74\x1c\x8b\xa7\xe2\x15\x5b\x7c\x57\x96\xe7\x45\x1b\x15\x6b\x53\x95\x65\x51\x22\x17\x55\x5d\xe6\xc8\xc2\x6a\xaa\x72\xb6\x42\x01\xe7\xe0\xca\xee\xd8\x6a\x2a\x2b\x31\x95\x5a\xe4\x85\x93\xcf\x70\xb4\x94\x65\xdf\xf4\xa9\xb1\xaa\xc3\x73\xb5\xf2\xc6\x16\xd3\x49\x67\xc7\x98\x68\xb1\x8e\x7d\xc6\x06\xd1\x37\x76\xdf\x80\xd1\x7c\xf2\x82\x3d\x8d\xaa\x3b\x03\x26\x57\xab\x2e\xad\xb5\x26\x8c\xf1\x24\x14\xef\x51\x44\xd3\xa2\xce\x62\x31\xe1\x0a\x89\x64\x96\xc1\x97\x22\x8f\x94\x58\xa8\xb2\xab\x34\x2f\x55\x01\xc2\x0b\xc3\xbf\x5e\x5e\x7e\x12\xf3\x34\x8e\x33\xb5\x42\x3d\xfa\x81\xd0\x85\xf1\xaf\x2c\x56\xa6\x44\xf3\xa2\x32\xb6\xa2\x29\x6c\xc9\xa8\x2c\xb4\x6e\x2d\xc1\xe9\xa4\xce\x23\x07\xb4\x47\x10\xdb\xf2\xb3\x6b\x01\xc0\x0d\x4b\xcd\x1f\x0a\x51\x59\x1a\x87\xbb\x9d\xbf\xe0\xe9\x9f\x32\xab\x15\x19\x0d\x9c\x9c\x06\xe2\x99\x75\x1e\x4a\x28\xe6\x13\x53\xb4\xf1\x24\xc0\x6b\x17\xe6\x07\xeb\x75\xdd\x40\xb6\xf1\x6d\xd9\x9e\x95\xc5\x7c\x18\x75\xa7\xaa\x6c\xe8\xad\xb3\x8e\xf0\x2e\x6f\x7d\xe1\xbd\xf8\xd0\xf5\x6f\x51\x64\x3e\xb9\x96\x05\xa2\x98\x11\x5d\x90\x1d\xe3\xd4\xc6\x1f\x3f\xec\x54\xfc\x2e\x0a\xac\x61\xf1\x91\x28\xdf\xb4\x98\x6d\x5a\x3d\x47\xab\xc7\x36\x2c\x83\x3e\xb0\x88\x03\x11\x95\x4a\x56\x3d\xd2\x60\xe9\xce\x06\x13\x8c\x60\xbf\xba\xda\x9f\xdc\x8b\x24\x37\x1d\x90\xa4\x25\xea\xa5\xd6\x2a\xb6\xce\x93\xfe\xee\x1c\x13\x1a\x61\xe2\x1d\x90\x3e\x89\x7b\x5b\x59\x77\x12\xf1\x9a\x8e\x3e\x83\x54\x00\xa7\xd0\xca\x08\x1c\x5a\x5e\x95\x89\x8c\x14\xd2\x63\x7a\xa7\x1f\xbc\x41\xe8\x11\x2c\x90\xed\x13\xec\x51\x1d\xd8\xb8\xe5\x29\xc4\xdd\x36\x44\x9e\x47\xb0\x11\xce\x6b\xe4\x3e\x9a\x79\xd0\xca\xe2\xd6\x62\x16\xda\x34\x5c\x11\xfe\xeb\x9e\x41\xf4\xd1\xa9\x0b\x18\x55\xce\x98\x55\x6c\x2c\x7b\x49\xee\x65\x61\x3c\xf1\x7d\x12\xee\x1b\x82\x66\x16\xd3\xc9\xe6\xe0\x3f\xf2\xcc\x1c\xbd\x49\x6f\xcc\x95\x68\x4a\x7b\xe6\x7b\x6d\x29\xce\x0c\xcd\x49\xe7\x60\x5e\x4b\x88\x9d\xe4\x2a\x68\x1b\x11\x49\x62\xba\xe7\x80\x23\x77\x8b\x52\x69\x95\x57\x48\x76\x91\x23\xfb\x64\x2a\x8d\x2d\xa9\x92\xfe\x86\xfc\xd3\x58\x08\x27\xd0\x40\x24\x57\xbd\x05\x03\xe4\xb3\xb1\xac\x61\x51\x27\xa9\x32\x0d\xe1\x44\xc3\x52\xb4\xd1\xb2\xd5\xe1\xd9\x44\x1a\x5d\xcf\x6f\xe1\x6d\x7a\x3b\x99\x57\xe1\xe7\x05\x16\x2b\x6f\x16\xa6\x71\xeb\xf5\x05\x20\x7c\xd5\x49\x50\xc4\x31\x0e\x7a\xe7\xac\x59\x08\xcf\x46\x66\x28\xab\x4c\x2b\x61\x07\xf4\x53\x0e\x0a\x8d\xee\x4b\x3c\x84\xe7\x54\xb8\xed\xf0\x7e\x0a\x02\x2d\x2b\xda\xf4\xf8\x89\xab\xda\x91\x1b\x23\x5f\x63\x31\x8e\x27\xb8\xa1\x40\x71\x47\xdc\xe9\x0e\xba\xc4\x3f\x9a\x6c\xf4\x0f\x57\x8a\x6b\x8e\x6b\x89\xab\xe0\x85\x98\x6f\xd8\xa7\x96\xf0\xbb\x23\x4b\x99\xe3\xeb\x21\x7c\x9f\xc7\xea\x0e\xce\xb7\x40\x10\x09\x14\x20\x01\x49\x69\x47\x84\x67\xb4\xa0\xc5\x2b\xbf\x13\xc1\x69\xad\x2b\xde\x6d\x2d\x33\x7e\xe1\x7e\x33\xda\x8c\xdb\xb7\x9f\x1d\x68\xc5\x90\xba\x8d\xdd\x68\x1a\x4b\x27\x14\x6a\x6a\x52\x56\xc5\x2a\xca\x2a\xad\x34\x2f\x19\x13\x56\xfc\xdb\x3f\x45\x8e\x8f\x49\xf2\x17\x95\xab\x12\x5f\x45\xb1\x48\xd0\xcb\xc2\xb8\xf6\x9c\xb0\xb1\xff\xe6\x6e\xcf\x7c\x6e\x73\x3d\x40\xbe\x9b\x7e\x50\xd5\x04\xba\x0d\x49\xd3\x10\x0f\x3b\xf4\xe3\x38\xe9\x52\xcb\x92\x5f\x98\x09\xba\x6e\x1c\x0f\x0e\x1c\x07\x64\x3a\x8b\x0c\x19\xba\x3b\x4c\x07\x01\x55\xe2\x3a\x8d\x09\x43\x63\x28\x89\x4c\x3e\x39\x25\x2a\xda\x62\x26\x6c\x19\x42\xb2\x8b\xcb\x70\x1f\x4c\x0c\x63\x68\xd8\x06\x79\x08\x40\x6f\x64\x39\x84\x8e\x19\x30\xf0\x64\x13\xd0\xbd\xc6\xbc\x5d\xb3\x7a\x17\x6b\xbb\x4d\x4d\x54\xbf\x33\x2b\xfc\xa5\xd7\x6f\x65\xed\x8b\xab\xde\x40\xbe\x50\xba\xce\x98\x99\x40\x4d\x9a\x52\x31\x97\x33\xe5\x5d\x5d\xf7\xf3\x89\x0f\x00\x95\x7b\x64\x90\xf9\x96\xf8\x30\xa5\xc9\x01\x79\xd3\x41\x7c\x16\x59\x21\x33\x57\x29\x51\xf0\x2c\x64\x7e\x08\xbd\x41\x69\x40\x88\x52\x70\xd4\x8e\x56\xea\x5e\xbc\xce\xbb\x62\xe8\x67\x84\x99\xba\xad\x3e\xbc\xc3\x55\x59\xd2\x26\xbe\x38\x90\x74\x4d\xb4\x8e\x58\xb8\xd8\xb7\x1d\xdc\x8d\x3f\x8d\x37\x0e\x90\xf7\x8c\x5f\xa3\x85\x90\xc4\x65\x3b\xa4\xe6\x57\x69\x7c\xfd\x93\xd9\x8b\xa4\x56\x4e\x7d\x9d\xd0\x1a\x9d\x6d\x1c\x7e\xb6\x75\xec\xfa\x1d\xd5\xfa\x09\xa9\x34\x9d\x3e\x26\xdc\xc1\x8a\xfa\x36\x0b\x79\x9c\x5e\x20\x48\x6c\x22\x56\x89\x84\xc0\x97\x2d\xbc\xc5\xca\x89\x58\xb2\x52\xd3\xc6\xdc\xd6\x0c\xf4\xa8\x0f\x88\xc9\x84\x02\xa3\xf4\xc9\xf0\xac\x28\x55\x7a\x93\x53\xb9\x74\x6c\xc7\x54\x4c\x54\xc7\xe3\xc3\xb6\x09\xf3\x93\x43\x71\x46\x6a\x48\x6f\x00\x94\x6c\x38\x1c\xbb\x52\xeb\x22\x4a\x99\x87\x3a\xba\x72\x5a\xef\xb9\x36\x9d\xe3\x32\xa0\xf0\x36\x4b\x6f\x8a\x8c\xae\x7a\x9e\xdb\x4d\x3f\xa0\xf3\xf7\x51\xe4\xd7\xf3\x63\x62\xe2\xc0\x13\x9c\x59\x72\x13\x18\xe7\x71\x9b\x2f\xdb\x30\xec\xee\x4a\x12\x30\x73\x0e\x6e\xed\x63\x9f\x2d\xfa\x1c\x06\xd1\xa1\x51\xfb\xb3\x17\x3f\x68\x3e\x95\x2a\x49\xdb\x61\xc5\xf3\x47\x8c\xa9\x82\xce\xeb\x2c\xe3\x1f\xc0\xfc\x81\xe5\x02\x08\xb7\x22\x4d\xdf\xc7\x44\xe7\x03\xe6\x24\x32\xa4\x12\xe2\x71\x6e\x2a\x66\x2f\x7d\xb7\xfe\xf7\xa9\xbb\x5d\x75\x68\x5b\x90\x35\x04\x68\x49\x9f\xfe\x16\x93\x41\xc3\xfe\x9a\x27\xf2\x75\x03\x19\x73\x5b\x3c\x06\xcf\x0f\xe3\xb9\x8f\xef\x7b\xa8\x1f\xe6\xfa\x56\x74\x9b\xe7\x7b\x46\xbe\x2b\xc7\xf3\x0f\x4b\x87\x28\xf7\xcd\xe8\x83\xd9\x9e\x0c\x0e\xe8\x7e\x5f\x4d\x3f\x26\xef\x93\xee\x37\x10\x7f\xaf\x07\x3b\xaf\x38\x38\x7b\x66\x00\x01\xf8\x62\xa1\xfe\x58\x63\xe2\x42\x2d\x0f\x9f\x14\x10\x3e\x6c\x58\xb8\x4d\xa9\x0f\x1c\x15\x6d\x0a\xfa\xd3\xa2\x5b\xfd\x31\x06\xc6\x56\x37\x87\x7b\xe6\xc5\x23\xf1\x76\x7f\x86\x0c\x53\xf0\x28\x9c\xfd\xb0\x4b\xff\x53\xf6\x7f\x8e\xb2\xd1\xe5\xdf\x91\xb5\xb7\xeb\xeb\x5f\xb1\xee\x11\x65\x2a\xcd\x6b\xd5\x52\xe1\x01\xbc\xc9\x83\xe1\xe1\x4e\xb8\x3e\x90\x55\xed\xe3\x3f\x63\x37\x07\x56\xde\x18\x00\x00"
 
 func postgresGraphqlLoaderGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -562,7 +883,27 @@ func postgresGraphqlLoaderGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _postgresIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdd\x4e\x1b\x3d\x10\xbd\xb6\x9f\x62\x3e\xeb\x13\x6c\xda\xb0\xb9\x47\xca\x45\x0b\xa1\xad\x4a\xa1\x05\xaa\x22\x21\xd4\x38\xbb\xb3\xb0\xd2\xc6\xce\xda\x5e\x20\xb2\xfc\xee\xd5\xd8\xbb\x94\x9f\x08\x41\x6f\x1c\xdb\xe3\x99\x73\xe6\x9c\xc9\x7a\xbf\x03\xff\xdb\x6b\x6d\x1c\xec\x4e\x21\x8b\x3b\x25\x97\x08\xf9\xd9\x7a\x85\xf9\x11\x6d\x05\x1a\x23\x40\xd8\xb6\xb1\x8e\x36\xe5\x42\x80\x68\x05\x08\x83\x56\x80\x38\x3f\x3e\xd4\x57\x02\xf2\x83\x1a\x9b\xd2\x8e\x60\x27\x04\x1e\xcb\x3a\xb9\x68\x30\x95\x2d\xae\x71\x29\x21\x3f\xed\x7f\x63\xed\x33\x0a\xa7\x95\x60\x52\xe2\x64\x02\xde\x43\x7e\xd0\xa9\x22\x62\x87\x00\x06\x9d\xa9\xf1\x06\x2d\x48\x30\xfa\x16\x2a\xa3\x97\xb0\xed\xfd\x00\x10\xc2\x36\x48\x0a\x52\xe2\x5f\xd6\x21\xe4\x7c\x32\xa1\x82\x9f\x50\xa1\x91\x0e\xcb\x94\x5a\xab\x12\xef\x62\x81\xfc\x0b\x6d\xd3\xda\xe7\x6c\xe7\xbc\xea\x54\xf1\x94\x44\x56\x2e\xe0\xfc\x78\xff\xa3\xf7\x70\xa5\x57\xd2\xc8\x65\x53\x5b\x37\xf4\x0c\xce\x74\x98\x96\x10\x46\x90\x79\x0f\x75\x05\x4a\xbb\x7b\x04\xfb\x53\xd5\x6d\x0c\x5f\x5c\x7a\x0f\xa8\x4a\x08\xe1\xdd\x53\xc2\x63\x40\x63\xb4\x19\x81\xe7\xec\x46\x1a\x3a\xa5\x1b\xce\xd9\x64\x02\xb6\x6d\xa0\xed\xd0\xac\x39\x2b\xb4\xb2\x0e\x92\x23\x30\x85\xf9\xe9\xec\x70\xb6\x77\x06\x73\x78\xcf\x19\x9b\x7b\x0f\x85\x6e\xc8\x46\xdb\x03\xf4\x3c\x43\x18\x9e\x1c\x9c\x1c\x7f\x83\x87\x1a\x0e\x81\x5f\x9f\x67\x27\x33\x78\x50\x21\x22\xde\x77\x2a\xe0\xc3\xd1\x3e\x08\x08\x61\x9e\x48\x99\x4e\x0d\xa4\xe2\x20\x64\x89\xd4\x4b\x42\x55\xb2\xb1\x51\xa9\x38\x26\x75\xb5\x41\x25\xce\x88\x5b\x9a\xcb\x10\x68\x86\x9e\x6a\xe5\xe9\x49\xca\x8e\xd7\xdf\x4d\xbd\x94\x66\xfd\x15\xd7\x31\x9d\xfd\xc6\xbb\xda\x3a\xbb\x1b\x21\xc7\xb1\x1e\xa9\x4e\x33\xc6\x02\xe7\x8c\xb4\x9d\x42\xb9\xc8\x7f\x10\xf9\x13\x7d\xfb\x16\xe2\xf9\x69\x21\x15\xd9\x5c\x51\x74\x83\xd0\xd9\xca\xd4\xca\x81\xd8\x12\x7d\x17\xa3\xd8\x2f\xab\xab\x68\xea\x7f\x53\x50\x75\x43\x36\x33\x83\xae\x33\x8a\x8e\xd1\xfd\x44\xae\xbf\xdc\x7a\x28\xc2\x98\xde\x44\xc5\x30\xb1\xe0\xac\x8d\x29\xa4\xce\xd0\xc7\x9b\xd4\x7f\x1d\x1b\x56\x62\x85\x06\xda\x7c\xaf\xd1\x16\xb3\x51\xb2\xbd\xd1\xb2\x04\x83\xb6\x6b\x9c\x25\xbe\x96\x58\x5c\x5c\x3e\x1b\x69\x1f\x38\xab\x34\xa5\x1f\xe1\x9d\xcb\xe2\x68\xbf\xc6\xdb\x97\xcd\x7d\xe6\xee\x23\x7b\xa3\x84\xf1\x0f\x53\x48\xc5\x59\x6f\x75\xfb\xcf\xa6\x6d\xd0\xe9\xb9\x50\x09\x94\x84\x98\x82\x5c\xad\x50\x95\x99\x41\x3b\x7e\xec\xe1\xe8\x91\xbd\x31\x7e\x6f\x6a\xfc\x24\xf0\xc0\xf9\x9f\x00\x00\x00\xff\xff\xc3\x4f\x76\x7d\x93\x05\x00\x00"
+var _postgresHasmanyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x55\x4d\x6f\xdb\x46\x10\x3d\x93\xbf\x62\x42\x14\xb1\x98\xd0\x4c\x7b\x55\xc1\x1e\xea\xca\x6d\x51\xc7\x6e\x6d\xa3\x0d\x20\x08\xd1\x8a\x5a\x4a\x44\xa8\xa5\xb4\x4b\x39\x16\x04\xfe\xf7\xce\xcc\xf2\x4b\x16\x0b\x5b\x46\x0e\x3d\x88\x22\xf7\x63\xe6\xcd\xdb\x37\x6f\xf7\xfb\x73\xf8\xce\x2c\x73\x5d\xc0\x30\x82\x01\xbf\x29\xb1\x92\x10\xde\xca\xe4\x7e\xb7\x96\xe1\x35\x7d\x79\x52\x6b\x0f\x3c\xb3\xc9\x4c\x41\x2f\xf3\x19\x3e\x36\xf8\xd3\xd2\xe0\xf3\xd3\xcd\x55\xbe\xf0\x7c\x38\x2f\x4b\x77\x4f\x11\x75\x6f\xc8\x13\xe3\x55\xc0\x3a\x61\x0b\x31\xcb\xa4\x8d\x1a\x2f\xe5\x4a\x40\x78\x57\xfd\x73\xe8\x7b\x9a\xb6\x4f\xca\xd2\xd9\x98\x9d\x5e\xe2\x66\x2b\xf5\xee\x18\xda\x17\xb9\xab\xfe\x68\xb1\x94\x0a\xff\x66\xa2\x88\x97\x7d\x34\xc4\x27\xd2\xf0\xea\x9c\x75\x85\xdd\x23\xc8\xbf\x1a\xce\xbc\xd6\xa9\x2a\x1a\x0a\x3c\xd3\x05\x88\x21\x0b\x44\xc3\xeb\xb4\xe4\x57\x62\xe5\x32\x95\xd9\x9c\x71\xda\xb5\x1f\x3e\xc0\x7e\x4f\x33\x0f\x0c\xbb\x2c\x01\x17\x6f\xb5\x32\x50\x2c\x25\x4f\xb5\x35\x95\xa5\x01\x61\x4c\x1e\xa7\xa2\x90\x73\xf8\x9a\x16\xcb\x66\xd5\x01\xe1\x65\x79\x66\xea\x51\x9b\xb0\x0e\x3e\x38\x18\xbd\xc8\x33\xfa\x6d\x57\xaa\x9a\xf7\x43\x04\x44\x98\x7e\x95\x4a\x6a\xce\x92\xe8\x7c\x05\x49\xae\x65\xba\x50\x80\x45\xc1\x19\x85\xb8\xb4\x03\x7f\xc8\x5d\xe7\xb5\xce\x1d\xba\xc9\x56\xc5\x9c\xab\x6a\x00\xcc\xfc\xae\x07\xa5\xff\xa4\x76\xda\x11\x17\x8f\x6b\xa1\xc5\x0a\x3f\xe7\x33\xf8\x74\xf3\xcb\xcf\x3e\x0c\xc6\x93\x77\x4f\xa9\x08\x00\x4f\x38\xd7\x18\xc2\x75\x1e\x84\xa6\x2f\x3b\xe2\xba\x0e\x16\x80\x07\x0f\x7c\xe6\xae\x13\xe7\xca\x14\x60\x95\x00\x11\x4c\xef\x46\x57\xa3\x8b\x7b\x98\xc2\x7b\xd7\x71\xa6\x94\x32\xcf\x48\x3e\xa6\x4a\xc0\xdc\x18\xc2\x5c\x2d\xb9\xbc\xbd\xf9\x48\x48\xab\x26\x69\x27\xfe\xf9\x6d\x74\x3b\x82\x36\x02\x84\x0d\xaf\xb4\x2a\xa2\x29\x55\x2c\x6d\x3d\xdf\xe3\xd0\xd4\x82\xd3\x5b\x55\x83\x63\x91\x0d\x2c\xb8\x00\xba\x8c\x85\x7d\x07\xe8\xbb\xce\x86\x2b\x27\x5d\xcd\x67\xa1\x25\x2c\x51\xe0\xfd\x65\x05\xde\x90\x28\xf4\x82\x04\x73\x42\xe0\x34\xe1\xc0\x6f\x22\x50\x69\x46\xb4\x3a\x56\x8a\xf4\xc9\x39\x5d\xa7\x74\x9d\xb9\x4c\xa4\x86\x4d\x78\x91\xe5\x46\x0e\x7c\x5b\x50\x96\x8b\x39\x0a\xd7\x6c\xb3\xc2\xb8\xb8\x8d\xdb\xa3\xe7\xd0\xf6\x18\x00\xb5\x84\xdb\xaf\xe5\x63\x31\xe0\xc3\x73\x08\x9b\x6e\x74\x32\x8c\x8e\x54\x6f\x17\x9d\x03\x02\xb4\xe3\x7f\xea\x74\x25\xf4\x0e\x25\x87\xb3\x38\xe9\x7c\x96\x8f\xa9\x29\xcc\x10\x0a\xbd\x95\x81\x8d\x29\xd5\x9c\x3b\xcc\x41\xd4\xf8\x20\x4d\xc4\x42\xe1\x1b\x15\x19\x21\x84\x3b\xfc\x24\xb2\x12\xe2\xa1\x47\x00\x55\x7b\x7b\x6f\xbd\x1a\x9f\x6f\x89\xea\x61\xea\x98\x2a\x9b\x95\xa8\x88\x40\xac\xd7\x88\x06\x6d\xc0\x04\xf0\xf6\xa0\x5c\xdf\xe5\x75\xd5\x6e\x5e\x80\x21\x5c\x1c\x6b\x8d\xe1\x0a\xc9\xfd\xa6\xe6\x60\xce\x5e\x67\x0e\x01\x81\x42\x0b\xc0\xf0\xb3\x5d\x6f\x88\x00\x52\x05\x02\x4c\xaa\x16\xd8\x28\x2c\x71\x58\xa3\x5c\xd8\x52\x21\x4f\x68\xb7\xf9\x96\x1e\x73\x4c\x51\xbf\x87\xd8\x0e\x60\xe3\xc6\x42\x2b\x65\x1e\xb9\xd1\x60\x25\xd6\x63\x5a\x58\x9b\x77\x59\x4e\x9e\xb1\x1e\xac\x03\x9b\x3f\x93\x71\xc1\xb5\x59\x2f\xa2\x37\x4c\x82\xea\x91\x3a\x11\xb1\x24\xd9\xd3\xe5\x42\xe2\xee\x49\x31\xcb\xf3\xac\xee\x8c\xcf\x16\x69\xd6\xed\x07\x2d\xd4\x42\x1e\x14\xb0\xb7\x22\x24\xaa\x6c\xbf\xb4\x1b\x7a\xbb\xfb\x47\x78\x43\xf9\xc7\xb8\x61\x62\xf5\xda\x7e\x46\xdc\x34\x34\xc6\xb0\x1b\xb5\xd2\x57\x40\x29\x7c\x16\x33\xeb\xf4\x7f\x62\xac\xbf\x5f\xc3\xd4\x6d\x7c\xe6\x65\x87\x56\x13\x9c\x49\xc5\xa5\xf9\xf0\x13\x5a\x32\x71\x61\xc5\x89\x81\xec\x01\x12\xb1\xb4\x88\x87\x69\x15\x46\x5a\x09\x2b\xa8\x86\xfc\x6a\x53\x64\x95\x3d\x1e\x3e\x59\x33\xe9\xb8\x4e\xc7\xec\x1d\xdb\x11\x98\xaa\x22\xed\x3d\x3c\xe6\x7f\x8b\x6c\x2b\xcd\xc0\xe3\x10\xe6\x0b\xee\xf6\x02\xf8\x21\xe8\x60\xa0\x03\xb0\xf7\x04\xef\x0f\x6c\xd2\x30\x0c\x69\xe2\xa4\xeb\xa0\x67\xff\xcb\xbd\xec\xc8\xe7\x8f\xad\x9c\xbd\x3c\x7e\xce\xcb\x9f\x31\xf3\x63\x37\x3f\xb4\x73\x8b\xa6\x63\xe8\xaf\x72\xf4\xf8\xc0\xd1\xfb\x68\x70\xda\x4b\xce\xe9\x65\xa5\x02\xd2\x76\x21\x36\xc4\x03\x92\xd1\x50\x60\xf3\xb6\xed\x58\x15\x88\x04\xd6\xad\xd7\xde\x0c\x3c\x52\x5d\x0f\x71\xe7\x7a\xa0\xd6\x6b\x0a\x1c\x69\xcd\x68\xba\xc8\x5e\x7e\x80\x55\x7f\xd3\xdf\xb8\x55\xd7\x70\xf2\x9f\x77\xd0\xbf\xdc\x8e\x6c\x6a\xc3\x0c\x00\x00"
+
+func postgresHasmanyGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_postgresHasmanyGoTpl,
+		"postgres.hasmany.go.tpl",
+	)
+}
+
+func postgresHasmanyGoTpl() (*asset, error) {
+	bytes, err := postgresHasmanyGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "postgres.hasmany.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _postgresIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x58\xdb\x6e\xdb\x46\x10\x7d\xa6\xbe\x62\x42\x14\x31\x15\x33\x4c\xf2\x56\xa8\x71\x81\xc6\x91\x6b\xa3\x8e\x9d\xda\x6e\x93\x40\x30\xe2\xb5\xb4\xb2\x08\x53\xa4\xb4\x4b\xf9\x02\x81\xff\xde\xb9\x90\x14\x29\xca\xaa\x6c\x03\x4d\x1f\x44\x91\xcb\xdd\xb9\x9c\x99\x3d\x33\xcb\xf9\xfc\x35\xfc\x64\x47\x89\x49\xa1\xb3\x03\x1e\xdf\xc5\x6a\xac\x21\x38\xbb\x9f\xe8\xe0\x88\x6e\x5d\x6d\x8c\x0b\xae\x9d\x46\x36\xa5\x9b\xc1\x25\x5e\xa6\xf8\x33\xda\xe2\xf5\xeb\xf1\x61\x72\x45\x23\x33\x6d\xee\xf1\xff\x5a\xdf\x5b\xf9\xc3\xeb\xa5\x4a\xfb\x23\xfc\xbf\x51\x11\x0d\x4e\x94\x51\x63\xb9\xb9\xd2\xf8\xa7\x86\xa9\x26\x91\xc3\xd0\xd8\xd4\x85\x60\x2f\xd4\xd1\xc0\xb6\xe1\x75\x96\xb5\xe6\x64\x5a\xaa\x2e\x23\x2d\xa6\xf5\x47\x7a\xac\x20\x38\xcd\xff\xd9\xbe\x33\x7a\x2d\x57\x32\x55\x16\xbe\x79\x03\xf3\x39\xca\x9a\xc5\x7d\xb6\x3f\xcb\xc0\xe8\xd4\x84\xfa\x46\x5b\x50\x60\x92\x5b\x18\x9a\x64\x0c\x5b\x38\x2b\x57\x90\x65\x5b\xa0\xe8\x25\x2d\x5c\x78\x9e\x65\x01\x4a\x23\x81\xbf\xeb\x58\x1b\x95\xea\x81\x2c\x0d\xe3\x81\xbe\x63\x01\xc1\x01\xdd\xca\x35\x5f\xb3\x15\xb4\x86\xa8\x7b\xd9\x08\x0f\x9f\xfb\xe9\x1d\x43\x80\x8f\x83\x4b\xf8\x7a\xfc\xf1\x03\x0e\x5e\x25\x3c\x16\x85\x36\x2d\x10\x80\xd4\xcc\xb4\x5c\xb2\xac\x0d\xb4\x34\x1c\x42\x9c\xa4\xa5\x3e\xfb\x57\x1c\x4e\xf9\x75\xef\x1c\xdf\xea\x78\x80\xb7\xaf\x96\xcd\xf7\x01\x63\x97\x98\x36\xcc\x5b\xce\x8d\x32\xf4\x24\x23\xad\x96\x83\x5e\x61\x48\x81\xc3\xd6\x72\xfa\x49\x8c\xea\x25\xc6\xb0\x03\x17\xa7\xdd\xc3\xee\xee\x19\x5c\xc0\x76\xcb\x71\x2e\xc8\xf4\x24\xa2\xc4\xb0\xb9\x82\xdc\x4e\xc4\x36\x9f\xb2\x77\x72\xfc\x09\xaa\x88\x16\x2f\xbe\xec\x77\x4f\xba\x50\x91\xc0\x1a\x4b\x4f\x5d\xf8\xed\xe8\x23\x5e\xb3\xec\x42\x8c\x32\xb3\xb8\x30\x8a\x53\xcb\x13\xa3\xd6\x01\x35\xc4\xec\x62\xa4\x38\x69\x10\xa9\x26\x4a\x2d\x87\x6c\x93\x4c\x47\xdb\x30\xa3\x96\xb1\x9a\xd3\x14\x59\xcd\xc3\x9f\x4d\x38\x56\xe6\xfe\x0f\x7d\xcf\xcb\x9d\xef\xfa\x0e\x15\xdb\x0e\xab\xf4\x59\x1e\xa1\x4e\x19\xe7\x64\x68\x3a\x61\xbb\x03\x83\xcb\x40\xe2\x3c\x8c\xc1\xfd\x93\xdc\x38\x49\x6e\xdd\x45\xf8\x95\xb9\xc2\x87\x47\xb8\x84\x09\xaf\x62\x5a\x3c\xa4\xb7\x2b\x42\xe0\x4d\x4c\x18\xa7\xe0\xbe\x74\x73\xff\xda\x8c\x84\x83\x8e\x90\x49\x2f\x76\x20\x0e\x23\x4a\x00\x07\x77\xc1\xcc\xc4\xf4\xc8\x79\x21\x66\xe7\x83\x2f\xab\xf0\xf8\x34\x87\xb1\xd4\x62\x45\xcb\x99\xf2\x12\xc2\xad\xe9\xe1\x73\xdc\xdb\xd0\x4e\x67\xa0\x87\xda\xc0\x34\xd8\x8d\x12\xab\xbd\xb6\xa4\x4a\x94\xa8\x01\xee\x6d\x3b\x8b\x52\x4b\x9e\x58\xb2\xaf\x77\xde\xd8\x06\x73\x14\x30\x4c\x68\xf9\x91\xbe\x4b\x3d\xde\x0e\x9b\xe4\xc3\xfa\x84\x68\x64\x44\x2d\x25\x18\x5c\xde\x64\x18\x3f\xbc\x93\xf4\x98\x3e\x39\x9c\x2b\x70\x6a\x02\x25\x4a\x09\x88\x1d\x50\x93\x09\x1a\xe3\xe1\x83\x5f\x8f\x6e\xbb\x16\x78\x7e\x5f\x86\x9b\x69\xa4\x95\x95\xfb\xe8\x10\x11\xce\xf1\x10\x42\x66\xb3\x99\x90\x85\x03\x0b\xb3\xdf\xb6\xcb\x29\xc8\xfc\x29\x3a\xc4\x93\x50\x09\xdd\xca\x32\xf6\x93\xe7\x15\x1c\x5d\x11\x5f\xe1\xe8\x74\xa4\x89\xa5\xed\x43\x34\xbd\x1c\x29\xeb\x93\x3c\x54\x8b\xf4\x7c\x79\xcf\x24\x24\xfa\x4a\x16\x0c\x63\xe4\x76\x1b\xc6\x57\x28\x44\xe8\x67\x82\xd9\xc4\xc5\x09\x92\x21\x2d\xb5\xcf\x65\xfa\x8a\x2b\xab\x99\xde\x67\x35\xc0\x74\x5d\x62\xc4\xec\x3e\x56\x93\x5e\x7d\xb0\x99\xc2\x55\x26\xff\x51\xcc\x5d\xc0\xba\x9b\x44\x34\xeb\xe0\x08\x2e\x5a\xe5\xb6\xdb\xc4\x89\x62\x1f\x46\x3a\xf6\x08\x8c\x36\xfc\x0a\x6f\x39\x95\x25\x14\x28\x86\x86\x25\xd9\x69\x12\x0f\xd3\x2c\x94\x34\x56\x82\x28\x9b\xce\xe9\x2f\x8b\x76\x24\x8e\xbd\xce\xd2\x9c\xf3\xca\x1e\x64\xa2\xc0\x1e\x64\xa6\x49\x38\x35\x23\x62\xf2\xb5\xf6\x7a\xe7\xb8\xdd\xb4\x19\xaa\xbe\x9e\x23\xca\x0b\xad\xb4\xe7\xc8\xd8\x90\x03\x47\xf3\x8d\x8a\xaf\x74\x9e\x35\x6c\x00\x09\xea\x85\xe7\xc0\x66\x57\xd4\x55\x4a\x98\x23\xe9\x86\xab\xf3\x08\x6d\xc3\x5d\xf2\x37\x5b\xe2\xb9\x6c\xb1\xbd\x46\x63\x5d\x1f\xde\x2d\x2b\x97\xea\xc7\xeb\x7d\x32\xde\x06\x41\x40\xe3\x8f\xa2\xe2\xe6\xf2\xcd\x79\xa4\xc1\xb0\x4d\x12\xdd\x88\x45\xff\x85\x46\x9b\x3c\x5a\x27\x52\x31\xa6\x42\xa5\xcf\xe7\xd2\x55\x20\x38\x8b\xe2\xe2\xac\xc4\x24\xb7\x03\xd1\xe8\x55\xbd\x0e\x1a\x7c\x43\x09\x51\x63\x5c\x06\x74\x61\x76\xd7\x18\x56\x52\x55\xb8\x79\x54\x98\x45\x64\xa3\xf4\x16\x09\xd3\x39\x5f\xcd\xea\x59\x95\xd7\x8b\x38\x7c\xc6\xee\xbb\xc6\xea\x93\x6b\x8a\xdd\xaa\xf8\xf0\xeb\x28\x1c\x87\x7c\x40\x70\x0f\x0f\x3e\x1d\x9c\x71\xb7\x26\x6f\x98\xa6\xe9\x85\x5b\x91\x8f\x49\xe2\xe9\x29\xe7\x8e\x36\x4c\x06\xee\xd8\x62\xfa\xbb\xed\xc6\x78\xd2\x0f\x7f\x5e\x35\x6c\x94\xdb\x5e\x52\x8f\x4a\x8e\xf7\xf6\x4e\xbb\x67\x48\x19\x27\xc7\x5f\x4e\x61\xaf\x7b\xb6\xbb\x0f\x47\xdd\xaf\x0d\x83\x70\xaa\x4c\x39\x3e\x3a\xfc\x56\xbe\xcb\x41\x28\x6a\x4f\x05\x84\xda\xf9\x80\x8e\x26\x54\x14\x54\x0a\xe3\x04\x99\x95\xcf\x26\xeb\xea\x51\x21\xb0\x5e\x92\x10\x05\x74\x67\x51\x91\x26\xd7\x0b\x2a\x1f\x26\x51\x94\xdc\x62\x39\x2a\x4a\x1d\xe9\xa3\xdb\xfe\xcc\x58\x44\x8f\x8f\x45\xe0\x61\x9b\x8e\xa2\x59\x29\xbd\x2c\x0d\x81\xdb\x91\x8e\x41\x8f\x27\xe9\x7d\xdb\x07\x15\x25\x28\xe8\x36\x4c\x47\x3c\x8b\xcc\xdf\xb2\xf4\x17\xc6\x2a\x0d\x93\x98\x64\x84\x31\xee\xdc\x31\x3f\x3e\xb7\xce\x55\x60\x7b\xf2\x89\xc6\xcf\x3d\xdc\x65\x77\xfd\xdc\x33\xdc\xaa\x98\x09\x2b\xda\x37\x1f\x5e\x91\xd6\x03\x74\x62\xdd\x81\x06\x33\x4f\x04\xbd\xcf\x8b\x4a\x75\x03\x15\xbb\x28\x31\x16\xf9\xeb\xd6\x93\x23\x27\x20\x81\xd0\xa9\xea\x52\x43\xac\xaf\x10\x9f\x1b\xed\xe6\xed\x51\xbd\xc8\xe6\xe4\xdd\xf9\x51\x27\x23\x27\x2f\x6b\xdc\xdf\x56\x0a\xd6\x1c\x28\xb3\x57\xe1\x2d\x1d\xb6\x5c\x91\x47\x81\x7c\xe2\xd4\x46\x11\x2f\x0b\x38\xa9\x20\x23\x6c\x12\x8d\x17\xbc\x8d\xe7\x5c\x20\x0d\xd7\xbc\x3c\x6f\xcf\xa4\x9e\x97\x0c\xc6\xd3\x83\x81\xee\x27\x83\xa5\xfa\xf9\x08\x73\xb0\x29\x45\x15\x1b\x50\x5f\x8d\xff\x9c\x22\x12\xdb\x18\x09\xc6\xa7\xda\x9e\xa0\xad\x79\x6f\xc2\x1d\x43\x9c\x8e\x24\x37\x3d\xe4\xc9\xc5\xc7\x05\xc6\xb3\x00\xb4\xec\x93\xe5\x99\x6b\x7d\xbb\xe8\xdc\x8b\xf8\x17\xdb\x73\x62\xf4\x4d\x98\xcc\x2c\xb3\x01\xce\x91\xd6\x8b\x46\xeb\xbd\xd7\x6a\x9b\x24\xea\x0f\xe7\xc3\x23\x12\x62\x8d\xe7\xef\x77\xd6\xba\x5e\xea\x3a\x3e\xf9\xd8\x3d\x81\x0f\xdf\x1e\x10\x43\xf6\x09\xef\x66\xd9\x3b\x7a\x60\x02\x94\x43\x3a\x61\xd3\x1f\xe9\xfe\x35\x50\x3f\xb0\x0c\x8a\xb4\x2d\x39\x28\x3e\x08\xae\x79\xef\xf1\x98\x33\xf2\x0a\x09\x52\xf0\x8b\xbc\xb1\xc8\x79\x79\x27\xd6\x57\x98\x56\x2c\x9c\xfb\x2c\xaa\xb0\x47\x09\x0a\xb5\x9d\xea\x4b\xc9\xb0\xce\x9a\x04\xc3\x53\xa6\xc2\x56\x87\xa7\xd0\x66\x09\xf6\x95\xfd\x9c\xfb\x47\x7b\x06\x8d\x27\x0a\x93\x54\xcc\x59\xa2\xa8\x1d\xa0\x90\x93\xef\x52\xc3\x5f\x98\x7c\x18\x68\xdc\x15\xe3\x30\x26\x96\x0f\x39\x7d\x8c\x86\x90\x0a\x4c\x8c\xb3\x98\xa6\x5b\xb5\x64\x7e\x44\x40\x30\x86\x80\xeb\x30\xfd\x6e\x82\x83\x34\x51\x1e\x93\xd9\xf6\xbb\x76\xf9\x09\xa3\x08\x17\x4e\xbc\xa8\x46\xaf\xfc\x54\xb4\xf6\x5b\x4b\x3d\x6a\x4f\x38\xfa\xd7\x05\xac\x3f\xe1\xff\x0f\x8e\xf9\xcd\x0e\xf4\x3f\x3e\xbc\x3f\xe5\x04\x5f\xc8\x2c\x3b\xca\x5f\x36\x45\xb9\x55\x1c\xaf\x50\x32\x1d\xae\xa4\x14\xce\x4b\xbd\xd4\xe1\x76\x78\xf0\xbc\xb5\xd8\x07\x84\x69\x6d\x0f\xe4\x68\x7f\xf7\x61\x19\x63\x39\x28\x91\xb0\x79\x21\x40\x4a\x7d\x8d\x6f\x17\xa3\x3e\x9e\x89\xe4\xd6\x7b\xa0\x7b\xa8\x96\x0f\x6a\x08\x1a\x0d\xf8\xa2\xbd\x6a\xaf\xf8\xbe\x41\xca\x56\xf4\xc3\xff\x00\x73\x8a\xf2\xde\xfe\x16\x00\x00"
 
 func postgresIndexGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -582,7 +923,47 @@ func postgresIndexGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _postgresProcGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x41\x6f\x13\x31\x10\x85\xcf\xf6\xaf\x18\x22\x44\x77\xa5\x76\x73\x47\xca\x05\xc8\xad\x6a\x4b\x53\xa1\xde\xa8\xeb\x9d\x4d\x2d\x39\x76\x3a\xf6\x06\x2a\xcb\xff\x1d\x8d\xd7\x6d\x36\x08\x90\x38\xc4\xd1\x7a\xe7\xbd\xef\xcd\xdb\x94\x2e\xe0\xbd\xf3\xf1\x9b\x37\x3d\x7c\x5c\x41\xe3\x10\xba\x1b\xf2\xba\xbb\xc5\x38\x92\xbb\x7b\xd9\x23\x2c\x0e\xde\xf4\x8b\x16\x2e\x72\x96\x45\xb0\x27\xaf\xcb\x74\xd0\x4f\xb8\x53\xd0\x6d\xea\x7f\x51\xf2\x71\xa5\x76\x78\x14\x98\x01\xfe\xe8\x1b\xc9\x6c\xb7\x48\x8b\x32\xb8\x5c\x42\x4a\xd0\xb1\x12\x72\x06\xad\xac\x0d\x10\x9f\x10\x42\xf4\x84\x3d\x30\x14\xfb\x91\x10\xce\x52\xaa\x19\x72\x6e\x58\xc3\xc6\x37\x8a\xd4\x2e\x40\xce\x2d\xbc\x5e\xcd\x59\x39\x9f\x81\x77\xd0\x3f\x76\x72\x18\x9d\x9e\xa3\x9a\xfe\x11\xee\xaf\xbf\x7c\x4a\x09\xb6\x7e\xcf\x36\xd6\x84\x08\x5d\x75\x8c\x34\xe2\x74\xb0\x37\xf3\xcc\x70\xec\x2c\xe7\x94\x80\x30\x32\xa3\xf2\xba\x0a\x3c\x67\x08\x3a\x9e\x41\x22\x4f\x2d\x24\x29\x0e\x8a\x00\xa9\xfc\x3c\x49\x29\x96\x4b\x08\xcf\x16\x9e\x47\xa4\x17\x29\xb4\x77\x21\xf2\x45\x88\x04\x2b\x78\xd8\xac\x2f\xd7\x9f\xef\xe0\xb7\x7d\xb5\xb7\x07\x65\xc3\x5b\xc2\x9c\xdb\x87\xc9\x8a\x46\x57\xad\x6a\xed\xb3\x9c\x13\x9b\x30\xc2\x5f\x13\x4b\x71\x7f\x7d\xe9\xb7\xcd\x14\xe0\x5f\x7d\x0c\xca\x86\x52\x88\x14\xbc\xcd\x8a\x8b\xfd\xca\xe0\x5b\xff\xe3\x7f\xe4\xdd\x46\x2b\xd7\x7c\x20\x8c\xad\x14\x66\x28\xd5\xbc\x5b\x81\x33\x96\xcb\x12\x54\xe2\x4d\x81\x9d\xb1\x27\x99\xaf\x8c\x7d\x2b\x1a\x89\xa4\xc8\x52\xbe\x0a\x08\xe3\x39\x9b\x94\x1a\x70\x62\x9d\x2e\xd7\x4a\xf1\xbd\xe8\xa6\xec\xeb\x9f\xa8\x8f\x6f\xaa\x0b\xbb\x16\x83\xf2\x0d\x65\x9e\x3f\xc8\x5f\x01\x00\x00\xff\xff\x42\xa9\x24\xb4\x3a\x03\x00\x00"
+var _postgresJsonGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\x51\xcb\x4e\xc3\x30\x10\x3c\xc7\x5f\xb1\x8a\x38\x38\xa8\x75\xef\x95\xf8\x01\x24\xca\xa1\x82\x0b\xe2\xe0\x3a\x1b\x62\x94\x3a\xb0\x76\x52\x55\x51\xfe\x1d\xaf\x13\x68\x78\x88\x5b\x3c\x3b\x3b\xb3\x33\x19\x86\x35\x5c\x85\xf3\x1b\xc2\xf6\x06\xd4\x4e\x1f\x11\xd6\xe3\x28\x06\x86\x7d\xdd\x52\x60\x5c\xa6\x2f\xc7\xc3\x89\x9b\x7b\x32\x39\xe4\x87\xae\xca\x8b\xc4\xdf\x6c\x60\x6f\xb4\x03\xaf\x83\xf5\x95\x45\x0f\xa1\x46\xf0\xef\x8d\x62\xd8\x21\x81\x75\x01\xa9\xd2\x06\xa1\x6a\x09\x86\x61\x56\x1a\xc7\x15\x94\x68\xda\xd2\xba\x97\xb4\x73\xbb\xbf\xdf\xb1\x5c\xaf\x9b\x0e\x95\xa8\x3a\x67\x40\x32\x7d\xba\x66\x1c\xe1\x7a\xb1\x5c\x24\x5b\x19\xcf\xb9\x18\x0c\x11\x45\x22\x76\x11\x59\xaf\x09\xe2\x99\xf0\xf4\x7c\x38\x07\x14\x99\x3f\xd9\x60\x6a\xe8\x39\x56\xdc\x52\x92\x85\x0a\x66\x1a\xed\x11\x9c\x6d\xb6\x22\xcb\x08\x43\x47\x8e\x5f\x33\x3e\xad\xf3\x88\xc5\x6e\xa0\x9f\x71\x1f\x28\x1e\x7e\xc1\x27\x9e\xec\x0b\x91\x95\x58\xe9\xae\x09\x0b\xb9\x74\x94\x57\x3b\x3c\xc9\xdc\xba\x18\xd0\x96\xcb\x22\xf2\xb8\x34\x0a\xf1\xc9\x7e\xf5\xad\x53\x0f\xee\xa8\xc9\xd7\xba\x91\x51\x7f\x05\xcb\x1e\x0a\x11\xc9\xb1\xa8\x47\x2e\xea\x47\xf1\x25\xd9\x1e\x49\xa5\xd1\xbf\xd5\xa3\xbb\x54\xff\xd5\x3a\x68\x9f\x7e\xc3\x9f\xed\x7f\x2b\x3f\x19\xc8\x02\xe4\xd2\x70\x35\x05\x4d\xa5\x2e\xb3\xdc\xcd\x49\x7e\x85\xf8\x00\x46\xfc\xb8\x31\x84\x02\x00\x00"
+
+func postgresJsonGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_postgresJsonGoTpl,
+		"postgres.json.go.tpl",
+	)
+}
+
+func postgresJsonGoTpl() (*asset, error) {
+	bytes, err := postgresJsonGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "postgres.json.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _postgresManytomanyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x55\x4d\x6f\xda\x40\x10\x3d\xdb\xbf\x62\x6a\x55\x8d\x9d\x12\xa7\xb9\x46\xe2\x90\x26\xd0\x26\x4d\xa1\x05\xda\x46\xaa\xaa\x60\xf0\x1a\x4c\x8d\x17\x76\x4d\x0a\x42\xfe\xef\x9d\xd9\x35\x0e\x36\x4e\x9a\x8f\x43\xd4\x03\x66\x3f\x66\xde\xcc\xbe\x7d\x33\xbb\x5e\x1f\xc0\x6b\x39\xe6\x22\x81\xe3\x3a\xd8\x6a\x14\x7b\x53\x06\x6e\x6f\x35\x63\x6e\x8b\x86\x16\x13\xc2\x02\x4b\xce\x23\x99\xd0\xc0\x1f\xe0\x67\x8e\x3f\xc1\x24\x7e\xaf\xda\x97\x7c\x64\x39\x70\x90\xa6\xe6\x9a\xe0\x44\x25\x5e\x87\x05\x8f\x84\xcc\x12\xdb\x42\x0e\x42\x16\xf9\x04\xec\x36\xb9\x60\xe1\x28\xfe\xc4\x56\x04\xdc\x54\xeb\xb9\xd9\x84\x87\x71\xb3\xca\xb4\x64\x27\x36\x9e\x64\x46\x30\xf7\x81\xa2\xf1\x45\x01\xb7\xe8\x50\xb2\x4e\xbc\x41\xc4\x34\x05\xc3\x31\x9b\x7a\xe0\x76\xb3\xff\x0d\x0f\x3d\xb2\xd0\x5f\xa2\xc4\x29\xa6\xdf\xbb\xd3\x9f\x92\xb8\x1b\xe0\xf0\x10\xd6\x6b\xd0\x24\xa7\x29\x08\x96\x2c\x44\x2c\x21\x19\x33\xb5\x5e\xb8\x84\x34\x95\xe0\x49\xc9\x87\xa1\x97\x30\x1f\xfe\x84\xc9\x38\x37\xdc\xb6\xc2\x45\xc1\x17\xa3\x31\xec\xe1\xce\x56\x76\x69\xba\xe7\x62\x40\x8a\xf9\x81\xc5\x4c\x28\x94\x40\xf0\x29\x04\x9a\x17\xf8\xcd\x56\x52\x79\x15\x2e\xa1\x6a\x98\x85\xda\x03\x2f\xf6\xb5\x47\x89\xde\xfb\x9c\x5c\x33\x58\xc4\x43\xb0\x29\x3d\xad\x3d\x4c\x7a\xbf\x7c\x0c\x67\x9b\x19\xb2\x1d\x26\xcb\x99\x27\xbc\x29\x4e\xfd\x01\x5c\xb5\xcf\xde\x3b\x60\xff\xfc\xb5\x5f\x41\x54\x0d\x50\xb0\x5c\x20\x84\x69\xdc\x78\x82\x66\x7a\xc5\x34\x0d\x3c\x3e\xea\x18\xe6\x0b\x26\x56\xa6\x31\xe4\xb1\x4c\x40\x0b\x1b\xea\xd0\xef\x36\x2e\x1b\xa7\x3d\xe8\xc3\x5b\xd3\x30\xfa\x14\x95\x47\x33\xd4\x52\xb8\xa4\xb2\x90\xb7\x91\x94\x80\x24\x56\x80\x45\xd9\x67\xf6\xcd\x4e\xfb\x33\xa5\x9d\xe9\x89\x6e\x74\xb3\x75\xd1\x3e\x6f\x41\xf9\x42\x60\x02\xed\x16\x4c\x5c\x1d\x47\x15\x5e\x41\xb8\xee\x29\x8f\xc8\xac\x0e\xa2\x6c\x53\xd8\xcf\x62\xfc\xf8\xd8\xe8\x34\x4a\x70\x93\x5d\x2c\xdc\x8e\x93\xb1\xe6\xf2\x1d\x2e\xf5\x35\x2b\x62\x11\x6f\x58\x51\x05\x6d\x6b\x56\x6a\xb0\x7d\x4f\x84\xad\xeb\x3a\xbf\x27\xd3\x98\x2b\xbe\x49\xfe\xfe\xc0\xd5\x37\x15\xc4\x60\x7d\x25\x30\xeb\xf6\xf6\x3c\x31\x22\x11\x3f\x14\x35\x0c\x14\xea\xab\x3a\xc4\x61\x44\x37\x69\xe8\xf2\xa0\xa9\x0a\x68\x1a\xa9\x69\xf8\x2c\x60\x02\xe6\xee\x69\xc4\x25\xb3\x1d\x7d\x94\x88\x7b\x3e\x16\x93\x5c\x44\x89\x34\xd1\x4d\x52\x6e\xd5\x52\x59\x23\x06\xea\x1f\x11\x5a\x6c\x99\xd8\x4a\x32\x06\xa5\x23\x72\x69\x1e\xd7\xab\x8a\x51\xdb\x1d\x00\xa6\x99\x6f\x7d\x11\xe1\xd4\x13\x2b\xd4\x3a\x1a\xe0\xbe\x71\xcd\x96\xa1\x4c\xe4\x31\x24\x62\xc1\x6a\x1a\x99\xc5\xba\xf1\x18\x98\x3e\x7e\x48\x8f\x43\x2f\xc6\x11\x9d\xb6\x8e\x89\x74\x71\x4a\x94\x29\x42\xaa\x65\x67\xcf\x44\x18\x27\x60\xbd\xb1\x36\x89\x3a\x9a\xb4\x0a\xd6\x76\x69\xd3\x81\x89\x96\x3a\x78\xb3\x19\x26\x64\xe3\xa4\x06\x6f\x0a\xe7\x76\x4c\x65\x97\x79\x2b\x03\x84\x30\x71\x0d\x53\x3e\xf1\x7d\xe2\xe4\x3c\x61\xd3\x4d\xdb\xc9\x7b\xd3\x9d\xfd\xeb\x89\x3d\xeb\x61\xdd\x62\x37\xa3\xea\xa6\xa1\x95\x27\x8a\x50\xa5\x44\x1d\xdd\x2d\x1e\xdf\x3e\xce\x5b\xdd\x46\xa7\x07\xe7\xad\x5e\x7b\xb7\xd8\xed\x42\x57\xa9\x2e\x4f\x95\xdd\x7d\xbd\x20\xc3\x70\xe0\xfb\xc9\xe5\xb7\x46\x77\x1b\xb4\x50\xd4\xb5\x42\x99\x1f\x6d\x79\x3e\xaf\xdc\x4b\xf4\x29\x83\xbc\x1d\xdd\x16\xef\xb5\x6e\x09\xa5\x8e\xd0\x58\xb2\xe1\x13\x1b\xc2\x03\xe3\x66\x6a\x25\x99\x6b\xa1\x76\xd8\x94\xdf\xb0\xb2\x56\x85\x5a\xd5\x42\xdd\xe8\x36\xe4\x31\xf0\xe0\x45\xb4\x5b\x99\xe4\x0b\xc8\xf7\x0c\x5f\xbf\x5e\x03\xf2\x67\xac\x20\xdf\xc2\x23\xf3\xc8\x27\x06\x4e\x5a\x67\xff\x52\x76\xc9\xed\xe8\xd9\x2f\xd3\x7f\x25\xd5\xbf\x7f\x84\x0f\xa6\xda\x0b\x00\x00"
+
+func postgresManytomanyGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_postgresManytomanyGoTpl,
+		"postgres.manytomany.go.tpl",
+	)
+}
+
+func postgresManytomanyGoTpl() (*asset, error) {
+	bytes, err := postgresManytomanyGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "postgres.manytomany.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _postgresProcGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbd\x56\x51\x6f\xd3\x30\x10\x7e\x4e\x7e\xc5\x11\xa1\x92\xa2\x12\xde\x91\xfa\xc2\x18\x12\x02\x56\xd8\x06\x9a\x84\xd0\xe6\x25\x6e\x17\x29\xb5\x5b\x3b\xe9\x56\x55\xf9\xef\xdc\xd9\x49\xe3\xa4\x69\x57\x36\xc1\x4b\x1a\x5f\xce\xf7\xdd\xf7\xdd\x9d\xdd\xcd\xe6\x0d\xbc\x14\x32\xff\x29\xd3\x04\xde\x8d\x21\x14\x1c\xa2\x6f\x4a\xc6\xd1\x39\xcf\x0b\x25\x2e\xd7\x0b\x0e\xc1\x0a\xbf\x06\x43\x78\x53\x96\xfe\x86\x36\x2c\xd0\xc1\x78\xeb\xf8\x8e\xcf\x19\x44\x17\xd5\xaf\xd9\x49\x8f\x33\x36\xe7\xce\x06\xcd\x73\xf2\x77\x23\xeb\x0b\xb4\x6d\x1d\x14\xd7\x26\xe0\x42\xa5\x22\x87\x88\xb6\x43\x28\x15\x84\x4c\x24\x76\x7b\x70\x2e\xef\x31\x87\xe0\x9c\xeb\x22\xcb\x83\x61\x13\x3d\x9d\x42\x6f\xd6\xb9\x4a\x67\x33\xae\x02\xd7\x31\x9a\x14\xf9\xa2\xc8\xb5\x6b\x33\xe1\x69\xfd\xf6\x2d\x6c\x36\x36\x97\xb2\x84\x54\x03\x03\x25\xef\x41\x99\x98\x3c\x81\xdb\x35\xe4\x77\x1c\x74\x2e\x15\xae\x48\x04\x9e\x14\x8a\xc3\x2b\xda\x65\x34\x29\xcb\x57\x23\xb8\x93\x59\x92\x8a\x19\x85\x4b\x11\x69\xf2\xe3\x12\x62\x99\x15\x73\xa1\x23\x03\xc9\x33\xcd\xfb\xf1\x28\xba\x32\xfc\x40\x4e\x9f\x8a\xb5\x60\x8a\xcd\x6b\x28\x54\x0f\x81\x72\xd2\xc3\x81\xd2\xb9\x2a\xe2\x1c\x36\xc6\x47\x31\x31\xe3\x8d\x30\xe8\xee\xa1\xab\x2d\x01\xfa\xe2\x3b\x0a\x40\x01\x22\x23\x6b\x59\xa2\x85\x94\xfc\x46\x38\xf6\x59\xfb\x5a\x42\x3d\x5f\xd0\x5a\xa5\xe2\x64\x55\xfa\x7e\x65\x6f\xc4\xd8\xc2\xc6\x2c\xcb\xf4\xe3\x12\x84\x06\x0f\xdf\x0d\x1a\x65\x3f\x84\xda\xe4\x36\x03\x8a\x05\x52\x40\x72\x1b\xb5\xaa\x6e\x70\x09\xfa\x92\x94\x97\xf7\x7a\xb7\xd8\xe8\x65\x6d\x28\x33\x4c\x0b\x11\xe7\x29\x06\x62\x8a\x37\xae\x8c\x3a\x45\x67\x69\xcc\x5b\xaa\x93\xb3\xcb\x89\x72\x8d\xf3\x07\x53\x1f\x5c\x26\xb7\x70\x35\xf9\xf0\x1e\x8d\x33\x69\x6c\x59\xaa\xb1\xf5\x3f\x09\x53\x06\xac\x10\xb7\x8f\x8a\x12\xa6\xbc\x9d\x05\xa7\x58\xe1\xaf\xdf\xaf\x9b\xca\x8e\x80\x2b\x25\xd5\x90\x74\xa5\x2e\x6b\x6d\xb2\x72\x7c\x4c\x79\x96\x38\x3b\x2b\x73\x5d\xdc\x9e\x08\x95\x52\xe8\xef\xf4\xc2\xa3\xbb\xdc\x1c\x0f\x67\xb8\x93\xd8\x91\x69\xd5\xc7\x96\x55\xf6\xb8\xc4\xca\xd2\xac\xb7\xfd\x88\x33\xe0\xad\x98\x22\x2f\xeb\xe9\xfb\x1e\xb6\x83\x5e\x66\xb0\x2c\xb8\x5a\xfb\x5e\x2c\x05\x96\x05\x0d\x38\x34\x30\x86\x9b\x8b\xd3\x2f\xa7\x27\x97\x55\x45\xf0\x84\xda\xf2\xec\xd2\x78\x0d\x1f\xcf\x27\x5f\x61\x0b\xd5\xe9\x5a\x3c\x12\x56\x0c\x5b\xbc\x2e\x38\x96\xf9\xc6\x82\xab\x42\xd4\xe0\x57\x93\x2f\x72\x16\x5a\xf0\x43\x8d\x32\x65\x96\xdc\xb0\xdb\xdd\xde\xd2\x28\x40\x67\x2b\xf6\xbe\x6d\xc0\xa9\x80\xe0\x3b\xc5\x0f\x9a\xa6\x64\x6a\x86\x8b\xbf\x01\xf2\x10\x85\x22\xbf\x18\x83\x48\x33\xd2\xd1\xb3\x03\x41\x4b\x03\xea\x7b\x88\x9f\xf0\x29\x57\xb0\x8c\x4e\x32\xa9\x79\x38\xb4\x0c\x33\xc9\x92\xea\xa0\xd3\x3b\x27\x33\x65\x5d\x5d\x07\xad\xde\xde\xa0\x7d\x2a\x29\xd6\x19\x7f\xc8\xc3\xa1\x85\x24\xb7\x96\x0f\x1a\xa9\x80\x31\x13\xf8\x46\x09\x8e\x71\xc7\x05\x2e\x89\xe9\x94\x8a\x23\x70\x1c\x75\x83\x17\x0c\x54\x60\x19\xf5\x50\xda\xe5\xe4\x19\x08\x82\x1b\x03\x5b\x2c\xb0\xb6\x21\x2e\x46\x30\x50\x43\x22\xbc\x3d\xe0\x7b\x1a\xbb\xc3\xab\xd3\xaa\x07\xf9\x75\x7d\xf1\x53\xad\x5b\x75\xca\xb1\xdb\xcc\x39\x9c\x1d\x23\x9e\x85\xe9\x9c\xa9\xf5\x67\xbe\x36\x39\x78\xde\x35\x7f\xc0\xc2\xea\x77\xa6\xa4\x23\x13\xaa\xe9\xd1\xfa\x3c\xae\x88\x1e\xa7\x65\x8b\xe8\x3f\x12\xb4\xa5\xdf\xde\x81\xef\x55\x91\x26\x7c\x05\x7b\xf7\x1c\xe0\x39\x58\x3d\x97\xc6\xaa\x61\x61\xaf\x05\xbf\xde\x6b\x3e\x63\x80\x76\xcf\xb8\x63\x40\x79\x2b\xa7\xbd\xfd\x2a\xb9\xdd\x51\xa6\x3f\x47\xcf\x98\xe6\x23\x07\xe4\xa8\x91\xdf\xf2\x1b\xa8\x1e\x7a\xbb\x23\xb1\xb7\xc3\x9f\xdc\xe0\xdd\xfe\xee\x6d\xef\xff\x2c\xe6\x9e\x09\x79\xbe\xa2\xce\x35\x58\x35\x0c\x9e\xfc\xfb\x5b\xfd\xdf\x92\x1e\x20\xea\x21\x56\x26\x2f\xb4\xb5\x52\x3b\x4b\x33\xf7\xb6\x6e\x31\xc6\x9f\x0e\x65\xe2\x70\x6d\x2f\xb5\x0e\x8d\xd3\x07\x1e\x3f\xef\x4a\xab\x40\x29\x89\xd6\xff\x54\x67\xf1\x07\x9f\x60\x00\xa8\x31\x0d\x00\x00"
 
 func postgresProcGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -602,7 +983,7 @@ func postgresProcGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _postgresQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x5f\x6b\xdb\x3e\x14\x7d\xb6\x3e\xc5\xfd\x89\x50\xec\xdf\x5c\xe7\xbd\xe0\x97\x75\x0c\x06\xa3\xd9\xbf\x87\x42\x29\x4c\x8d\xe5\x4c\xa0\x48\xb1\x24\x77\x0d\x42\xdf\x7d\x5c\xc9\x76\xec\xa6\x65\xa3\x6f\xca\xf1\xfd\x73\xce\x3d\xf7\xc6\xfb\x4b\x58\xd9\x5f\xda\x38\xb8\xaa\x21\x8f\x2f\xc5\xf6\x1c\xaa\x1f\xc7\x03\xaf\x6e\xf0\x49\xb9\x31\x14\xa8\xed\xa4\x75\xf8\x68\x1e\x28\xd0\x8e\x02\x35\xdc\x52\xa0\xb7\x9b\xcf\x7a\x47\xa1\xfa\xda\x73\x73\xfc\xc2\x0c\xdb\xdb\x02\x2e\x43\x20\xb1\x76\x87\xe8\xb5\xde\xef\xb9\x72\x16\x7b\xa4\xb8\x09\x19\x03\x45\x0b\xd5\x00\x46\x6c\xbd\x06\xef\x4f\xd0\x10\xc5\xa5\xe5\xf3\xcf\x91\x5f\x08\x60\x7a\x65\x81\xc1\xb6\xb7\x4e\xef\x21\xf6\x2c\xc1\x70\xd7\x1b\x25\xd4\x0e\x0c\xb7\xbd\x74\x16\x98\x8d\x59\x27\x69\x21\x54\xa9\xae\x6a\xb0\x45\xdb\xab\xed\xa2\x6e\xde\x3c\xc0\xed\xe6\xc3\x7b\xef\xc1\x30\xb5\xe3\x0b\x95\x10\x42\xb9\x88\x1e\x6b\x43\x08\xde\x0f\x35\x0b\xc8\xbd\x47\x75\x4a\x3b\xa8\x36\x4a\x1e\x37\x0a\x03\xee\xee\xa7\x90\xff\x9f\x73\x2a\x81\x1b\xa3\x4d\x01\x9e\x64\x8f\xcc\xe0\xaf\x84\x10\x92\xad\xd7\x60\x3b\x99\x24\x92\x2c\x95\xae\x3e\x29\xc7\xcd\x41\x4b\xe6\x30\xfd\x91\x19\xac\x8d\xa3\x0a\x61\xab\x95\x75\x53\x2b\x48\x26\x42\x0d\x93\xa2\x95\x28\x61\x25\x4f\xce\x24\xf2\xa2\x85\x95\xc0\x84\x77\x53\x6e\x42\x73\xa1\x1a\xfe\xf4\xdc\xd7\x95\x28\x30\x38\xb9\xf2\x4a\xc4\x7c\x2a\xb3\x0e\x28\x02\xc1\xcb\x10\x7e\x7a\x8f\x54\xd2\x63\xb0\x24\x2a\x36\xbd\x1a\x15\xc7\x6d\xcb\x93\x8c\xd7\x5c\x99\x0d\x7c\x39\x99\x85\x5d\x73\x32\x83\x57\xd3\x26\x9e\x7c\x4a\x0e\x20\xb1\x74\x25\x33\x9b\xc7\x42\x24\x43\x83\x6a\x68\x1e\x12\x8f\x6f\xfa\xf7\x5f\x08\xbe\xcc\xa3\xa8\xbe\x6f\x99\xc2\x75\x69\x05\x97\x0d\x9e\xa1\x1d\x3a\x7d\x44\xc0\x42\x7e\x30\x42\x39\xa0\x17\x74\xa0\x53\x44\xd6\x99\x68\xe3\x8e\xfc\x57\x83\x12\x12\xb7\x26\x4b\xbb\x8f\x3f\xe3\x32\x91\x0c\x27\x39\x80\x17\x73\x35\x25\xc6\x9c\x6e\x0b\xd5\x74\x31\x05\x37\x62\x54\xf4\x36\x39\xff\xc8\x2b\x6b\x78\xcb\x0d\x74\xd5\xb5\xd4\x96\xe7\x45\xb2\x5c\x6a\xd6\x8c\x77\x8b\xcc\xe3\x7f\xc7\xdd\xfd\xd9\xad\xf8\x40\xb2\x56\x63\xfa\x0d\x7f\x72\x79\xbc\x99\x6c\x61\xd7\x55\x7d\xe6\x98\xc7\x69\xc4\x53\xda\x32\x45\xb2\xc1\xbf\xee\xcd\xf3\x7f\x41\xe8\xb9\xd2\x68\x41\x54\x52\x03\x3b\x1c\xb8\x6a\x72\xc3\x6d\xb9\xb4\xa3\x58\x38\x15\xbf\x4f\xfe\xa4\x83\x08\x84\xfc\x09\x00\x00\xff\xff\xb6\xe2\x6b\x23\xb5\x05\x00\x00"
+var _postgresQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x54\x4b\x6b\xdc\x30\x10\x3e\xdb\xbf\x62\x62\x96\x60\xb7\x8e\x73\x0f\xf8\xd2\x84\x42\xa1\x64\xfb\x3a\x04\x42\xa0\xda\xb5\xbc\x35\xd8\x92\x2d\xc9\xed\x2e\xc6\xff\xbd\x33\x92\x9f\xd9\xa4\x94\xd2\xc3\x9a\x99\xd1\xbc\xbe\xf9\x66\xb6\xeb\xae\x60\xa3\x7f\x48\x65\xe0\x26\x85\xd0\x4a\x82\x55\x1c\x92\x6f\xa7\x9a\x27\xf7\x24\x06\x5c\xa9\x00\x02\xdd\x94\xda\x90\x90\xed\xf0\xd3\xe0\x4f\x71\x8d\xdf\x87\xed\x47\x79\x08\x20\xf9\xdc\x72\x75\xfa\xc4\x14\xab\x74\x04\x57\x7d\xef\x77\x94\xbb\x21\xeb\xad\xac\x2a\x2e\x8c\xa6\x1a\xce\x6f\xb2\x8c\x8e\x45\x0e\xc9\x60\xb4\xb6\xeb\x6b\xe8\xba\xd9\x34\x78\xf1\x52\xf3\xe5\xb3\xed\xaf\xef\x41\xb5\x42\x03\x83\x7d\xab\x8d\xac\xc0\xd6\x8c\x41\x71\xd3\x2a\x51\x88\x03\x4a\xba\x2d\xb1\x18\xd3\x36\x6a\x86\xd6\xf7\x89\xcb\x2b\x32\x2a\x91\xb7\x62\xbf\xca\x1b\xa2\xb2\x37\xc7\x9a\x50\xa1\x9e\xed\xe0\x61\x7b\xf7\x0e\x8d\x8a\x89\x03\x5f\x61\xc6\xe7\x78\x15\x3b\x56\x42\x19\x45\x57\x21\xb2\x19\x11\xab\x90\x06\x92\xad\x28\x4f\x5b\x41\x0e\x8f\x4f\x93\xcb\x9b\xe7\x1d\xc6\x80\xf3\x97\x2a\x82\xce\xf7\x7e\x32\x45\x9a\xb3\xf8\xbe\x87\x63\x40\x5a\x1c\x60\xdf\x73\xa9\x93\x0f\xc2\x70\x55\xcb\x92\x19\x0a\xc7\x10\xca\x4d\x83\xeb\xfb\xbd\x14\xda\x4c\xa5\xc0\x51\x0a\x29\x4c\x88\x36\x45\x0c\x9b\x72\xe6\xc9\x35\x8f\x59\x37\x05\x05\xbc\x9d\x62\x9d\x35\x2c\x44\xc6\x8f\xcf\x59\xde\x14\x11\x39\x3b\x8e\x5e\xf1\x58\x4e\x65\x51\x81\x40\x90\x11\x39\xfe\x8e\x66\x6c\xc5\x09\x03\x41\x16\x31\x92\x3d\x22\xb6\xbb\x17\x3a\x18\xaf\xb1\xb2\x18\xf8\x7a\x32\x2b\xba\x96\xcd\x0c\x5c\x4d\x7b\x39\xf3\xe4\x18\xa0\xc6\xdc\xcd\x2c\x68\x1e\x13\xf9\x1e\x11\x94\x42\xb6\x4b\xdc\xf2\xe4\x02\x02\xdb\xd1\x17\xf9\x2b\x40\x87\x61\xa7\x98\x3a\xa0\xf2\xe7\xd6\x5f\xee\x30\x4a\xbe\xee\x99\xa0\x34\x79\xc1\xcb\x8c\xce\x55\x0f\x3d\xbc\x27\x83\x86\xb0\x56\x05\x1e\x4d\x70\x19\x0c\x8d\x46\x16\x8f\x87\x60\xa8\xb9\x8b\x14\x44\x51\xd2\x3e\x79\xee\x46\x48\xb5\x6b\xe6\x7b\x34\xe3\xc1\x78\xb9\xc4\x19\x93\xcf\x7c\x83\x84\xb3\xb1\x21\xb4\x2b\xe7\x58\xff\x0f\xd0\xbf\xec\xd8\xcb\x78\xce\x15\x34\xc9\x6d\x29\x35\x0f\x23\xb7\x26\xa5\x64\xd9\x78\xf9\x84\xc9\xfe\xfb\x3c\x3e\x9d\xdd\x57\x87\x09\x72\x49\xe1\xf7\xfc\x68\x42\x7b\x67\xde\x8a\xe2\x9b\xf4\x8c\xe5\x8e\xe6\x64\xcf\x0f\xa9\x40\xc9\x71\xde\xfc\x33\x33\x2f\x00\x3d\x47\x6a\xc9\xb1\x48\x52\x60\x75\x8d\x43\x0a\x51\x89\xd7\x44\x45\x2b\x0e\xed\xfb\xc4\x9c\x3b\x22\x7c\xfe\x0d\x37\x03\x87\x60\xf7\x05\x00\x00"
 
 func postgresQueryGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -622,7 +1003,7 @@ func postgresQueryGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _postgresQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\xb1\x0e\x82\x30\x10\x86\x67\x79\x8a\x7f\x30\x41\x07\xca\x6e\xe2\x64\xe2\xe8\x22\x2f\x50\xe1\x50\x92\xb6\x90\xb6\xc4\x98\xcb\xbd\xbb\x29\xa0\xe2\xd0\x6b\x72\xff\xd7\xaf\x3f\x73\x81\x6d\xd4\x37\x43\x38\x1c\xb1\x0b\xf5\x83\xac\x86\xba\x2e\x77\x95\x92\x79\x5e\xb4\xa5\x3d\x0a\x91\x2c\xbd\xe9\x5a\xa8\x53\x6f\x2d\xb9\x38\xed\xca\x12\xcc\xbf\xd5\x42\x91\x09\xb4\x8e\x93\x03\x22\xf0\x34\x78\x0a\xe4\x62\x80\x86\xef\x9f\x68\x7d\x6f\x91\x33\x7f\xba\x88\xe4\x6a\x36\xb8\x26\xc9\xe2\x6b\xa0\x3f\x43\x88\x7e\xac\x23\x78\x82\xbc\x76\x77\x82\x3a\x77\x64\x9a\x90\xf0\xcd\x1a\x65\x86\xa7\x49\xa0\xaa\x34\x45\xf0\x6d\x6b\xd2\x19\xad\x5b\xd8\xf5\x97\x92\x65\xef\x00\x00\x00\xff\xff\x5b\x7f\x83\xf0\x1d\x01\x00\x00"
+var _postgresQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x55\x8e\xb1\x0e\xc2\x30\x0c\x44\x67\xfa\x15\x37\x20\x15\x86\xa6\x3b\x12\x13\x12\x23\x0b\xfd\x81\x40\x5d\xa8\x94\xa4\x95\x93\x0a\xa1\x28\xff\x4e\xd2\x46\x50\x06\xdb\xd1\xdd\xf3\xc5\xde\x57\xd8\x3a\x79\x53\x84\xc3\x11\x3b\x7b\x7f\x92\x96\x10\xd7\x3c\x9b\xe4\x2c\xfd\x22\x35\xed\x51\x85\x50\xf8\xb8\xd3\x77\x10\xa7\x41\x6b\x32\x6e\xd6\xea\x1a\xde\xff\xa4\x4c\x91\xb2\xb4\xb6\x53\x46\xf4\xc0\x34\x32\xd9\x08\x5a\x48\xf0\xf0\x42\xc7\x83\x46\x19\x91\x7c\x4b\x08\xa5\x58\x12\x4c\x9b\xc2\xdc\x7b\xa4\xbf\x04\xeb\x78\xba\x3b\xf8\x19\x62\x69\x1e\x04\x71\xee\x49\xb5\x36\xe1\x9b\x35\x1a\xdf\x4c\x73\x80\x68\x52\x8f\xd2\xf7\x5a\x95\x6a\xd2\x26\xb3\xeb\x2f\x43\x51\x7c\x00\x5b\x7f\x83\xf0\x1d\x01\x00\x00"
 
 func postgresQuerytypeGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -642,7 +1023,7 @@ func postgresQuerytypeGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _postgresTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\xdf\x73\xe2\x36\x10\x7e\xb6\xff\x8a\x3d\xcf\x4d\x03\x3d\xce\x4c\x1f\xfa\xd0\xcc\xf0\x70\x0d\x4e\x9b\x29\x07\x39\x7e\xb4\x79\x0b\x02\x2f\xc1\x8d\x2d\x11\x49\x4e\xc2\x78\xf8\xdf\x3b\x92\x6c\x63\x63\x07\x9c\x1f\x7d\x38\x38\xec\xdd\x4f\xab\x5d\x7d\xdf\xae\x92\x24\x5f\xe1\xb3\x58\x33\x2e\xe1\xbc\x07\x2d\xfd\x3f\x4a\x22\x04\x77\xa8\x3e\x1d\xe4\xdc\x01\x87\xa3\x70\xc0\x11\x0f\xa1\x90\xea\xa7\xbf\x70\xc0\xb9\x19\x0d\xd8\x9d\xd3\x86\xaf\xbb\x9d\xad\x51\x24\x59\x84\x68\x50\x96\x6b\x8c\x08\xb8\x93\xf4\x7b\xaa\xde\x98\x4f\x85\xba\xf7\x09\x56\xe0\x5e\xb0\x28\x42\x2a\xf5\xb3\x6e\x17\x92\x64\xff\x28\xb5\xc2\x50\x60\xf1\xb5\x8e\x6c\xb7\x03\x8e\x1b\x8e\x02\xa9\x14\x40\x80\xb3\x27\x58\x71\x16\xc1\x59\x92\x64\xb1\xec\x76\x67\xae\x41\xa0\xbe\x02\x93\xdb\x0d\x96\x10\x84\xe4\xf1\x52\x42\xa2\x8d\x38\xa1\x77\x08\xee\x65\x80\xa1\x2f\x94\xb9\x55\x34\x4d\x12\xe0\xa8\x01\xdc\xa9\xfa\xdc\xed\x60\xfe\xaf\x60\xf4\xdc\x31\x11\x87\xea\x5f\x1c\xd1\xd4\xde\x99\x43\xbe\x99\x83\x57\xc5\x88\xb2\x24\x5c\xf3\x20\x22\x7c\xfb\x17\x6e\xd5\x53\xdb\xea\x76\xe1\x99\xc1\x4a\x87\x62\x5b\xb7\xf8\x1c\x08\x29\x3a\x70\xeb\x63\x88\x12\x7d\x58\x30\x16\xda\x49\x92\xc1\xec\x6c\xf5\xa3\x0a\xd4\xed\x82\xa7\x5d\xc1\x47\x89\x3c\x0a\x28\x0a\x65\x26\xd7\xe5\x3c\x18\x7c\x08\xa8\x7e\xe3\x13\x49\x16\x44\xa0\x6b\xaf\x62\xba\x84\x96\x4a\xa8\x39\x22\xbb\x1d\xfc\x5c\xf0\x6b\xa7\xe8\xad\xb6\x0e\x08\x12\xdb\xe2\x28\x63\x4e\xa1\xe8\xe2\xa6\xe1\xab\x28\xbb\x5d\xe8\xa7\x5b\xd8\x70\xf6\x18\xf8\x2a\x1e\xba\x62\x3c\x22\x32\x60\xb4\x2e\xb6\x35\x11\xb0\x40\xa4\x90\xed\x5d\x57\xf9\x95\x71\xa6\x8b\x9e\x0a\x34\x5d\x22\x8d\xf4\x8a\x0a\xe4\x12\x02\xfd\x25\x2a\x81\x49\xf6\xda\x28\x0c\x60\xcb\x5f\xc0\xcd\xa8\xff\x7b\x1b\x90\x73\xc6\x55\x30\x8f\x84\xab\x1f\xe6\x81\x29\x7f\xb0\x02\x12\x72\x24\xfe\xd6\x54\xa7\x03\x0b\x12\x84\xb6\x15\xac\x6a\x93\xab\x50\xb2\x3d\x69\x14\xe1\x0e\xf1\xa9\xe5\x98\xe0\x61\x45\x82\x10\xfd\xf3\x32\xa4\x70\xda\xb6\xb5\x3f\x3a\x86\xa5\xdf\x09\x8d\x49\x78\x7d\xaf\x09\xd0\xed\x82\x78\x08\xd3\x0c\xc0\x43\x8c\x7c\xdb\x81\x8d\x39\x62\x70\x8f\x5b\x88\x62\x21\x61\x81\x59\x31\x7d\xdb\x5a\x32\x2a\x24\x18\xa9\x80\x1e\xcc\xaf\x86\x13\x6f\x3c\x85\xab\xe1\x74\x04\x45\x66\x42\x6b\x0e\x5f\x6c\xcb\x9a\x27\x09\x2c\x59\xa8\x34\x47\x14\xc8\x97\xbe\x6c\xc3\xdf\xdf\x06\x33\x6f\x72\x60\xfd\x48\xc2\x3a\xe3\xb9\x49\x1d\x8f\xa9\x89\xd5\xb6\xb4\x48\xb5\x4c\x34\x1d\xb5\xbe\xa6\x54\x79\xb1\x3c\x97\x6d\xdb\x52\x45\xe8\x81\xbf\x70\x7f\x28\xff\x31\x7b\x6a\xec\xeb\x4e\x96\x84\xb6\x7e\x2a\xd5\x46\x15\x7f\x4f\xc8\xfc\x1c\xe8\x22\xaa\x95\x3e\xf5\x80\x06\xe1\x41\xe9\x54\x49\x14\xb3\x95\xe8\x35\xaa\x41\x96\x7b\x58\x6c\x41\xe0\x43\x8c\x74\x89\x1f\x54\x87\x9a\xe8\x5f\x51\x98\x63\xde\x63\x6f\x3a\x1b\x0f\xaf\x86\x7f\xc0\x7e\xdd\x92\xc3\x05\x0b\x95\xfd\x7b\x2a\x5a\x9f\xfb\x37\x96\xb8\x0e\xec\xc3\x6b\x6e\xd4\xdc\xd4\x1c\xa5\x61\xa9\x29\x67\x2d\xe7\x7b\x20\x79\x8c\x76\x2e\x66\x34\x08\xf7\xad\x80\x22\xb4\x9a\x6f\xa7\x0d\x8e\x93\x1d\xb7\xd9\xc6\x27\x12\x21\xd6\x5f\x55\xdd\xab\x74\x09\xeb\xa4\xf0\x19\xc4\x1a\xe1\xab\x28\x5f\x2a\x7d\x3e\x43\x41\xcf\x64\x59\xfa\x54\x0a\x3f\xbd\x28\x7e\x75\xea\x67\xb6\x90\xab\x9f\x42\x05\xca\x52\x58\xa5\x7e\x4a\xfe\xf2\x35\x8d\xf8\x17\x57\xab\xed\x0e\x4d\x57\x8b\x08\xbf\x57\xed\x8a\x71\x03\x1d\x30\x5a\x5a\x52\xb1\x3a\x3d\xd4\x15\xb6\xce\xae\xfb\xdf\xa6\x5e\x99\xa8\x13\x6f\x9a\xb1\xed\x95\x6c\x55\x84\xeb\x41\x0b\xca\xce\xcd\xb8\xaa\x7c\xff\xf9\xd3\x1b\x7b\xc7\x89\x0a\x3d\xf8\x6c\x0c\x96\x2c\xa6\xf2\x28\x6e\xba\xff\x02\xa9\xdf\xc9\xea\x0e\x34\xa3\xa0\x75\xdb\x81\x9c\xfe\xde\x33\x2e\xff\xff\x15\xcb\x1c\xd7\xf4\x9a\x90\x47\x04\x41\x1e\x6b\xa8\x55\x19\x29\x4e\x53\x4b\xa1\xd5\x11\xeb\xf0\xf4\xe6\x93\x5a\xf1\xf4\x96\x2c\x72\x92\xe6\x87\xb4\xce\x2a\x9f\x61\xda\xf9\x86\x66\x1b\xdd\x9a\x36\xc8\xd5\x20\x27\x80\x50\x88\xcd\x23\x75\xf6\x0b\xd1\xba\xca\x5c\xbb\x0c\x47\x53\xef\x1c\xae\x99\x90\x77\x1c\x27\x3f\x06\xf0\x9b\xfb\xeb\x17\x60\x34\xdc\x36\x52\x93\x17\xc6\xa8\x97\xd4\xa4\x76\x90\x3a\x3a\x49\xbd\x69\x94\x6a\xc4\xec\x53\x7d\xf8\xf8\x40\x54\x6d\xbc\x47\x47\x22\x65\x3e\x1a\xc2\xc5\x68\x78\x39\xb8\xba\x98\xea\xac\xbe\xcc\xe1\x36\xf4\x47\x90\x2a\xcf\x49\xb1\x39\xd0\x96\xb2\xe9\x86\xe3\x2a\x78\x2e\x3b\x38\xde\xcd\xc5\x60\xd6\xf7\xfa\x4e\xd1\xf7\x9d\x62\xf0\x1e\x5a\x1b\xdf\x6a\x5b\x2e\x71\x76\x5f\xd3\x72\x3f\x3e\xd1\x90\xcb\x1d\xf9\x60\x9c\x4b\xfb\xab\x90\x44\x62\xa4\xaf\xaf\x2c\x0a\xa4\xea\x2c\x7e\x8c\x4a\x03\x42\xb2\xbc\x07\xb6\x4a\xef\x7f\xc0\xe4\x1a\x39\xc8\x35\xa1\xc5\xb9\xaf\x38\x30\xe4\xd7\xaa\xb4\x89\x55\x95\xe5\xed\x97\xa6\xc6\xd7\x95\xda\x9e\x7d\xb4\x65\x17\x92\x94\x69\x49\xb5\x0f\x1f\x6d\xc3\x35\x08\x05\xf2\x1d\x72\xaf\xef\x0d\xbc\xa9\x07\x97\xe3\xd1\xf7\x32\xf7\x1a\x36\xb8\x5f\x1a\x8c\xa3\x0d\x9a\xc2\xb1\xe3\xfa\x01\x83\x64\x3e\x3e\x66\xb7\x59\xab\x3e\x7f\xf5\xd3\x63\xe1\x8f\x13\xf6\x7f\x01\x00\x00\xff\xff\x0d\xd3\x13\x8d\x1d\x12\x00\x00"
+var _postgresTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x5a\x6d\x73\xdb\xb8\x11\xfe\x4c\xfd\x0a\x1c\xc7\xd3\x48\x17\x45\xd7\x9b\x69\x3b\x53\xb7\xee\x4c\x62\x29\xad\xa7\x3e\xd9\x67\xcb\x77\xe9\x64\x3c\x16\x24\x42\x36\x13\x0a\x94\x48\x2a\x91\xeb\xf1\x7f\xef\xee\x02\xa4\x00\x12\xa2\x28\x25\x77\x93\x7e\x30\x2d\x92\xc0\x62\xb1\x2f\xcf\xbe\x80\x4f\x4f\xaf\xd8\x51\xfa\x10\x27\x19\x3b\x3e\x61\x6d\xfa\x25\xf9\x5c\xb0\xde\x10\xaf\xbe\x48\x12\x9f\xf9\x89\x48\xe1\x9a\x2e\xa3\x34\xc3\xdb\x60\x02\x97\x77\x17\xe7\xf1\xbd\xdf\x61\xaf\x9e\x9f\x5b\x4f\x48\x25\xe3\x93\x48\x28\x2a\xd3\x07\x31\xe7\xac\x77\xad\xff\x8f\xf0\x8d\xba\x22\x55\x63\xce\x22\x5a\x25\x3c\xa2\x49\xea\xe7\x66\x6d\x63\xd4\x64\x27\x83\x05\x6b\xf0\x63\x1a\xcb\x59\x14\x4e\x33\xb8\x5b\xae\x44\xf2\x98\x33\x9c\xad\x0b\xae\x99\x3f\xe1\xd9\xf4\x01\xfe\x7f\xe2\x11\x6e\x2d\x0a\xe7\x70\x95\xf0\x17\xe2\x5f\x40\x17\x7c\xb1\x44\x9a\xd9\x3c\x33\x37\x9a\xc4\x9f\x53\xc5\x72\x12\xca\xac\x60\xcf\x4f\x8d\x41\xe1\x8c\xf5\x4e\xe3\xf9\x5c\xc0\x00\x7c\xf6\xc3\x0f\xec\xe9\x69\xf3\x48\x8f\x12\x51\x2a\xcc\xd7\xb4\xa5\xe7\x67\x96\x88\x05\x88\x1c\x06\xa6\x8c\x33\x58\x8e\xcd\x92\x78\xce\x5e\xc0\x10\x2d\xe5\xe7\xe7\x17\x3d\x45\x41\x06\x48\x2c\x7b\x5c\x08\x8b\x02\x48\x63\x35\xcd\xd8\x13\x0d\x4a\xb8\xbc\x07\x81\xbd\x0d\x45\x14\xa4\x38\xdc\x33\x87\xc2\xef\x44\x10\x81\xde\x08\xaf\xf0\x68\xfc\x21\x8d\xe5\xb1\xaf\x38\x8e\xf0\x6f\x35\x97\x7a\xbc\x3f\x66\xc5\x66\x4a\xaf\x4c\x8e\x72\x21\x5c\x26\xe1\x9c\x27\x8f\xff\x16\x8f\xf8\xb4\xe5\xc1\xdc\x75\xcc\x66\xc4\x4a\xcb\xbb\x13\xeb\x30\xcd\xd2\x2e\xbb\x0b\x44\x24\x32\x11\xb0\x49\x1c\x47\x30\x39\x27\x03\x53\xe0\xa6\x4a\x08\xc8\x0c\x68\x2a\x0b\x60\x5a\x32\x0f\xa5\x48\x71\x58\xf6\x60\xcb\x41\xd1\x67\xa1\xa4\x37\x01\x07\xf1\xf1\x54\xf4\x5a\xb3\x95\x9c\xb2\x36\x0a\x54\x29\x0f\x86\x7e\x6f\xcc\xeb\x68\xea\xed\x0e\x31\x04\x72\xf4\x40\x46\xab\x44\x32\x73\x4a\x4f\xb3\x8f\x5c\x02\x43\x7d\xbd\x85\x45\x12\x7f\x0a\x03\xe4\x47\xce\xe2\x64\xce\xb3\x30\x96\x2e\xde\x1e\x78\xca\x26\x42\x48\x96\xef\x9d\xb4\xbc\x27\x9f\x7a\xd1\x5d\x8c\xea\x25\x34\xa7\x67\x32\x15\xf0\x22\xa4\x7f\x69\x85\xb1\x2c\xde\x97\x0b\x45\x10\x47\x4c\xb3\xf5\x82\x27\x7c\x0e\x8f\x83\x09\x7b\x77\xd1\x7f\xd3\x61\xe0\xa3\x71\x82\xac\x7d\xe2\x09\xde\xa8\x07\xca\x18\x40\x2e\x3c\x4a\x04\x0f\x1e\x95\xae\xba\x6c\xc2\xc3\xa8\xe5\xc1\x73\x97\xa8\x91\x4a\xbe\x43\xa2\x92\xf6\x86\xe2\x73\xdb\x57\x5b\x61\x33\x98\x2b\x82\x63\x9b\x24\xf8\x65\xcb\xdb\x18\x92\x42\xa3\x9f\xb8\x5c\xf1\xe8\xf2\x23\xb9\x03\xf0\x01\xc0\xa1\xe5\xc1\x08\x35\xba\xa0\x46\x32\x38\xf6\x11\x2c\x6e\xbe\x4a\x33\xd0\x55\xae\xda\xa0\xe5\x01\xca\xc0\x23\x85\x3b\xec\x84\x8d\xcf\x86\xd7\x83\xab\x11\x3b\x1b\x8e\x2e\x98\xe9\xa7\xac\x3d\x66\x2f\x81\xe7\x31\xca\x26\x26\x7c\x4b\x0d\x57\xd4\x2f\x3b\xec\x97\xd7\xe7\x37\x83\xeb\xd2\x68\x44\x27\xc7\xe0\xb1\x12\x5d\xb2\x92\x8a\xd7\x96\x47\xb0\xd6\x56\xdc\x74\x71\x7d\x72\x30\x7b\xb1\x42\x96\x20\x8d\xbb\x2e\xe9\xe1\x84\x05\x93\x9e\x52\xda\x4c\x32\x7f\xb0\x16\x53\x1f\x06\x68\x3d\xf2\xe4\x1e\x6e\x9a\x13\x05\xe1\x22\xd1\xef\x4e\x98\x0c\xa3\x92\xa2\x50\x01\xe8\xd5\x08\x78\x8d\x24\x9e\x4b\x9a\x4d\x1e\x59\x2a\x60\x80\x9c\x8a\xaf\x24\x75\x03\x4a\x72\x0b\xde\x43\x0d\x75\xb3\xaf\x06\xa3\x9b\xab\xe1\xd9\xf0\x9f\x6c\xb3\xae\x35\x01\xe0\x12\xc7\x7f\x89\xfe\x1c\xeb\x83\xec\x1d\xda\xfc\x19\x49\x5f\xc5\x9f\x0f\xd3\xa8\x6b\x19\x08\xe8\x5c\xb6\xff\x60\xf9\x25\xc2\x80\x93\xa3\x26\xd6\xa0\x30\x5e\x59\x83\xc8\x94\xb7\x2a\x45\x3b\x7d\xff\x84\x41\x54\x13\xad\x02\xe2\x80\xf0\x26\x40\x88\x25\x8b\x62\x1e\x80\x39\x61\x10\xf3\x17\x71\x9a\xdd\x53\xde\xa2\xa2\x85\x42\x28\x24\xab\x73\x0e\x30\x92\x2d\xf8\x97\x96\x01\x90\xad\xd2\x50\xde\xb3\xd3\x8b\xcb\xff\x74\x61\x12\x92\xa3\xfc\x01\xa4\x16\xcf\x00\xe3\xde\xe0\xcd\x75\xf8\x5f\x81\xb1\x3a\xed\xb1\x5f\x1f\x10\xd4\x27\x2c\xc4\xf0\xfd\x3d\x88\xbb\xd7\x7f\xd3\x25\x8a\x94\x3a\xf0\x44\xe8\xa5\xc1\xbc\x15\x39\x0e\x3b\xe3\x32\xe5\x53\x8c\x15\x1a\x6f\x1d\x1c\xbb\x01\x96\x34\xa9\xb2\x12\xd8\xd4\xfb\x5b\x1b\x9a\xdb\x05\xe0\x76\x50\x0b\x35\x90\x0b\xc1\x8a\xdd\x29\x62\x93\x02\xe7\x21\xcf\x51\xe9\x83\xb9\x06\x6a\x53\x03\xf4\xc4\x89\xd0\x07\x41\x34\x18\x85\xa7\xad\x01\x45\x5d\x80\x2e\xfa\x09\x04\x70\x4b\x46\x2d\x2f\x5b\x77\xd1\x80\x14\x90\x01\x97\xeb\xf8\x34\x5e\x3c\x8e\xd6\x96\xa9\x07\x93\x06\x96\xe8\x05\x62\x26\x12\x86\x42\x6f\x93\x8c\xb4\x37\x01\x75\x14\x1e\x06\x8f\x76\x47\x5b\x99\x8c\xb3\xba\x08\x52\x05\xb2\xb4\x1e\xc9\xc4\x52\x23\xd9\xf5\xe0\x7c\x70\x3a\x62\x52\xac\x33\x80\x9b\xf6\xe2\xfe\xee\x5e\x64\x77\x20\xb1\x90\x47\x77\xf9\xb4\xb6\x9d\x02\x76\x29\x25\x2c\xe1\x8b\x9d\x92\xbd\xe8\x74\x98\x86\xa7\xb7\x57\x17\x3f\xb1\x7b\x21\x45\xc2\x33\x41\x94\x45\xda\xfe\xb1\xcb\x8e\x7e\xdc\x1e\x50\x88\xbd\x2e\x8b\x84\x6c\x1b\xfa\xef\x80\x48\x96\x85\xe0\xb3\x75\x19\x74\xaa\x88\xb3\x9d\x4e\x43\xe5\x2c\x7b\xa7\x51\x9c\x0a\xd0\x03\x71\x8a\x9e\x6e\x49\x59\xe5\x16\x90\xb3\x83\xfd\x97\x64\xa2\x93\x5a\x65\xdf\x4b\xb0\xc5\x75\xa6\xd5\xac\xa6\xb0\x6d\xe3\xb5\x19\x2c\x35\xec\x85\x41\x47\x99\x7d\x89\x5f\x8b\x61\xe4\xd8\x43\x36\x4e\x18\x5f\x2c\xd0\x82\xe0\x06\x40\x23\xa0\x14\xa4\xa0\x38\x48\x92\x76\x93\xcd\xc3\x00\x94\x19\xd0\xe8\xe0\xa8\xb2\xfc\x1a\x66\x43\xd3\x78\x15\x05\x64\xba\x30\x16\xd4\xfe\x49\x58\xb2\xd3\xe9\x51\x8e\xc2\xb6\x58\x51\x68\x61\x63\x50\xb0\x11\xc1\x1d\x1b\x40\x00\xb0\x9f\xf7\xe1\xad\x1d\x05\x2c\x17\x6a\x79\x3a\xc6\xc3\x4a\x0b\xd0\x3d\x78\xf6\x99\x54\xe5\x64\x1b\x83\x72\x5e\x5a\x42\x2c\xed\x32\x7a\x50\xaa\x31\xe9\x0d\x31\x94\x97\x3f\x47\xb0\x8b\xa3\x19\x12\xdc\x24\x54\xca\xa7\x8f\x42\xf8\x49\x5b\x54\xac\x20\xbd\xa3\x59\xd5\x97\xc6\xc5\x08\xb4\x42\x14\x4c\x45\x1f\xff\x60\x7f\x24\x39\x50\x78\xc0\xb5\x8c\xb7\xca\x78\x70\x0a\xbd\xc5\xc1\x66\xe8\x20\x53\x52\xf3\x4e\x54\x78\x79\x7f\x6c\xbc\xbf\x25\xdb\x82\x0b\x88\x09\x8a\xc3\x05\x06\x91\x29\x88\x05\x9e\x60\x7d\xea\xf6\xc6\x4b\x35\x70\x4b\x06\xd0\xcc\x9c\xd5\x92\xb8\x14\x45\x2f\xb8\xad\x8f\x13\x6a\x0b\x44\xa9\x51\x56\x33\x31\x32\x48\x6f\x93\x98\xe2\xa6\x76\xa5\xa6\x38\xe1\x20\x0d\x17\x13\x55\xde\xa0\x95\xdd\x87\x70\x4f\xce\xef\x63\xf9\xeb\xab\x7f\x13\x5c\x55\xc7\x16\x44\x8e\x8a\x85\xc3\xe4\x3c\xd6\x6e\x12\xdc\x9a\x51\x55\x26\x72\x9b\xf2\x9c\xea\x20\xf5\x16\xe8\xe7\x95\x15\x44\x80\x93\x6b\x69\x16\xad\xd2\x87\x5c\x4d\x4d\x44\x09\xeb\x15\xd2\x84\x1f\x86\x81\x3b\x79\x29\xb3\x52\x41\x3e\x63\xc1\xcd\xb0\xa6\x36\x56\x4a\x01\x9b\xdb\x99\x3b\x0f\xd1\xd9\xa2\xa6\x6f\x22\x95\xe5\x96\xef\x37\x1e\x79\x7c\xab\x80\xd0\x4a\x2f\x8d\xaa\xe5\xeb\xa4\x91\xf3\x55\x94\x85\xaf\x60\x75\xa4\xa7\xe7\x62\x5a\x59\x9b\x53\x7e\xd5\x94\xb0\xa8\xc7\xbf\xf9\x64\x10\x89\x4a\x28\xa4\x54\xbf\x0d\x34\x95\xbb\x76\xc7\xec\x31\x7d\xd3\x15\xfd\x78\xd3\xe9\xcb\x1b\x88\x6a\x47\xb0\x21\x48\xf1\xd2\x8c\x27\x19\x04\x68\x99\xb9\x2a\x4c\x63\xb3\xfb\x95\xcc\x7c\x95\xc5\xa1\x9c\x26\x02\xdb\x8d\xbf\x5f\xdd\x3c\x36\x5b\x80\x2a\x53\x0b\xe7\x61\xa6\xea\x9e\x05\xe6\x71\xc8\x76\xee\x18\x73\xbe\x66\x72\x35\x9f\xc0\x73\xb0\x79\x32\x61\x00\x2e\x98\x81\xda\x36\x5c\x20\x57\xb4\x16\xdc\xb3\x4a\x8c\xa4\x8e\xaf\x40\x45\x4d\x45\xfe\xa9\x33\x59\x8c\xfb\x1b\x2e\x0f\x71\x56\x92\x1d\xe2\x6f\xc0\x20\x65\x57\x06\x97\x5f\x23\x92\x23\x6d\x57\x04\x87\xe7\x66\xe4\xa6\xbc\x15\xf2\xfb\x95\x48\x75\xfe\x49\xbd\x85\xf7\xb7\xa1\xcc\x44\x32\xe3\x53\xf1\xf4\xdc\x18\xf9\x6a\xcc\x1f\x69\x47\x46\x12\x8a\x77\x4d\x22\xb0\x69\xaa\x07\x11\x71\xf6\x02\x0c\x69\x6f\x44\x61\x14\x1b\x9e\xb2\x0a\xd8\xa0\xb6\xd0\x97\x50\xcd\xfd\x42\x52\x6a\xfb\xa4\xe1\x14\xb7\xe5\x77\x0d\x99\x77\x2d\x45\x23\x60\xa8\x6c\x43\xfb\x05\xf2\xda\xeb\xf5\x3a\xb5\x42\xba\x2b\xb2\xa6\xdd\x6d\xb0\x32\xdd\x26\x61\xcd\x96\xe6\xd2\xbd\x9a\xbb\x62\xa2\xe5\x5e\x8e\x1b\x77\x94\xf6\xe4\xcc\xb0\x46\x3b\xdd\x37\xca\x28\x30\xc9\xbf\xfc\x49\x1b\xa3\x55\x36\xe5\x75\x53\x3e\xc0\x59\x27\x39\x53\x99\xe5\x8e\x3c\x66\x5b\xe5\xe4\x99\xd5\x98\xae\x9d\x9a\x55\x62\xd5\xfa\x49\xbb\x6c\xc3\xc0\xd4\xa0\x74\xb2\xf2\x17\xf3\xa5\x6a\xf9\xc4\x49\xa0\xf0\x8d\xfa\x3f\xb4\x20\x40\x34\x21\xc9\x44\x60\x3e\xb0\x92\x50\xe2\x86\xf0\xb0\xab\xc8\x4c\x56\x19\x8d\xa5\xe6\x41\x3e\x6f\x33\x9c\xa7\x69\x78\x2f\xa9\x73\xc4\x08\xe1\x39\x65\x15\xb4\x0c\x16\x03\xe0\x85\xbd\xeb\x28\x9c\x0a\x25\x3f\xea\x6a\x40\x62\xfc\x01\xd5\x95\x1f\x12\x68\x3e\x74\x1d\xc6\xfe\x4e\x3f\x3e\xdc\x32\xf2\xa3\xfa\x8a\x6f\x6b\xda\xb5\xb5\xd8\x73\x97\xd7\x6d\xb5\x76\x67\xe3\x28\x39\x1c\x7f\xdb\xa9\x60\x7e\xba\x45\xcd\x20\xc1\xda\x1b\x2c\xa4\xbc\xae\xa6\x8d\x9a\xc7\x72\xdf\xcf\xc3\xf9\xcd\x02\x52\x43\x48\x0b\xe9\x5f\xf5\x18\xa6\x72\x68\xe5\xed\x3c\x87\x51\x14\x77\x9e\xc3\x54\x0e\x62\x74\x26\x18\xc4\x22\x95\x2f\x32\x3b\x13\x44\x27\xfa\x6e\xeb\x59\x8c\xcb\x87\xd4\x86\x0a\x1f\x42\xaa\xe4\x42\x34\xcd\x72\x19\x5c\x53\x9d\x4c\x99\xab\x39\x8f\xae\x9a\xae\x06\xd2\xfe\x88\x67\x69\xb0\x53\x9a\x19\x42\x29\x67\x79\xe9\xa6\xc7\x50\x49\x8b\x6e\x2e\xfb\xaf\x47\x03\x3b\x23\xba\x1e\x8c\xf2\xac\xc8\x4a\x8b\x6c\x75\x57\xf4\x5c\x24\x47\x98\x1d\x41\xaa\xc7\x6c\x12\x88\xd6\xfb\x50\xf8\xf5\x5f\x83\xab\x81\x11\x03\x52\xda\x82\xa6\x50\x99\xe9\xb3\xd7\xc3\x3e\x5c\x9b\x27\x98\x08\xa1\xea\x60\xa2\x1c\x9b\x6b\x8a\xf8\x66\x16\x9f\x97\xde\x66\xd6\x50\x19\x63\x9e\x23\x7d\xf1\xe9\xd4\x6f\xc5\x97\xdd\x9c\x23\x17\xbe\xe6\x10\x11\x52\xb8\x34\x38\x45\xdd\xed\xbe\x48\x6d\xb7\xf3\x96\x3d\xa4\x38\xaa\x36\x3d\xc4\x1a\x61\xc1\x82\xd1\x19\x57\x4e\xe1\x9a\x61\x1d\xe8\x1a\x33\xf4\xb6\x6f\x16\x14\x25\x21\xa3\xc7\x13\xee\x94\x71\x09\x28\xa6\x02\x27\x32\xb9\xd9\x53\x0f\x87\xd3\x94\xe1\xc5\x68\x70\xcc\x2e\xd5\xb1\xcc\xf5\xcf\xe7\xec\xaf\xbd\x3f\xbf\x64\xb1\x8c\x1e\x1b\xe1\x5a\xa3\xf3\xe5\x6d\xb8\xe6\xac\x70\x6b\x8f\x98\x0f\xad\x59\x77\x63\xcc\xae\xd2\xab\xbe\xae\xac\x9e\x51\xd6\x9e\x15\xe3\xf0\x8b\x21\x3b\xbd\x18\xbe\x3d\x3f\x3b\x1d\x91\x8c\x37\xb4\x1d\x1e\xd1\x61\xfd\x0b\xa6\x61\x70\x1b\xf2\x39\x57\x39\x29\x0f\x5d\x24\x90\xd5\xac\xed\x09\xfe\xe0\xdd\xe9\xf9\x4d\x7f\xd0\xf7\xcd\xb9\x7b\xa2\x8e\xf3\xec\xf9\xb7\x39\xd1\x3e\xb8\x75\xe5\xb4\xab\xe2\xf8\xb2\x74\x7e\xb9\x71\x28\xbb\xaf\xb4\xc5\xbb\x2a\x6d\xa6\x72\x5b\xc9\xdb\xa7\xaf\xd4\xd8\x3d\x1d\x1c\x7e\x51\xef\x69\x3f\x5f\xdd\xaf\x1d\xb5\xa3\x1f\x75\x98\x73\x5b\x9d\xd6\xdf\xc5\xc3\xc7\x26\xf1\xfc\xb3\x36\x5c\xe1\xff\xc7\xa7\xf7\x6f\xff\xb8\xfa\x3f\x5e\x7d\xbf\xc7\xe8\x0b\x56\x9a\x3e\x9b\xae\x8f\xd2\x5d\x6d\xb3\x67\x5b\xb7\x67\x7b\xbb\x67\x4b\xbf\x47\xad\x55\xed\xf8\x6c\x6f\xf9\x34\x2f\x71\x0e\x6c\xed\x98\x4c\x99\x48\x7b\x70\xf3\xc5\x96\x7a\x07\xe6\x19\x56\xda\xf2\xb6\x35\x64\xbc\xbd\xb0\xda\x31\xdb\xd5\x58\x28\xb7\x12\xf4\x3e\xcb\xb0\xbc\x87\x90\x77\x14\x92\x7a\x8d\xa6\xa5\xa4\x95\x68\x21\xec\x57\x3f\x87\xd2\xc5\x20\xa4\xeb\x19\x35\x6d\x01\xaf\xc1\x75\xb0\xf0\x09\x56\x02\x5d\x26\xe2\xd3\x8f\xe8\x28\xea\xdb\x49\x16\x83\x0b\x61\x30\x80\xe0\x60\x34\x1d\xcc\xcf\x6a\x8a\x4f\x12\x75\x8d\x55\x4d\x51\x0f\xff\xe0\xf0\xc0\x4f\xfd\x9c\x05\x66\x6d\x7d\x69\xcb\xac\xe5\x2e\x1a\x6b\x6b\x46\x07\x05\x03\xbd\xcb\xe0\xdd\x1f\x9c\x0f\x00\x27\xe9\x63\x09\x0b\xbc\xdd\x15\x58\x4d\xf1\xb5\xf7\x77\x5e\xb5\xc5\xc7\x97\xe7\x37\xb5\xe4\x77\x7d\x89\x50\x7c\x1d\x90\x7f\x48\xea\xb9\x05\xee\xfe\x44\xcb\xec\xef\xfc\x0f\x47\x2c\xb1\x05\x72\x2e\x00\x00"
 
 func postgresTypeGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -662,7 +1043,7 @@ func postgresTypeGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _sqlite3ForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x4b\xc4\x30\x10\x85\xcf\xe6\x57\xbc\x83\xb0\xc9\xb2\xdb\xde\x05\x2f\xab\xe8\x41\x50\x10\x0f\x5e\xbb\xed\xd4\x16\xb7\x89\x4c\x52\xb5\x84\xfc\x77\x49\xda\xed\xd6\x65\x0f\x81\xe1\x9b\x37\x99\xf7\xc6\xfb\x2d\xae\x6d\x63\xd8\xe1\xe6\x16\x32\x55\xba\xe8\x08\xd9\xdb\xf0\x45\xd9\x73\xd1\x91\xc2\x36\x04\x91\xe7\xf0\x1e\x09\x20\x04\x30\xb9\x9e\xb5\x85\x6b\x28\xf1\x57\xaa\xe7\x81\xd8\x2f\xac\x35\x65\x5b\x38\xaa\xf0\xd3\xba\x66\xd6\x2d\x45\x2b\x9b\xd0\x43\x4b\x87\x6a\x1e\x94\x27\x74\x67\x0e\xf1\xf5\x9d\x9e\x9a\x2a\x13\x79\x1e\x9d\x3c\x92\x26\x4e\x9f\xd7\x6c\x3a\xd4\x86\xa9\xfd\xd0\xf8\xa4\x01\xab\x34\x3f\x82\x27\x1a\x16\xe5\x71\x6b\x26\xea\x5e\x97\x69\xd1\x94\x3c\x04\xac\xcf\xcd\xa9\x65\x5c\x59\xed\xf1\xfe\x72\xbf\x53\x90\xeb\x0b\x69\x37\x20\x66\xc3\x0a\x5e\x5c\x8d\x87\xb9\x74\x93\xdd\x30\xc1\x7f\x81\x65\xb5\xdf\x44\x75\x69\xf4\x37\xfd\xba\xa3\xa5\xf1\x04\x27\x79\x74\x24\x82\x10\x7f\x01\x00\x00\xff\xff\xea\x89\x96\x81\xb0\x01\x00\x00"
+var _sqlite3ForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x55\xdb\x6e\xdb\x46\x10\x7d\x26\xbf\x62\x4c\x14\x31\x15\xd3\x74\xfa\xea\x42\x79\x88\x2b\xb7\x45\x1d\x3b\xb5\x8d\x26\x80\x20\xc4\x2b\x6a\x29\x11\x59\x2d\xa5\x5d\xd2\x91\x20\xe8\xdf\x33\x33\xbb\x94\x28\x87\x49\x1c\xa0\x05\x24\xee\x85\x73\x39\x73\x39\xc3\xcd\xe6\x14\x7e\xb1\xb3\xd2\x54\x70\xde\x87\x98\x77\x5a\xcc\x25\xa4\xf7\xeb\x85\x4c\xaf\x71\xdb\x83\xd3\xed\x36\xdc\x90\xa0\xfa\xbe\x24\x44\xd2\x98\x08\x22\xbb\x54\xb6\xa2\xcd\xb2\x96\x66\x8d\xeb\x64\x4c\x07\xfc\x1b\x69\xf1\xf9\x49\xae\xfd\x42\xc2\x52\x6a\x5c\xc6\xa2\xca\x66\xb8\x7e\xb8\xb9\x2a\xa7\x51\xcb\xa7\xe9\xf4\x79\x2b\xf3\xff\xc1\x6d\x13\x61\xdb\x7d\xf9\xd9\xb2\xf3\x85\x29\x74\xb5\x4b\x41\x64\xdb\x18\xd1\x64\x85\x68\x58\xce\x48\xde\x12\xc2\xcb\x42\xaa\x09\xa7\xc7\xc9\x9e\x9d\xc1\x66\x03\x0e\xf3\x76\x0b\x28\x59\x1b\x6d\xa1\x9a\x49\xbe\x3f\x88\x09\xdf\x0b\x6b\xcb\xac\x10\x95\x9c\xc0\xe7\xa2\x9a\xed\xe4\xda\x42\xc7\x96\xaf\x9c\xa7\x46\x31\xde\x5f\x5d\x94\x8a\xfe\xf5\x5c\xfb\x97\xbd\x14\x61\x10\x92\x3f\xa4\x96\x86\x8d\xe7\xa6\x9c\x43\x5e\x1a\x59\x4c\x35\x60\x28\x70\xcc\xfa\xee\xe2\x6f\xb9\x6e\x6d\x1b\xaf\x69\x98\xd7\x3a\x63\x47\xbe\x7b\xd0\xed\xcb\xa7\xe0\x7a\xed\x70\x49\x36\xab\x56\x0b\x61\xc4\x1c\x8f\x93\x31\x7c\xb8\xf9\xfd\x4d\x0f\xe2\x97\x1d\xb1\x27\x80\x25\x2d\x0d\x1a\x08\x03\x97\xa6\xae\x0c\xbd\x59\xfb\xcb\x83\xf0\xbd\x23\x61\xa6\xec\x26\x21\xcd\xac\xd4\x8f\x72\x55\x35\x60\x5d\x72\xf6\xaa\x84\x35\xdc\x86\x4d\x81\xae\x4a\x31\x79\x66\x91\xec\xb3\xaa\x64\x8f\x7f\xb6\x4a\x09\x61\xc1\x5a\xa0\xd5\xf1\x9a\x6c\x16\x06\xba\x82\x4d\xa0\xd0\x20\xc0\x16\x7a\xaa\x24\x70\xe3\xc3\x42\x1a\xe0\xbe\x86\x32\x27\x1b\xf6\xbf\x2c\xf9\x93\xfc\x74\x57\x95\x73\xee\xa8\x83\x81\x0e\x47\x1d\x9d\x11\xcf\xc5\x62\x48\x52\x0d\x77\xb6\xdb\xd1\x8f\x3a\x01\x43\xc8\x4a\xa5\x64\x56\x71\x58\x61\xf0\x28\x0c\xef\xd0\x05\x92\x53\x9a\x5c\x64\x72\xb3\x0d\x03\x22\x37\x91\xb1\xc3\xc7\xb8\x2c\x15\x89\x60\xf0\xf0\xd1\xe1\x54\xbb\x0e\x46\x15\x23\xf4\x54\x1e\xc0\xdf\x30\xc7\x8b\x1c\x66\xc2\xbe\x33\x32\x2f\x56\x4d\xd9\x08\x27\x8f\x9d\xf4\xba\x56\x2a\x42\xe1\x30\x08\x50\xf0\xe8\xc0\x6a\xfa\x55\xe9\xd3\x7f\x85\x2a\x26\x14\x51\x10\x60\x67\x56\x85\xae\x25\xee\xdd\x30\x91\x7a\xb2\x33\x44\x95\x41\x4c\xed\x06\x56\xdf\xec\xe0\xdf\xe0\x88\xe2\x1e\xa2\xd2\xc8\xd9\xde\x1f\xfb\x50\x19\xf6\x11\x70\xba\xfa\x20\x16\x0b\x74\x14\xd3\x29\x21\x37\x3d\xf6\x8f\x3f\xce\x32\x86\xe4\x7a\x29\x24\x7c\xb6\x02\x37\x5a\x51\xef\xe1\x6e\x70\x35\xb8\xb8\x87\x07\x38\x41\x8d\x07\x46\xa6\x68\x24\xdb\x7d\xe5\x18\x10\xa7\xce\x4b\x5d\xde\xde\xbc\xa5\x20\x6c\x36\x93\x73\x01\xe9\x9d\x5f\x1b\x85\x7b\x31\x56\xfe\xd9\x90\xc3\x6b\xbe\xff\x73\x70\x3b\x80\xbd\x97\x16\x01\x90\x2f\x24\xf8\xd7\x35\x3c\x84\x34\x24\xec\x37\x0a\xde\xd5\x54\x4d\x03\x28\xa9\x39\x05\x3d\x78\x0d\xaf\x38\x67\x8e\x37\x68\xc9\x35\x18\x15\x81\x84\xf8\x9a\xa4\xd0\xd8\x5c\xb8\x76\xf7\xcd\x11\x34\x4a\x7d\x47\xba\xe1\xf9\x13\x99\x11\xa7\x16\x1f\x98\x58\x53\xeb\x26\xb1\x81\x23\x2b\xba\xf2\xc9\x3d\x81\x55\x89\x8d\x51\x4b\x1b\x47\x6c\xc2\x7e\x42\xed\x28\x81\x5f\x93\x16\x06\x2a\x14\x7f\xac\x62\xd6\x4f\x9c\xd3\x34\x4d\xe9\xc5\x92\xd9\x42\x36\x27\xe3\xd4\x31\x33\xd7\x10\xfd\xe3\xbe\x87\x4f\x46\x63\x87\x3e\x46\x4b\xfa\x47\x7d\xd0\x85\x72\xb1\xf9\xe9\x8b\x67\xb6\xdd\x8a\x45\xe1\x14\xc0\xf1\x68\x6b\x55\x51\xa6\x28\x9f\xcb\xf4\x1a\x9b\x34\xee\x39\x55\x26\x51\x9b\x5b\x5d\x95\x70\x82\xcc\xaf\xdd\xbb\x77\xa6\x98\x0b\xb3\xc6\xe1\xe3\x98\x10\x04\x1f\xe5\xaa\xb0\x95\x3d\xe7\x36\x4e\xbc\x71\x62\xca\xa9\x13\x60\x4c\xdc\xb9\x99\xd0\xb4\xa5\x30\xfa\x88\xe7\x0e\xcf\x14\x75\x4e\x3d\xd3\xdd\xa7\xfe\xcb\x1e\xbd\x88\x1a\xb8\x3d\xfe\x1e\x04\x9d\xf9\x08\x96\xe9\x85\x2a\xad\x8c\x59\xa0\x23\x3d\x1e\x0b\x26\x66\x78\x90\x80\xb4\x6b\x7c\x13\x33\x5f\x1c\x88\x39\x1e\xee\xf0\x0f\x8c\x61\x4f\x6d\xaf\xcf\xaf\x92\x27\x3b\x2d\xc3\x7d\x0b\x9d\x8f\x1c\xd1\xbd\x12\x22\x4d\x48\x93\x3e\x80\x5f\x00\x70\xd6\x95\x1b\x0f\x0a\x00\x00"
 
 func sqlite3ForeignkeyGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -682,7 +1063,47 @@ func sqlite3ForeignkeyGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _sqlite3IndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdd\x4e\x1b\x3d\x10\xbd\xb6\x9f\x62\x3e\xeb\x13\x6c\xda\xb0\xb9\x47\xca\x45\x0b\xa1\xad\x4a\xa1\x05\xaa\x22\x21\xd4\x38\xbb\xb3\xb0\xd2\xc6\xce\xda\x5e\x20\xb2\xfc\xee\xd5\xd8\xbb\x94\x9f\x08\x41\x6f\x1c\xdb\xe3\x99\x73\xe6\x9c\xc9\x7a\xbf\x03\xff\xdb\x6b\x6d\x1c\xec\x4e\x21\x8b\x3b\x25\x97\x08\xf9\xd9\x7a\x85\xf9\x11\x6d\x05\x1a\x23\x40\xd8\xb6\xb1\x8e\x36\xe5\x42\x80\x68\x05\x08\x83\x56\x80\x38\x3f\x3e\xd4\x57\x02\xf2\x83\x1a\x9b\xd2\x8e\x60\x27\x04\x1e\xcb\x3a\xb9\x68\x30\x95\x2d\xae\x71\x29\x21\x3f\xed\x7f\x63\xed\x33\x0a\xa7\x95\x60\x52\xe2\x64\x02\xde\x43\x7e\xd0\xa9\x22\x62\x87\x00\x06\x9d\xa9\xf1\x06\x2d\x48\x30\xfa\x16\x2a\xa3\x97\xb0\xed\xfd\x00\x10\xc2\x36\x48\x0a\x52\xe2\x5f\xd6\x21\xe4\x7c\x32\xa1\x82\x9f\x50\xa1\x91\x0e\xcb\x94\x5a\xab\x12\xef\x62\x81\xfc\x0b\x6d\xd3\xda\xe7\x6c\xe7\xbc\xea\x54\xf1\x94\x44\x56\x2e\xe0\xfc\x78\xff\xa3\xf7\x70\xa5\x57\xd2\xc8\x65\x53\x5b\x37\xf4\x0c\xce\x74\x98\x96\x10\x46\x90\x79\x0f\x75\x05\x4a\xbb\x7b\x04\xfb\x53\xd5\x6d\x0c\x5f\x5c\x7a\x0f\xa8\x4a\x08\xe1\xdd\x53\xc2\x63\x40\x63\xb4\x19\x81\xe7\xec\x46\x1a\x3a\xa5\x1b\xce\xd9\x64\x02\xb6\x6d\xa0\xed\xd0\xac\x39\x2b\xb4\xb2\x0e\x92\x23\x30\x85\xf9\xe9\xec\x70\xb6\x77\x06\x73\x78\xcf\x19\x9b\x7b\x0f\x85\x6e\xc8\x46\xdb\x03\xf4\x3c\x43\x18\x9e\x1c\x9c\x1c\x7f\x83\x87\x1a\x0e\x81\x5f\x9f\x67\x27\x33\x78\x50\x21\x22\xde\x77\x2a\xe0\xc3\xd1\x3e\x08\x08\x61\x9e\x48\x99\x4e\x0d\xa4\xe2\x20\x64\x89\xd4\x4b\x42\x55\xb2\xb1\x51\xa9\x38\x26\x75\xb5\x41\x25\xce\x88\x5b\x9a\xcb\x10\x68\x86\x9e\x6a\xe5\xe9\x49\xca\x8e\xd7\xdf\x4d\xbd\x94\x66\xfd\x15\xd7\x31\x9d\xfd\xc6\xbb\xda\x3a\xbb\x1b\x21\xc7\xb1\x1e\xa9\x4e\x33\xc6\x02\xe7\x8c\xb4\x9d\x42\xb9\xc8\x7f\x10\xf9\x13\x7d\xfb\x16\xe2\xf9\x69\x21\x15\xd9\x5c\x51\x74\x83\xd0\xd9\xca\xd4\xca\x81\xd8\x12\x7d\x17\xa3\xd8\x2f\xab\xab\x68\xea\x7f\x53\x50\x75\x43\x36\x33\x83\xae\x33\x8a\x8e\xd1\xfd\x44\xae\xbf\xdc\x7a\x28\xc2\x98\xde\x44\xc5\x30\xb1\xe0\xac\x8d\x29\xa4\xce\xd0\xc7\x9b\xd4\x7f\x1d\x1b\x56\x62\x85\x06\xda\x7c\xaf\xd1\x16\xb3\x51\xb2\xbd\xd1\xb2\x04\x83\xb6\x6b\x9c\x25\xbe\x96\x58\x5c\x5c\x3e\x1b\x69\x1f\x38\xab\x34\xa5\x1f\xe1\x9d\xcb\xe2\x68\xbf\xc6\xdb\x97\xcd\x7d\xe6\xee\x23\x7b\xa3\x84\xf1\x0f\x53\x48\xc5\x59\x6f\x75\xfb\xcf\xa6\x6d\xd0\xe9\xb9\x50\x09\x94\x84\x98\x82\x5c\xad\x50\x95\x99\x41\x3b\x7e\xec\xe1\xe8\x91\xbd\x31\x7e\x6f\x6a\xfc\x24\xf0\xc0\xf9\x9f\x00\x00\x00\xff\xff\xc3\x4f\x76\x7d\x93\x05\x00\x00"
+var _sqlite3GraphqlLoaderGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\x5b\x6f\x9b\x48\x14\x7e\x8e\x7f\xc5\xd4\xaa\x5a\xa8\x28\xe9\x73\x56\x79\xd8\x5e\xb2\x5b\x6d\x37\xad\xd2\xec\x45\x8a\xf2\x30\x86\x21\x46\xc6\xe0\x30\x60\x27\xb2\xf8\xef\xfb\x9d\x33\x03\x1e\xb0\x9d\x7a\xdb\x48\x5d\xa9\x1b\x09\x02\x33\xe7\x9c\xf9\xce\xed\x3b\x78\xbd\x7e\x29\x9e\x2e\x66\x37\xe2\xe4\x54\x84\x9f\x64\x34\x93\x37\x4a\xbc\x6c\x9a\xd1\x1a\x1b\x69\x22\xd4\xad\x08\x2f\xd5\x7c\x91\xc9\x4a\x89\xf1\x87\x8f\x3f\xbf\x7d\x77\xf1\x79\x2c\x20\x70\x7c\x2c\x3e\x14\x32\x56\xa5\x16\xb2\x54\xa2\x9a\x2a\x51\xaa\xdb\x5a\xe9\x4a\xe8\xa8\x58\xa8\x58\xc4\xb2\x92\x99\x11\x09\xc4\x44\x56\xd1\x34\xcd\x6f\x84\xcc\x63\x11\x49\xf3\x0c\x25\xb2\x93\x15\xc5\xac\x5e\x68\x31\x87\xac\x58\xa5\xd5\x94\xad\x69\x39\x57\x22\x2a\xf2\x4a\xdd\x55\xe1\xa8\xba\x5f\xa8\xee\x40\x5d\x95\x75\x54\x89\xf5\xe8\x28\x9e\x08\xfe\x5b\xaf\x8d\x1b\x4d\x13\xfe\xfd\xf1\xed\xeb\xd1\xd1\xbc\x36\x1b\xfa\x3e\x8f\xc2\xdf\x6b\x18\x19\x1d\x59\x30\x38\x67\x71\x05\x13\x40\x70\xfd\x62\x03\x32\x34\xd6\x47\xcd\x88\x30\xd9\x83\x7f\x53\xf7\x22\xd5\x8c\x87\x11\x14\x09\x3f\xdb\x5d\x31\x53\xf7\xda\xac\x41\x68\x61\xc2\x67\xb1\xba\x06\xf2\x6a\x64\xfc\xe4\xf3\x1d\x9b\x8e\x9d\xd6\xb4\xf5\x31\x1c\x61\x0f\xb1\x74\x74\x1c\x8b\xa7\xe2\x15\x5b\x7c\x57\x96\xe7\x45\x1b\x15\x6b\x53\x95\x65\x51\x22\x17\x55\x5d\xe6\xc8\xc2\x6a\xaa\x72\xb6\x42\x01\xe7\xe0\xca\xee\xd8\x6a\x2a\x2b\x31\x95\x5a\xe4\x85\x93\xcf\x70\xb4\x94\x65\xdf\xf4\xa9\xb1\xaa\xc3\x73\xb5\xf2\xc6\x16\xd3\x49\x67\xc7\x98\x68\xb1\x8e\x7d\xc6\x06\xd1\x37\x76\xdf\x80\xd1\x7c\xf2\x82\x3d\x8d\xaa\x3b\x03\x26\x57\xab\x2e\xad\xb5\x26\x8c\xf1\x24\x14\xef\x51\x44\xd3\xa2\xce\x62\x31\xe1\x0a\x89\x64\x96\xc1\x97\x22\x8f\x94\x58\xa8\xb2\xab\x34\x2f\x55\x01\xc2\x0b\xc3\xbf\x5e\x5e\x7e\x12\xf3\x34\x8e\x33\xb5\x42\x3d\xfa\x81\xd0\x85\xf1\xaf\x2c\x56\xa6\x44\xf3\xa2\x32\xb6\xa2\x29\x6c\xc9\xa8\x2c\xb4\x6e\x2d\xc1\xe9\xa4\xce\x23\x07\xb4\x47\x10\xdb\xf2\xb3\x6b\x01\xc0\x0d\x4b\xcd\x1f\x0a\x51\x59\x1a\x87\xbb\x9d\xbf\xe0\xe9\x9f\x32\xab\x15\x19\x0d\x9c\x9c\x06\xe2\x99\x75\x1e\x4a\x28\xe6\x13\x53\xb4\xf1\x24\xc0\x6b\x17\xe6\x07\xeb\x75\xdd\x40\xb6\xf1\x6d\xd9\x9e\x95\xc5\x7c\x18\x75\xa7\xaa\x6c\xe8\xad\xb3\x8e\xf0\x2e\x6f\x7d\xe1\xbd\xf8\xd0\xf5\x6f\x51\x64\x3e\xb9\x96\x05\xa2\x98\x11\x5d\x90\x1d\xe3\xd4\xc6\x1f\x3f\xec\x54\xfc\x2e\x0a\xac\x61\xf1\x91\x28\xdf\xb4\x98\x6d\x5a\x3d\x47\xab\xc7\x36\x2c\x83\x3e\xb0\x88\x03\x11\x95\x4a\x56\x3d\xd2\x60\xe9\xce\x06\x13\x8c\x60\xbf\xba\xda\x9f\xdc\x8b\x24\x37\x1d\x90\xa4\x25\xea\xa5\xd6\x2a\xb6\xce\x93\xfe\xee\x1c\x13\x1a\x61\xe2\x1d\x90\x3e\x89\x7b\x5b\x59\x77\x12\xf1\x9a\x8e\x3e\x83\x54\x00\xa7\xd0\xca\x08\x1c\x5a\x5e\x95\x89\x8c\x14\xd2\x63\x7a\xa7\x1f\xbc\x41\xe8\x11\x2c\x90\xed\x13\xec\x51\x1d\xd8\xb8\xe5\x29\xc4\xdd\x36\x44\x9e\x47\xb0\x11\xce\x6b\xe4\x3e\x9a\x79\xd0\xca\xe2\xd6\x62\x16\xda\x34\x5c\x11\xfe\xeb\x9e\x41\xf4\xd1\xa9\x0b\x18\x55\xce\x98\x55\x6c\x2c\x7b\x49\xee\x65\x61\x3c\xf1\x7d\x12\xee\x1b\x82\x66\x16\xd3\xc9\xe6\xe0\x3f\xf2\xcc\x1c\xbd\x49\x6f\xcc\x95\x68\x4a\x7b\xe6\x7b\x6d\x29\xce\x0c\xcd\x49\xe7\x60\x5e\x4b\x88\x9d\xe4\x2a\x68\x1b\x11\x49\x62\xba\xe7\x80\x23\x77\x8b\x52\x69\x95\x57\x48\x76\x91\x23\xfb\x64\x2a\x8d\x2d\xa9\x92\xfe\x86\xfc\xd3\x58\x08\x27\xd0\x40\x24\x57\xbd\x05\x03\xe4\xb3\xb1\xac\x61\x51\x27\xa9\x32\x0d\xe1\x44\xc3\x52\xb4\xd1\xb2\xd5\xe1\xd9\x44\x1a\x5d\xcf\x6f\xe1\x6d\x7a\x3b\x99\x57\xe1\xe7\x05\x16\x2b\x6f\x16\xa6\x71\xeb\xf5\x05\x20\x7c\xd5\x49\x50\xc4\x31\x0e\x7a\xe7\xac\x59\x08\xcf\x46\x66\x28\xab\x4c\x2b\x61\x07\xf4\x53\x0e\x0a\x8d\xee\x4b\x3c\x84\xe7\x54\xb8\xed\xf0\x7e\x0a\x02\x2d\x2b\xda\xf4\xf8\x89\xab\xda\x91\x1b\x23\x5f\x63\x31\x8e\x27\xb8\xa1\x40\x71\x47\xdc\xe9\x0e\xba\xc4\x3f\x9a\x6c\xf4\x0f\x57\x8a\x6b\x8e\x6b\x89\xab\xe0\x85\x98\x6f\xd8\xa7\x96\xf0\xbb\x23\x4b\x99\xe3\xeb\x21\x7c\x9f\xc7\xea\x0e\xce\xb7\x40\x10\x09\x14\x20\x01\x49\x69\x47\x84\x67\xb4\xa0\xc5\x2b\xbf\x13\xc1\x69\xad\x2b\xde\x6d\x2d\x33\x7e\xe1\x7e\x33\xda\x8c\xdb\xb7\x9f\x1d\x68\xc5\x90\xba\x8d\xdd\x68\x1a\x4b\x27\x14\x6a\x6a\x52\x56\xc5\x2a\xca\x2a\xad\x34\x2f\x19\x13\x56\xfc\xdb\x3f\x45\x8e\x8f\x49\xf2\x17\x95\xab\x12\x5f\x45\xb1\x48\xd0\xcb\xc2\xb8\xf6\x9c\xb0\xb1\xff\xe6\x6e\xcf\x7c\x6e\x73\x3d\x40\xbe\x9b\x7e\x50\xd5\x04\xba\x0d\x49\xd3\x10\x0f\x3b\xf4\xe3\x38\xe9\x52\xcb\x92\x5f\x98\x09\xba\x6e\x1c\x0f\x0e\x1c\x07\x64\x3a\x8b\x0c\x19\xba\x3b\x4c\x07\x01\x55\xe2\x3a\x8d\x09\x43\x63\x28\x89\x4c\x3e\x39\x25\x2a\xda\x62\x26\x6c\x19\x42\xb2\x8b\xcb\x70\x1f\x4c\x0c\x63\x68\xd8\x06\x79\x08\x40\x6f\x64\x39\x84\x8e\x19\x30\xf0\x64\x13\xd0\xbd\xc6\xbc\x5d\xb3\x7a\x17\x6b\xbb\x4d\x4d\x54\xbf\x33\x2b\xfc\xa5\xd7\x6f\x65\xed\x8b\xab\xde\x40\xbe\x50\xba\xce\x98\x99\x40\x4d\x9a\x52\x31\x97\x33\xe5\x5d\x5d\xf7\xf3\x89\x0f\x00\x95\x7b\x64\x90\xf9\x96\xf8\x30\xa5\xc9\x01\x79\xd3\x41\x7c\x16\x59\x21\x33\x57\x29\x51\xf0\x2c\x64\x7e\x08\xbd\x41\x69\x40\x88\x52\x70\xd4\x8e\x56\xea\x5e\xbc\xce\xbb\x62\xe8\x67\x84\x99\xba\xad\x3e\xbc\xc3\x55\x59\xd2\x26\xbe\x38\x90\x74\x4d\xb4\x8e\x58\xb8\xd8\xb7\x1d\xdc\x8d\x3f\x8d\x37\x0e\x90\xf7\x8c\x5f\xa3\x85\x90\xc4\x65\x3b\xa4\xe6\x57\x69\x7c\xfd\x93\xd9\x8b\xa4\x56\x4e\x7d\x9d\xd0\x1a\x9d\x6d\x1c\x7e\xb6\x75\xec\xfa\x1d\xd5\xfa\x09\xa9\x34\x9d\x3e\x26\xdc\xc1\x8a\xfa\x36\x0b\x79\x9c\x5e\x20\x48\x6c\x22\x56\x89\x84\xc0\x97\x2d\xbc\xc5\xca\x89\x58\xb2\x52\xd3\xc6\xdc\xd6\x0c\xf4\xa8\x0f\x88\xc9\x84\x02\xa3\xf4\xc9\xf0\xac\x28\x55\x7a\x93\x53\xb9\x74\x6c\xc7\x54\x4c\x54\xc7\xe3\xc3\xb6\x09\xf3\x93\x43\x71\x46\x6a\x48\x6f\x00\x94\x6c\x38\x1c\xbb\x52\xeb\x22\x4a\x99\x87\x3a\xba\x72\x5a\xef\xb9\x36\x9d\xe3\x32\xa0\xf0\x36\x4b\x6f\x8a\x8c\xae\x7a\x9e\xdb\x4d\x3f\xa0\xf3\xf7\x51\xe4\xd7\xf3\x63\x62\xe2\xc0\x13\x9c\x59\x72\x13\x18\xe7\x71\x9b\x2f\xdb\x30\xec\xee\x4a\x12\x30\x73\x0e\x6e\xed\x63\x9f\x2d\xfa\x1c\x06\xd1\xa1\x51\xfb\xb3\x17\x3f\x68\x3e\x95\x2a\x49\xdb\x61\xc5\xf3\x47\x8c\xa9\x82\xce\xeb\x2c\xe3\x1f\xc0\xfc\x81\xe5\x02\x08\xb7\x22\x4d\xdf\xc7\x44\xe7\x03\xe6\x24\x32\xa4\x12\xe2\x71\x6e\x2a\x66\x2f\x7d\xb7\xfe\xf7\xa9\xbb\x5d\x75\x68\x5b\x90\x35\x04\x68\x49\x9f\xfe\x16\x93\x41\xc3\xfe\x9a\x27\xf2\x75\x03\x19\x73\x5b\x3c\x06\xcf\x0f\xe3\xb9\x8f\xef\x7b\xa8\x1f\xe6\xfa\x56\x74\x9b\xe7\x7b\x46\xbe\x2b\xc7\xf3\x0f\x4b\x87\x28\xf7\xcd\xe8\x83\xd9\x9e\x0c\x0e\xe8\x7e\x5f\x4d\x3f\x26\xef\x93\xee\x37\x10\x7f\xaf\x07\x3b\xaf\x38\x38\x7b\x66\x00\x01\xf8\x62\xa1\xfe\x58\x63\xe2\x42\x2d\x0f\x9f\x14\x10\x3e\x6c\x58\xb8\x4d\xa9\x0f\x1c\x15\x6d\x0a\xfa\xd3\xa2\x5b\xfd\x31\x06\xc6\x56\x37\x87\x7b\xe6\xc5\x23\xf1\x76\x7f\x86\x0c\x53\xf0\x28\x9c\xfd\xb0\x4b\xff\x53\xf6\x7f\x8e\xb2\xd1\xe5\xdf\x91\xb5\xb7\xeb\xeb\x5f\xb1\xee\x11\x65\x2a\xcd\x6b\xd5\x52\xe1\x01\xbc\xc9\x83\xe1\xe1\x4e\xb8\x3e\x90\x55\xed\xe3\x3f\x63\x37\x07\x56\xde\x18\x00\x00"
+
+func sqlite3GraphqlLoaderGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlite3GraphqlLoaderGoTpl,
+		"sqlite3.graphql.loader.go.tpl",
+	)
+}
+
+func sqlite3GraphqlLoaderGoTpl() (*asset, error) {
+	bytes, err := sqlite3GraphqlLoaderGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sqlite3.graphql.loader.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _sqlite3HasmanyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x55\x4d\x6f\xdb\x46\x10\x3d\x93\xbf\x62\x42\x14\xb1\x98\xd0\x4c\x7b\x55\xc1\x1e\xea\xca\x6d\x51\xc7\x6e\x6d\xa3\x0d\x20\x08\xd1\x8a\x5a\x4a\x44\xa8\xa5\xb4\x4b\x39\x16\x04\xfe\xf7\xce\xcc\xf2\x4b\x16\x0b\x5b\x46\x0e\x3d\x88\x22\xf7\x63\xe6\xcd\xdb\x37\x6f\xf7\xfb\x73\xf8\xce\x2c\x73\x5d\xc0\x30\x82\x01\xbf\x29\xb1\x92\x10\xde\xca\xe4\x7e\xb7\x96\xe1\x35\x7d\x79\x52\x6b\x0f\x3c\xb3\xc9\x4c\x41\x2f\xf3\x19\x3e\x36\xf8\xd3\xd2\xe0\xf3\xd3\xcd\x55\xbe\xf0\x7c\x38\x2f\x4b\x77\x4f\x11\x75\x6f\xc8\x13\xe3\x55\xc0\x3a\x61\x0b\x31\xcb\xa4\x8d\x1a\x2f\xe5\x4a\x40\x78\x57\xfd\x73\xe8\x7b\x9a\xb6\x4f\xca\xd2\xd9\x98\x9d\x5e\xe2\x66\x2b\xf5\xee\x18\xda\x17\xb9\xab\xfe\x68\xb1\x94\x0a\xff\x66\xa2\x88\x97\x7d\x34\xc4\x27\xd2\xf0\xea\x9c\x75\x85\xdd\x23\xc8\xbf\x1a\xce\xbc\xd6\xa9\x2a\x1a\x0a\x3c\xd3\x05\x88\x21\x0b\x44\xc3\xeb\xb4\xe4\x57\x62\xe5\x32\x95\xd9\x9c\x71\xda\xb5\x1f\x3e\xc0\x7e\x4f\x33\x0f\x0c\xbb\x2c\x01\x17\x6f\xb5\x32\x50\x2c\x25\x4f\xb5\x35\x95\xa5\x01\x61\x4c\x1e\xa7\xa2\x90\x73\xf8\x9a\x16\xcb\x66\xd5\x01\xe1\x65\x79\x66\xea\x51\x9b\xb0\x0e\x3e\x38\x18\xbd\xc8\x33\xfa\x6d\x57\xaa\x9a\xf7\x43\x04\x44\x98\x7e\x95\x4a\x6a\xce\x92\xe8\x7c\x05\x49\xae\x65\xba\x50\x80\x45\xc1\x19\x85\xb8\xb4\x03\x7f\xc8\x5d\xe7\xb5\xce\x1d\xba\xc9\x56\xc5\x9c\xab\x6a\x00\xcc\xfc\xae\x07\xa5\xff\xa4\x76\xda\x11\x17\x8f\x6b\xa1\xc5\x0a\x3f\xe7\x33\xf8\x74\xf3\xcb\xcf\x3e\x0c\xc6\x93\x77\x4f\xa9\x08\x00\x4f\x38\xd7\x18\xc2\x75\x1e\x84\xa6\x2f\x3b\xe2\xba\x0e\x16\x80\x07\x0f\x7c\xe6\xae\x13\xe7\xca\x14\x60\x95\x00\x11\x4c\xef\x46\x57\xa3\x8b\x7b\x98\xc2\x7b\xd7\x71\xa6\x94\x32\xcf\x48\x3e\xa6\x4a\xc0\xdc\x18\xc2\x5c\x2d\xb9\xbc\xbd\xf9\x48\x48\xab\x26\x69\x27\xfe\xf9\x6d\x74\x3b\x82\x36\x02\x84\x0d\xaf\xb4\x2a\xa2\x29\x55\x2c\x6d\x3d\xdf\xe3\xd0\xd4\x82\xd3\x5b\x55\x83\x63\x91\x0d\x2c\xb8\x00\xba\x8c\x85\x7d\x07\xe8\xbb\xce\x86\x2b\x27\x5d\xcd\x67\xa1\x25\x2c\x51\xe0\xfd\x65\x05\xde\x90\x28\xf4\x82\x04\x73\x42\xe0\x34\xe1\xc0\x6f\x22\x50\x69\x46\xb4\x3a\x56\x8a\xf4\xc9\x39\x5d\xa7\x74\x9d\xb9\x4c\xa4\x86\x4d\x78\x91\xe5\x46\x0e\x7c\x5b\x50\x96\x8b\x39\x0a\xd7\x6c\xb3\xc2\xb8\xb8\x8d\xdb\xa3\xe7\xd0\xf6\x18\x00\xb5\x84\xdb\xaf\xe5\x63\x31\xe0\xc3\x73\x08\x9b\x6e\x74\x32\x8c\x8e\x54\x6f\x17\x9d\x03\x02\xb4\xe3\x7f\xea\x74\x25\xf4\x0e\x25\x87\xb3\x38\xe9\x7c\x96\x8f\xa9\x29\xcc\x10\x0a\xbd\x95\x81\x8d\x29\xd5\x9c\x3b\xcc\x41\xd4\xf8\x20\x4d\xc4\x42\xe1\x1b\x15\x19\x21\x84\x3b\xfc\x24\xb2\x12\xe2\xa1\x47\x00\x55\x7b\x7b\x6f\xbd\x1a\x9f\x6f\x89\xea\x61\xea\x98\x2a\x9b\x95\xa8\x88\x40\xac\xd7\x88\x06\x6d\xc0\x04\xf0\xf6\xa0\x5c\xdf\xe5\x75\xd5\x6e\x5e\x80\x21\x5c\x1c\x6b\x8d\xe1\x0a\xc9\xfd\xa6\xe6\x60\xce\x5e\x67\x0e\x01\x81\x42\x0b\xc0\xf0\xb3\x5d\x6f\x88\x00\x52\x05\x02\x4c\xaa\x16\xd8\x28\x2c\x71\x58\xa3\x5c\xd8\x52\x21\x4f\x68\xb7\xf9\x96\x1e\x73\x4c\x51\xbf\x87\xd8\x0e\x60\xe3\xc6\x42\x2b\x65\x1e\xb9\xd1\x60\x25\xd6\x63\x5a\x58\x9b\x77\x59\x4e\x9e\xb1\x1e\xac\x03\x9b\x3f\x93\x71\xc1\xb5\x59\x2f\xa2\x37\x4c\x82\xea\x91\x3a\x11\xb1\x24\xd9\xd3\xe5\x42\xe2\xee\x49\x31\xcb\xf3\xac\xee\x8c\xcf\x16\x69\xd6\xed\x07\x2d\xd4\x42\x1e\x14\xb0\xb7\x22\x24\xaa\x6c\xbf\xb4\x1b\x7a\xbb\xfb\x47\x78\x43\xf9\xc7\xb8\x61\x62\xf5\xda\x7e\x46\xdc\x34\x34\xc6\xb0\x1b\xb5\xd2\x57\x40\x29\x7c\x16\x33\xeb\xf4\x7f\x62\xac\xbf\x5f\xc3\xd4\x6d\x7c\xe6\x65\x87\x56\x13\x9c\x49\xc5\xa5\xf9\xf0\x13\x5a\x32\x71\x61\xc5\x89\x81\xec\x01\x12\xb1\xb4\x88\x87\x69\x15\x46\x5a\x09\x2b\xa8\x86\xfc\x6a\x53\x64\x95\x3d\x1e\x3e\x59\x33\xe9\xb8\x4e\xc7\xec\x1d\xdb\x11\x98\xaa\x22\xed\x3d\x3c\xe6\x7f\x8b\x6c\x2b\xcd\xc0\xe3\x10\xe6\x0b\xee\xf6\x02\xf8\x21\xe8\x60\xa0\x03\xb0\xf7\x04\xef\x0f\x6c\xd2\x30\x0c\x69\xe2\xa4\xeb\xa0\x67\xff\xcb\xbd\xec\xc8\xe7\x8f\xad\x9c\xbd\x3c\x7e\xce\xcb\x9f\x31\xf3\x63\x37\x3f\xb4\x73\x8b\xa6\x63\xe8\xaf\x72\xf4\xf8\xc0\xd1\xfb\x68\x70\xda\x4b\xce\xe9\x65\xa5\x02\xd2\x76\x21\x36\xc4\x03\x92\xd1\x50\x60\xf3\xb6\xed\x58\x15\x88\x04\xd6\xad\xd7\xde\x0c\x3c\x52\x5d\x0f\x71\xe7\x7a\xa0\xd6\x6b\x0a\x1c\x69\xcd\x68\xba\xc8\x5e\x7e\x80\x55\x7f\xd3\xdf\xb8\x55\xd7\x70\xf2\x9f\x77\xd0\xbf\xdc\x8e\x6c\x6a\xc3\x0c\x00\x00"
+
+func sqlite3HasmanyGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlite3HasmanyGoTpl,
+		"sqlite3.hasmany.go.tpl",
+	)
+}
+
+func sqlite3HasmanyGoTpl() (*asset, error) {
+	bytes, err := sqlite3HasmanyGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sqlite3.hasmany.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _sqlite3IndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x58\xdb\x6e\xdb\x46\x10\x7d\xa6\xbe\x62\x42\x14\x31\x15\x33\x4c\xf2\x56\xa8\x71\x81\xc6\x91\x6b\xa3\x8e\x9d\xda\x6e\x93\x40\x30\xe2\xb5\xb4\xb2\x08\x53\xa4\xb4\x4b\xf9\x02\x81\xff\xde\xb9\x90\x14\x29\xca\xaa\x6c\x03\x4d\x1f\x44\x91\xcb\xdd\xb9\x9c\x99\x3d\x33\xcb\xf9\xfc\x35\xfc\x64\x47\x89\x49\xa1\xb3\x03\x1e\xdf\xc5\x6a\xac\x21\x38\xbb\x9f\xe8\xe0\x88\x6e\x5d\x6d\x8c\x0b\xae\x9d\x46\x36\xa5\x9b\xc1\x25\x5e\xa6\xf8\x33\xda\xe2\xf5\xeb\xf1\x61\x72\x45\x23\x33\x6d\xee\xf1\xff\x5a\xdf\x5b\xf9\xc3\xeb\xa5\x4a\xfb\x23\xfc\xbf\x51\x11\x0d\x4e\x94\x51\x63\xb9\xb9\xd2\xf8\xa7\x86\xa9\x26\x91\xc3\xd0\xd8\xd4\x85\x60\x2f\xd4\xd1\xc0\xb6\xe1\x75\x96\xb5\xe6\x64\x5a\xaa\x2e\x23\x2d\xa6\xf5\x47\x7a\xac\x20\x38\xcd\xff\xd9\xbe\x33\x7a\x2d\x57\x32\x55\x16\xbe\x79\x03\xf3\x39\xca\x9a\xc5\x7d\xb6\x3f\xcb\xc0\xe8\xd4\x84\xfa\x46\x5b\x50\x60\x92\x5b\x18\x9a\x64\x0c\x5b\x38\x2b\x57\x90\x65\x5b\xa0\xe8\x25\x2d\x5c\x78\x9e\x65\x01\x4a\x23\x81\xbf\xeb\x58\x1b\x95\xea\x81\x2c\x0d\xe3\x81\xbe\x63\x01\xc1\x01\xdd\xca\x35\x5f\xb3\x15\xb4\x86\xa8\x7b\xd9\x08\x0f\x9f\xfb\xe9\x1d\x43\x80\x8f\x83\x4b\xf8\x7a\xfc\xf1\x03\x0e\x5e\x25\x3c\x16\x85\x36\x2d\x10\x80\xd4\xcc\xb4\x5c\xb2\xac\x0d\xb4\x34\x1c\x42\x9c\xa4\xa5\x3e\xfb\x57\x1c\x4e\xf9\x75\xef\x1c\xdf\xea\x78\x80\xb7\xaf\x96\xcd\xf7\x01\x63\x97\x98\x36\xcc\x5b\xce\x8d\x32\xf4\x24\x23\xad\x96\x83\x5e\x61\x48\x81\xc3\xd6\x72\xfa\x49\x8c\xea\x25\xc6\xb0\x03\x17\xa7\xdd\xc3\xee\xee\x19\x5c\xc0\x76\xcb\x71\x2e\xc8\xf4\x24\xa2\xc4\xb0\xb9\x82\xdc\x4e\xc4\x36\x9f\xb2\x77\x72\xfc\x09\xaa\x88\x16\x2f\xbe\xec\x77\x4f\xba\x50\x91\xc0\x1a\x4b\x4f\x5d\xf8\xed\xe8\x23\x5e\xb3\xec\x42\x8c\x32\xb3\xb8\x30\x8a\x53\xcb\x13\xa3\xd6\x01\x35\xc4\xec\x62\xa4\x38\x69\x10\xa9\x26\x4a\x2d\x87\x6c\x93\x4c\x47\xdb\x30\xa3\x96\xb1\x9a\xd3\x14\x59\xcd\xc3\x9f\x4d\x38\x56\xe6\xfe\x0f\x7d\xcf\xcb\x9d\xef\xfa\x0e\x15\xdb\x0e\xab\xf4\x59\x1e\xa1\x4e\x19\xe7\x64\x68\x3a\x61\xbb\x03\x83\xcb\x40\xe2\x3c\x8c\xc1\xfd\x93\xdc\x38\x49\x6e\xdd\x45\xf8\x95\xb9\xc2\x87\x47\xb8\x84\x09\xaf\x62\x5a\x3c\xa4\xb7\x2b\x42\xe0\x4d\x4c\x18\xa7\xe0\xbe\x74\x73\xff\xda\x8c\x84\x83\x8e\x90\x49\x2f\x76\x20\x0e\x23\x4a\x00\x07\x77\xc1\xcc\xc4\xf4\xc8\x79\x21\x66\xe7\x83\x2f\xab\xf0\xf8\x34\x87\xb1\xd4\x62\x45\xcb\x99\xf2\x12\xc2\xad\xe9\xe1\x73\xdc\xdb\xd0\x4e\x67\xa0\x87\xda\xc0\x34\xd8\x8d\x12\xab\xbd\xb6\xa4\x4a\x94\xa8\x01\xee\x6d\x3b\x8b\x52\x4b\x9e\x58\xb2\xaf\x77\xde\xd8\x06\x73\x14\x30\x4c\x68\xf9\x91\xbe\x4b\x3d\xde\x0e\x9b\xe4\xc3\xfa\x84\x68\x64\x44\x2d\x25\x18\x5c\xde\x64\x18\x3f\xbc\x93\xf4\x98\x3e\x39\x9c\x2b\x70\x6a\x02\x25\x4a\x09\x88\x1d\x50\x93\x09\x1a\xe3\xe1\x83\x5f\x8f\x6e\xbb\x16\x78\x7e\x5f\x86\x9b\x69\xa4\x95\x95\xfb\xe8\x10\x11\xce\xf1\x10\x42\x66\xb3\x99\x90\x85\x03\x0b\xb3\xdf\xb6\xcb\x29\xc8\xfc\x29\x3a\xc4\x93\x50\x09\xdd\xca\x32\xf6\x93\xe7\x15\x1c\x5d\x11\x5f\xe1\xe8\x74\xa4\x89\xa5\xed\x43\x34\xbd\x1c\x29\xeb\x93\x3c\x54\x8b\xf4\x7c\x79\xcf\x24\x24\xfa\x4a\x16\x0c\x63\xe4\x76\x1b\xc6\x57\x28\x44\xe8\x67\x82\xd9\xc4\xc5\x09\x92\x21\x2d\xb5\xcf\x65\xfa\x8a\x2b\xab\x99\xde\x67\x35\xc0\x74\x5d\x62\xc4\xec\x3e\x56\x93\x5e\x7d\xb0\x99\xc2\x55\x26\xff\x51\xcc\x5d\xc0\xba\x9b\x44\x34\xeb\xe0\x08\x2e\x5a\xe5\xb6\xdb\xc4\x89\x62\x1f\x46\x3a\xf6\x08\x8c\x36\xfc\x0a\x6f\x39\x95\x25\x14\x28\x86\x86\x25\xd9\x69\x12\x0f\xd3\x2c\x94\x34\x56\x82\x28\x9b\xce\xe9\x2f\x8b\x76\x24\x8e\xbd\xce\xd2\x9c\xf3\xca\x1e\x64\xa2\xc0\x1e\x64\xa6\x49\x38\x35\x23\x62\xf2\xb5\xf6\x7a\xe7\xb8\xdd\xb4\x19\xaa\xbe\x9e\x23\xca\x0b\xad\xb4\xe7\xc8\xd8\x90\x03\x47\xf3\x8d\x8a\xaf\x74\x9e\x35\x6c\x00\x09\xea\x85\xe7\xc0\x66\x57\xd4\x55\x4a\x98\x23\xe9\x86\xab\xf3\x08\x6d\xc3\x5d\xf2\x37\x5b\xe2\xb9\x6c\xb1\xbd\x46\x63\x5d\x1f\xde\x2d\x2b\x97\xea\xc7\xeb\x7d\x32\xde\x06\x41\x40\xe3\x8f\xa2\xe2\xe6\xf2\xcd\x79\xa4\xc1\xb0\x4d\x12\xdd\x88\x45\xff\x85\x46\x9b\x3c\x5a\x27\x52\x31\xa6\x42\xa5\xcf\xe7\xd2\x55\x20\x38\x8b\xe2\xe2\xac\xc4\x24\xb7\x03\xd1\xe8\x55\xbd\x0e\x1a\x7c\x43\x09\x51\x63\x5c\x06\x74\x61\x76\xd7\x18\x56\x52\x55\xb8\x79\x54\x98\x45\x64\xa3\xf4\x16\x09\xd3\x39\x5f\xcd\xea\x59\x95\xd7\x8b\x38\x7c\xc6\xee\xbb\xc6\xea\x93\x6b\x8a\xdd\xaa\xf8\xf0\xeb\x28\x1c\x87\x7c\x40\x70\x0f\x0f\x3e\x1d\x9c\x71\xb7\x26\x6f\x98\xa6\xe9\x85\x5b\x91\x8f\x49\xe2\xe9\x29\xe7\x8e\x36\x4c\x06\xee\xd8\x62\xfa\xbb\xed\xc6\x78\xd2\x0f\x7f\x5e\x35\x6c\x94\xdb\x5e\x52\x8f\x4a\x8e\xf7\xf6\x4e\xbb\x67\x48\x19\x27\xc7\x5f\x4e\x61\xaf\x7b\xb6\xbb\x0f\x47\xdd\xaf\x0d\x83\x70\xaa\x4c\x39\x3e\x3a\xfc\x56\xbe\xcb\x41\x28\x6a\x4f\x05\x84\xda\xf9\x80\x8e\x26\x54\x14\x54\x0a\xe3\x04\x99\x95\xcf\x26\xeb\xea\x51\x21\xb0\x5e\x92\x10\x05\x74\x67\x51\x91\x26\xd7\x0b\x2a\x1f\x26\x51\x94\xdc\x62\x39\x2a\x4a\x1d\xe9\xa3\xdb\xfe\xcc\x58\x44\x8f\x8f\x45\xe0\x61\x9b\x8e\xa2\x59\x29\xbd\x2c\x0d\x81\xdb\x91\x8e\x41\x8f\x27\xe9\x7d\xdb\x07\x15\x25\x28\xe8\x36\x4c\x47\x3c\x8b\xcc\xdf\xb2\xf4\x17\xc6\x2a\x0d\x93\x98\x64\x84\x31\xee\xdc\x31\x3f\x3e\xb7\xce\x55\x60\x7b\xf2\x89\xc6\xcf\x3d\xdc\x65\x77\xfd\xdc\x33\xdc\xaa\x98\x09\x2b\xda\x37\x1f\x5e\x91\xd6\x03\x74\x62\xdd\x81\x06\x33\x4f\x04\xbd\xcf\x8b\x4a\x75\x03\x15\xbb\x28\x31\x16\xf9\xeb\xd6\x93\x23\x27\x20\x81\xd0\xa9\xea\x52\x43\xac\xaf\x10\x9f\x1b\xed\xe6\xed\x51\xbd\xc8\xe6\xe4\xdd\xf9\x51\x27\x23\x27\x2f\x6b\xdc\xdf\x56\x0a\xd6\x1c\x28\xb3\x57\xe1\x2d\x1d\xb6\x5c\x91\x47\x81\x7c\xe2\xd4\x46\x11\x2f\x0b\x38\xa9\x20\x23\x6c\x12\x8d\x17\xbc\x8d\xe7\x5c\x20\x0d\xd7\xbc\x3c\x6f\xcf\xa4\x9e\x97\x0c\xc6\xd3\x83\x81\xee\x27\x83\xa5\xfa\xf9\x08\x73\xb0\x29\x45\x15\x1b\x50\x5f\x8d\xff\x9c\x22\x12\xdb\x18\x09\xc6\xa7\xda\x9e\xa0\xad\x79\x6f\xc2\x1d\x43\x9c\x8e\x24\x37\x3d\xe4\xc9\xc5\xc7\x05\xc6\xb3\x00\xb4\xec\x93\xe5\x99\x6b\x7d\xbb\xe8\xdc\x8b\xf8\x17\xdb\x73\x62\xf4\x4d\x98\xcc\x2c\xb3\x01\xce\x91\xd6\x8b\x46\xeb\xbd\xd7\x6a\x9b\x24\xea\x0f\xe7\xc3\x23\x12\x62\x8d\xe7\xef\x77\xd6\xba\x5e\xea\x3a\x3e\xf9\xd8\x3d\x81\x0f\xdf\x1e\x10\x43\xf6\x09\xef\x66\xd9\x3b\x7a\x60\x02\x94\x43\x3a\x61\xd3\x1f\xe9\xfe\x35\x50\x3f\xb0\x0c\x8a\xb4\x2d\x39\x28\x3e\x08\xae\x79\xef\xf1\x98\x33\xf2\x0a\x09\x52\xf0\x8b\xbc\xb1\xc8\x79\x79\x27\xd6\x57\x98\x56\x2c\x9c\xfb\x2c\xaa\xb0\x47\x09\x0a\xb5\x9d\xea\x4b\xc9\xb0\xce\x9a\x04\xc3\x53\xa6\xc2\x56\x87\xa7\xd0\x66\x09\xf6\x95\xfd\x9c\xfb\x47\x7b\x06\x8d\x27\x0a\x93\x54\xcc\x59\xa2\xa8\x1d\xa0\x90\x93\xef\x52\xc3\x5f\x98\x7c\x18\x68\xdc\x15\xe3\x30\x26\x96\x0f\x39\x7d\x8c\x86\x90\x0a\x4c\x8c\xb3\x98\xa6\x5b\xb5\x64\x7e\x44\x40\x30\x86\x80\xeb\x30\xfd\x6e\x82\x83\x34\x51\x1e\x93\xd9\xf6\xbb\x76\xf9\x09\xa3\x08\x17\x4e\xbc\xa8\x46\xaf\xfc\x54\xb4\xf6\x5b\x4b\x3d\x6a\x4f\x38\xfa\xd7\x05\xac\x3f\xe1\xff\x0f\x8e\xf9\xcd\x0e\xf4\x3f\x3e\xbc\x3f\xe5\x04\x5f\xc8\x2c\x3b\xca\x5f\x36\x45\xb9\x55\x1c\xaf\x50\x32\x1d\xae\xa4\x14\xce\x4b\xbd\xd4\xe1\x76\x78\xf0\xbc\xb5\xd8\x07\x84\x69\x6d\x0f\xe4\x68\x7f\xf7\x61\x19\x63\x39\x28\x91\xb0\x79\x21\x40\x4a\x7d\x8d\x6f\x17\xa3\x3e\x9e\x89\xe4\xd6\x7b\xa0\x7b\xa8\x96\x0f\x6a\x08\x1a\x0d\xf8\xa2\xbd\x6a\xaf\xf8\xbe\x41\xca\x56\xf4\xc3\xff\x00\x73\x8a\xf2\xde\xfe\x16\x00\x00"
 
 func sqlite3IndexGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -702,7 +1123,27 @@ func sqlite3IndexGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _sqlite3QueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x5f\x6b\xdb\x3e\x14\x7d\xb6\x3e\xc5\xfd\x89\x50\xec\xdf\x5c\xe7\xbd\xe0\x97\x75\x0c\x06\xa3\xd9\xbf\x87\x42\x29\x4c\x8d\xe5\x4c\xa0\x48\xb1\x24\x77\x0d\x42\xdf\x7d\x5c\xc9\x76\xec\xa6\x65\xa3\x6f\xca\xf1\xfd\x73\xce\x3d\xf7\xc6\xfb\x4b\x58\xd9\x5f\xda\x38\xb8\xaa\x21\x8f\x2f\xc5\xf6\x1c\xaa\x1f\xc7\x03\xaf\x6e\xf0\x49\xb9\x31\x14\xa8\xed\xa4\x75\xf8\x68\x1e\x28\xd0\x8e\x02\x35\xdc\x52\xa0\xb7\x9b\xcf\x7a\x47\xa1\xfa\xda\x73\x73\xfc\xc2\x0c\xdb\xdb\x02\x2e\x43\x20\xb1\x76\x87\xe8\xb5\xde\xef\xb9\x72\x16\x7b\xa4\xb8\x09\x19\x03\x45\x0b\xd5\x00\x46\x6c\xbd\x06\xef\x4f\xd0\x10\xc5\xa5\xe5\xf3\xcf\x91\x5f\x08\x60\x7a\x65\x81\xc1\xb6\xb7\x4e\xef\x21\xf6\x2c\xc1\x70\xd7\x1b\x25\xd4\x0e\x0c\xb7\xbd\x74\x16\x98\x8d\x59\x27\x69\x21\x54\xa9\xae\x6a\xb0\x45\xdb\xab\xed\xa2\x6e\xde\x3c\xc0\xed\xe6\xc3\x7b\xef\xc1\x30\xb5\xe3\x0b\x95\x10\x42\xb9\x88\x1e\x6b\x43\x08\xde\x0f\x35\x0b\xc8\xbd\x47\x75\x4a\x3b\xa8\x36\x4a\x1e\x37\x0a\x03\xee\xee\xa7\x90\xff\x9f\x73\x2a\x81\x1b\xa3\x4d\x01\x9e\x64\x8f\xcc\xe0\xaf\x84\x10\x92\xad\xd7\x60\x3b\x99\x24\x92\x2c\x95\xae\x3e\x29\xc7\xcd\x41\x4b\xe6\x30\xfd\x91\x19\xac\x8d\xa3\x0a\x61\xab\x95\x75\x53\x2b\x48\x26\x42\x0d\x93\xa2\x95\x28\x61\x25\x4f\xce\x24\xf2\xa2\x85\x95\xc0\x84\x77\x53\x6e\x42\x73\xa1\x1a\xfe\xf4\xdc\xd7\x95\x28\x30\x38\xb9\xf2\x4a\xc4\x7c\x2a\xb3\x0e\x28\x02\xc1\xcb\x10\x7e\x7a\x8f\x54\xd2\x63\xb0\x24\x2a\x36\xbd\x1a\x15\xc7\x6d\xcb\x93\x8c\xd7\x5c\x99\x0d\x7c\x39\x99\x85\x5d\x73\x32\x83\x57\xd3\x26\x9e\x7c\x4a\x0e\x20\xb1\x74\x25\x33\x9b\xc7\x42\x24\x43\x83\x6a\x68\x1e\x12\x8f\x6f\xfa\xf7\x5f\x08\xbe\xcc\xa3\xa8\xbe\x6f\x99\xc2\x75\x69\x05\x97\x0d\x9e\xa1\x1d\x3a\x7d\x44\xc0\x42\x7e\x30\x42\x39\xa0\x17\x74\xa0\x53\x44\xd6\x99\x68\xe3\x8e\xfc\x57\x83\x12\x12\xb7\x26\x4b\xbb\x8f\x3f\xe3\x32\x91\x0c\x27\x39\x80\x17\x73\x35\x25\xc6\x9c\x6e\x0b\xd5\x74\x31\x05\x37\x62\x54\xf4\x36\x39\xff\xc8\x2b\x6b\x78\xcb\x0d\x74\xd5\xb5\xd4\x96\xe7\x45\xb2\x5c\x6a\xd6\x8c\x77\x8b\xcc\xe3\x7f\xc7\xdd\xfd\xd9\xad\xf8\x40\xb2\x56\x63\xfa\x0d\x7f\x72\x79\xbc\x99\x6c\x61\xd7\x55\x7d\xe6\x98\xc7\x69\xc4\x53\xda\x32\x45\xb2\xc1\xbf\xee\xcd\xf3\x7f\x41\xe8\xb9\xd2\x68\x41\x54\x52\x03\x3b\x1c\xb8\x6a\x72\xc3\x6d\xb9\xb4\xa3\x58\x38\x15\xbf\x4f\xfe\xa4\x83\x08\x84\xfc\x09\x00\x00\xff\xff\xb6\xe2\x6b\x23\xb5\x05\x00\x00"
+var _sqlite3ManytomanyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x55\x4d\x6f\xda\x40\x10\x3d\xdb\xbf\x62\x6a\x55\x8d\x9d\x12\xa7\xb9\x46\xe2\x90\x26\xd0\x26\x4d\xa1\x05\xda\x46\xaa\xaa\x60\xf0\x1a\x4c\x8d\x17\x76\x4d\x0a\x42\xfe\xef\x9d\xd9\x35\x0e\x36\x4e\x9a\x8f\x43\xd4\x03\x66\x3f\x66\xde\xcc\xbe\x7d\x33\xbb\x5e\x1f\xc0\x6b\x39\xe6\x22\x81\xe3\x3a\xd8\x6a\x14\x7b\x53\x06\x6e\x6f\x35\x63\x6e\x8b\x86\x16\x13\xc2\x02\x4b\xce\x23\x99\xd0\xc0\x1f\xe0\x67\x8e\x3f\xc1\x24\x7e\xaf\xda\x97\x7c\x64\x39\x70\x90\xa6\xe6\x9a\xe0\x44\x25\x5e\x87\x05\x8f\x84\xcc\x12\xdb\x42\x0e\x42\x16\xf9\x04\xec\x36\xb9\x60\xe1\x28\xfe\xc4\x56\x04\xdc\x54\xeb\xb9\xd9\x84\x87\x71\xb3\xca\xb4\x64\x27\x36\x9e\x64\x46\x30\xf7\x81\xa2\xf1\x45\x01\xb7\xe8\x50\xb2\x4e\xbc\x41\xc4\x34\x05\xc3\x31\x9b\x7a\xe0\x76\xb3\xff\x0d\x0f\x3d\xb2\xd0\x5f\xa2\xc4\x29\xa6\xdf\xbb\xd3\x9f\x92\xb8\x1b\xe0\xf0\x10\xd6\x6b\xd0\x24\xa7\x29\x08\x96\x2c\x44\x2c\x21\x19\x33\xb5\x5e\xb8\x84\x34\x95\xe0\x49\xc9\x87\xa1\x97\x30\x1f\xfe\x84\xc9\x38\x37\xdc\xb6\xc2\x45\xc1\x17\xa3\x31\xec\xe1\xce\x56\x76\x69\xba\xe7\x62\x40\x8a\xf9\x81\xc5\x4c\x28\x94\x40\xf0\x29\x04\x9a\x17\xf8\xcd\x56\x52\x79\x15\x2e\xa1\x6a\x98\x85\xda\x03\x2f\xf6\xb5\x47\x89\xde\xfb\x9c\x5c\x33\x58\xc4\x43\xb0\x29\x3d\xad\x3d\x4c\x7a\xbf\x7c\x0c\x67\x9b\x19\xb2\x1d\x26\xcb\x99\x27\xbc\x29\x4e\xfd\x01\x5c\xb5\xcf\xde\x3b\x60\xff\xfc\xb5\x5f\x41\x54\x0d\x50\xb0\x5c\x20\x84\x69\xdc\x78\x82\x66\x7a\xc5\x34\x0d\x3c\x3e\xea\x18\xe6\x0b\x26\x56\xa6\x31\xe4\xb1\x4c\x40\x0b\x1b\xea\xd0\xef\x36\x2e\x1b\xa7\x3d\xe8\xc3\x5b\xd3\x30\xfa\x14\x95\x47\x33\xd4\x52\xb8\xa4\xb2\x90\xb7\x91\x94\x80\x24\x56\x80\x45\xd9\x67\xf6\xcd\x4e\xfb\x33\xa5\x9d\xe9\x89\x6e\x74\xb3\x75\xd1\x3e\x6f\x41\xf9\x42\x60\x02\xed\x16\x4c\x5c\x1d\x47\x15\x5e\x41\xb8\xee\x29\x8f\xc8\xac\x0e\xa2\x6c\x53\xd8\xcf\x62\xfc\xf8\xd8\xe8\x34\x4a\x70\x93\x5d\x2c\xdc\x8e\x93\xb1\xe6\xf2\x1d\x2e\xf5\x35\x2b\x62\x11\x6f\x58\x51\x05\x6d\x6b\x56\x6a\xb0\x7d\x4f\x84\xad\xeb\x3a\xbf\x27\xd3\x98\x2b\xbe\x49\xfe\xfe\xc0\xd5\x37\x15\xc4\x60\x7d\x25\x30\xeb\xf6\xf6\x3c\x31\x22\x11\x3f\x14\x35\x0c\x14\xea\xab\x3a\xc4\x61\x44\x37\x69\xe8\xf2\xa0\xa9\x0a\x68\x1a\xa9\x69\xf8\x2c\x60\x02\xe6\xee\x69\xc4\x25\xb3\x1d\x7d\x94\x88\x7b\x3e\x16\x93\x5c\x44\x89\x34\xd1\x4d\x52\x6e\xd5\x52\x59\x23\x06\xea\x1f\x11\x5a\x6c\x99\xd8\x4a\x32\x06\xa5\x23\x72\x69\x1e\xd7\xab\x8a\x51\xdb\x1d\x00\xa6\x99\x6f\x7d\x11\xe1\xd4\x13\x2b\xd4\x3a\x1a\xe0\xbe\x71\xcd\x96\xa1\x4c\xe4\x31\x24\x62\xc1\x6a\x1a\x99\xc5\xba\xf1\x18\x98\x3e\x7e\x48\x8f\x43\x2f\xc6\x11\x9d\xb6\x8e\x89\x74\x71\x4a\x94\x29\x42\xaa\x65\x67\xcf\x44\x18\x27\x60\xbd\xb1\x36\x89\x3a\x9a\xb4\x0a\xd6\x76\x69\xd3\x81\x89\x96\x3a\x78\xb3\x19\x26\x64\xe3\xa4\x06\x6f\x0a\xe7\x76\x4c\x65\x97\x79\x2b\x03\x84\x30\x71\x0d\x53\x3e\xf1\x7d\xe2\xe4\x3c\x61\xd3\x4d\xdb\xc9\x7b\xd3\x9d\xfd\xeb\x89\x3d\xeb\x61\xdd\x62\x37\xa3\xea\xa6\xa1\x95\x27\x8a\x50\xa5\x44\x1d\xdd\x2d\x1e\xdf\x3e\xce\x5b\xdd\x46\xa7\x07\xe7\xad\x5e\x7b\xb7\xd8\xed\x42\x57\xa9\x2e\x4f\x95\xdd\x7d\xbd\x20\xc3\x70\xe0\xfb\xc9\xe5\xb7\x46\x77\x1b\xb4\x50\xd4\xb5\x42\x99\x1f\x6d\x79\x3e\xaf\xdc\x4b\xf4\x29\x83\xbc\x1d\xdd\x16\xef\xb5\x6e\x09\xa5\x8e\xd0\x58\xb2\xe1\x13\x1b\xc2\x03\xe3\x66\x6a\x25\x99\x6b\xa1\x76\xd8\x94\xdf\xb0\xb2\x56\x85\x5a\xd5\x42\xdd\xe8\x36\xe4\x31\xf0\xe0\x45\xb4\x5b\x99\xe4\x0b\xc8\xf7\x0c\x5f\xbf\x5e\x03\xf2\x67\xac\x20\xdf\xc2\x23\xf3\xc8\x27\x06\x4e\x5a\x67\xff\x52\x76\xc9\xed\xe8\xd9\x2f\xd3\x7f\x25\xd5\xbf\x7f\x84\x0f\xa6\xda\x0b\x00\x00"
+
+func sqlite3ManytomanyGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlite3ManytomanyGoTpl,
+		"sqlite3.manytomany.go.tpl",
+	)
+}
+
+func sqlite3ManytomanyGoTpl() (*asset, error) {
+	bytes, err := sqlite3ManytomanyGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sqlite3.manytomany.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _sqlite3QueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x54\x4b\x6b\xdc\x30\x10\x3e\xdb\xbf\x62\x62\x96\x60\xb7\x8e\x73\x0f\xf8\xd2\x84\x42\xa1\x64\xfb\x3a\x04\x42\xa0\xda\xb5\xbc\x35\xd8\x92\x2d\xc9\xed\x2e\xc6\xff\xbd\x33\x92\x9f\xd9\xa4\x94\xd2\xc3\x9a\x99\xd1\xbc\xbe\xf9\x66\xb6\xeb\xae\x60\xa3\x7f\x48\x65\xe0\x26\x85\xd0\x4a\x82\x55\x1c\x92\x6f\xa7\x9a\x27\xf7\x24\x06\x5c\xa9\x00\x02\xdd\x94\xda\x90\x90\xed\xf0\xd3\xe0\x4f\x71\x8d\xdf\x87\xed\x47\x79\x08\x20\xf9\xdc\x72\x75\xfa\xc4\x14\xab\x74\x04\x57\x7d\xef\x77\x94\xbb\x21\xeb\xad\xac\x2a\x2e\x8c\xa6\x1a\xce\x6f\xb2\x8c\x8e\x45\x0e\xc9\x60\xb4\xb6\xeb\x6b\xe8\xba\xd9\x34\x78\xf1\x52\xf3\xe5\xb3\xed\xaf\xef\x41\xb5\x42\x03\x83\x7d\xab\x8d\xac\xc0\xd6\x8c\x41\x71\xd3\x2a\x51\x88\x03\x4a\xba\x2d\xb1\x18\xd3\x36\x6a\x86\xd6\xf7\x89\xcb\x2b\x32\x2a\x91\xb7\x62\xbf\xca\x1b\xa2\xb2\x37\xc7\x9a\x50\xa1\x9e\xed\xe0\x61\x7b\xf7\x0e\x8d\x8a\x89\x03\x5f\x61\xc6\xe7\x78\x15\x3b\x56\x42\x19\x45\x57\x21\xb2\x19\x11\xab\x90\x06\x92\xad\x28\x4f\x5b\x41\x0e\x8f\x4f\x93\xcb\x9b\xe7\x1d\xc6\x80\xf3\x97\x2a\x82\xce\xf7\x7e\x32\x45\x9a\xb3\xf8\xbe\x87\x63\x40\x5a\x1c\x60\xdf\x73\xa9\x93\x0f\xc2\x70\x55\xcb\x92\x19\x0a\xc7\x10\xca\x4d\x83\xeb\xfb\xbd\x14\xda\x4c\xa5\xc0\x51\x0a\x29\x4c\x88\x36\x45\x0c\x9b\x72\xe6\xc9\x35\x8f\x59\x37\x05\x05\xbc\x9d\x62\x9d\x35\x2c\x44\xc6\x8f\xcf\x59\xde\x14\x11\x39\x3b\x8e\x5e\xf1\x58\x4e\x65\x51\x81\x40\x90\x11\x39\xfe\x8e\x66\x6c\xc5\x09\x03\x41\x16\x31\x92\x3d\x22\xb6\xbb\x17\x3a\x18\xaf\xb1\xb2\x18\xf8\x7a\x32\x2b\xba\x96\xcd\x0c\x5c\x4d\x7b\x39\xf3\xe4\x18\xa0\xc6\xdc\xcd\x2c\x68\x1e\x13\xf9\x1e\x11\x94\x42\xb6\x4b\xdc\xf2\xe4\x02\x02\xdb\xd1\x17\xf9\x2b\x40\x87\x61\xa7\x98\x3a\xa0\xf2\xe7\xd6\x5f\xee\x30\x4a\xbe\xee\x99\xa0\x34\x79\xc1\xcb\x8c\xce\x55\x0f\x3d\xbc\x27\x83\x86\xb0\x56\x05\x1e\x4d\x70\x19\x0c\x8d\x46\x16\x8f\x87\x60\xa8\xb9\x8b\x14\x44\x51\xd2\x3e\x79\xee\x46\x48\xb5\x6b\xe6\x7b\x34\xe3\xc1\x78\xb9\xc4\x19\x93\xcf\x7c\x83\x84\xb3\xb1\x21\xb4\x2b\xe7\x58\xff\x0f\xd0\xbf\xec\xd8\xcb\x78\xce\x15\x34\xc9\x6d\x29\x35\x0f\x23\xb7\x26\xa5\x64\xd9\x78\xf9\x84\xc9\xfe\xfb\x3c\x3e\x9d\xdd\x57\x87\x09\x72\x49\xe1\xf7\xfc\x68\x42\x7b\x67\xde\x8a\xe2\x9b\xf4\x8c\xe5\x8e\xe6\x64\xcf\x0f\xa9\x40\xc9\x71\xde\xfc\x33\x33\x2f\x00\x3d\x47\x6a\xc9\xb1\x48\x52\x60\x75\x8d\x43\x0a\x51\x89\xd7\x44\x45\x2b\x0e\xed\xfb\xc4\x9c\x3b\x22\x7c\xfe\x0d\x37\x03\x87\x60\xf7\x05\x00\x00"
 
 func sqlite3QueryGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -722,7 +1163,7 @@ func sqlite3QueryGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _sqlite3QuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\xb1\x0e\x82\x30\x10\x86\x67\x79\x8a\x7f\x30\x41\x07\xca\x6e\xe2\x64\xe2\xe8\x22\x2f\x50\xe1\x50\x92\xb6\x90\xb6\xc4\x98\xcb\xbd\xbb\x29\xa0\xe2\xd0\x6b\x72\xff\xd7\xaf\x3f\x73\x81\x6d\xd4\x37\x43\x38\x1c\xb1\x0b\xf5\x83\xac\x86\xba\x2e\x77\x95\x92\x79\x5e\xb4\xa5\x3d\x0a\x91\x2c\xbd\xe9\x5a\xa8\x53\x6f\x2d\xb9\x38\xed\xca\x12\xcc\xbf\xd5\x42\x91\x09\xb4\x8e\x93\x03\x22\xf0\x34\x78\x0a\xe4\x62\x80\x86\xef\x9f\x68\x7d\x6f\x91\x33\x7f\xba\x88\xe4\x6a\x36\xb8\x26\xc9\xe2\x6b\xa0\x3f\x43\x88\x7e\xac\x23\x78\x82\xbc\x76\x77\x82\x3a\x77\x64\x9a\x90\xf0\xcd\x1a\x65\x86\xa7\x49\xa0\xaa\x34\x45\xf0\x6d\x6b\xd2\x19\xad\x5b\xd8\xf5\x97\x92\x65\xef\x00\x00\x00\xff\xff\x5b\x7f\x83\xf0\x1d\x01\x00\x00"
+var _sqlite3QuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x55\x8e\xb1\x0e\xc2\x30\x0c\x44\x67\xfa\x15\x37\x20\x15\x86\xa6\x3b\x12\x13\x12\x23\x0b\xfd\x81\x40\x5d\xa8\x94\xa4\x95\x93\x0a\xa1\x28\xff\x4e\xd2\x46\x50\x06\xdb\xd1\xdd\xf3\xc5\xde\x57\xd8\x3a\x79\x53\x84\xc3\x11\x3b\x7b\x7f\x92\x96\x10\xd7\x3c\x9b\xe4\x2c\xfd\x22\x35\xed\x51\x85\x50\xf8\xb8\xd3\x77\x10\xa7\x41\x6b\x32\x6e\xd6\xea\x1a\xde\xff\xa4\x4c\x91\xb2\xb4\xb6\x53\x46\xf4\xc0\x34\x32\xd9\x08\x5a\x48\xf0\xf0\x42\xc7\x83\x46\x19\x91\x7c\x4b\x08\xa5\x58\x12\x4c\x9b\xc2\xdc\x7b\xa4\xbf\x04\xeb\x78\xba\x3b\xf8\x19\x62\x69\x1e\x04\x71\xee\x49\xb5\x36\xe1\x9b\x35\x1a\xdf\x4c\x73\x80\x68\x52\x8f\xd2\xf7\x5a\x95\x6a\xd2\x26\xb3\xeb\x2f\x43\x51\x7c\x00\x5b\x7f\x83\xf0\x1d\x01\x00\x00"
 
 func sqlite3QuerytypeGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -742,7 +1183,7 @@ func sqlite3QuerytypeGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _sqlite3TypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\xdf\x73\xe2\x36\x10\x7e\xb6\xff\x8a\x3d\xcf\x4d\x03\x3d\xce\x4c\x1f\xfa\xd0\xcc\xf0\x70\x0d\x4e\x9b\x29\x07\x39\x7e\xb4\x79\x0b\x02\x2f\xc1\x8d\x2d\x11\x49\x4e\xc2\x78\xf8\xdf\x3b\x92\x6c\x63\x63\x07\x9c\x1f\x7d\x38\x38\xec\xdd\x4f\xab\x5d\x7d\xdf\xae\x92\x24\x5f\xe1\xb3\x58\x33\x2e\xe1\xbc\x07\x2d\xfd\x3f\x4a\x22\x04\x77\xa8\x3e\x1d\xe4\xdc\x01\x87\xa3\x70\xc0\x11\x0f\xa1\x90\xea\xa7\xbf\x70\xc0\xb9\x19\x0d\xd8\x9d\xd3\x86\xaf\xbb\x9d\xad\x51\x24\x59\x84\x68\x50\x96\x6b\x8c\x08\xb8\x93\xf4\x7b\xaa\xde\x98\x4f\x85\xba\xf7\x09\x56\xe0\x5e\xb0\x28\x42\x2a\xf5\xb3\x6e\x17\x92\x64\xff\x28\xb5\xc2\x50\x60\xf1\xb5\x8e\x6c\xb7\x03\x8e\x1b\x8e\x02\xa9\x14\x40\x80\xb3\x27\x58\x71\x16\xc1\x59\x92\x64\xb1\xec\x76\x67\xae\x41\xa0\xbe\x02\x93\xdb\x0d\x96\x10\x84\xe4\xf1\x52\x42\xa2\x8d\x38\xa1\x77\x08\xee\x65\x80\xa1\x2f\x94\xb9\x55\x34\x4d\x12\xe0\xa8\x01\xdc\xa9\xfa\xdc\xed\x60\xfe\xaf\x60\xf4\xdc\x31\x11\x87\xea\x5f\x1c\xd1\xd4\xde\x99\x43\xbe\x99\x83\x57\xc5\x88\xb2\x24\x5c\xf3\x20\x22\x7c\xfb\x17\x6e\xd5\x53\xdb\xea\x76\xe1\x99\xc1\x4a\x87\x62\x5b\xb7\xf8\x1c\x08\x29\x3a\x70\xeb\x63\x88\x12\x7d\x58\x30\x16\xda\x49\x92\xc1\xec\x6c\xf5\xa3\x0a\xd4\xed\x82\xa7\x5d\xc1\x47\x89\x3c\x0a\x28\x0a\x65\x26\xd7\xe5\x3c\x18\x7c\x08\xa8\x7e\xe3\x13\x49\x16\x44\xa0\x6b\xaf\x62\xba\x84\x96\x4a\xa8\x39\x22\xbb\x1d\xfc\x5c\xf0\x6b\xa7\xe8\xad\xb6\x0e\x08\x12\xdb\xe2\x28\x63\x4e\xa1\xe8\xe2\xa6\xe1\xab\x28\xbb\x5d\xe8\xa7\x5b\xd8\x70\xf6\x18\xf8\x2a\x1e\xba\x62\x3c\x22\x32\x60\xb4\x2e\xb6\x35\x11\xb0\x40\xa4\x90\xed\x5d\x57\xf9\x95\x71\xa6\x8b\x9e\x0a\x34\x5d\x22\x8d\xf4\x8a\x0a\xe4\x12\x02\xfd\x25\x2a\x81\x49\xf6\xda\x28\x0c\x60\xcb\x5f\xc0\xcd\xa8\xff\x7b\x1b\x90\x73\xc6\x55\x30\x8f\x84\xab\x1f\xe6\x81\x29\x7f\xb0\x02\x12\x72\x24\xfe\xd6\x54\xa7\x03\x0b\x12\x84\xb6\x15\xac\x6a\x93\xab\x50\xb2\x3d\x69\x14\xe1\x0e\xf1\xa9\xe5\x98\xe0\x61\x45\x82\x10\xfd\xf3\x32\xa4\x70\xda\xb6\xb5\x3f\x3a\x86\xa5\xdf\x09\x8d\x49\x78\x7d\xaf\x09\xd0\xed\x82\x78\x08\xd3\x0c\xc0\x43\x8c\x7c\xdb\x81\x8d\x39\x62\x70\x8f\x5b\x88\x62\x21\x61\x81\x59\x31\x7d\xdb\x5a\x32\x2a\x24\x18\xa9\x80\x1e\xcc\xaf\x86\x13\x6f\x3c\x85\xab\xe1\x74\x04\x45\x66\x42\x6b\x0e\x5f\x6c\xcb\x9a\x27\x09\x2c\x59\xa8\x34\x47\x14\xc8\x97\xbe\x6c\xc3\xdf\xdf\x06\x33\x6f\x72\x60\xfd\x48\xc2\x3a\xe3\xb9\x49\x1d\x8f\xa9\x89\xd5\xb6\xb4\x48\xb5\x4c\x34\x1d\xb5\xbe\xa6\x54\x79\xb1\x3c\x97\x6d\xdb\x52\x45\xe8\x81\xbf\x70\x7f\x28\xff\x31\x7b\x6a\xec\xeb\x4e\x96\x84\xb6\x7e\x2a\xd5\x46\x15\x7f\x4f\xc8\xfc\x1c\xe8\x22\xaa\x95\x3e\xf5\x80\x06\xe1\x41\xe9\x54\x49\x14\xb3\x95\xe8\x35\xaa\x41\x96\x7b\x58\x6c\x41\xe0\x43\x8c\x74\x89\x1f\x54\x87\x9a\xe8\x5f\x51\x98\x63\xde\x63\x6f\x3a\x1b\x0f\xaf\x86\x7f\xc0\x7e\xdd\x92\xc3\x05\x0b\x95\xfd\x7b\x2a\x5a\x9f\xfb\x37\x96\xb8\x0e\xec\xc3\x6b\x6e\xd4\xdc\xd4\x1c\xa5\x61\xa9\x29\x67\x2d\xe7\x7b\x20\x79\x8c\x76\x2e\x66\x34\x08\xf7\xad\x80\x22\xb4\x9a\x6f\xa7\x0d\x8e\x93\x1d\xb7\xd9\xc6\x27\x12\x21\xd6\x5f\x55\xdd\xab\x74\x09\xeb\xa4\xf0\x19\xc4\x1a\xe1\xab\x28\x5f\x2a\x7d\x3e\x43\x41\xcf\x64\x59\xfa\x54\x0a\x3f\xbd\x28\x7e\x75\xea\x67\xb6\x90\xab\x9f\x42\x05\xca\x52\x58\xa5\x7e\x4a\xfe\xf2\x35\x8d\xf8\x17\x57\xab\xed\x0e\x4d\x57\x8b\x08\xbf\x57\xed\x8a\x71\x03\x1d\x30\x5a\x5a\x52\xb1\x3a\x3d\xd4\x15\xb6\xce\xae\xfb\xdf\xa6\x5e\x99\xa8\x13\x6f\x9a\xb1\xed\x95\x6c\x55\x84\xeb\x41\x0b\xca\xce\xcd\xb8\xaa\x7c\xff\xf9\xd3\x1b\x7b\xc7\x89\x0a\x3d\xf8\x6c\x0c\x96\x2c\xa6\xf2\x28\x6e\xba\xff\x02\xa9\xdf\xc9\xea\x0e\x34\xa3\xa0\x75\xdb\x81\x9c\xfe\xde\x33\x2e\xff\xff\x15\xcb\x1c\xd7\xf4\x9a\x90\x47\x04\x41\x1e\x6b\xa8\x55\x19\x29\x4e\x53\x4b\xa1\xd5\x11\xeb\xf0\xf4\xe6\x93\x5a\xf1\xf4\x96\x2c\x72\x92\xe6\x87\xb4\xce\x2a\x9f\x61\xda\xf9\x86\x66\x1b\xdd\x9a\x36\xc8\xd5\x20\x27\x80\x50\x88\xcd\x23\x75\xf6\x0b\xd1\xba\xca\x5c\xbb\x0c\x47\x53\xef\x1c\xae\x99\x90\x77\x1c\x27\x3f\x06\xf0\x9b\xfb\xeb\x17\x60\x34\xdc\x36\x52\x93\x17\xc6\xa8\x97\xd4\xa4\x76\x90\x3a\x3a\x49\xbd\x69\x94\x6a\xc4\xec\x53\x7d\xf8\xf8\x40\x54\x6d\xbc\x47\x47\x22\x65\x3e\x1a\xc2\xc5\x68\x78\x39\xb8\xba\x98\xea\xac\xbe\xcc\xe1\x36\xf4\x47\x90\x2a\xcf\x49\xb1\x39\xd0\x96\xb2\xe9\x86\xe3\x2a\x78\x2e\x3b\x38\xde\xcd\xc5\x60\xd6\xf7\xfa\x4e\xd1\xf7\x9d\x62\xf0\x1e\x5a\x1b\xdf\x6a\x5b\x2e\x71\x76\x5f\xd3\x72\x3f\x3e\xd1\x90\xcb\x1d\xf9\x60\x9c\x4b\xfb\xab\x90\x44\x62\xa4\xaf\xaf\x2c\x0a\xa4\xea\x2c\x7e\x8c\x4a\x03\x42\xb2\xbc\x07\xb6\x4a\xef\x7f\xc0\xe4\x1a\x39\xc8\x35\xa1\xc5\xb9\xaf\x38\x30\xe4\xd7\xaa\xb4\x89\x55\x95\xe5\xed\x97\xa6\xc6\xd7\x95\xda\x9e\x7d\xb4\x65\x17\x92\x94\x69\x49\xb5\x0f\x1f\x6d\xc3\x35\x08\x05\xf2\x1d\x72\xaf\xef\x0d\xbc\xa9\x07\x97\xe3\xd1\xf7\x32\xf7\x1a\x36\xb8\x5f\x1a\x8c\xa3\x0d\x9a\xc2\xb1\xe3\xfa\x01\x83\x64\x3e\x3e\x66\xb7\x59\xab\x3e\x7f\xf5\xd3\x63\xe1\x8f\x13\xf6\x7f\x01\x00\x00\xff\xff\x0d\xd3\x13\x8d\x1d\x12\x00\x00"
+var _sqlite3TypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x5a\x6d\x73\xdb\xb8\x11\xfe\x4c\xfd\x0a\x1c\xc7\xd3\x48\x17\x45\xd7\x9b\x69\x3b\x53\xb7\xee\x4c\x62\x29\xad\xa7\x3e\xd9\x67\xcb\x77\xe9\x64\x3c\x16\x24\x42\x36\x13\x0a\x94\x48\x2a\x91\xeb\xf1\x7f\xef\xee\x02\xa4\x00\x12\xa2\x28\x25\x77\x93\x7e\x30\x2d\x92\xc0\x62\xb1\x2f\xcf\xbe\x80\x4f\x4f\xaf\xd8\x51\xfa\x10\x27\x19\x3b\x3e\x61\x6d\xfa\x25\xf9\x5c\xb0\xde\x10\xaf\xbe\x48\x12\x9f\xf9\x89\x48\xe1\x9a\x2e\xa3\x34\xc3\xdb\x60\x02\x97\x77\x17\xe7\xf1\xbd\xdf\x61\xaf\x9e\x9f\x5b\x4f\x48\x25\xe3\x93\x48\x28\x2a\xd3\x07\x31\xe7\xac\x77\xad\xff\x8f\xf0\x8d\xba\x22\x55\x63\xce\x22\x5a\x25\x3c\xa2\x49\xea\xe7\x66\x6d\x63\xd4\x64\x27\x83\x05\x6b\xf0\x63\x1a\xcb\x59\x14\x4e\x33\xb8\x5b\xae\x44\xf2\x98\x33\x9c\xad\x0b\xae\x99\x3f\xe1\xd9\xf4\x01\xfe\x7f\xe2\x11\x6e\x2d\x0a\xe7\x70\x95\xf0\x17\xe2\x5f\x40\x17\x7c\xb1\x44\x9a\xd9\x3c\x33\x37\x9a\xc4\x9f\x53\xc5\x72\x12\xca\xac\x60\xcf\x4f\x8d\x41\xe1\x8c\xf5\x4e\xe3\xf9\x5c\xc0\x00\x7c\xf6\xc3\x0f\xec\xe9\x69\xf3\x48\x8f\x12\x51\x2a\xcc\xd7\xb4\xa5\xe7\x67\x96\x88\x05\x88\x1c\x06\xa6\x8c\x33\x58\x8e\xcd\x92\x78\xce\x5e\xc0\x10\x2d\xe5\xe7\xe7\x17\x3d\x45\x41\x06\x48\x2c\x7b\x5c\x08\x8b\x02\x48\x63\x35\xcd\xd8\x13\x0d\x4a\xb8\xbc\x07\x81\xbd\x0d\x45\x14\xa4\x38\xdc\x33\x87\xc2\xef\x44\x10\x81\xde\x08\xaf\xf0\x68\xfc\x21\x8d\xe5\xb1\xaf\x38\x8e\xf0\x6f\x35\x97\x7a\xbc\x3f\x66\xc5\x66\x4a\xaf\x4c\x8e\x72\x21\x5c\x26\xe1\x9c\x27\x8f\xff\x16\x8f\xf8\xb4\xe5\xc1\xdc\x75\xcc\x66\xc4\x4a\xcb\xbb\x13\xeb\x30\xcd\xd2\x2e\xbb\x0b\x44\x24\x32\x11\xb0\x49\x1c\x47\x30\x39\x27\x03\x53\xe0\xa6\x4a\x08\xc8\x0c\x68\x2a\x0b\x60\x5a\x32\x0f\xa5\x48\x71\x58\xf6\x60\xcb\x41\xd1\x67\xa1\xa4\x37\x01\x07\xf1\xf1\x54\xf4\x5a\xb3\x95\x9c\xb2\x36\x0a\x54\x29\x0f\x86\x7e\x6f\xcc\xeb\x68\xea\xed\x0e\x31\x04\x72\xf4\x40\x46\xab\x44\x32\x73\x4a\x4f\xb3\x8f\x5c\x02\x43\x7d\xbd\x85\x45\x12\x7f\x0a\x03\xe4\x47\xce\xe2\x64\xce\xb3\x30\x96\x2e\xde\x1e\x78\xca\x26\x42\x48\x96\xef\x9d\xb4\xbc\x27\x9f\x7a\xd1\x5d\x8c\xea\x25\x34\xa7\x67\x32\x15\xf0\x22\xa4\x7f\x69\x85\xb1\x2c\xde\x97\x0b\x45\x10\x47\x4c\xb3\xf5\x82\x27\x7c\x0e\x8f\x83\x09\x7b\x77\xd1\x7f\xd3\x61\xe0\xa3\x71\x82\xac\x7d\xe2\x09\xde\xa8\x07\xca\x18\x40\x2e\x3c\x4a\x04\x0f\x1e\x95\xae\xba\x6c\xc2\xc3\xa8\xe5\xc1\x73\x97\xa8\x91\x4a\xbe\x43\xa2\x92\xf6\x86\xe2\x73\xdb\x57\x5b\x61\x33\x98\x2b\x82\x63\x9b\x24\xf8\x65\xcb\xdb\x18\x92\x42\xa3\x9f\xb8\x5c\xf1\xe8\xf2\x23\xb9\x03\xf0\x01\xc0\xa1\xe5\xc1\x08\x35\xba\xa0\x46\x32\x38\xf6\x11\x2c\x6e\xbe\x4a\x33\xd0\x55\xae\xda\xa0\xe5\x01\xca\xc0\x23\x85\x3b\xec\x84\x8d\xcf\x86\xd7\x83\xab\x11\x3b\x1b\x8e\x2e\x98\xe9\xa7\xac\x3d\x66\x2f\x81\xe7\x31\xca\x26\x26\x7c\x4b\x0d\x57\xd4\x2f\x3b\xec\x97\xd7\xe7\x37\x83\xeb\xd2\x68\x44\x27\xc7\xe0\xb1\x12\x5d\xb2\x92\x8a\xd7\x96\x47\xb0\xd6\x56\xdc\x74\x71\x7d\x72\x30\x7b\xb1\x42\x96\x20\x8d\xbb\x2e\xe9\xe1\x84\x05\x93\x9e\x52\xda\x4c\x32\x7f\xb0\x16\x53\x1f\x06\x68\x3d\xf2\xe4\x1e\x6e\x9a\x13\x05\xe1\x22\xd1\xef\x4e\x98\x0c\xa3\x92\xa2\x50\x01\xe8\xd5\x08\x78\x8d\x24\x9e\x4b\x9a\x4d\x1e\x59\x2a\x60\x80\x9c\x8a\xaf\x24\x75\x03\x4a\x72\x0b\xde\x43\x0d\x75\xb3\xaf\x06\xa3\x9b\xab\xe1\xd9\xf0\x9f\x6c\xb3\xae\x35\x01\xe0\x12\xc7\x7f\x89\xfe\x1c\xeb\x83\xec\x1d\xda\xfc\x19\x49\x5f\xc5\x9f\x0f\xd3\xa8\x6b\x19\x08\xe8\x5c\xb6\xff\x60\xf9\x25\xc2\x80\x93\xa3\x26\xd6\xa0\x30\x5e\x59\x83\xc8\x94\xb7\x2a\x45\x3b\x7d\xff\x84\x41\x54\x13\xad\x02\xe2\x80\xf0\x26\x40\x88\x25\x8b\x62\x1e\x80\x39\x61\x10\xf3\x17\x71\x9a\xdd\x53\xde\xa2\xa2\x85\x42\x28\x24\xab\x73\x0e\x30\x92\x2d\xf8\x97\x96\x01\x90\xad\xd2\x50\xde\xb3\xd3\x8b\xcb\xff\x74\x61\x12\x92\xa3\xfc\x01\xa4\x16\xcf\x00\xe3\xde\xe0\xcd\x75\xf8\x5f\x81\xb1\x3a\xed\xb1\x5f\x1f\x10\xd4\x27\x2c\xc4\xf0\xfd\x3d\x88\xbb\xd7\x7f\xd3\x25\x8a\x94\x3a\xf0\x44\xe8\xa5\xc1\xbc\x15\x39\x0e\x3b\xe3\x32\xe5\x53\x8c\x15\x1a\x6f\x1d\x1c\xbb\x01\x96\x34\xa9\xb2\x12\xd8\xd4\xfb\x5b\x1b\x9a\xdb\x05\xe0\x76\x50\x0b\x35\x90\x0b\xc1\x8a\xdd\x29\x62\x93\x02\xe7\x21\xcf\x51\xe9\x83\xb9\x06\x6a\x53\x03\xf4\xc4\x89\xd0\x07\x41\x34\x18\x85\xa7\xad\x01\x45\x5d\x80\x2e\xfa\x09\x04\x70\x4b\x46\x2d\x2f\x5b\x77\xd1\x80\x14\x90\x01\x97\xeb\xf8\x34\x5e\x3c\x8e\xd6\x96\xa9\x07\x93\x06\x96\xe8\x05\x62\x26\x12\x86\x42\x6f\x93\x8c\xb4\x37\x01\x75\x14\x1e\x06\x8f\x76\x47\x5b\x99\x8c\xb3\xba\x08\x52\x05\xb2\xb4\x1e\xc9\xc4\x52\x23\xd9\xf5\xe0\x7c\x70\x3a\x62\x52\xac\x33\x80\x9b\xf6\xe2\xfe\xee\x5e\x64\x77\x20\xb1\x90\x47\x77\xf9\xb4\xb6\x9d\x02\x76\x29\x25\x2c\xe1\x8b\x9d\x92\xbd\xe8\x74\x98\x86\xa7\xb7\x57\x17\x3f\xb1\x7b\x21\x45\xc2\x33\x41\x94\x45\xda\xfe\xb1\xcb\x8e\x7e\xdc\x1e\x50\x88\xbd\x2e\x8b\x84\x6c\x1b\xfa\xef\x80\x48\x96\x85\xe0\xb3\x75\x19\x74\xaa\x88\xb3\x9d\x4e\x43\xe5\x2c\x7b\xa7\x51\x9c\x0a\xd0\x03\x71\x8a\x9e\x6e\x49\x59\xe5\x16\x90\xb3\x83\xfd\x97\x64\xa2\x93\x5a\x65\xdf\x4b\xb0\xc5\x75\xa6\xd5\xac\xa6\xb0\x6d\xe3\xb5\x19\x2c\x35\xec\x85\x41\x47\x99\x7d\x89\x5f\x8b\x61\xe4\xd8\x43\x36\x4e\x18\x5f\x2c\xd0\x82\xe0\x06\x40\x23\xa0\x14\xa4\xa0\x38\x48\x92\x76\x93\xcd\xc3\x00\x94\x19\xd0\xe8\xe0\xa8\xb2\xfc\x1a\x66\x43\xd3\x78\x15\x05\x64\xba\x30\x16\xd4\xfe\x49\x58\xb2\xd3\xe9\x51\x8e\xc2\xb6\x58\x51\x68\x61\x63\x50\xb0\x11\xc1\x1d\x1b\x40\x00\xb0\x9f\xf7\xe1\xad\x1d\x05\x2c\x17\x6a\x79\x3a\xc6\xc3\x4a\x0b\xd0\x3d\x78\xf6\x99\x54\xe5\x64\x1b\x83\x72\x5e\x5a\x42\x2c\xed\x32\x7a\x50\xaa\x31\xe9\x0d\x31\x94\x97\x3f\x47\xb0\x8b\xa3\x19\x12\xdc\x24\x54\xca\xa7\x8f\x42\xf8\x49\x5b\x54\xac\x20\xbd\xa3\x59\xd5\x97\xc6\xc5\x08\xb4\x42\x14\x4c\x45\x1f\xff\x60\x7f\x24\x39\x50\x78\xc0\xb5\x8c\xb7\xca\x78\x70\x0a\xbd\xc5\xc1\x66\xe8\x20\x53\x52\xf3\x4e\x54\x78\x79\x7f\x6c\xbc\xbf\x25\xdb\x82\x0b\x88\x09\x8a\xc3\x05\x06\x91\x29\x88\x05\x9e\x60\x7d\xea\xf6\xc6\x4b\x35\x70\x4b\x06\xd0\xcc\x9c\xd5\x92\xb8\x14\x45\x2f\xb8\xad\x8f\x13\x6a\x0b\x44\xa9\x51\x56\x33\x31\x32\x48\x6f\x93\x98\xe2\xa6\x76\xa5\xa6\x38\xe1\x20\x0d\x17\x13\x55\xde\xa0\x95\xdd\x87\x70\x4f\xce\xef\x63\xf9\xeb\xab\x7f\x13\x5c\x55\xc7\x16\x44\x8e\x8a\x85\xc3\xe4\x3c\xd6\x6e\x12\xdc\x9a\x51\x55\x26\x72\x9b\xf2\x9c\xea\x20\xf5\x16\xe8\xe7\x95\x15\x44\x80\x93\x6b\x69\x16\xad\xd2\x87\x5c\x4d\x4d\x44\x09\xeb\x15\xd2\x84\x1f\x86\x81\x3b\x79\x29\xb3\x52\x41\x3e\x63\xc1\xcd\xb0\xa6\x36\x56\x4a\x01\x9b\xdb\x99\x3b\x0f\xd1\xd9\xa2\xa6\x6f\x22\x95\xe5\x96\xef\x37\x1e\x79\x7c\xab\x80\xd0\x4a\x2f\x8d\xaa\xe5\xeb\xa4\x91\xf3\x55\x94\x85\xaf\x60\x75\xa4\xa7\xe7\x62\x5a\x59\x9b\x53\x7e\xd5\x94\xb0\xa8\xc7\xbf\xf9\x64\x10\x89\x4a\x28\xa4\x54\xbf\x0d\x34\x95\xbb\x76\xc7\xec\x31\x7d\xd3\x15\xfd\x78\xd3\xe9\xcb\x1b\x88\x6a\x47\xb0\x21\x48\xf1\xd2\x8c\x27\x19\x04\x68\x99\xb9\x2a\x4c\x63\xb3\xfb\x95\xcc\x7c\x95\xc5\xa1\x9c\x26\x02\xdb\x8d\xbf\x5f\xdd\x3c\x36\x5b\x80\x2a\x53\x0b\xe7\x61\xa6\xea\x9e\x05\xe6\x71\xc8\x76\xee\x18\x73\xbe\x66\x72\x35\x9f\xc0\x73\xb0\x79\x32\x61\x00\x2e\x98\x81\xda\x36\x5c\x20\x57\xb4\x16\xdc\xb3\x4a\x8c\xa4\x8e\xaf\x40\x45\x4d\x45\xfe\xa9\x33\x59\x8c\xfb\x1b\x2e\x0f\x71\x56\x92\x1d\xe2\x6f\xc0\x20\x65\x57\x06\x97\x5f\x23\x92\x23\x6d\x57\x04\x87\xe7\x66\xe4\xa6\xbc\x15\xf2\xfb\x95\x48\x75\xfe\x49\xbd\x85\xf7\xb7\xa1\xcc\x44\x32\xe3\x53\xf1\xf4\xdc\x18\xf9\x6a\xcc\x1f\x69\x47\x46\x12\x8a\x77\x4d\x22\xb0\x69\xaa\x07\x11\x71\xf6\x02\x0c\x69\x6f\x44\x61\x14\x1b\x9e\xb2\x0a\xd8\xa0\xb6\xd0\x97\x50\xcd\xfd\x42\x52\x6a\xfb\xa4\xe1\x14\xb7\xe5\x77\x0d\x99\x77\x2d\x45\x23\x60\xa8\x6c\x43\xfb\x05\xf2\xda\xeb\xf5\x3a\xb5\x42\xba\x2b\xb2\xa6\xdd\x6d\xb0\x32\xdd\x26\x61\xcd\x96\xe6\xd2\xbd\x9a\xbb\x62\xa2\xe5\x5e\x8e\x1b\x77\x94\xf6\xe4\xcc\xb0\x46\x3b\xdd\x37\xca\x28\x30\xc9\xbf\xfc\x49\x1b\xa3\x55\x36\xe5\x75\x53\x3e\xc0\x59\x27\x39\x53\x99\xe5\x8e\x3c\x66\x5b\xe5\xe4\x99\xd5\x98\xae\x9d\x9a\x55\x62\xd5\xfa\x49\xbb\x6c\xc3\xc0\xd4\xa0\x74\xb2\xf2\x17\xf3\xa5\x6a\xf9\xc4\x49\xa0\xf0\x8d\xfa\x3f\xb4\x20\x40\x34\x21\xc9\x44\x60\x3e\xb0\x92\x50\xe2\x86\xf0\xb0\xab\xc8\x4c\x56\x19\x8d\xa5\xe6\x41\x3e\x6f\x33\x9c\xa7\x69\x78\x2f\xa9\x73\xc4\x08\xe1\x39\x65\x15\xb4\x0c\x16\x03\xe0\x85\xbd\xeb\x28\x9c\x0a\x25\x3f\xea\x6a\x40\x62\xfc\x01\xd5\x95\x1f\x12\x68\x3e\x74\x1d\xc6\xfe\x4e\x3f\x3e\xdc\x32\xf2\xa3\xfa\x8a\x6f\x6b\xda\xb5\xb5\xd8\x73\x97\xd7\x6d\xb5\x76\x67\xe3\x28\x39\x1c\x7f\xdb\xa9\x60\x7e\xba\x45\xcd\x20\xc1\xda\x1b\x2c\xa4\xbc\xae\xa6\x8d\x9a\xc7\x72\xdf\xcf\xc3\xf9\xcd\x02\x52\x43\x48\x0b\xe9\x5f\xf5\x18\xa6\x72\x68\xe5\xed\x3c\x87\x51\x14\x77\x9e\xc3\x54\x0e\x62\x74\x26\x18\xc4\x22\x95\x2f\x32\x3b\x13\x44\x27\xfa\x6e\xeb\x59\x8c\xcb\x87\xd4\x86\x0a\x1f\x42\xaa\xe4\x42\x34\xcd\x72\x19\x5c\x53\x9d\x4c\x99\xab\x39\x8f\xae\x9a\xae\x06\xd2\xfe\x88\x67\x69\xb0\x53\x9a\x19\x42\x29\x67\x79\xe9\xa6\xc7\x50\x49\x8b\x6e\x2e\xfb\xaf\x47\x03\x3b\x23\xba\x1e\x8c\xf2\xac\xc8\x4a\x8b\x6c\x75\x57\xf4\x5c\x24\x47\x98\x1d\x41\xaa\xc7\x6c\x12\x88\xd6\xfb\x50\xf8\xf5\x5f\x83\xab\x81\x11\x03\x52\xda\x82\xa6\x50\x99\xe9\xb3\xd7\xc3\x3e\x5c\x9b\x27\x98\x08\xa1\xea\x60\xa2\x1c\x9b\x6b\x8a\xf8\x66\x16\x9f\x97\xde\x66\xd6\x50\x19\x63\x9e\x23\x7d\xf1\xe9\xd4\x6f\xc5\x97\xdd\x9c\x23\x17\xbe\xe6\x10\x11\x52\xb8\x34\x38\x45\xdd\xed\xbe\x48\x6d\xb7\xf3\x96\x3d\xa4\x38\xaa\x36\x3d\xc4\x1a\x61\xc1\x82\xd1\x19\x57\x4e\xe1\x9a\x61\x1d\xe8\x1a\x33\xf4\xb6\x6f\x16\x14\x25\x21\xa3\xc7\x13\xee\x94\x71\x09\x28\xa6\x02\x27\x32\xb9\xd9\x53\x0f\x87\xd3\x94\xe1\xc5\x68\x70\xcc\x2e\xd5\xb1\xcc\xf5\xcf\xe7\xec\xaf\xbd\x3f\xbf\x64\xb1\x8c\x1e\x1b\xe1\x5a\xa3\xf3\xe5\x6d\xb8\xe6\xac\x70\x6b\x8f\x98\x0f\xad\x59\x77\x63\xcc\xae\xd2\xab\xbe\xae\xac\x9e\x51\xd6\x9e\x15\xe3\xf0\x8b\x21\x3b\xbd\x18\xbe\x3d\x3f\x3b\x1d\x91\x8c\x37\xb4\x1d\x1e\xd1\x61\xfd\x0b\xa6\x61\x70\x1b\xf2\x39\x57\x39\x29\x0f\x5d\x24\x90\xd5\xac\xed\x09\xfe\xe0\xdd\xe9\xf9\x4d\x7f\xd0\xf7\xcd\xb9\x7b\xa2\x8e\xf3\xec\xf9\xb7\x39\xd1\x3e\xb8\x75\xe5\xb4\xab\xe2\xf8\xb2\x74\x7e\xb9\x71\x28\xbb\xaf\xb4\xc5\xbb\x2a\x6d\xa6\x72\x5b\xc9\xdb\xa7\xaf\xd4\xd8\x3d\x1d\x1c\x7e\x51\xef\x69\x3f\x5f\xdd\xaf\x1d\xb5\xa3\x1f\x75\x98\x73\x5b\x9d\xd6\xdf\xc5\xc3\xc7\x26\xf1\xfc\xb3\x36\x5c\xe1\xff\xc7\xa7\xf7\x6f\xff\xb8\xfa\x3f\x5e\x7d\xbf\xc7\xe8\x0b\x56\x9a\x3e\x9b\xae\x8f\xd2\x5d\x6d\xb3\x67\x5b\xb7\x67\x7b\xbb\x67\x4b\xbf\x47\xad\x55\xed\xf8\x6c\x6f\xf9\x34\x2f\x71\x0e\x6c\xed\x98\x4c\x99\x48\x7b\x70\xf3\xc5\x96\x7a\x07\xe6\x19\x56\xda\xf2\xb6\x35\x64\xbc\xbd\xb0\xda\x31\xdb\xd5\x58\x28\xb7\x12\xf4\x3e\xcb\xb0\xbc\x87\x90\x77\x14\x92\x7a\x8d\xa6\xa5\xa4\x95\x68\x21\xec\x57\x3f\x87\xd2\xc5\x20\xa4\xeb\x19\x35\x6d\x01\xaf\xc1\x75\xb0\xf0\x09\x56\x02\x5d\x26\xe2\xd3\x8f\xe8\x28\xea\xdb\x49\x16\x83\x0b\x61\x30\x80\xe0\x60\x34\x1d\xcc\xcf\x6a\x8a\x4f\x12\x75\x8d\x55\x4d\x51\x0f\xff\xe0\xf0\xc0\x4f\xfd\x9c\x05\x66\x6d\x7d\x69\xcb\xac\xe5\x2e\x1a\x6b\x6b\x46\x07\x05\x03\xbd\xcb\xe0\xdd\x1f\x9c\x0f\x00\x27\xe9\x63\x09\x0b\xbc\xdd\x15\x58\x4d\xf1\xb5\xf7\x77\x5e\xb5\xc5\xc7\x97\xe7\x37\xb5\xe4\x77\x7d\x89\x50\x7c\x1d\x90\x7f\x48\xea\xb9\x05\xee\xfe\x44\xcb\xec\xef\xfc\x0f\x47\x2c\xb1\x05\x72\x2e\x00\x00"
 
 func sqlite3TypeGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -762,7 +1203,7 @@ func sqlite3TypeGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _xo_dbGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x94\xdf\x6f\x9b\x48\x10\xc7\x9f\xcd\x5f\x31\x41\x77\x2a\xa4\x14\x2e\xf7\x18\xc9\x0f\xd7\x6b\x5f\x4e\xf7\xd3\xa9\x4e\x27\x19\x9f\xbc\x86\xc1\x5e\x05\x76\xc9\xce\x82\x63\x59\xfe\xdf\xab\xd9\x05\x17\xd2\xa6\x2f\x89\x59\xe6\x3b\x3f\x3e\xdf\x61\xb3\x0c\xfe\xfb\xeb\xc3\x7b\x90\x04\xf6\x80\x50\xe8\xa6\xd1\x0a\xa4\xb2\x68\x2a\x51\x20\x54\xda\x40\x29\xac\xd8\x09\x42\xd0\x2d\x1a\x61\xa5\x56\x1c\x2c\x2c\x14\x42\xc1\x0e\xa1\x23\x2c\xe1\x28\xed\x21\xc8\x32\xb0\xa7\x16\x09\x2a\xa3\x1b\xa0\xe2\x80\x8d\x80\x37\xe7\xf3\xf8\x33\x7d\xf0\xff\x2f\x97\x37\x69\x90\x65\x1c\xff\xe9\x20\x09\xe8\xa0\xbb\xba\x84\xa3\x36\x8f\x2e\xd1\xb5\x64\x46\x4f\x75\xfa\xe1\x3d\x08\x55\xce\xcf\x3e\x3d\xa7\x01\x97\x1a\xba\xbf\xf6\x7b\x0e\x16\x1f\x9f\xb1\x88\xc8\x1a\xa9\xf6\x09\xa4\x69\x7a\x7d\x79\xbe\xc4\x10\xb1\x78\x85\xd4\xd5\x36\x01\x34\x46\x9b\x38\x58\xfc\xd3\xa1\x39\xbd\x2e\xb9\x75\x1a\x7d\xa4\x17\x8a\x95\x3e\xbe\x2a\x1a\x35\xc1\x25\x08\x1c\xe3\xdf\xf5\x1e\x5a\xa3\x7b\x59\xa2\x47\x5d\xeb\x3d\x54\x9d\x2a\x3c\xbe\xdd\x09\xf6\xa8\x18\x2f\x96\xf0\xd4\xa1\x91\x48\x69\xd0\x0b\x33\x48\x97\x2e\xf6\xd5\x72\x67\xf0\x75\x1e\x0a\xa1\x14\x9a\x7f\x45\xdd\xa1\xf9\xae\xa9\xde\x27\x67\xa3\x6c\xda\x1a\x1b\x54\x16\x76\xda\x1e\x58\xc2\xa9\x66\xb8\x87\xbc\xce\x07\x7a\xaa\xb3\xd2\xc8\x1e\x4d\x3a\xd6\x19\x33\xd3\x60\xca\x8b\x36\xa6\xee\x4c\xb2\x05\x8b\x59\x9a\x01\xd5\x83\x1b\xf1\xa1\x96\x05\xf2\x00\x02\xc8\xfd\xd4\x15\xf8\xe1\xaf\x35\x26\x71\xeb\x8d\x7f\xe7\x12\x3c\x75\xda\xe2\x47\x2a\x44\x8b\x2b\xdc\xe3\xf3\x88\xc1\xb8\x07\xab\xa1\x11\xb6\x38\x00\xba\x88\x12\x8a\x83\x30\xa2\xb0\x68\x08\xa4\xe2\x72\x2e\x93\x67\xff\x55\xaa\xa5\xcf\xd2\xa6\x7f\x74\x64\x7f\xd5\x4d\x2b\x6b\x8c\xb6\xd1\xfa\xff\x3c\xdf\x44\xeb\x3c\xdf\x9c\x7f\xbe\xc4\xb7\x71\x9e\x87\xdb\xf8\x6a\x08\x90\xb0\x92\x2a\x39\x18\x3f\xe5\x39\xf7\x64\x32\x52\x1a\xb8\xdd\x88\x88\xe0\x76\x72\x1c\xbb\x84\x11\x99\x02\x66\xfe\xbb\xbd\x64\xbc\xbb\xae\x4a\x40\x3f\xc2\xfd\x12\xc8\x14\x69\xb4\xde\xec\x4e\x16\xe3\x60\x21\x2b\xb8\xd1\x8f\x1c\xb2\x30\x68\x3b\xa3\xbc\x86\xd2\x3f\xf1\x18\x85\x52\xf5\xa2\x96\xe5\xb4\x83\x30\x0e\x16\x97\x20\x58\x64\x19\x23\x52\x7b\xf4\x34\x06\x6e\xe4\x1a\x2e\xa8\x87\x56\x18\x62\x2f\xc9\x1a\xae\xfa\x12\x59\xba\xc2\xb6\x16\x05\xfe\x52\xd7\x3e\xf9\xb0\xc3\xd1\xae\xab\xe2\x04\xb6\x3f\xdc\x85\xcc\xca\xc9\x97\x57\x8b\x07\x11\xc7\x26\xb0\xcd\xf3\x2d\xff\xdd\x26\xf0\xee\x2e\xf6\x2d\x19\x6c\x74\x8f\xb0\x33\xbc\x75\x13\xf5\xfa\xee\xbe\x46\xc5\xba\xf8\xdd\xdd\xc6\xc7\xee\x84\xac\x41\x56\xa0\x55\x7d\x02\xad\xd0\xc1\x18\xa3\x60\xb9\x84\x9f\x1c\x96\x5b\x22\x58\x4e\x09\x44\xe3\x5a\x9d\x2f\xf1\x17\x6c\x4a\xd6\x57\x30\x6e\x76\x7f\x63\x31\x0a\x83\xa2\x64\x14\x85\x23\x51\x50\xcf\x70\x57\xee\x30\x1a\x27\x9b\x9d\xc4\x3c\x38\x97\x72\x37\x8b\x13\x99\x94\x5f\x47\xde\x31\x3e\xbc\x59\x72\x49\xd7\x61\xd5\xd8\xf4\x6f\x23\x95\xad\xa2\x10\x9f\xa5\x95\x6a\x7f\x73\x0f\x3f\xf6\xb9\x0a\x5d\x82\x78\x66\xae\xef\xf2\xeb\xa9\x5c\x41\xc6\x38\x19\xc8\x7f\x7a\xee\x3b\x7c\xb1\xad\xaf\x7c\xe9\xdf\xd9\xd7\xd9\xba\x3a\x5d\x14\x43\x34\xcd\x33\xde\xa3\x3c\x54\xcf\x53\x37\xe2\xf1\x0b\xed\xc4\x7b\x43\x0c\x87\xab\xc8\x04\x88\x83\x8c\x5b\x42\x22\x87\xa2\x5f\xcb\x0d\x2c\x61\x1b\x6e\xe1\xed\xb7\xb6\x66\xfe\x3c\x6c\xcf\x36\xcf\x87\x25\x4a\x58\xc9\x07\xa1\x7f\x86\xb7\x7c\xc0\xc4\x46\x2a\xe1\x39\x9c\x64\xfe\x4d\x4b\x15\xf5\x09\x84\x49\xc8\xb1\xe1\x25\x4c\x26\xdc\xbe\x75\x59\xcd\xae\xc0\xeb\x9d\x35\xdc\x56\xb3\x97\x41\xf0\x39\x00\x00\xff\xff\x7a\x63\xe4\xe0\x85\x07\x00\x00"
+var _xo_dbGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdd\x5d\x7b\x73\xdb\x46\x92\xff\x5b\xfc\x14\x63\x56\xd6\x02\x64\x1a\x96\x1c\xaf\x6f\x8f\x89\x6e\x2b\x7e\xa4\xce\x7b\x5a\x27\xeb\x47\x6a\xef\x14\xd5\x06\x24\x41\x0b\x36\x09\xd0\x78\xd0\x52\xb4\xfa\xee\xd7\xdd\xd3\xf3\xc2\x83\x24\x40\xb9\x6a\xef\x5c\x29\x49\x18\xcc\xf4\xf4\xf4\xe3\xd7\xd3\x8d\x01\xf2\xe8\x91\xf8\xfb\x4f\x2f\x9e\x89\x38\x17\xc5\x65\x24\xa6\xe9\x72\x99\x26\x22\x4e\x8a\x28\x9b\x87\xd3\x48\xcc\xd3\x4c\xcc\xc2\x22\x9c\x84\x79\x24\xd2\x55\x94\x85\x45\x9c\x26\xd8\x39\x2c\xc4\x34\x4c\xc4\x24\x12\x65\x1e\xcd\xc4\x97\xb8\xb8\x1c\x3c\x7a\x24\x8a\xeb\x55\x94\x8b\x79\x96\x2e\x45\x3e\xbd\x8c\x96\xa1\x38\xbc\xb9\x51\x7f\x06\x6f\xe5\xef\xdb\xdb\xc3\x00\x3a\x63\xff\x77\x97\x30\x75\x7e\x99\x96\x0b\xa0\x91\x66\x9f\x88\x90\x9e\xf2\x51\xfe\x79\x11\x00\x7b\x61\x32\x73\xdb\xde\x5d\x05\x03\x9c\x8a\xb9\xd7\xfc\xde\x0c\x6e\x6e\x1e\x8a\x78\x2e\x82\xf7\x79\xf4\x3c\x85\xf6\xab\x02\xa6\x1b\x1c\xbc\xbc\x8a\xa6\x7c\xed\x4d\xe5\xef\x80\xaf\x47\x22\x2f\xb2\x38\xf9\x30\x12\x41\x10\x68\x52\x37\xb7\xbe\xf0\x70\xaa\x37\x51\x5e\x2e\xa0\x53\x94\x65\x69\xe6\x0f\x0e\xfe\x56\x46\xd9\x75\x77\x52\x47\x44\x2b\xfd\x92\x57\x28\x41\x53\x67\x62\x8a\x16\x2d\x36\x5a\x80\x6a\xd4\x12\xbd\xee\x4b\x69\x1f\xb2\x81\xe5\xd6\x41\x2e\x6b\xa0\x36\xe0\xec\x76\x80\x9a\xfe\xfb\x4f\x67\xe9\x07\xb1\xca\xd2\x75\x3c\x8b\xa4\xb9\x2d\xa0\x61\x5e\x26\x53\x69\x42\x93\x6b\xf1\x21\x4a\xd0\xc4\xe0\xe2\x33\x4c\x14\x47\x79\x30\x58\x87\x19\x0f\x3d\xa5\xbe\xad\x33\xdf\x08\x35\xcf\xb3\xb0\x98\x5e\xbe\x8d\x7f\x8f\x94\x59\x2f\xc3\xab\x78\x59\x2e\x45\x52\x2e\x27\x51\x26\xd2\xb9\xc8\x60\x55\x60\x36\x79\x94\xe1\x64\x60\xd7\x34\xe1\x35\xf2\x00\x03\x90\x8c\x61\x65\x82\xe4\xb8\x33\xb1\xa0\xb9\x32\x13\x9d\x0a\xb0\xf2\xc0\x5c\xc3\xaa\xd9\x10\xa3\xcf\x22\x38\x4b\xc3\x59\x94\xbd\x43\x7b\x1d\xae\xd2\xbc\xf8\x90\x45\xf9\x10\xfb\xe0\x44\x57\xe9\xf3\x74\x75\xfd\xee\x4a\x64\x51\x51\x66\x89\xe4\xb8\xc8\xc2\x24\x0f\xa7\xe8\x6c\x5a\x38\xd8\xbe\x89\x2b\x51\xa4\x48\xef\xf9\x4f\x3f\xff\x37\xad\x6f\x24\xc2\x5c\x5e\x2d\xcb\xbc\x40\x4f\xcd\x4a\xf4\x6d\x11\xda\xe4\x47\x70\xe3\x43\x9c\x24\x20\x53\x91\x26\x91\xf8\x72\x19\x25\x62\x36\x41\xd1\x85\x48\xee\x48\xba\x60\x00\x9e\x1a\x31\x8b\x30\x3b\x69\x0d\xd4\x0b\x9d\x70\x3c\xd0\x75\x48\x22\x8e\xc4\x45\x81\x34\x63\xb0\xb7\x34\x43\x4a\x59\xba\x58\xc8\x16\x60\x7e\xfa\x49\xce\x04\x86\x85\x53\x25\x69\x21\x92\x78\x11\x0c\x88\xb0\x12\x89\x07\x42\xad\xf9\xf2\xb4\xb8\x12\x35\x4f\x81\x8e\xd2\xd8\x80\x73\x84\x04\x65\xbd\xef\xae\x46\xd2\x6a\xa4\x01\x4b\x3b\x56\xe6\x0c\x68\x71\x90\x03\xde\x80\x1c\xd7\x62\x7c\x0a\xab\x0e\x3c\x04\x15\xba\x31\x45\xc4\x63\x1a\xe3\xc1\xc1\x81\x5c\xba\x58\x1b\x7a\xc2\xa6\x09\xe6\xc7\x3d\xf0\xce\xed\x08\x57\x33\xb0\xa9\xbc\x78\x36\x6e\xc3\xa6\x83\xe2\x8a\x58\x42\x1e\xd6\xc1\x33\x54\x07\xac\x7d\x8a\xad\x40\xc5\x77\xbd\xbc\xa1\xb3\xe7\xdb\xde\x76\x70\x80\x56\x07\x1d\xee\x9d\xe2\x70\x5c\x8b\xe6\x1e\xae\x47\xf2\x07\x74\x80\xf6\x5b\xb3\xb0\xe2\xaa\x75\x65\x48\xa0\x81\x26\x70\x02\x9e\xbe\x58\xa0\x32\x81\x85\x03\x33\x8d\x24\x4e\xd4\x0d\x79\x50\x16\x1a\x05\xf5\x64\xf9\x40\x8f\x41\x23\x6b\x69\x96\x07\xaf\xa3\x2f\xde\x50\xd9\x77\x18\x2f\xa2\xd9\x98\x6d\x3b\xfa\x5c\xc6\xe0\x41\x60\x7a\x2c\x59\x30\x30\xa5\xaa\xa1\x3f\x70\xdc\x0a\x65\x6c\x3b\xd6\x3a\x5c\x94\xf0\x13\x31\x20\x14\x1f\x73\x70\xaf\x69\xba\x28\x97\x09\x91\x3e\xac\xfb\x19\x92\xaa\xbb\x1a\x5a\x78\xb2\x86\x4b\x69\xcf\xb9\x38\xbf\x98\x5c\x17\x8a\xba\x17\x47\x23\xa6\x1e\xbc\x09\xbf\xfc\x35\xca\xf3\xf0\x43\xc4\x5e\x60\x98\x20\x16\x66\x59\x0c\x74\x82\x5f\xb0\x05\x24\x5e\xa4\xd0\xa6\x10\x4e\xb9\x6f\x94\x4c\x53\x84\x4c\x7b\x12\x58\x7d\x4e\xbc\x41\x4b\x18\x88\x5f\x64\x1b\xca\x09\x59\x02\x32\xcc\xa0\x08\x33\xcb\x6d\x81\xf7\xcb\x30\xf9\x10\xcd\x5c\x47\xc3\xe0\xb3\x16\x0e\x9a\x5a\x17\xa8\x6d\x50\x7f\x46\x3e\x92\x45\xf3\x45\x34\x2d\x24\xc3\x3f\xcd\xbd\xb5\xff\x1d\xdc\x09\xfe\x2b\x4e\x66\x9e\x2f\x4e\x4d\x87\x9f\x8b\x4c\xdc\xbf\x8f\xf7\x5e\xe5\xaf\xe3\x85\x47\x4e\xa5\xbd\x48\xaa\x1e\x56\x42\x66\x4c\xf4\xd7\xe0\x97\xe9\x27\x69\xd6\x9e\x2b\x96\xef\xf0\x06\x0e\x47\xd8\xd5\xf6\x49\xd7\xd2\x5e\x00\x7f\xd7\xdc\x9b\xec\xab\xdd\x03\xd6\xd2\xea\x6f\x37\x2d\x29\x5c\xb4\x2d\xea\xed\x22\x86\x4d\x86\x5c\x16\xc2\xb9\xe7\x07\x2f\x17\xd1\x12\x7e\xd5\xfb\xbe\x07\x11\xfe\xc9\x5e\xb4\x54\xab\x07\x43\x9f\x81\xd2\x72\xcf\xf7\x1d\x07\x80\x69\x07\xb7\xb6\x23\x4b\x2b\x66\xcd\xda\x36\xfc\xcb\x0f\x67\xef\x5f\xbe\x15\xab\x05\xee\x78\x2e\xd3\x05\xc4\x96\x9c\x76\x6a\x89\x8c\x6b\x60\x58\x60\xd4\x39\x1b\xca\x08\x02\x08\xcc\x8b\xd4\x96\x61\xfe\x89\x3a\x46\x21\x18\xb4\x3d\x5e\xda\xec\xd0\xfb\xe6\x64\x24\xbe\x79\xec\x8f\x84\xf7\xcd\xb7\xf0\xd7\x13\x7f\x88\xbe\x35\xf4\xfe\x3c\x12\x7f\xc6\x56\xfa\x3d\xf4\xb5\xf9\x48\xee\x3c\x22\xac\xcc\x96\xf0\x7f\x81\xe1\xb5\xf0\xb9\x11\xc5\x40\xac\x81\xb8\x97\xe1\xa7\xc8\x3b\xbf\xd0\xbd\x7d\xb2\x83\xa6\x5b\x48\x05\xee\x22\xc3\x31\x29\x0a\x4d\x57\x2e\x11\xc5\x8a\xed\x1f\x4d\x3b\x11\x21\x55\xe3\x5f\xe7\x1f\x2f\xc4\x29\x2d\x98\xd1\x4b\x12\xcd\x29\x64\x84\xe0\xcb\xc4\x33\x2c\xf9\x0f\x43\x9f\xe1\xcc\x0c\x9b\x2f\x41\xd5\x2b\xe8\x5f\xcc\xb9\x5b\x7c\x84\xcc\x3c\xf8\xf8\xe0\xc4\x57\xc8\x46\xd8\x09\xbc\x9c\xc7\x38\x64\xe8\x0d\xc5\x03\x3d\xc9\x5f\x52\x40\x65\xa4\x07\x13\xc0\x7f\x3e\xdc\x1a\xfa\x43\x32\x3b\xc7\x18\xb8\xa7\x8c\xd6\xd4\x93\xd1\xeb\x79\x99\xe5\x29\x45\x46\xd8\x63\xa7\xab\x10\x76\x27\x62\x2a\xdb\x08\x36\x60\x04\x46\x72\xb4\x86\x15\x60\x4b\xae\xf7\x34\x40\x1e\xf6\x4d\x6b\xbd\x63\x70\xb7\x32\xdf\xbf\x4a\x66\xd1\xd5\x7f\xfc\x8c\x70\xc4\x40\x46\xe0\x42\x98\x71\x49\xb2\x3d\x44\xc5\x41\x27\xda\x73\x83\x0c\x96\x21\x6c\x8b\x3e\x45\xd7\x48\x48\x1a\x14\xef\xbc\x99\x45\xb9\x10\x62\xfa\x65\x96\xbd\x4a\xa0\x4f\x3c\x33\xec\x23\x55\x19\x46\x34\x08\x51\xdc\x07\xae\xe3\x24\x24\xfc\x0c\xe7\x00\x35\xb8\xce\x58\x0e\x36\xeb\xc7\x9d\x03\xdf\x46\x3a\xcf\x8d\x00\x60\xbf\x70\x09\xad\x92\xd3\x54\xfd\xa1\xf8\x43\x98\xa8\x31\x73\x5a\x09\x2c\x74\x8f\x85\x0a\x72\xe7\x98\x21\xfb\xda\xde\xf6\xdc\x15\xbb\x25\x31\x04\x7e\x90\x8c\x02\x64\xd4\xb7\xc1\x55\x1a\x45\x36\x50\xdb\xa9\x32\x45\xb0\xba\x49\x39\x1f\x89\x7f\xa0\x0d\x53\xb0\xf8\x6b\x98\xe5\x97\xe1\x82\x46\xf9\xda\x54\x98\x14\xe6\x3e\x4f\x9f\x60\x40\x79\xff\xe6\xec\x25\xf3\x10\xd0\x1f\xd1\xbb\xf4\xad\x04\x17\x20\xe8\x2b\x1b\x9a\x45\x78\x8b\x7f\xf1\x86\x3b\x84\x8d\xa0\x66\x19\xd7\x64\xad\x10\x82\x06\xf4\x83\xcd\x14\x24\x69\xd3\x4f\xd2\x24\x20\xcb\x63\x23\x5a\x65\xd1\x34\xa2\x65\x5b\x4b\xc6\xe0\x62\x2d\xdb\x9b\x32\x2d\x9f\x67\x95\xeb\x3f\xbf\xb0\x96\x3f\xa2\x49\xdc\x60\xa3\xf7\x19\x24\x0f\xde\xdc\x34\xaf\xf7\x05\xd1\xe5\xd5\x32\xa2\x4e\x11\x49\xeb\x90\xcf\xe2\xab\x1a\x82\x0a\x3d\x19\x2e\x04\x99\xab\xc4\x69\x4d\x4a\x69\xe5\x7d\xb2\x64\xbd\x10\x7b\xf7\x71\x18\x04\x08\x6b\xb6\x7f\xfe\x53\x2c\xa2\xc4\xa3\x1b\xd8\x86\x17\xa4\xc3\x07\x27\xdb\x38\x91\xe8\x36\x92\x1b\xd0\x0a\x90\x01\x1f\xb6\x40\x5c\x13\xa9\x71\x70\x0f\xb7\x02\x79\xf0\xf2\x73\xa9\x38\x45\x7e\x00\x9d\x7c\x27\x00\xd6\x99\x30\xf1\xb0\x79\xd5\x44\x46\x2f\xe9\x42\x2a\xd0\x9d\x7e\xab\xb8\xcd\x26\x8f\x8d\x13\x21\xe8\x55\x32\x4f\x15\x46\x28\x3c\xa0\xea\x03\xc8\x64\x29\xff\x26\xaf\x5b\xd1\xee\xa9\x09\xe0\xc2\xcd\xf0\xc6\x48\xa5\xe7\x02\x7b\x29\xa7\x05\x72\xab\x21\x46\xda\x30\xd5\x3e\xf8\x9a\x9d\x02\x27\x05\x2c\xc4\x39\x83\xc1\x81\xea\x7c\x7e\xc1\xab\x22\x12\xff\x19\xe6\xaf\x61\xef\x44\xf3\xe1\x3a\xb2\x92\xf3\x27\xe2\x74\x0e\x7b\x63\x80\x69\x45\x0c\xa8\xd8\xfd\x27\x69\xba\xd0\x54\x7e\xce\xa2\x75\x9c\x96\x79\x0b\x25\xe9\x7b\x51\x85\x94\x33\x88\xc8\x49\xd1\xbe\x2d\xc2\xac\x68\x00\x32\x13\x3f\xf0\x6a\x1e\x67\xe0\x86\x18\x47\xac\x05\x8f\x30\x3d\x09\x93\x6b\xe5\xcf\x2b\x71\xa4\x84\xe7\xdb\x74\x3d\x1b\xc4\x60\x08\x5a\xc7\x2a\x60\x21\xd1\x2e\xe8\xd8\xb6\x89\xe1\xd0\xb1\x02\xdd\xf3\xfc\xf8\x82\x79\x7e\x99\xcc\xb6\x72\x4c\xe0\xd5\x85\x61\x4d\xf4\xae\xd8\x75\xc7\x3d\x3c\x51\xdc\xbf\x9d\x86\x09\xd8\xa0\xdc\xad\x6e\xac\xa5\xc9\xf2\x18\xe1\x6a\xbc\x5c\xc1\xd6\x31\x4a\x20\x1b\x86\x60\xa6\x60\xd6\xa9\x72\x31\x5d\x0a\xc5\x70\xfd\xc8\xd9\x15\x1b\xca\x2a\x22\x57\xd8\xb0\x8a\x62\x07\x16\xb5\xc1\x81\x43\x86\x97\xb0\x02\x67\x8f\x7e\xc8\xb2\xf0\x5a\xe4\xab\x05\x46\x36\x2a\x43\xc8\xf4\x09\xec\x2f\x07\x46\x2d\x97\x4c\x93\xe8\xe1\x2c\x06\xee\x73\x68\x82\x5d\x7c\x48\x23\x61\x4a\x2a\x41\xe0\xf0\x48\x2e\x0e\x76\x18\x52\x8c\x18\x39\x10\x2a\x50\x0a\xaf\xdf\x9f\x9d\xe9\x0e\xac\x3a\xc3\x80\x97\x67\x53\xe1\x44\x8c\x24\x5c\x46\xbc\xd1\x80\xc4\xfe\xfc\xe2\x48\xed\x10\x4d\x1e\x8f\x78\x0e\xa0\xc7\x39\x92\x9b\xd7\x03\xbd\x6a\x62\x2f\xbb\x61\x5e\x8f\x83\x28\x05\xa1\x76\x49\xd8\xb4\xcb\x7e\x80\xb6\x20\xb5\x68\x1e\x96\x8b\xc2\xaa\x05\x34\x64\xab\x72\x53\x81\x5b\x41\xe4\x99\x37\xf8\x58\xfb\x88\x96\xe9\x1a\xbc\x34\x43\x75\x69\x1b\xc4\x68\x2d\xbe\x17\x8f\x11\xbe\xe1\x6f\x70\x07\x04\xd4\xc3\x9b\x43\xd5\xa0\x3a\x81\xa9\xd1\x9d\xdb\x43\xdb\x4e\x77\x9b\x9f\x57\x82\xe4\x4e\xc4\x58\x58\x24\x81\x35\x54\x02\x6d\xbd\xb5\x50\x6f\x6e\xad\x1d\xf7\xf1\x77\xf0\xfb\x7b\x3d\x06\xae\x1e\x3c\x30\x89\x18\x8c\xd5\xf2\x3e\xf8\x5c\xa6\x08\xc1\x63\x39\x13\xee\x89\x81\xdf\xe1\xa1\x0c\x63\x7c\x93\xe2\x10\x48\x83\x2f\xd9\x02\x46\xb2\xe6\x8b\x65\x84\x1c\x7c\xfc\x52\x44\xf9\x34\x5c\xa1\x98\xe4\x1e\x1f\xe6\x74\xd9\xc0\x04\x8c\x27\xb9\x47\x93\x18\xbe\x54\xd0\x54\x0c\xfc\xfa\xeb\x21\xf6\x8e\x21\x10\x5b\xe3\x65\xcf\x03\x18\x44\x7f\x50\x9d\x82\x44\x01\x72\x0a\x57\x2b\xc8\xbf\x3c\xbc\x1a\x31\x25\xbd\xe5\x47\xe2\x31\xd2\xad\x50\xea\xa0\x0f\x45\x88\xa6\xbe\x95\x65\x9d\x1b\xb5\xd2\x8d\xcb\x1c\x6d\x58\xe6\xcd\xa1\x5a\xd3\xae\xac\x60\x46\x32\x16\x4b\x30\xe8\xb8\xe6\xc7\x32\x26\x62\x49\x2e\x2f\x57\xab\x14\x4b\xa4\x43\xbf\x9b\xa4\xd0\xec\xa5\xb4\x36\xac\xa7\x5e\x9a\xda\x26\x3c\x22\x0b\x06\x54\x26\x6c\x42\x84\x24\x98\x25\x39\x98\x22\xe7\xbe\xc7\x7d\x60\x5a\xde\x28\xe2\x6d\x82\xfb\x21\xf6\x1e\xca\xf9\xa5\x0b\x38\xcb\xc9\xb9\xf0\x06\x77\xb1\xd2\x18\x27\x65\xa4\x26\x07\x4a\x04\x29\x16\xc1\x41\x1b\x8d\xfb\xd0\xcb\xcd\xf1\x0d\x71\x1d\xab\x91\x8e\x2c\x2b\xd0\x32\x72\xfa\x33\xd5\xf9\xea\x88\x00\x93\xd5\x82\x7e\x92\x96\x45\x05\x3f\xc5\x0f\x44\xb0\x4e\x2d\x2f\xd2\x0c\xd6\x1f\x52\x12\x19\x2d\x57\xc5\xb5\x24\xa4\xc2\x85\xd5\x5b\x25\xdd\x3a\x9c\x89\x1c\xd0\x3e\x9f\xc7\x9c\x38\xd8\xd1\xc8\x8d\x68\x16\x15\x15\x84\xf3\x5c\x1c\x59\xcd\x3e\x11\xac\xa2\xba\xb5\xeb\xc7\xec\x1c\x6e\x9e\x9a\xbd\xe4\x51\x8e\xd2\xa4\x12\xa1\xbd\x75\x24\x51\xb2\x0c\x79\xaf\xea\x86\x0d\xc8\xa0\xad\x89\x87\x9b\xd2\x02\xaa\x51\x52\x26\xa0\x2b\x0f\xd6\xd0\x11\x59\x2d\x4d\xe5\xfb\x7a\x93\x1e\x99\x4d\xba\xd4\x38\xef\xd2\x23\x9b\x77\x6b\x82\x9a\x2d\x5b\x33\x8c\x1d\x2d\x0e\x7d\xae\x28\xac\x65\x39\xe1\x28\x22\xe8\x96\x72\x58\x37\x6d\xa0\x29\x7a\x57\xb4\xd4\xb2\x3f\xd8\xa0\x27\x47\x4d\x5c\x3f\x13\x4e\xf5\xcd\x09\xb2\x0d\x45\x1a\x94\x53\x6e\x0b\x29\x37\x42\xca\xa5\x84\x78\x4d\xbf\x0d\x7f\xb3\x4a\x24\x6f\x22\xaa\x3f\x79\xb5\xeb\x91\xf8\xed\xd7\xdf\xf0\x07\xfe\x7c\x78\xe2\x8f\x70\x20\x5e\x0f\xe5\x35\xd0\x80\x06\xbb\x96\x32\xbc\xa9\xd7\x5e\xb0\x9c\x22\xeb\x2e\xb7\x43\xdb\xe1\x5e\x25\xc5\xd3\x27\x4d\xfe\x16\xe3\x8d\x6e\xee\xe6\xd2\xda\xe2\x6d\x56\x67\xca\x87\x9f\x3e\xe9\xee\x6b\x86\x86\x52\x21\xcc\x7b\x64\x5a\xfb\x78\x5a\xdc\xdb\xd3\xcc\xbc\x5d\x1d\xcd\x8c\xfc\x4a\x7e\x66\x26\xd8\xe0\x66\xaa\x72\x0c\x86\x83\xc5\xf2\xe0\x67\x5c\x20\x8c\xf4\x8e\x80\xad\x93\xe3\x91\x78\xfa\x64\x73\x2d\x59\x3f\x43\x41\x4f\x8d\xef\xcc\x53\x1b\xb5\x6c\x2b\xb9\xb7\x9f\xc6\xb6\x90\x13\x23\xe4\xd8\xf1\x53\x25\x8f\x1f\x29\x0b\x47\x81\x24\x28\x0f\xbf\xaf\xcb\xfd\xb8\x48\xc3\x16\xa7\x9b\xcb\x5b\xbb\xb9\x1d\xd2\x42\x15\x54\xe9\x6d\x71\x3c\xa7\xfb\xf9\x05\x4f\xd9\xdd\xf9\x6c\x3a\x4a\x31\x73\x70\x3f\xbb\xbd\x8f\x03\xce\x7b\x3b\xa0\x3d\x73\x57\x17\xb4\xc7\x7e\x25\x27\xb4\xa7\xe8\xea\x86\x34\x96\x1c\xb1\x93\x13\xce\xef\xcc\x09\x5b\xb4\xed\x2a\xbb\xb7\x23\xce\x6d\x41\xcf\x8d\xa0\xe7\x1b\x1c\x51\x8a\x64\x3e\x12\x87\x1f\x0e\x31\x10\xb2\x68\xfa\xf9\xe4\xb3\x34\x5d\x34\x39\x24\x96\x91\xba\x05\x41\x87\xd2\x16\x57\x34\x7d\x21\x6d\xa4\xfa\x57\x57\x27\xd4\x14\x94\x4e\x26\xe0\x81\xba\xb1\x8f\xfb\x4d\x7a\xbb\x9f\x9e\xb6\xab\xef\xe9\x81\x5f\xc9\xf1\x34\xfd\xae\x5e\x87\x03\xc1\xe9\x3a\x38\xdc\xe4\xce\x1c\xae\x49\xb3\x96\x62\x7b\xbb\xda\xc4\x16\xed\xc4\x88\x76\xe2\xb8\xda\x70\x3e\xe4\x82\x3b\x3f\x42\xe4\xe6\x62\xa8\xd7\xda\xc3\xcb\x5e\x97\x8b\xc5\x86\x0c\x0f\xac\xdc\xf4\x60\xaf\x4b\xa0\x21\x9c\x2c\x22\xe5\x7e\x54\x2a\x5c\x86\xf4\xf8\xed\x32\x5c\x47\x8d\x7e\xd8\x34\x91\x71\x45\x1c\xc1\x0e\x58\xed\x07\xa2\x72\x98\xe8\xee\x90\x15\x8a\x4a\x79\x09\xe6\x80\x95\x7b\x7d\xbc\x33\xe9\x9f\x08\x56\x66\xef\xea\xa4\x95\xe1\xfb\xb8\xaa\x53\x43\x30\xe8\xee\x48\xfe\x46\xfe\x1a\x0b\x8c\x79\xbf\xa0\x1b\x8f\xe9\x29\x00\xfb\x59\x72\x77\x89\xe0\x06\x8d\xd5\x14\xb6\xd5\xeb\x60\xad\xc4\x5a\x4d\xa2\xea\x38\x4f\x55\xac\xae\x73\x26\x79\x8b\x34\x13\x37\x75\x94\xf5\x1a\x96\x6d\x40\xe2\x71\xfc\x74\xb7\xd4\x32\x0a\xde\xf2\xdc\xbb\x65\x98\xfb\x39\x7e\x6b\xa6\xa9\x14\xff\xca\xca\x38\xfb\xbb\x7d\x5b\x12\x5a\xf1\x7a\x27\xfd\x74\x38\xe8\xe7\xf3\xf5\x24\x25\x89\xd9\xe5\xf7\xcb\x47\x93\xfe\x09\xa9\x3b\x79\x1f\x87\xbf\x9b\xc4\xb4\xe2\xef\x89\xe6\x78\xff\x4c\xb3\x0a\x1e\xc4\xf0\x0d\xfd\x1c\xe3\xc1\x9b\x06\xe4\xb8\xbb\xc4\xb4\x5d\xef\x55\xb5\xef\x84\x1b\xf1\x1e\xb8\x11\xb7\xe1\x46\xdc\x09\x37\xea\xa9\x6e\x14\xd0\x3a\x38\xe1\xdd\x0f\x01\x36\x24\xbe\x4a\x7f\x3f\x3a\x09\x70\x7f\x14\x68\xcf\x89\x2b\x38\x50\xc9\x86\x2b\x7c\xf4\xc3\x82\xa6\x5c\x29\x99\x33\x1a\xec\x9b\x1e\x27\xfd\xf3\xe3\xea\xf4\x7d\x10\xe1\xae\xf2\xe4\x0a\x26\xcc\x9b\x31\xa1\x47\xda\x5b\x45\x04\x66\xf8\x86\x7f\x8f\xc5\xbc\x11\x15\xee\x2e\x53\xde\x64\x01\x75\x03\xd8\x09\x19\xe6\x7b\x20\xc3\xbc\x0d\x19\xe6\x7b\x20\x83\xd4\x4b\x14\xf0\x5a\xaa\x39\xf8\x7e\x30\xd1\x96\x8b\x2b\x8d\x3e\x33\x39\x79\x1b\x40\x10\x3a\x10\xbd\x36\x80\x68\x49\xd3\x2b\xe8\x60\x27\xe8\xf6\xf4\xfd\x70\xa1\x96\xd2\x25\x13\x06\x85\xbd\x32\xf6\xa4\x7f\xca\xee\xcc\xdd\x07\x0e\xee\x24\x75\xaf\x60\xc1\xa4\x19\x0b\xba\x25\xe3\x55\x18\xc0\xd1\x37\xf8\x63\x2c\x26\x8d\x00\x70\x77\x99\x7b\xab\xaa\x2b\x9a\xde\xc9\xf5\x27\x7b\xb8\xfe\xa4\xcd\xf5\x27\x9d\x5c\x5f\xd6\x02\xe4\x5d\x64\x5f\x1d\x0f\xb6\x4b\x02\xea\x04\x70\x77\xaf\xdf\xe5\xb5\x20\xee\x23\x0f\xb5\xca\x43\xe7\xef\xe8\xe0\xd0\xf0\xfd\xfb\x57\x2f\xa4\xf1\xaa\x13\xe2\xba\xa5\x82\x1e\xd8\xde\xad\x92\xe7\x50\xda\x52\xc9\x33\x7d\xcf\x2f\xca\x32\x9e\x05\xd8\xd0\x1d\x25\x34\x19\x65\x36\x25\x00\x84\x6e\xec\x03\x0e\x65\x6f\x6c\x30\xa2\xed\x88\x0b\x7a\xe0\x57\x2a\xe7\x69\xfa\x3b\x94\xf3\x48\x17\x04\x1f\xdd\xea\x78\xe5\x9d\xa1\x41\x93\x4a\x2d\x8d\xf6\xae\xe3\x95\xb6\x4c\x4b\x23\xd3\xd2\xf1\xed\x92\x53\x7c\xaf\x57\x65\xdc\x7a\xf9\xa2\xdd\x05\x11\xd5\xea\x6e\xe8\xb4\x56\x5c\x91\x94\xa2\x3a\xec\x1d\xc9\x5b\xdc\xb4\x12\xc9\x6b\x0e\xaa\x1a\xfb\x85\xf2\x9a\x56\x93\x92\x43\xf9\x5e\xde\x9a\xf4\x77\x57\x57\x0d\x3d\x42\xf9\x9d\xb8\x6d\x25\x94\x97\x9a\xdf\x3e\x8e\xa8\x8d\xd8\xd6\xd7\x0d\xfe\x18\x8b\xb2\x31\x88\xdf\x9d\xdb\xb6\x2a\xb9\xa2\xe3\x9d\x82\x78\xb9\x47\x10\x2f\xdb\x82\x78\xd9\x29\x88\x47\x14\x91\x2c\x34\xe8\x1f\xad\xad\xd7\xb1\xe8\x68\xec\x9b\x68\x9a\x66\x33\xfb\x98\x2c\x48\x78\x31\xcb\xf9\x15\x11\x79\x17\x4f\x32\x23\xdf\x6d\xc7\x5f\x91\x9a\xc7\x71\x35\x99\xe9\x03\x6d\x3e\xd3\x62\x0d\x58\xf3\x79\xe6\x74\xeb\x4e\x07\x62\x99\xa7\x2d\x47\x3b\x4f\x5b\xce\x76\xd2\xe8\xea\xe1\xce\x11\xd8\xcc\xdf\xf0\x2f\x22\x3b\x0f\x17\x39\xb0\x42\xbf\x06\x6d\xe7\x17\x3d\x3d\x82\x4f\xb4\xea\xc3\x7f\xd6\x84\xea\xb0\xee\xd4\x9c\x1e\xfd\x8e\xdd\x0b\xcf\xe4\x4e\xf9\x28\x29\x1d\xe4\x54\xe4\xea\x87\x3a\xd5\xe9\xc2\x07\x27\xea\xec\xe9\x98\xf6\x6d\x78\xa8\x3a\x2d\x01\x6a\x67\xf2\xdc\xe9\xc0\x3e\xfa\x29\xd7\xa9\x0f\xee\xd1\xe5\x08\xc7\xfa\xb5\xe9\x25\xb5\x9a\x24\x4e\xc9\x21\x47\xe2\x9e\x6a\xa9\x0c\x6c\x3c\x81\x3a\xde\x81\x09\xeb\x44\xa5\x75\xf4\xb8\xad\xf7\xd4\x79\x2f\x8d\x22\x17\x33\xd8\xef\x90\xa5\x7b\x7e\x92\x9e\x59\xe3\x44\xd6\x61\x79\x65\x61\x2e\x27\x3b\x1f\x9d\x94\xe4\x06\xed\x64\xea\xa7\x27\xad\x09\x18\xe5\x88\x43\xe9\x1f\x3f\x92\x58\x3e\xcb\x35\xd7\xdc\x50\xb0\x98\x8c\x33\xd2\x8b\x69\xe4\x7f\x7c\xf2\x11\xc8\x29\xe7\x64\xef\xab\x52\x97\xac\x89\x23\xe5\x78\xe6\xdd\x46\x90\x16\xab\xa5\x06\x7b\x95\x97\x09\x76\x7b\x52\x71\xc4\xec\xee\xf4\x9c\xc2\x3a\xc0\x5f\x87\xa6\xd6\x13\xfc\xd3\x74\x09\x39\x47\x5c\x44\xf4\x4e\x02\xbe\xe9\x42\x63\x91\x14\x0c\x8e\xb2\x70\x41\xc7\xf9\xe9\x2c\xbf\x12\x7c\xcb\x49\x7e\x07\xb2\x2c\x3e\xfe\x1f\x1f\xe4\x87\x55\x82\x38\x2f\x41\xa8\x5b\x4f\xf3\x7b\xad\xa7\xf9\xfd\x1e\xa7\xf9\xcd\x0b\xa4\x6e\x68\x38\x3f\x19\x5b\xe4\x47\x3c\x40\x9a\x86\x7c\x9d\x8a\x6d\x43\x5e\x28\x9d\x52\x82\xa7\x9c\x85\xf5\xce\x8a\xb4\x47\xb1\x53\x9a\x50\x62\x1b\x7f\xeb\x21\x17\x1a\xd3\x72\xd0\x45\xd2\xb3\x02\x7a\xdd\xd9\xdc\x2d\x7c\xc3\xfb\xb5\x26\x60\xfb\x43\x5e\x6a\x11\x2f\xa3\xbc\x08\x97\xab\xb3\xf0\x1a\x72\x5d\xf3\x76\xd7\x82\xaf\xf9\xdd\xa1\x06\xb7\xa0\x7b\xb3\x90\xe0\x23\x99\x39\xc4\xf8\x95\xd2\x1a\xf1\x53\x7d\x6e\x1a\x16\x32\x7c\x7c\x7c\xfc\xf4\xe1\xf1\xc9\xc3\xe3\xc7\xe2\xe4\x8f\xe3\xe3\x27\xe3\xe3\x3f\x06\xff\xae\xfe\xfd\xcf\xf1\xbf\x8d\x8f\x8f\xe1\xbf\xe1\x68\xb7\xae\x3b\xf5\xdb\xde\xa9\xd2\x03\x2e\x2d\xb8\x78\xa7\x16\x24\x2f\xb7\x20\x06\xca\x06\x01\x55\x8b\xc1\xf6\x78\x4d\xca\xcb\x8d\x83\x63\xcf\x00\xef\x38\x1b\x13\x20\xf1\x8f\x11\x2b\xc4\x58\x44\x4d\xb8\x68\x1b\x85\xde\x4f\x13\x29\xb9\x9f\x96\x23\x47\x22\xb7\xb6\xd5\x0d\xa9\x74\xc1\xbb\x4d\xb9\xf1\xd3\x86\xa4\x79\x42\x3c\x6a\x72\x37\xcd\x88\x60\x8b\xa3\x92\x1c\x05\x53\x2f\xd7\x2f\xd7\xe6\x90\xeb\xd8\x81\x07\xaf\xb7\xc8\x8f\xbd\x4c\x06\x0a\xc2\x56\x7a\xdd\x96\x10\x37\x0a\xd7\xe8\x4d\xf4\x6a\xac\xfe\x48\x83\x7c\x29\x1f\x69\xfe\x1e\x65\xa9\x7c\xe7\xd6\xaf\x06\xa9\x0a\x23\x5e\xf5\xed\xda\x91\xa8\x44\x2d\x3b\x1f\x6b\x0b\x5a\x7a\x9b\x0e\xbc\x85\x8b\x45\x3a\x45\xd5\xaf\x52\x22\x9b\x4b\xb6\x74\x02\x4b\xea\xcb\xfd\x0d\x1f\x54\xe0\xf7\x45\xb7\x7c\x26\xa2\xf5\x43\x0a\xd8\x85\x78\xd3\x7d\xde\x46\x85\xa7\xee\xa2\xee\xcc\x1d\xe7\xa3\x0c\x08\x3d\x1a\x2e\x2b\x62\x32\x43\x5e\x29\x59\x79\x3e\xcb\xca\x37\xaf\x08\x5b\x1f\x9b\xa0\x4d\xaf\x0c\xcc\x3a\x2a\xd1\xfb\x4e\xb8\xbc\xda\x87\x63\xb4\x99\x61\x98\x39\x9a\xa9\xfa\x4c\xd5\x57\x7c\xd5\x7f\xf5\x99\x32\x3e\x35\x62\x66\xdc\xa6\x79\x14\xf4\x90\x49\xcf\xa9\x65\xff\x2a\xf6\x99\x54\x1e\x69\x49\x1a\x33\x6a\xf2\x38\x0e\x12\x2c\xf3\xc7\x69\x74\xa4\x3c\x42\x6a\xb9\x6a\xc7\x03\x7a\xa6\x95\xde\x9a\xc1\xd2\xa7\xbc\x09\x96\x30\xe6\x54\x21\x91\x47\xe7\xcd\x83\xee\x86\xe7\xdc\xb9\x7c\xcc\x4d\x8f\x54\x89\x5e\x8c\xa7\x8a\x7d\x8b\xda\xc9\xd3\x1e\xf4\x4e\x9e\xda\x04\x4f\x9e\xba\x24\xbf\x7d\xdc\x83\xe4\xb7\x8f\x6d\x92\xdf\x3e\x76\x49\x3e\x7d\xe2\xaa\xb3\x8d\x0a\x3d\x15\x92\xa3\x4a\x47\x54\xe5\x66\x46\xf0\x9b\x21\x75\x61\x95\xae\xb4\xca\x8a\xb8\x3a\xd0\xb4\x04\x56\x56\x25\x56\x56\x44\xd6\x81\xac\x25\xb4\xb2\x2a\xb5\x72\x9b\xd8\x6c\x42\x96\xdc\xe8\x9c\xb6\xe1\x47\x9f\x15\x37\x0f\x4f\x1b\x9f\x9d\xe6\x0e\x37\x4c\x84\xf6\x12\x16\xd9\x8d\xfc\x68\x3a\x16\x33\x95\x4f\x04\x68\xb7\xa8\xb4\x5b\xfe\x6c\xf6\xa5\xea\x23\x3d\x98\x33\x8a\xcb\xe8\x8a\x37\x56\x9c\x63\xa9\x1d\x8d\x7c\x9f\x7b\x1e\x5f\xc9\x17\x60\xae\x7e\xf3\xab\x35\xa2\x5a\xa0\x22\xaa\x2d\x41\x4a\xa6\x81\x66\x85\x30\x6f\xe5\xd3\x09\xe7\x8f\xc7\x17\x8d\x9b\x61\xfc\xf8\xc9\x4b\x9c\x6e\xee\x0d\xcb\x44\xbf\x01\xe8\x86\x2f\xaa\x33\xfe\xe1\xdd\x90\x3f\x06\xe0\xbe\xe4\x96\xa9\x97\x8a\x33\x03\xb8\xb2\x1e\xe5\x7c\xe2\x6c\x97\x38\x49\x5f\x00\xe1\xe7\x13\x95\xc0\x57\x25\x5e\xfd\x82\x91\xd7\x90\x5c\xb4\xea\x02\x4f\x7e\x5a\x9f\x1f\x92\xda\x33\xdf\x1d\xa2\x83\xa1\x0d\x7b\x0c\x53\xd3\x22\x79\x53\x88\x40\xe5\x81\x4e\x50\xe2\xd5\x8f\x73\x58\xf1\xe8\x7e\x6e\x15\xc3\x66\x6b\xbd\xcf\xe1\xb2\xda\x0b\xa9\x16\x30\x48\xd8\xc7\xc3\xa2\x9e\xcb\xaf\x39\xc1\x1d\xfe\x8b\x97\xbc\xa9\xee\x99\xe8\xef\x7c\xa9\x68\xa6\xb6\x66\x3a\x7e\x5d\xd1\x94\xeb\x6a\xf4\x82\x91\xd5\xec\xc8\x44\x17\x63\xd9\xb9\xd0\xd9\xfc\x95\x5f\xcb\xbb\xf0\xee\x15\xb7\x6a\x0c\xc8\x1b\x0f\xdd\x5c\xf1\x71\x1b\xea\x6b\x79\x68\xbd\xb7\x74\xce\xab\xea\x03\x78\x1a\xa8\x02\x56\x6e\x9f\xe7\xbd\xe2\x0a\x93\x7d\x98\x97\x7a\x3b\x01\x9a\x78\xe5\x29\xbc\xea\x4e\xf4\xfc\xa4\xd9\x51\x48\x2e\x1d\xbd\x65\xed\xba\xca\x7d\xa7\x9a\x41\x3b\xa9\x67\x69\x89\x9f\xd5\x8b\x37\x3a\x09\xde\x9a\xc8\x8e\xd2\x65\x70\x24\x57\xff\x6d\x2a\xe6\x83\x17\x8b\xf4\x4b\x94\x8d\x04\xf0\x17\x65\x42\xfe\x3b\x52\xc6\x40\xf7\x5e\x25\x53\xbe\x0d\x7f\xc9\xcf\x49\x1c\xc8\xfa\x88\xf3\xcf\xfa\xce\x84\xcc\xf4\x69\xf3\xbe\x4b\xfa\xc0\xaf\x1a\xa9\x9a\x82\x64\x7f\x24\x61\xa2\x5a\x56\x08\x21\xfc\x24\xd4\x03\xbf\xf3\x81\xbf\x9d\xea\x02\x52\xda\x56\x5c\x38\xb2\xe4\xf0\x7f\xa5\xbe\xa0\x3f\x53\x25\x6b\x09\xb8\xe5\x22\x15\x0c\x6d\xb7\xbe\x6f\x2d\xec\x86\x6e\xf3\x33\x81\x91\xb3\x6d\xb7\xbe\x38\xf0\x29\x2a\x6a\x55\x8a\x6f\xb1\x28\xe1\x59\x65\x8a\xf3\x43\x55\x41\xd5\x75\x0b\x5f\xf7\xa9\x56\x2e\x2e\x74\xe7\x7a\x4d\xc3\xef\x5e\xd4\x38\x98\x18\x35\x91\x1e\x76\xa9\x6d\xec\x06\x7c\x7a\xd9\x34\x03\x7d\x9d\xe7\xf1\x1e\x55\x17\x47\xf8\x40\x85\x5c\x67\x2c\x1d\x03\xdb\x40\x78\x23\x7c\x0e\x84\x7e\x64\x37\x9f\x50\xb3\x72\xb4\xb1\x92\xf3\x29\x09\x5e\x8f\xd0\xb7\x6c\xa9\x9e\x92\xbc\xa1\xcf\x6d\xfd\xc5\xf1\x5d\x02\x2a\x7d\xd7\x4b\xe2\x83\x7c\xaa\x93\x4d\x6c\x8c\xf0\x85\x7a\x46\xe2\xd6\x33\xb3\x49\x20\xdd\xdf\xae\x65\x4a\x6b\x94\x12\x59\x40\xc0\x2c\xe9\x85\x8a\xa1\x47\x9f\x2e\x1b\xaa\x71\x6a\x99\x34\x74\x31\x41\xe8\x3d\x1f\xea\x6f\xee\x4d\x02\x8d\x32\x78\xbf\xa4\xfb\x17\xce\xc7\xd1\x60\xcc\x83\x7a\x41\x48\x51\xa6\x8a\xcf\x68\xd8\xd2\x85\x88\x63\x97\x72\x62\xbf\xf2\xfb\x46\xbd\xe8\x18\x22\x68\x3c\x91\x60\x44\x1f\x0e\x2b\xfe\x64\xa3\xa7\xd5\xd9\x80\xe7\x19\x4e\x4b\x90\x49\x81\x4c\x00\x51\xd9\x14\xab\xef\xd8\xe2\x05\xa9\x5a\x6d\x58\xf0\xe1\xb1\x86\xb0\xc1\xc1\x7b\x85\xbb\x86\x82\x6c\x62\x0a\x12\x97\x37\x51\x38\x53\x42\x45\x04\x06\x42\x8a\x07\x6c\x52\x5f\x0c\x8a\xe7\x55\x76\xf0\x56\x9c\x4c\x17\x65\x0e\xdb\x0a\x66\xc3\x25\xa2\x9b\x2a\x44\x2c\x8e\x2a\x44\x5e\xaa\xa8\x60\x88\xc8\x26\x45\x81\x3e\x74\xa3\xcb\xeb\x24\x5d\xeb\x43\x39\xdd\xde\x76\x7e\xe3\x58\x2e\xbf\xec\x4c\x8d\x7d\x9e\x37\xe3\x56\xd8\x50\xb8\xb9\x1d\xd4\x2b\x1e\x99\x39\x8e\xe5\x06\x1b\xf5\xc6\x33\x35\x74\x78\xe0\x6c\xcd\x77\xa6\xfd\xdf\xf2\x92\x91\x56\xc1\xd8\x76\x8e\x91\x14\xea\x58\x3b\xe2\xad\xe3\x5e\xf6\xb4\x9b\x0e\x98\xab\xfe\x9d\xcf\x99\x07\xd2\xc2\x4f\xc5\xfd\xa4\xea\xba\x1d\xe6\xa6\xfe\xdd\xe7\x96\xbe\xa1\xe7\x26\xbd\xdd\xdd\xbb\xd5\x15\x9b\xb2\x4d\x6a\xeb\xe3\xed\xcc\xbc\x42\xc6\x51\xc0\x80\x7a\x16\x9c\xd5\x76\x52\xd8\xfa\x5e\xab\x94\xc3\x75\x16\xbc\xb4\x34\xca\x92\xb6\x44\x92\xdb\xf2\x34\xdb\xe4\x23\xee\xaa\x0e\xa7\x6b\x5b\x00\x41\xe5\x5a\x49\x2c\xbc\x9d\xc8\xbd\x57\xea\x61\x72\xa5\x92\x7b\x6e\xc3\x31\xdc\x50\x31\xa2\xe1\xbd\x6e\x0b\x59\x93\x72\x69\x63\xa9\xd3\xa1\x09\x4d\x79\xab\xbf\x17\x9e\x5a\x34\xf6\x44\xd4\x3b\xc2\xd4\x3b\x42\xd5\xaf\x87\xab\xb6\x5a\x2c\x64\xb5\x9b\xfb\x62\xab\x4d\xa3\x33\xba\xda\x83\x3b\xe0\xab\x33\xe7\x57\x42\xd8\xcd\xc7\xf5\x0d\xc6\xf6\xc2\xd7\xf9\x26\x7c\xdd\x3a\x33\x23\x6c\x2f\x74\x9d\xdf\x31\xba\x36\x5b\x96\x6b\x58\xff\xb2\x08\xab\x44\xaa\x30\xb6\x72\xc4\x7f\x0f\xb0\xd5\x94\x19\x6e\xeb\x94\x3b\xe1\x2e\x16\x2d\x2c\xd0\x2d\x72\x3e\x68\x54\xe4\xc5\xef\x7a\x5f\x8b\x0f\xeb\x6c\x2c\x36\x83\x9a\x80\x58\x17\x43\xf6\x82\x62\x87\xca\xde\x60\x7c\x67\x70\x7c\x67\x80\xfc\xf5\x20\x59\x6b\xc7\xc2\x63\xdd\xd6\x17\x8c\x35\x81\xce\x48\xac\x47\x76\x80\x61\x33\xdb\x57\xc2\xe0\xc2\xe5\xd7\x3c\x0e\xd3\xf8\xdb\x03\x7b\x8b\x4d\xd8\xbb\x71\x46\x99\x67\x76\xc7\xdc\xe2\x8e\x31\xb7\xc1\x74\x2c\xcb\xf9\x17\x42\x5b\x6e\xdf\x5c\x65\xed\x06\xb3\xdc\xbe\x9d\xe4\xce\xf8\x5a\x39\xef\xd9\x74\x9a\xdb\xf9\xca\xaa\xfe\xf4\x2a\x1f\xb5\x76\x6e\xea\x83\xe1\x7f\x79\xfb\xd3\x6b\xb5\x43\xe6\xa7\xe6\xd4\x44\x4f\xf4\xad\x43\xdb\xd4\x68\xf0\x99\x2e\xab\xcf\x98\x06\x07\xf2\xb9\x2f\xa1\x92\xb4\x97\x78\x66\xa3\x9a\x9a\x0c\xbf\xdf\x48\x27\x4d\x7b\xe0\x91\x62\x46\x1f\x04\xfe\x28\x0f\x7b\x63\xdb\x56\x38\xda\x52\x51\xe5\x87\x0b\xc9\xc7\x00\xa9\x81\xe0\x3f\xea\x27\xd9\xb2\xa2\x2e\x0f\x94\x56\xaa\xaf\x4d\xdd\xf9\xc0\x60\x45\x40\x58\x0a\x5e\x07\x41\x00\x3a\x45\x91\xd4\xea\xb5\x4d\x94\xaa\xcf\xf1\xd6\x7a\x70\xbd\x98\xdb\x54\x2c\x54\xb2\x19\xba\xd5\xc2\xfd\x8e\x63\x57\x14\x60\xe4\xbf\xcb\x19\xec\x7b\x7a\x75\xad\x47\xb0\xb9\x91\x2b\xd7\x2c\x16\x67\xa7\xc1\x1f\x1b\x97\x66\xe9\xf0\x6e\x7f\x8b\xbc\x95\x79\xf3\xa9\x7c\xfd\x42\x43\x98\x93\x0b\x34\x2e\xcb\x9a\xcd\xa3\xd3\x81\xf2\xac\xf3\xf6\x65\xf1\x0a\x86\x48\x79\xe8\x37\xfc\xaf\x65\xb4\xc6\xf5\xca\xf4\xd7\xcd\xdb\xd6\xa6\x3b\xb4\xaf\x4e\x7f\x63\x9f\x9e\x5c\x40\xab\x79\x0d\xa3\xc9\x6b\x9c\x29\xad\xd3\xdc\x95\x40\x5e\xa9\xff\xd3\x9a\x64\xa5\x65\x8b\xc3\xd4\x22\x7c\x37\x97\x81\x19\x2d\xa7\xa9\x9a\xf0\xff\x02\xee\x98\x48\x36\x28\x6e\x00\x00"
 
 func xo_dbGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -782,7 +1223,7 @@ func xo_dbGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _xo_packageGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8f\xc1\x52\x04\x21\x0c\x44\xcf\xc3\x67\xe4\xe6\x61\xcd\x37\x05\x88\x0c\x0a\x13\x36\xc9\x6e\xad\x7e\xbd\x05\x5a\xa5\xce\xc1\x5b\x77\xba\xeb\xa5\x7a\x83\x24\x87\xf3\xc3\x21\x6c\x90\xc9\x29\x92\x31\xda\xb5\x9d\x3d\x66\xad\x77\xd6\x79\xe6\x23\x49\xae\x47\xc1\x64\xf7\xe5\x55\x45\x6d\xaa\x97\xbe\x38\xca\x85\x1f\x63\x2a\x73\xad\x47\x59\x99\xd7\xce\x10\xc2\x06\xa5\xfa\x7e\x8b\xcf\x49\x3a\x76\xaa\x4d\x6f\x98\xe3\xe2\xfe\x0a\x1a\x45\x73\x4a\x6f\xc8\x69\x17\x08\xdb\xdf\xb4\x28\x8d\xfd\x52\x64\xec\xac\xf6\xe5\xae\xed\x52\x04\xfe\xab\xcd\x31\x4d\x28\xcf\x0d\xa7\x5e\x63\xfe\xc0\xcc\x5d\x90\xc6\x40\xe5\x46\xef\x67\xd4\x4f\xe5\xfb\x1d\x4a\x7c\xe5\xe4\x06\xe1\x29\x7c\x06\x00\x00\xff\xff\xb3\x04\x94\x6a\x45\x01\x00\x00"
+var _xo_packageGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\x52\xc1\x6e\xc2\x30\x0c\x3d\xd3\xaf\xb0\x7a\x01\x0e\x34\x97\x69\x3f\x30\x76\xe0\xb0\x31\x69\xfc\x40\xda\x98\x36\x2c\x4d\x4a\x12\x10\x5d\xd5\x7f\x9f\x93\x16\x01\x15\xda\xc9\xcf\xcf\x8e\xfd\x62\x9b\x31\xf8\xe2\xc5\x0f\x2f\x11\xba\x0e\xb2\x2b\xee\x7b\x28\x8c\xf6\x5c\x6a\x07\xbe\x42\xf0\x6d\x83\x0e\xf6\xc6\x82\x2b\x2a\xac\x39\xcc\x29\x7b\x84\xd9\xf7\x60\xfb\x7e\x9e\x25\xcd\xd3\x62\x49\xc2\x18\xbc\x19\x81\x50\xa2\x46\xcb\x3d\x0a\xc8\x5b\xb8\x98\x0c\xd6\x5b\xf8\xdc\xee\xe0\x7d\xbd\xd9\x65\x49\x22\xeb\xc6\x58\x0f\x8b\x64\x96\xe6\xad\x47\x97\x12\x08\x42\xf0\xe2\x03\x14\xdc\xf3\x9c\x3b\x64\xee\xa8\xa6\x3e\x13\x56\x9e\xd1\x06\x1a\x75\x61\x84\xd4\x25\x0b\xb1\xd7\x97\x07\xaa\xc2\xcb\x83\x7f\x70\x46\x47\xc2\x5a\x63\x63\xbf\x7d\x1d\x7b\x59\xdc\x2b\x2c\x22\x74\xa4\x29\x5a\x6f\x49\xcc\x79\x84\xf4\x3a\xe6\xbb\x56\x17\xc1\x7a\x59\x63\x9a\x10\x28\xa5\xaf\x4e\x79\x56\x98\x9a\xd5\x5c\x2a\x7b\x62\x22\x8f\xba\xee\x02\x8a\xe7\xce\xd3\x7c\x18\x16\x95\xa1\xd8\x63\xb4\xb4\xbc\xa9\x56\xa5\x69\x2a\xb4\x6e\xf0\x8e\x8a\xfc\xf4\xbf\xb4\x30\x0c\x65\xb8\x08\x33\x98\xe4\x29\xc4\x5f\x26\xb0\x36\x8c\x37\x0d\xb3\xa8\x78\x3b\x2d\x75\x4b\x19\xdb\x31\x93\x1f\xe8\xff\xf4\xc5\xae\x5b\x81\xdc\x03\xd7\x02\x16\x74\x01\x0b\x3c\xde\x76\x9b\x0e\x1d\x5d\xba\x9c\xf0\xd7\xd7\x53\xfe\x78\x42\x2b\x69\xb1\xcb\x25\x64\x1f\x74\x11\xca\x6d\x86\x9d\x87\x2b\x99\xd5\x91\x81\x34\x9c\xcf\x24\x3a\xe8\x40\x12\x41\x99\xa3\xa4\x6c\x88\xba\x81\x02\xcb\x35\xb5\xb8\x27\x67\xb1\xd2\xb3\xd7\x23\x5c\x26\x7f\xf1\x83\x24\x43\x02\x03\x00\x00"
 
 func xo_packageGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -854,36 +1295,57 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"graphql.model.go.tpl": graphqlModelGoTpl,
 	"graphql.query.go.tpl": graphqlQueryGoTpl,
+	"graphql.schema.go.tpl": graphqlSchemaGoTpl,
 	"graphql.type.go.tpl": graphqlTypeGoTpl,
 	"mssql.foreignkey.go.tpl": mssqlForeignkeyGoTpl,
+	"mssql.graphql.loader.go.tpl": mssqlGraphqlLoaderGoTpl,
+	"mssql.hasmany.go.tpl": mssqlHasmanyGoTpl,
 	"mssql.index.go.tpl": mssqlIndexGoTpl,
+	"mssql.manytomany.go.tpl": mssqlManytomanyGoTpl,
+	"mssql.proc.go.tpl": mssqlProcGoTpl,
 	"mssql.query.go.tpl": mssqlQueryGoTpl,
 	"mssql.querytype.go.tpl": mssqlQuerytypeGoTpl,
 	"mssql.type.go.tpl": mssqlTypeGoTpl,
 	"mysql.enum.go.tpl": mysqlEnumGoTpl,
 	"mysql.foreignkey.go.tpl": mysqlForeignkeyGoTpl,
+	"mysql.graphql.loader.go.tpl": mysqlGraphqlLoaderGoTpl,
+	"mysql.hasmany.go.tpl": mysqlHasmanyGoTpl,
 	"mysql.index.go.tpl": mysqlIndexGoTpl,
+	"mysql.json.go.tpl": mysqlJsonGoTpl,
+	"mysql.manytomany.go.tpl": mysqlManytomanyGoTpl,
 	"mysql.proc.go.tpl": mysqlProcGoTpl,
 	"mysql.query.go.tpl": mysqlQueryGoTpl,
 	"mysql.querytype.go.tpl": mysqlQuerytypeGoTpl,
 	"mysql.type.go.tpl": mysqlTypeGoTpl,
 	"oracle.foreignkey.go.tpl": oracleForeignkeyGoTpl,
+	"oracle.graphql.loader.go.tpl": oracleGraphqlLoaderGoTpl,
+	"oracle.hasmany.go.tpl": oracleHasmanyGoTpl,
 	"oracle.index.go.tpl": oracleIndexGoTpl,
+	"oracle.manytomany.go.tpl": oracleManytomanyGoTpl,
+	"oracle.proc.go.tpl": oracleProcGoTpl,
 	"oracle.query.go.tpl": oracleQueryGoTpl,
 	"oracle.querytype.go.tpl": oracleQuerytypeGoTpl,
 	"oracle.type.go.tpl": oracleTypeGoTpl,
+	"postgres.composite.go.tpl": postgresCompositeGoTpl,
 	"postgres.enum.go.tpl": postgresEnumGoTpl,
 	"postgres.foreignkey.go.tpl": postgresForeignkeyGoTpl,
 	"postgres.graphql.bundle.go.tpl": postgresGraphqlBundleGoTpl,
 	"postgres.graphql.loader.go.tpl": postgresGraphqlLoaderGoTpl,
+	"postgres.hasmany.go.tpl": postgresHasmanyGoTpl,
 	"postgres.index.go.tpl": postgresIndexGoTpl,
+	"postgres.json.go.tpl": postgresJsonGoTpl,
+	"postgres.manytomany.go.tpl": postgresManytomanyGoTpl,
 	"postgres.proc.go.tpl": postgresProcGoTpl,
 	"postgres.query.go.tpl": postgresQueryGoTpl,
 	"postgres.querytype.go.tpl": postgresQuerytypeGoTpl,
 	"postgres.type.go.tpl": postgresTypeGoTpl,
 	"sqlite3.foreignkey.go.tpl": sqlite3ForeignkeyGoTpl,
+	"sqlite3.graphql.loader.go.tpl": sqlite3GraphqlLoaderGoTpl,
+	"sqlite3.hasmany.go.tpl": sqlite3HasmanyGoTpl,
 	"sqlite3.index.go.tpl": sqlite3IndexGoTpl,
+	"sqlite3.manytomany.go.tpl": sqlite3ManytomanyGoTpl,
 	"sqlite3.query.go.tpl": sqlite3QueryGoTpl,
 	"sqlite3.querytype.go.tpl": sqlite3QuerytypeGoTpl,
 	"sqlite3.type.go.tpl": sqlite3TypeGoTpl,
@@ -931,36 +1393,57 @@ type bintree struct {
 	Children map[string]*bintree
 }
 var _bintree = &bintree{nil, map[string]*bintree{
+	"graphql.model.go.tpl": &bintree{graphqlModelGoTpl, map[string]*bintree{}},
 	"graphql.query.go.tpl": &bintree{graphqlQueryGoTpl, map[string]*bintree{}},
+	"graphql.schema.go.tpl": &bintree{graphqlSchemaGoTpl, map[string]*bintree{}},
 	"graphql.type.go.tpl": &bintree{graphqlTypeGoTpl, map[string]*bintree{}},
 	"mssql.foreignkey.go.tpl": &bintree{mssqlForeignkeyGoTpl, map[string]*bintree{}},
+	"mssql.graphql.loader.go.tpl": &bintree{mssqlGraphqlLoaderGoTpl, map[string]*bintree{}},
+	"mssql.hasmany.go.tpl": &bintree{mssqlHasmanyGoTpl, map[string]*bintree{}},
 	"mssql.index.go.tpl": &bintree{mssqlIndexGoTpl, map[string]*bintree{}},
+	"mssql.manytomany.go.tpl": &bintree{mssqlManytomanyGoTpl, map[string]*bintree{}},
+	"mssql.proc.go.tpl": &bintree{mssqlProcGoTpl, map[string]*bintree{}},
 	"mssql.query.go.tpl": &bintree{mssqlQueryGoTpl, map[string]*bintree{}},
 	"mssql.querytype.go.tpl": &bintree{mssqlQuerytypeGoTpl, map[string]*bintree{}},
 	"mssql.type.go.tpl": &bintree{mssqlTypeGoTpl, map[string]*bintree{}},
 	"mysql.enum.go.tpl": &bintree{mysqlEnumGoTpl, map[string]*bintree{}},
 	"mysql.foreignkey.go.tpl": &bintree{mysqlForeignkeyGoTpl, map[string]*bintree{}},
+	"mysql.graphql.loader.go.tpl": &bintree{mysqlGraphqlLoaderGoTpl, map[string]*bintree{}},
+	"mysql.hasmany.go.tpl": &bintree{mysqlHasmanyGoTpl, map[string]*bintree{}},
 	"mysql.index.go.tpl": &bintree{mysqlIndexGoTpl, map[string]*bintree{}},
+	"mysql.json.go.tpl": &bintree{mysqlJsonGoTpl, map[string]*bintree{}},
+	"mysql.manytomany.go.tpl": &bintree{mysqlManytomanyGoTpl, map[string]*bintree{}},
 	"mysql.proc.go.tpl": &bintree{mysqlProcGoTpl, map[string]*bintree{}},
 	"mysql.query.go.tpl": &bintree{mysqlQueryGoTpl, map[string]*bintree{}},
 	"mysql.querytype.go.tpl": &bintree{mysqlQuerytypeGoTpl, map[string]*bintree{}},
 	"mysql.type.go.tpl": &bintree{mysqlTypeGoTpl, map[string]*bintree{}},
 	"oracle.foreignkey.go.tpl": &bintree{oracleForeignkeyGoTpl, map[string]*bintree{}},
+	"oracle.graphql.loader.go.tpl": &bintree{oracleGraphqlLoaderGoTpl, map[string]*bintree{}},
+	"oracle.hasmany.go.tpl": &bintree{oracleHasmanyGoTpl, map[string]*bintree{}},
 	"oracle.index.go.tpl": &bintree{oracleIndexGoTpl, map[string]*bintree{}},
+	"oracle.manytomany.go.tpl": &bintree{oracleManytomanyGoTpl, map[string]*bintree{}},
+	"oracle.proc.go.tpl": &bintree{oracleProcGoTpl, map[string]*bintree{}},
 	"oracle.query.go.tpl": &bintree{oracleQueryGoTpl, map[string]*bintree{}},
 	"oracle.querytype.go.tpl": &bintree{oracleQuerytypeGoTpl, map[string]*bintree{}},
 	"oracle.type.go.tpl": &bintree{oracleTypeGoTpl, map[string]*bintree{}},
+	"postgres.composite.go.tpl": &bintree{postgresCompositeGoTpl, map[string]*bintree{}},
 	"postgres.enum.go.tpl": &bintree{postgresEnumGoTpl, map[string]*bintree{}},
 	"postgres.foreignkey.go.tpl": &bintree{postgresForeignkeyGoTpl, map[string]*bintree{}},
 	"postgres.graphql.bundle.go.tpl": &bintree{postgresGraphqlBundleGoTpl, map[string]*bintree{}},
 	"postgres.graphql.loader.go.tpl": &bintree{postgresGraphqlLoaderGoTpl, map[string]*bintree{}},
+	"postgres.hasmany.go.tpl": &bintree{postgresHasmanyGoTpl, map[string]*bintree{}},
 	"postgres.index.go.tpl": &bintree{postgresIndexGoTpl, map[string]*bintree{}},
+	"postgres.json.go.tpl": &bintree{postgresJsonGoTpl, map[string]*bintree{}},
+	"postgres.manytomany.go.tpl": &bintree{postgresManytomanyGoTpl, map[string]*bintree{}},
 	"postgres.proc.go.tpl": &bintree{postgresProcGoTpl, map[string]*bintree{}},
 	"postgres.query.go.tpl": &bintree{postgresQueryGoTpl, map[string]*bintree{}},
 	"postgres.querytype.go.tpl": &bintree{postgresQuerytypeGoTpl, map[string]*bintree{}},
 	"postgres.type.go.tpl": &bintree{postgresTypeGoTpl, map[string]*bintree{}},
 	"sqlite3.foreignkey.go.tpl": &bintree{sqlite3ForeignkeyGoTpl, map[string]*bintree{}},
+	"sqlite3.graphql.loader.go.tpl": &bintree{sqlite3GraphqlLoaderGoTpl, map[string]*bintree{}},
+	"sqlite3.hasmany.go.tpl": &bintree{sqlite3HasmanyGoTpl, map[string]*bintree{}},
 	"sqlite3.index.go.tpl": &bintree{sqlite3IndexGoTpl, map[string]*bintree{}},
+	"sqlite3.manytomany.go.tpl": &bintree{sqlite3ManytomanyGoTpl, map[string]*bintree{}},
 	"sqlite3.query.go.tpl": &bintree{sqlite3QueryGoTpl, map[string]*bintree{}},
 	"sqlite3.querytype.go.tpl": &bintree{sqlite3QuerytypeGoTpl, map[string]*bintree{}},
 	"sqlite3.type.go.tpl": &bintree{sqlite3TypeGoTpl, map[string]*bintree{}},